@@ -0,0 +1,135 @@
+// Package acl restricts which clients a DNS server answers.
+//
+// An ACL holds ordered rules per kind of request: queries, zone transfers
+// (AXFR and IXFR), dynamic updates and NOTIFY. Each rule matches by source
+// network and query type and allows, refuses or drops the request; the first
+// rule that matches wins. A request that matches no rule is refused, so an
+// empty ACL answers nobody and a resolver is only open to the networks it
+// lists:
+//
+//	a := &acl.ACL{Query: []acl.Rule{{Action: acl.ActionAllow, Sources: acl.NewSet(internal...)}}}
+//	handler = a.Wrap(handler)
+//
+// Source networks are kept in a compressed binary trie, so a rule can list
+// tens of thousands of networks at the cost of a few node visits per lookup.
+package acl
+
+import (
+	"net/netip"
+	"slices"
+
+	"github.com/dnsoa/go/dns"
+)
+
+// Action is what becomes of a request that matches a rule.
+type Action uint8
+
+const (
+	// ActionRefuse answers with REFUSED. It is the zero Action, so a rule
+	// without an action denies.
+	ActionRefuse Action = iota
+	// ActionAllow passes the request on.
+	ActionAllow
+	// ActionDrop discards the request without answering.
+	ActionDrop
+)
+
+func (a Action) String() string {
+	switch a {
+	case ActionRefuse:
+		return "REFUSE"
+	case ActionAllow:
+		return "ALLOW"
+	case ActionDrop:
+		return "DROP"
+	}
+	return "UNKNOWN"
+}
+
+// Rule matches requests by source network and query type.
+type Rule struct {
+	Action Action
+	// Sources holds the client networks the rule applies to; nil matches
+	// every client.
+	Sources *Set
+	// Types holds the query types the rule applies to; empty matches every
+	// type.
+	Types []dns.Type
+}
+
+// Match reports whether the rule applies to a request for qtype from src.
+func (r *Rule) Match(src netip.Addr, qtype dns.Type) bool {
+	if r.Sources != nil && !r.Sources.Contains(src) {
+		return false
+	}
+	return len(r.Types) == 0 || slices.Contains(r.Types, qtype)
+}
+
+// ACL is an access control list for a DNS server. Requests with an opcode
+// other than QUERY, UPDATE and NOTIFY are refused.
+type ACL struct {
+	// Query holds the rules for standard queries other than zone transfers.
+	Query []Rule
+	// Transfer holds the rules for AXFR and IXFR queries.
+	Transfer []Rule
+	// Update holds the rules for dynamic updates (RFC 2136).
+	Update []Rule
+	// Notify holds the rules for NOTIFY messages (RFC 1996).
+	Notify []Rule
+	// ECS holds the sources, typically downstream resolvers, whose EDNS
+	// Client Subnet (RFC 7871) is trusted: Query rules match queries from
+	// them by the ECS address rather than by the source address. Other
+	// kinds of request are always matched by the source address. ECS is
+	// ignored when nil.
+	ECS *Set
+}
+
+// Check returns the action for req received from src.
+func (a *ACL) Check(req *dns.Request, src netip.Addr) Action {
+	src = src.Unmap()
+	qtype := req.Question.Type
+	var rules []Rule
+	switch req.Header.OpCode() {
+	case dns.OpcodeQuery:
+		if qtype == dns.TypeAXFR || qtype == dns.TypeIXFR {
+			rules = a.Transfer
+			break
+		}
+		rules = a.Query
+		if a.ECS.Contains(src) {
+			if cs, ok := req.ClientSubnet(); ok && cs.Prefix.Bits() > 0 {
+				src = cs.Prefix.Addr().Unmap()
+			}
+		}
+	case dns.OpcodeUpdate:
+		rules = a.Update
+	case dns.OpcodeNotify:
+		rules = a.Notify
+	}
+	for i := range rules {
+		if rules[i].Match(src, qtype) {
+			return rules[i].Action
+		}
+	}
+	return ActionRefuse
+}
+
+// Handler answers req received from src. A nil response sends no answer.
+type Handler func(req *dns.Request, src netip.Addr) *dns.Response
+
+// Wrap returns a handler that passes the requests allowed by a to next,
+// answers the refused ones with REFUSED and drops the others.
+func (a *ACL) Wrap(next Handler) Handler {
+	return func(req *dns.Request, src netip.Addr) *dns.Response {
+		switch a.Check(req, src) {
+		case ActionAllow:
+			return next(req, src)
+		case ActionDrop:
+			return nil
+		}
+		resp := new(dns.Response)
+		resp.SetReply(req)
+		resp.SetRcode(dns.RcodeRefused)
+		return resp
+	}
+}
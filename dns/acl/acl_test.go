@@ -0,0 +1,247 @@
+package acl
+
+import (
+	"math/rand/v2"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+)
+
+func TestTrie(t *testing.T) {
+	r := assert.New(t)
+	var tr Trie[string]
+	for _, s := range []string{"10.0.0.0/8", "10.1.0.0/16", "10.1.2.0/24", "10.1.0.0/16", "0.0.0.0/0", "2001:db8::/32", "::ffff:192.0.2.0/120"} {
+		tr.Insert(netip.MustParsePrefix(s), s)
+	}
+	r.Equal(6, tr.Len())
+
+	tests := []struct {
+		addr  string
+		match string
+		value string
+	}{
+		{"10.1.2.3", "10.1.2.0/24", "10.1.2.0/24"},
+		{"10.1.3.3", "10.1.0.0/16", "10.1.0.0/16"},
+		{"10.2.3.4", "10.0.0.0/8", "10.0.0.0/8"},
+		{"11.0.0.1", "0.0.0.0/0", "0.0.0.0/0"},
+		{"::ffff:10.1.2.3", "10.1.2.0/24", "10.1.2.0/24"},
+		{"192.0.2.200", "192.0.2.0/24", "::ffff:192.0.2.0/120"},
+		{"2001:db8:1::1", "2001:db8::/32", "2001:db8::/32"},
+		{"2001:db9::1", "", ""},
+	}
+	for _, tt := range tests {
+		p, v, ok := tr.Lookup(netip.MustParseAddr(tt.addr))
+		r.Equal(tt.match != "", ok, tt.addr)
+		if ok {
+			r.Equal(tt.match, p.String(), tt.addr)
+			r.Equal(tt.value, v, tt.addr)
+		}
+	}
+}
+
+// TestTrieRandom checks the trie against a linear scan over tens of
+// thousands of networks.
+func TestTrieRandom(t *testing.T) {
+	r := assert.New(t)
+	rnd := rand.New(rand.NewPCG(1, 2))
+	var tr Trie[netip.Prefix]
+	var prefixes []netip.Prefix
+	for range 40000 {
+		p := randomPrefix(rnd)
+		tr.Insert(p, p)
+		prefixes = append(prefixes, p)
+	}
+	for range 2000 {
+		// Pick addresses near the networks, so that most lookups match.
+		base := prefixes[rnd.IntN(len(prefixes))].Addr()
+		addr := randomAddr(rnd, base.Is4())
+		if rnd.IntN(2) == 0 {
+			p, _ := base.Prefix(base.BitLen() - rnd.IntN(8))
+			addr = p.Addr()
+		}
+		var want netip.Prefix
+		for _, p := range prefixes {
+			if p.Contains(addr) && (!want.IsValid() || p.Bits() > want.Bits()) {
+				want = p
+			}
+		}
+		got, v, ok := tr.Lookup(addr)
+		r.Equal(want.IsValid(), ok, addr.String())
+		if ok {
+			r.Equal(want, got, addr.String())
+			r.Equal(want, v, addr.String())
+		}
+	}
+}
+
+func randomAddr(rnd *rand.Rand, v4 bool) netip.Addr {
+	if v4 {
+		return netip.AddrFrom4([4]byte{byte(rnd.IntN(16)), byte(rnd.Uint32()), byte(rnd.Uint32()), byte(rnd.Uint32())})
+	}
+	var a [16]byte
+	a[0], a[1] = 0x20, 0x01
+	for i := 2; i < len(a); i++ {
+		a[i] = byte(rnd.Uint32())
+	}
+	a[2] &= 0x0f
+	return netip.AddrFrom16(a)
+}
+
+func randomPrefix(rnd *rand.Rand) netip.Prefix {
+	v4 := rnd.IntN(3) > 0
+	addr := randomAddr(rnd, v4)
+	n := 8 + rnd.IntN(25)
+	if !v4 {
+		n = 16 + rnd.IntN(113)
+	}
+	p, _ := addr.Prefix(n)
+	return p
+}
+
+func TestParseSet(t *testing.T) {
+	r := assert.New(t)
+	s, err := ParseSet(strings.NewReader(`# RFC 1918
+10.0.0.0/8
+192.168.0.0/16 # office
+
+2001:db8::1
+`))
+	r.NoError(err)
+	r.Equal(3, s.Len())
+	r.True(s.Contains(netip.MustParseAddr("10.9.9.9")))
+	r.True(s.Contains(netip.MustParseAddr("2001:db8::1")))
+	r.False(s.Contains(netip.MustParseAddr("2001:db8::2")))
+
+	_, err = ParseSet(strings.NewReader("10.0.0.0/8\n10.0.0.0/33\n"))
+	r.ErrorIs(err, ErrBadPrefix)
+	r.Contains(err.Error(), "line 2")
+
+	var none *Set
+	r.False(none.Contains(netip.MustParseAddr("10.0.0.1")))
+}
+
+func newRequest(t *testing.T, op dns.Opcode, qtype dns.Type, subnet string) *dns.Request {
+	raw := new(dns.Request)
+	raw.Header.SetOpCode(op)
+	if subnet != "" {
+		raw.SetEDNS0(1232, false)
+		assert.NoError(t, raw.SetEDNS0ClientSubnet(netip.MustParsePrefix(subnet)))
+	}
+	raw.SetQuestion("example.com.", qtype, dns.ClassINET)
+	req := new(dns.Request)
+	assert.NoError(t, req.Unpack(raw.Raw))
+	return req
+}
+
+func prefixes(s ...string) *Set {
+	set := new(Set)
+	for _, p := range s {
+		set.Add(netip.MustParsePrefix(p))
+	}
+	return set
+}
+
+func TestCheck(t *testing.T) {
+	r := assert.New(t)
+	a := &ACL{
+		Query: []Rule{
+			{Action: ActionDrop, Sources: prefixes("192.0.2.66/32"), Types: []dns.Type{dns.TypeANY}},
+			{Action: ActionRefuse, Types: []dns.Type{dns.TypeANY}},
+			{Action: ActionAllow, Sources: prefixes("192.0.2.0/24", "2001:db8::/32")},
+		},
+		Transfer: []Rule{{Action: ActionAllow, Sources: prefixes("198.51.100.2/32")}},
+		Update:   []Rule{{Action: ActionAllow, Sources: prefixes("198.51.100.3/32")}},
+		Notify:   []Rule{{Action: ActionAllow, Sources: prefixes("198.51.100.0/24")}},
+		ECS:      prefixes("203.0.113.53/32"),
+	}
+
+	tests := []struct {
+		name   string
+		op     dns.Opcode
+		qtype  dns.Type
+		src    string
+		subnet string
+		want   Action
+	}{
+		{"allowed", dns.OpcodeQuery, dns.TypeA, "192.0.2.1", "", ActionAllow},
+		{"mapped", dns.OpcodeQuery, dns.TypeA, "::ffff:192.0.2.1", "", ActionAllow},
+		{"v6", dns.OpcodeQuery, dns.TypeAAAA, "2001:db8::1", "", ActionAllow},
+		{"outside", dns.OpcodeQuery, dns.TypeA, "198.51.100.2", "", ActionRefuse},
+		{"dropped type", dns.OpcodeQuery, dns.TypeANY, "192.0.2.66", "", ActionDrop},
+		{"refused type", dns.OpcodeQuery, dns.TypeANY, "192.0.2.1", "", ActionRefuse},
+		{"transfer", dns.OpcodeQuery, dns.TypeAXFR, "198.51.100.2", "", ActionAllow},
+		{"transfer refused", dns.OpcodeQuery, dns.TypeIXFR, "192.0.2.1", "", ActionRefuse},
+		{"update", dns.OpcodeUpdate, dns.TypeSOA, "198.51.100.3", "", ActionAllow},
+		{"update refused", dns.OpcodeUpdate, dns.TypeSOA, "198.51.100.2", "", ActionRefuse},
+		{"notify", dns.OpcodeNotify, dns.TypeSOA, "198.51.100.9", "", ActionAllow},
+		{"other opcode", dns.OpcodeStatus, dns.TypeA, "192.0.2.1", "", ActionRefuse},
+		{"trusted ecs", dns.OpcodeQuery, dns.TypeA, "203.0.113.53", "192.0.2.0/24", ActionAllow},
+		{"trusted ecs outside", dns.OpcodeQuery, dns.TypeA, "203.0.113.53", "198.51.100.0/24", ActionRefuse},
+		{"untrusted ecs", dns.OpcodeQuery, dns.TypeA, "203.0.113.54", "192.0.2.0/24", ActionRefuse},
+		{"ecs zero source", dns.OpcodeQuery, dns.TypeA, "203.0.113.53", "0.0.0.0/0", ActionRefuse},
+		{"ecs not for transfers", dns.OpcodeQuery, dns.TypeAXFR, "203.0.113.53", "198.51.100.2/32", ActionRefuse},
+	}
+	for _, tt := range tests {
+		req := newRequest(t, tt.op, tt.qtype, tt.subnet)
+		r.Equal(tt.want, a.Check(req, netip.MustParseAddr(tt.src)), tt.name)
+	}
+
+	// The zero ACL answers nobody.
+	r.Equal(ActionRefuse, new(ACL).Check(newRequest(t, dns.OpcodeQuery, dns.TypeA, ""), netip.MustParseAddr("127.0.0.1")))
+}
+
+func TestWrap(t *testing.T) {
+	r := assert.New(t)
+	a := &ACL{Query: []Rule{
+		{Action: ActionDrop, Sources: prefixes("192.0.2.66/32")},
+		{Action: ActionAllow, Sources: prefixes("192.0.2.0/24")},
+	}}
+	h := a.Wrap(func(req *dns.Request, src netip.Addr) *dns.Response {
+		resp := new(dns.Response)
+		resp.SetReply(req)
+		return resp
+	})
+	req := newRequest(t, dns.OpcodeQuery, dns.TypeA, "")
+
+	resp := h(req, netip.MustParseAddr("192.0.2.1"))
+	r.NotNil(resp)
+	r.Equal(dns.RcodeSuccess, resp.Rcode())
+
+	r.True(h(req, netip.MustParseAddr("192.0.2.66")) == nil)
+
+	resp = h(req, netip.MustParseAddr("198.51.100.1"))
+	r.Equal(dns.RcodeRefused, resp.Rcode())
+	r.Equal(req.Header.ID, resp.Header.ID)
+	got := new(dns.Response)
+	r.NoError(got.Unpack(resp.Pack()))
+	r.Equal(dns.RcodeRefused, got.Rcode())
+	r.Equal("example.com.", string(got.Question.Name))
+}
+
+func TestActionString(t *testing.T) {
+	r := assert.New(t)
+	r.Equal("REFUSE", Action(0).String())
+	r.Equal("DROP", ActionDrop.String())
+	r.Equal("UNKNOWN", Action(9).String())
+}
+
+func BenchmarkLookup(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 2))
+	s := new(Set)
+	for range 50000 {
+		s.Add(randomPrefix(rnd))
+	}
+	addrs := make([]netip.Addr, 1024)
+	for i := range addrs {
+		addrs[i] = randomAddr(rnd, i%3 > 0)
+	}
+	b.ReportAllocs()
+	i := 0
+	for b.Loop() {
+		s.Contains(addrs[i%len(addrs)])
+		i++
+	}
+}
@@ -0,0 +1,223 @@
+package acl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"net/netip"
+	"strings"
+
+	"github.com/dnsoa/go/dns"
+)
+
+// ErrBadPrefix is returned by ParseSet for a network that cannot be parsed.
+var ErrBadPrefix = errors.New("acl: bad network")
+
+// Trie maps networks to values and finds the longest network containing an
+// address. It is a binary trie with path compression: a node only exists
+// where a network ends or where two networks branch, so a lookup visits at
+// most one node per distinct prefix length on the path, whatever the number
+// of networks. IPv4-mapped IPv6 addresses and networks are taken as IPv4.
+//
+// The zero Trie is empty and ready to use. A Trie must not be modified
+// concurrently with lookups.
+type Trie[T any] struct {
+	v4, v6 *node[T]
+	n      int
+}
+
+type node[T any] struct {
+	key   [16]byte // the address, masked to bits; IPv4 in the first 4 bytes
+	bits  int
+	child [2]*node[T]
+	set   bool // a network ends here, as opposed to a branch
+	value T
+}
+
+// Len returns the number of networks in the trie.
+func (t *Trie[T]) Len() int {
+	return t.n
+}
+
+// Insert maps the network p to v, replacing any previous value for p.
+// Invalid prefixes are ignored.
+func (t *Trie[T]) Insert(p netip.Prefix, v T) {
+	p = dns.UnmapPrefix(p)
+	if !p.IsValid() {
+		return
+	}
+	key, n := keyOf(p.Addr()), p.Bits()
+	key = mask(key, n)
+	link := t.root(p.Addr())
+	for {
+		cur := *link
+		if cur == nil {
+			*link = &node[T]{key: key, bits: n, set: true, value: v}
+			t.n++
+			return
+		}
+		c := commonBits(&cur.key, &key, min(cur.bits, n))
+		switch {
+		case c == cur.bits && c == n:
+			if !cur.set {
+				cur.set = true
+				t.n++
+			}
+			cur.value = v
+			return
+		case c == cur.bits:
+			// cur contains p: go down.
+			link = &cur.child[bitAt(&key, c)]
+			continue
+		case c == n:
+			// p contains cur: p goes above it.
+			up := &node[T]{key: key, bits: n, set: true, value: v}
+			up.child[bitAt(&cur.key, n)] = cur
+			*link = up
+		default:
+			// p and cur part after c bits: branch there.
+			fork := &node[T]{key: mask(key, c), bits: c}
+			fork.child[bitAt(&cur.key, c)] = cur
+			fork.child[bitAt(&key, c)] = &node[T]{key: key, bits: n, set: true, value: v}
+			*link = fork
+		}
+		t.n++
+		return
+	}
+}
+
+// Lookup returns the longest network containing addr and its value.
+func (t *Trie[T]) Lookup(addr netip.Addr) (p netip.Prefix, v T, ok bool) {
+	addr = addr.Unmap()
+	if !addr.IsValid() {
+		return p, v, false
+	}
+	key, n := keyOf(addr), addr.BitLen()
+	var best *node[T]
+	for cur := *t.root(addr); cur != nil; {
+		if cur.bits > n || commonBits(&cur.key, &key, cur.bits) < cur.bits {
+			break
+		}
+		if cur.set {
+			best = cur
+		}
+		if cur.bits == n {
+			break
+		}
+		cur = cur.child[bitAt(&key, cur.bits)]
+	}
+	if best == nil {
+		return p, v, false
+	}
+	p, _ = addr.Prefix(best.bits)
+	return p, best.value, true
+}
+
+// Contains reports whether a network of the trie contains addr.
+func (t *Trie[T]) Contains(addr netip.Addr) bool {
+	_, _, ok := t.Lookup(addr)
+	return ok
+}
+
+func (t *Trie[T]) root(addr netip.Addr) **node[T] {
+	if addr.Is4() {
+		return &t.v4
+	}
+	return &t.v6
+}
+
+func keyOf(addr netip.Addr) (key [16]byte) {
+	if addr.Is4() {
+		a := addr.As4()
+		copy(key[:], a[:])
+		return key
+	}
+	return addr.As16()
+}
+
+func bitAt(key *[16]byte, i int) int {
+	return int(key[i/8]>>(7-i%8)) & 1
+}
+
+// commonBits returns the length of the common prefix of a and b, up to n bits.
+func commonBits(a, b *[16]byte, n int) int {
+	for i := 0; i*8 < n; i++ {
+		if x := a[i] ^ b[i]; x != 0 {
+			return min(i*8+bits.LeadingZeros8(x), n)
+		}
+	}
+	return n
+}
+
+// mask clears the bits of key after the first n.
+func mask(key [16]byte, n int) [16]byte {
+	for i := n / 8; i < len(key); i++ {
+		if i == n/8 && n%8 != 0 {
+			key[i] &= ^byte(0xff >> (n % 8))
+			continue
+		}
+		key[i] = 0
+	}
+	return key
+}
+
+// Set is a set of networks.
+type Set struct {
+	t Trie[struct{}]
+}
+
+// NewSet returns a set of the networks prefixes.
+func NewSet(prefixes ...netip.Prefix) *Set {
+	s := new(Set)
+	for _, p := range prefixes {
+		s.Add(p)
+	}
+	return s
+}
+
+// ParseSet reads a set of networks from r, one per line in CIDR notation or
+// as a single address. Text after '#' is a comment, and blank lines are
+// skipped.
+//
+//	# RFC 1918
+//	10.0.0.0/8
+//	192.168.0.0/16
+//	2001:db8::1
+func ParseSet(r io.Reader) (*Set, error) {
+	s := new(Set)
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		text, _, _ := strings.Cut(sc.Text(), "#")
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		p, err := dns.ParsePrefix(text)
+		if err != nil {
+			return nil, fmt.Errorf("%w on line %d: %q", ErrBadPrefix, line, text)
+		}
+		s.Add(p)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Add adds the network p to the set.
+func (s *Set) Add(p netip.Prefix) {
+	s.t.Insert(p, struct{}{})
+}
+
+// Contains reports whether a network of the set contains addr. A nil set
+// contains nothing.
+func (s *Set) Contains(addr netip.Addr) bool {
+	return s != nil && s.t.Contains(addr)
+}
+
+// Len returns the number of networks in the set.
+func (s *Set) Len() int {
+	return s.t.Len()
+}
@@ -0,0 +1,583 @@
+// Package aggressive implements the aggressive use of a DNSSEC-validated
+// cache, see RFC 8198.
+//
+// A Cache keeps the NSEC and NSEC3 records of validated negative answers,
+// per zone and in canonical order, and answers queries for other names in
+// the ranges they cover with a synthesized NXDOMAIN or NODATA response, so
+// that queries for random subdomains of a signed zone do not reach its
+// servers.
+//
+//	resp, ok := cache.Synthesize(req)
+//	if !ok {
+//		resp, err = upstream.Exchange(ctx, req)
+//		cache.Add(resp) // after validation
+//	}
+package aggressive
+
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dnsoa/go/dns"
+)
+
+// DefaultCapacity is the number of NSEC and NSEC3 records a Cache made by
+// New with a capacity of zero holds.
+const DefaultCapacity = 10000
+
+// maxRRSize bounds the wire size of a cached record.
+const maxRRSize = 4096
+
+// entry is a cached record and the RRSIGs covering it.
+type entry struct {
+	rr      dns.RR
+	sigs    []dns.RR
+	expires time.Time
+}
+
+// types returns the type bitmap of an NSEC or NSEC3 record.
+func (e *entry) types() []dns.Type {
+	switch rr := e.rr.(type) {
+	case *dns.NSEC:
+		return rr.TypeBitMap
+	case *dns.NSEC3:
+		return rr.TypeBitMap
+	}
+	return nil
+}
+
+// denies reports whether the bitmap of e proves that its owner has no
+// record of qtype: it lacks the type and CNAME, and the owner is no
+// delegation point, whose NSEC proves the absence of DS records only.
+func (e *entry) denies(qtype dns.Type) bool {
+	types := e.types()
+	if slices.Contains(types, qtype) || slices.Contains(types, dns.TypeCNAME) {
+		return false
+	}
+	return qtype == dns.TypeDS || !slices.Contains(types, dns.TypeNS) || slices.Contains(types, dns.TypeSOA)
+}
+
+// cut reports whether the owner of e is a delegation point or has a DNAME,
+// so e proves nothing about the names below it.
+func (e *entry) cut() bool {
+	types := e.types()
+	return slices.Contains(types, dns.TypeDNAME) ||
+		(slices.Contains(types, dns.TypeNS) && !slices.Contains(types, dns.TypeSOA))
+}
+
+// zone holds the cached records of a signed zone. nsec is ordered by the
+// canonical order of the owners, nsec3 by the hashes, which all use the
+// parameters of the zone.
+type zone struct {
+	soa       *entry
+	nsec      []*entry
+	nsecKeys  []dns.NameKey
+	nsec3     []*entry
+	nsec3Keys []string
+	hash      uint8
+	iter      uint16
+	salt      string
+}
+
+func (z *zone) len() int {
+	return len(z.nsec) + len(z.nsec3)
+}
+
+// Cache is a cache of the NSEC and NSEC3 records of validated negative
+// answers. It is safe for concurrent use.
+type Cache struct {
+	mu       sync.Mutex
+	zones    map[string]*zone // by apex, lowercased
+	size     int
+	capacity int
+	now      func() time.Time
+}
+
+// New returns a cache for capacity NSEC and NSEC3 records; DefaultCapacity
+// when zero. Once full, records are only added after others expire.
+func New(capacity int) *Cache {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return &Cache{zones: make(map[string]*zone), capacity: capacity}
+}
+
+func (c *Cache) time() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+// Len returns the number of cached NSEC and NSEC3 records.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// Add caches the NSEC and NSEC3 records of a negative response, NXDOMAIN
+// or NODATA, with the SOA record of their zone. The response must have
+// been validated as secure: responses without the AD bit are ignored. The
+// records are cached for no longer than the negative TTL of the zone, see
+// RFC 9077.
+func (c *Cache) Add(resp *dns.Response) {
+	if !resp.Header.AuthenticatedData() {
+		return
+	}
+	switch resp.Rcode() {
+	case dns.RcodeNameError:
+	case dns.RcodeSuccess:
+		if len(resp.Answer) > 0 {
+			return
+		}
+	default:
+		return
+	}
+	var soa *dns.SOA
+	sigs := make(map[string][]dns.RR)
+	for _, rr := range resp.Ns {
+		switch rr := rr.(type) {
+		case *dns.SOA:
+			soa = rr
+		case *dns.RFC3597:
+			if covered, ok := covers(rr); ok {
+				k := sigKey(rr.Hdr.Name, covered)
+				sigs[k] = append(sigs[k], rr)
+			}
+		}
+	}
+	if soa == nil {
+		return
+	}
+	apex := dns.CanonicalFqdn(soa.Hdr.Name)
+	negTTL := min(soa.Hdr.Ttl, soa.Minttl)
+	now := c.time()
+	newEntry := func(rr dns.RR) *entry {
+		ttl := min(rr.Header().Ttl, negTTL)
+		if ttl == 0 {
+			return nil
+		}
+		e := &entry{rr: clone(rr, ttl), expires: now.Add(time.Duration(ttl) * time.Second)}
+		for _, sig := range sigs[sigKey(rr.Header().Name, rr.Header().Rrtype)] {
+			e.sigs = append(e.sigs, clone(sig, ttl))
+		}
+		if e.rr == nil || slices.Contains(e.sigs, nil) {
+			return nil
+		}
+		return e
+	}
+
+	soaEntry := newEntry(soa)
+	if soaEntry == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	z := c.zones[apex]
+	if z == nil {
+		z = new(zone)
+		c.zones[apex] = z
+	}
+	z.soa = soaEntry
+	for _, rr := range resp.Ns {
+		switch rr := rr.(type) {
+		case *dns.NSEC:
+			if !dns.IsSubDomain(apex, rr.Hdr.Name) {
+				continue
+			}
+			key, err := dns.NameKeyFromString(rr.Hdr.Name)
+			if e := newEntry(rr); e != nil && err == nil {
+				c.insertNSEC(z, key, e, now)
+			}
+		case *dns.NSEC3:
+			label, parent, _ := strings.Cut(dns.CanonicalFqdn(rr.Hdr.Name), ".")
+			if dns.CanonicalFqdn(parent) != apex {
+				continue
+			}
+			if e := newEntry(rr); e != nil {
+				c.insertNSEC3(z, label, e, now)
+			}
+		}
+	}
+}
+
+func (c *Cache) insertNSEC(z *zone, key dns.NameKey, e *entry, now time.Time) {
+	i, found := slices.BinarySearchFunc(z.nsecKeys, key, func(a, b dns.NameKey) int { return a.Compare(&b) })
+	if found {
+		z.nsec[i] = e
+		return
+	}
+	if !c.reserve(now) {
+		return
+	}
+	// reserve may have removed expired records.
+	i, _ = slices.BinarySearchFunc(z.nsecKeys, key, func(a, b dns.NameKey) int { return a.Compare(&b) })
+	z.nsecKeys = slices.Insert(z.nsecKeys, i, key)
+	z.nsec = slices.Insert(z.nsec, i, e)
+}
+
+func (c *Cache) insertNSEC3(z *zone, hash string, e *entry, now time.Time) {
+	rr := e.rr.(*dns.NSEC3)
+	if len(z.nsec3) > 0 && (rr.Hash != z.hash || rr.Iterations != z.iter || !strings.EqualFold(rr.Salt, z.salt)) {
+		// The zone moved to a new chain.
+		c.size -= len(z.nsec3)
+		z.nsec3, z.nsec3Keys = nil, nil
+	}
+	z.hash, z.iter, z.salt = rr.Hash, rr.Iterations, rr.Salt
+	i, found := slices.BinarySearch(z.nsec3Keys, hash)
+	if found {
+		z.nsec3[i] = e
+		return
+	}
+	if !c.reserve(now) {
+		return
+	}
+	i, _ = slices.BinarySearch(z.nsec3Keys, hash)
+	z.nsec3Keys = slices.Insert(z.nsec3Keys, i, hash)
+	z.nsec3 = slices.Insert(z.nsec3, i, e)
+}
+
+// reserve makes room for a new record, removing expired ones when the
+// cache is full, and reports whether there is room.
+func (c *Cache) reserve(now time.Time) bool {
+	if c.size >= c.capacity {
+		c.purge(now)
+	}
+	if c.size >= c.capacity {
+		return false
+	}
+	c.size++
+	return true
+}
+
+// purge removes the expired records, and the zones left without records
+// and with an expired SOA record.
+func (c *Cache) purge(now time.Time) {
+	expired := func(e *entry) bool { return !now.Before(e.expires) }
+	for apex, z := range c.zones {
+		for i := len(z.nsec) - 1; i >= 0; i-- {
+			if expired(z.nsec[i]) {
+				z.nsec = slices.Delete(z.nsec, i, i+1)
+				z.nsecKeys = slices.Delete(z.nsecKeys, i, i+1)
+				c.size--
+			}
+		}
+		for i := len(z.nsec3) - 1; i >= 0; i-- {
+			if expired(z.nsec3[i]) {
+				z.nsec3 = slices.Delete(z.nsec3, i, i+1)
+				z.nsec3Keys = slices.Delete(z.nsec3Keys, i, i+1)
+				c.size--
+			}
+		}
+		if z.len() == 0 && (z.soa == nil || expired(z.soa)) {
+			delete(c.zones, apex)
+		}
+	}
+}
+
+// Synthesize answers req from the cache if cached records prove that the
+// name or type does not exist. The response holds the SOA record of the
+// zone and, for queries with the DO bit, the proving records and their
+// RRSIGs, all with the TTLs they have left.
+func (c *Cache) Synthesize(req *dns.Request) (*dns.Response, bool) {
+	if req.Question.Class != dns.ClassINET {
+		return nil, false
+	}
+	qname := dns.CanonicalFqdn(string(req.Domain))
+	qtype := req.Question.Type
+	now := c.time()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var (
+		z    *zone
+		apex string
+	)
+	for name := range ancestors(qname) {
+		if z = c.zones[name]; z != nil {
+			apex = name
+			break
+		}
+	}
+	if z == nil || z.soa == nil || !now.Before(z.soa.expires) {
+		return nil, false
+	}
+	rcode, proof := z.deny(apex, qname, qtype, now)
+	if proof == nil {
+		return nil, false
+	}
+
+	do := req.DNSSECOK()
+	resp := new(dns.Response)
+	resp.SetReply(req)
+	resp.Header.SetRecursionAvailable()
+	if do || req.Header.AuthenticatedData() {
+		resp.Header.SetAuthenticatedData()
+	}
+	resp.SetRcode(rcode)
+	resp.Ns = z.soa.append(resp.Ns, do, now)
+	if do {
+		for _, e := range proof {
+			resp.Ns = e.append(resp.Ns, true, now)
+		}
+	}
+	if req.OPT.Hdr.Class != 0 {
+		opt := &dns.OPT{Hdr: dns.RR_Header{Name: ".", Rrtype: dns.TypeOPT, Class: 1232}}
+		opt.SetDo(do)
+		resp.Extra = append(resp.Extra, opt)
+	}
+	resp.Header.Nscount = uint16(len(resp.Ns))
+	resp.Header.Arcount = uint16(len(resp.Extra))
+	return resp, true
+}
+
+// append appends the record of e, and its RRSIGs if sigs is set, to rrs
+// with the TTL left at now.
+func (e *entry) append(rrs []dns.RR, sigs bool, now time.Time) []dns.RR {
+	ttl := uint32(e.expires.Sub(now) / time.Second)
+	rrs = append(rrs, clone(e.rr, ttl))
+	if sigs {
+		for _, sig := range e.sigs {
+			rrs = append(rrs, clone(sig, ttl))
+		}
+	}
+	return rrs
+}
+
+// deny returns the rcode and the records that prove that qname, in the
+// zone at apex, has no record of qtype, or no records if the cache holds no
+// proof, see RFC 8198, section 5.
+func (z *zone) deny(apex, qname string, qtype dns.Type, now time.Time) (dns.Rcode, []*entry) {
+	if rcode, proof := z.denyNSEC(apex, qname, qtype, now); proof != nil {
+		return rcode, proof
+	}
+	return z.denyNSEC3(apex, qname, qtype, now)
+}
+
+func (z *zone) denyNSEC(apex, qname string, qtype dns.Type, now time.Time) (dns.Rcode, []*entry) {
+	if e := z.matchNSEC(qname, now); e != nil {
+		if e.denies(qtype) {
+			return dns.RcodeSuccess, []*entry{e}
+		}
+		return 0, nil
+	}
+	cover := z.coverNSEC(qname, now)
+	if cover == nil {
+		return 0, nil
+	}
+	owner := dns.CanonicalFqdn(cover.rr.Header().Name)
+	if cover.cut() && dns.IsSubDomain(owner, qname) {
+		return 0, nil
+	}
+	// The closest encloser is the longest ancestor of qname that is an
+	// ancestor of the owner or the next name.
+	ce := commonAncestor(qname, owner)
+	if next := commonAncestor(qname, dns.CanonicalFqdn(cover.rr.(*dns.NSEC).NextDomain)); len(next) > len(ce) {
+		ce = next
+	}
+	// A next name below qname makes it an empty non-terminal: it exists,
+	// with no records of any type.
+	if ce == qname {
+		return dns.RcodeSuccess, []*entry{cover}
+	}
+	if !dns.IsSubDomain(apex, ce) {
+		return 0, nil
+	}
+	wildcard := "*." + ce
+	if ce == "." {
+		wildcard = "*."
+	}
+	if w := z.matchNSEC(wildcard, now); w != nil {
+		if w.denies(qtype) {
+			return dns.RcodeSuccess, unique(cover, w)
+		}
+		// Answers synthesized from the wildcard are not cached here.
+		return 0, nil
+	}
+	if w := z.coverNSEC(wildcard, now); w != nil {
+		return dns.RcodeNameError, unique(cover, w)
+	}
+	return 0, nil
+}
+
+// matchNSEC returns the unexpired NSEC record owned by name.
+func (z *zone) matchNSEC(name string, now time.Time) *entry {
+	key, err := dns.NameKeyFromString(name)
+	if err != nil {
+		return nil
+	}
+	i, found := slices.BinarySearchFunc(z.nsecKeys, key, func(a, b dns.NameKey) int { return a.Compare(&b) })
+	if !found || !now.Before(z.nsec[i].expires) {
+		return nil
+	}
+	return z.nsec[i]
+}
+
+// coverNSEC returns the unexpired NSEC record that covers name: the last
+// one before it in canonical order, or the last one of all, which covers
+// the names after it when its next name is the apex.
+func (z *zone) coverNSEC(name string, now time.Time) *entry {
+	key, err := dns.NameKeyFromString(name)
+	if err != nil || len(z.nsec) == 0 {
+		return nil
+	}
+	i, _ := slices.BinarySearchFunc(z.nsecKeys, key, func(a, b dns.NameKey) int { return a.Compare(&b) })
+	if i == 0 {
+		i = len(z.nsec)
+	}
+	e := z.nsec[i-1]
+	if !now.Before(e.expires) || !e.rr.(*dns.NSEC).Cover(name) {
+		return nil
+	}
+	return e
+}
+
+func (z *zone) denyNSEC3(apex, qname string, qtype dns.Type, now time.Time) (dns.Rcode, []*entry) {
+	if len(z.nsec3) == 0 {
+		return 0, nil
+	}
+	if e := z.matchNSEC3(qname, now); e != nil {
+		if e.denies(qtype) {
+			return dns.RcodeSuccess, []*entry{e}
+		}
+		return 0, nil
+	}
+	// The closest encloser proof, see RFC 5155, section 7.2.1.
+	for ce := range ancestors(qname) {
+		if !dns.IsSubDomain(apex, ce) {
+			break
+		}
+		match := z.matchNSEC3(ce, now)
+		if match == nil {
+			continue
+		}
+		if match.cut() {
+			return 0, nil
+		}
+		nextCloser := ancestorBelow(qname, ce)
+		cover := z.coverNSEC3(nextCloser, now)
+		// An opt-out range may hide unsigned delegations, see RFC 8198,
+		// section 5.2.
+		if cover == nil || cover.rr.(*dns.NSEC3).OptOut() {
+			return 0, nil
+		}
+		wildcard := "*." + ce
+		if ce == "." {
+			wildcard = "*."
+		}
+		if w := z.matchNSEC3(wildcard, now); w != nil {
+			if w.denies(qtype) {
+				return dns.RcodeSuccess, unique(match, cover, w)
+			}
+			return 0, nil
+		}
+		if w := z.coverNSEC3(wildcard, now); w != nil {
+			return dns.RcodeNameError, unique(match, cover, w)
+		}
+		return 0, nil
+	}
+	return 0, nil
+}
+
+// matchNSEC3 returns the unexpired NSEC3 record whose owner is the hash of
+// name.
+func (z *zone) matchNSEC3(name string, now time.Time) *entry {
+	h := dns.HashName(name, z.hash, z.iter, z.salt)
+	i, found := slices.BinarySearch(z.nsec3Keys, h)
+	if h == "" || !found || !now.Before(z.nsec3[i].expires) {
+		return nil
+	}
+	return z.nsec3[i]
+}
+
+// coverNSEC3 returns the unexpired NSEC3 record that covers the hash of
+// name.
+func (z *zone) coverNSEC3(name string, now time.Time) *entry {
+	h := dns.HashName(name, z.hash, z.iter, z.salt)
+	if h == "" {
+		return nil
+	}
+	i, _ := slices.BinarySearch(z.nsec3Keys, h)
+	if i == 0 {
+		i = len(z.nsec3)
+	}
+	e := z.nsec3[i-1]
+	if !now.Before(e.expires) || !e.rr.(*dns.NSEC3).Cover(name) {
+		return nil
+	}
+	return e
+}
+
+func unique(entries ...*entry) []*entry {
+	return slices.Compact(entries)
+}
+
+// covers returns the type covered by an RRSIG record, which the package dns
+// keeps in the RFC 3597 form.
+func covers(rr *dns.RFC3597) (dns.Type, bool) {
+	if rr.Hdr.Rrtype != dns.TypeRRSIG || len(rr.Rdata) < 4 {
+		return 0, false
+	}
+	t, err := strconv.ParseUint(rr.Rdata[:4], 16, 16)
+	return dns.Type(t), err == nil
+}
+
+func sigKey(name string, covered dns.Type) string {
+	return dns.CanonicalFqdn(name) + "/" + strconv.Itoa(int(covered))
+}
+
+// clone returns a copy of rr with the given TTL, or nil if rr does not
+// pack.
+func clone(rr dns.RR, ttl uint32) dns.RR {
+	buf := make([]byte, maxRRSize)
+	off, err := dns.PackRR(rr, buf, 0, nil)
+	if err != nil {
+		return nil
+	}
+	c, _, err := dns.UnpackRR(buf[:off], 0)
+	if err != nil {
+		return nil
+	}
+	c.Header().Ttl = ttl
+	return c
+}
+
+// ancestors yields name and its ancestors up to the root.
+func ancestors(name string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for {
+			if !yield(name) || name == "." {
+				return
+			}
+			_, parent, _ := strings.Cut(name, ".")
+			name = dns.CanonicalFqdn(parent)
+		}
+	}
+}
+
+// commonAncestor returns the longest common ancestor of two canonical
+// names.
+func commonAncestor(a, b string) string {
+	for name := range ancestors(a) {
+		if dns.IsSubDomain(name, b) {
+			return name
+		}
+	}
+	return "."
+}
+
+// ancestorBelow returns the ancestor of name one label below ce.
+func ancestorBelow(name, ce string) string {
+	for n := range ancestors(name) {
+		if _, parent, _ := strings.Cut(n, "."); dns.CanonicalFqdn(parent) == ce {
+			return n
+		}
+	}
+	return name
+}
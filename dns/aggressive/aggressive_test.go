@@ -0,0 +1,243 @@
+package aggressive
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/dns/dnstest"
+	"github.com/dnsoa/go/dns/nsec"
+)
+
+const zoneFile = `
+$ORIGIN example.
+@ 3600 IN SOA ns1 hostmaster 1 3600 300 3600000 300
+  NS ns1
+a A 192.0.2.1
+m A 192.0.2.2
+  TXT "m"
+ns1 A 192.0.2.53
+sub NS ns1.sub
+ns1.sub A 192.0.2.54
+x.y A 192.0.2.4
+z A 192.0.2.3
+`
+
+// denier is a chain of NSEC or NSEC3 records.
+type denier interface {
+	Deny(qname string, qtype dns.Type) (nsec.Kind, []dns.RR)
+}
+
+// signed plays a validating upstream for the zone: it answers queries for
+// names without records of the type with the proof of the chain, RRSIGs
+// and the AD bit.
+type signed struct {
+	t     *testing.T
+	soa   dns.RR
+	chain denier
+}
+
+func newSigned(t *testing.T, nsec3 *nsec.Params) *signed {
+	rrs, err := dns.ParseZone(strings.NewReader(zoneFile), ".")
+	assert.NoError(t, err)
+	s := &signed{t: t, soa: rrs[0]}
+	if nsec3 != nil {
+		s.chain, err = nsec.NewChain3("example.", rrs, 300, *nsec3)
+	} else {
+		s.chain, err = nsec.NewChain("example.", rrs, 300)
+	}
+	assert.NoError(t, err)
+	return s
+}
+
+func (s *signed) exchange(qname string, qtype dns.Type) *dns.Response {
+	kind, proof := s.chain.Deny(qname, qtype)
+	req := request(qname, qtype, true)
+	resp := new(dns.Response)
+	resp.SetReply(req)
+	resp.Header.SetAuthenticatedData()
+	switch kind {
+	case nsec.NXDomain:
+		resp.SetRcode(dns.RcodeNameError)
+	case nsec.NoData:
+	default:
+		s.t.Fatalf("no denial for %s %s: %s", qname, qtype, kind)
+	}
+	resp.Ns = append(resp.Ns, s.soa, rrsig(s.t, s.soa))
+	for _, rr := range proof {
+		resp.Ns = append(resp.Ns, rr, rrsig(s.t, rr))
+	}
+	return resp
+}
+
+// rrsig returns a made up RRSIG record covering rr.
+func rrsig(t *testing.T, rr dns.RR) dns.RR {
+	h := rr.Header()
+	sig, err := dns.NewRR(fmt.Sprintf(`%s %d IN TYPE46 \# 30 %04x0d020000012c6000000060000000303907076578616d706c6500abcd`, h.Name, h.Ttl, uint16(h.Rrtype)))
+	assert.NoError(t, err)
+	return sig
+}
+
+func request(qname string, qtype dns.Type, do bool) *dns.Request {
+	req := new(dns.Request)
+	req.SetEDNS0(1232, do)
+	req.SetQuestion(qname, qtype, dns.ClassINET)
+	return req
+}
+
+func newCache() (*Cache, *time.Time) {
+	c := New(0)
+	now := time.Unix(1e9, 0)
+	c.now = func() time.Time { return now }
+	return c, &now
+}
+
+func TestNSEC(t *testing.T) {
+	r := assert.New(t)
+	s := newSigned(t, nil)
+	c, now := newCache()
+
+	// b.example. is covered by a. -> m., the wildcard by example. -> a.
+	c.Add(s.exchange("b.example.", dns.TypeA))
+	r.Equal(2, c.Len())
+
+	resp, ok := c.Synthesize(request("C.example", dns.TypeAAAA, true))
+	r.True(ok)
+	dnstest.AssertRcode(t, resp, dns.RcodeNameError)
+	r.True(resp.Header.AuthenticatedData())
+	r.Len(resp.Ns, 6)
+	r.Equal(dns.TypeSOA, resp.Ns[0].Header().Rrtype)
+	r.Equal(dns.TypeRRSIG, resp.Ns[1].Header().Rrtype)
+	r.Equal(dns.TypeNSEC, resp.Ns[2].Header().Rrtype)
+	r.Equal(uint32(300), resp.Ns[0].Header().Ttl)
+	r.Equal(uint16(len(resp.Ns)), resp.Header.Nscount)
+
+	// Without DO only the SOA record is returned.
+	resp, ok = c.Synthesize(request("c.example.", dns.TypeA, false))
+	r.True(ok)
+	r.Len(resp.Ns, 1)
+
+	// Names outside the cached ranges go upstream.
+	_, ok = c.Synthesize(request("n.example.", dns.TypeA, true))
+	r.False(ok)
+	// So do names below a delegation covered by its NSEC.
+	c.Add(s.exchange("sub0.example.", dns.TypeA))
+	_, ok = c.Synthesize(request("www.sub.example.", dns.TypeA, true))
+	r.False(ok)
+
+	// NODATA for an existing name, but not for its types.
+	c.Add(s.exchange("m.example.", dns.TypeAAAA))
+	resp, ok = c.Synthesize(request("m.example.", dns.TypeMX, true))
+	r.True(ok)
+	dnstest.AssertRcode(t, resp, dns.RcodeSuccess)
+	dnstest.AssertAnswerLen(t, resp, 0)
+	_, ok = c.Synthesize(request("m.example.", dns.TypeTXT, true))
+	r.False(ok)
+
+	// y.example. is an empty non-terminal above the next name x.y.example.
+	resp, ok = c.Synthesize(request("y.example.", dns.TypeA, true))
+	r.True(ok)
+	dnstest.AssertRcode(t, resp, dns.RcodeSuccess)
+	dnstest.AssertAnswerLen(t, resp, 0)
+
+	// TTLs count down until the records expire.
+	*now = now.Add(100 * time.Second)
+	resp, ok = c.Synthesize(request("c.example.", dns.TypeA, true))
+	r.True(ok)
+	r.Equal(uint32(200), resp.Ns[0].Header().Ttl)
+	r.Equal(uint32(200), resp.Ns[2].Header().Ttl)
+	*now = now.Add(200 * time.Second)
+	_, ok = c.Synthesize(request("c.example.", dns.TypeA, true))
+	r.False(ok)
+}
+
+func TestNotValidated(t *testing.T) {
+	r := assert.New(t)
+	s := newSigned(t, nil)
+	c, _ := newCache()
+	resp := s.exchange("b.example.", dns.TypeA)
+	resp.Header.Bits &^= 1 << 5 // AD
+	c.Add(resp)
+	r.Equal(0, c.Len())
+	_, ok := c.Synthesize(request("c.example.", dns.TypeA, true))
+	r.False(ok)
+}
+
+func TestNSEC3(t *testing.T) {
+	r := assert.New(t)
+	s := newSigned(t, &nsec.Params{Iterations: 1, Salt: "aabb"})
+	c, _ := newCache()
+
+	// Random subdomains soon fall in cached ranges.
+	asked := 0
+	for i := range 200 {
+		req := request(fmt.Sprintf("rnd%d.example.", i), dns.TypeA, true)
+		if resp, ok := c.Synthesize(req); ok {
+			dnstest.AssertRcode(t, resp, dns.RcodeNameError)
+			continue
+		}
+		asked++
+		c.Add(s.exchange(string(req.Domain), dns.TypeA))
+	}
+	r.True(asked < 50, asked)
+
+	c.Add(s.exchange("a.example.", dns.TypeTXT))
+	resp, ok := c.Synthesize(request("a.example.", dns.TypeMX, true))
+	r.True(ok)
+	dnstest.AssertRcode(t, resp, dns.RcodeSuccess)
+	_, ok = c.Synthesize(request("a.example.", dns.TypeA, true))
+	r.False(ok)
+}
+
+func TestNSEC3OptOut(t *testing.T) {
+	r := assert.New(t)
+	s := newSigned(t, &nsec.Params{OptOut: true})
+	c, _ := newCache()
+	for i := range 50 {
+		c.Add(s.exchange(fmt.Sprintf("rnd%d.example.", i), dns.TypeA))
+	}
+	r.True(c.Len() > 0)
+	// Opt-out ranges may hide unsigned delegations.
+	synthesized := 0
+	for i := range 50 {
+		if _, ok := c.Synthesize(request(fmt.Sprintf("other%d.example.", i), dns.TypeA, true)); ok {
+			synthesized++
+		}
+	}
+	r.True(synthesized < 50, synthesized)
+}
+
+func TestCapacity(t *testing.T) {
+	r := assert.New(t)
+	s := newSigned(t, nil)
+	c, now := newCache()
+	c.capacity = 2
+	c.Add(s.exchange("b.example.", dns.TypeA))
+	c.Add(s.exchange("n.example.", dns.TypeA))
+	r.Equal(2, c.Len())
+	_, ok := c.Synthesize(request("n.example.", dns.TypeA, true))
+	r.False(ok)
+
+	// Expired records make room.
+	*now = now.Add(time.Hour)
+	c.Add(s.exchange("n.example.", dns.TypeA))
+	r.Equal(2, c.Len())
+	_, ok = c.Synthesize(request("mm.example.", dns.TypeA, true))
+	r.True(ok)
+}
+
+func BenchmarkSynthesize(b *testing.B) {
+	s := newSigned(&testing.T{}, nil)
+	c := New(0)
+	c.Add(s.exchange("b.example.", dns.TypeA))
+	req := request("c.example.", dns.TypeA, true)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, ok := c.Synthesize(req); !ok {
+			b.Fatal("not synthesized")
+		}
+	}
+}
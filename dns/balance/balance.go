@@ -0,0 +1,222 @@
+// Package balance serves RRsets as weighted, prioritised and health-checked
+// groups for load balancing and service discovery.
+//
+// A Group holds the A, AAAA or SRV records of one RRset as targets. Pick
+// returns the records of the healthy targets in weighted random order per
+// query, following the selection algorithm of RFC 2782. A Monitor probes the
+// targets with a Checker and takes failing ones out of the answers.
+package balance
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/dnsoa/go/dns"
+)
+
+var (
+	// ErrUnsupportedType is returned when adding a record that is not A, AAAA or SRV.
+	ErrUnsupportedType = errors.New("balance: record type must be A, AAAA or SRV")
+	// ErrTypeMismatch is returned when adding a record of a different type than the group.
+	ErrTypeMismatch = errors.New("balance: record type differs from group")
+)
+
+// Target is a record of a group with its balancing parameters and health.
+type Target struct {
+	RR dns.RR
+	// Priority orders targets: lower values are preferred, higher ones are
+	// used only when no preferred target is healthy.
+	Priority uint16
+	// Weight is the relative chance of the target among targets with the
+	// same priority. As in RFC 2782, a zero weight target still comes first
+	// with a small chance, 1 in (sum of weights + 1).
+	Weight uint16
+
+	healthy atomic.Bool
+	// Consecutive probe results, owned by the Monitor.
+	fails, passes int
+}
+
+// Healthy reports whether the target is served.
+func (t *Target) Healthy() bool {
+	return t.healthy.Load()
+}
+
+// SetHealthy marks the target healthy or not.
+func (t *Target) SetHealthy(healthy bool) {
+	t.healthy.Store(healthy)
+}
+
+// Host returns the address of an A or AAAA target, or the target name of an
+// SRV record without the trailing dot.
+func (t *Target) Host() string {
+	switch rr := t.RR.(type) {
+	case *dns.A:
+		return netip.AddrFrom4(rr.A).String()
+	case *dns.AAAA:
+		return rr.AAAA.String()
+	case *dns.SRV:
+		return strings.TrimSuffix(rr.Target, ".")
+	}
+	return ""
+}
+
+// Port returns the port of an SRV target, and 0 for address records.
+func (t *Target) Port() uint16 {
+	if srv, ok := t.RR.(*dns.SRV); ok {
+		return srv.Port
+	}
+	return 0
+}
+
+// Address returns host:port of the target, using port when the record has none.
+func (t *Target) Address(port uint16) string {
+	if p := t.Port(); p != 0 {
+		port = p
+	}
+	return net.JoinHostPort(t.Host(), strconv.Itoa(int(port)))
+}
+
+// Group is a load balanced RRset. It is safe for concurrent use.
+type Group struct {
+	mu      sync.RWMutex
+	targets []*Target
+	typ     dns.Type
+	// Max limits the number of records returned by Pick; 0 returns all.
+	Max int
+}
+
+// NewGroup returns an empty group.
+func NewGroup() *Group {
+	return &Group{}
+}
+
+// Add adds rr as a healthy target. SRV records carry their own priority and
+// weight; address records get priority 0 and weight 1.
+func (g *Group) Add(rr dns.RR) (*Target, error) {
+	if srv, ok := rr.(*dns.SRV); ok {
+		return g.AddWeighted(rr, srv.Priority, srv.Weight)
+	}
+	return g.AddWeighted(rr, 0, 1)
+}
+
+// AddWeighted adds rr as a healthy target with the given priority and weight.
+func (g *Group) AddWeighted(rr dns.RR, priority, weight uint16) (*Target, error) {
+	typ := rr.Header().Rrtype
+	switch typ {
+	case dns.TypeA, dns.TypeAAAA, dns.TypeSRV:
+	default:
+		return nil, ErrUnsupportedType
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(g.targets) > 0 && g.typ != typ {
+		return nil, ErrTypeMismatch
+	}
+	g.typ = typ
+	t := &Target{RR: rr, Priority: priority, Weight: weight}
+	t.healthy.Store(true)
+	g.targets = append(g.targets, t)
+	return t, nil
+}
+
+// Targets returns the targets of the group.
+func (g *Group) Targets() []*Target {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return slices.Clone(g.targets)
+}
+
+// Pick appends the records to answer a query with to dst and returns it.
+//
+// Healthy targets are ordered by priority, and by weighted random selection
+// within a priority. SRV answers carry all priorities since clients select by
+// priority themselves; address answers carry only the best priority with a
+// healthy target. When no target is healthy all targets are used, so that an
+// outage of the health checks does not take the name down.
+func (g *Group) Pick(dst []dns.RR) []dns.RR {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	var buf [16]*Target
+	candidates := buf[:0]
+	for _, t := range g.targets {
+		if t.Healthy() {
+			candidates = append(candidates, t)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = append(candidates, g.targets...)
+	}
+	slices.SortStableFunc(candidates, func(a, b *Target) int {
+		return int(a.Priority) - int(b.Priority)
+	})
+	if g.typ != dns.TypeSRV && len(candidates) > 0 {
+		n := 1
+		for n < len(candidates) && candidates[n].Priority == candidates[0].Priority {
+			n++
+		}
+		candidates = candidates[:n]
+	}
+
+	limit := len(candidates)
+	if g.Max > 0 && g.Max < limit {
+		limit = g.Max
+	}
+	for i := 0; i < len(candidates) && limit > 0; {
+		j := i + 1
+		for j < len(candidates) && candidates[j].Priority == candidates[i].Priority {
+			j++
+		}
+		tier := candidates[i:j]
+		shuffle(tier)
+		for _, t := range tier[:min(limit, len(tier))] {
+			dst = append(dst, t.RR)
+		}
+		limit -= len(tier)
+		i = j
+	}
+	return dst
+}
+
+// shuffle orders targets of one priority by weighted random selection as
+// described in RFC 2782: zero weight targets go first in the running sum, so
+// they are only picked when the random number in [0, sum] is 0.
+func shuffle(tier []*Target) {
+	slices.SortStableFunc(tier, func(a, b *Target) int {
+		switch {
+		case a.Weight == 0 && b.Weight != 0:
+			return -1
+		case a.Weight != 0 && b.Weight == 0:
+			return 1
+		}
+		return 0
+	})
+	var sum int
+	for _, t := range tier {
+		sum += int(t.Weight)
+	}
+	for i := range tier {
+		n := rand.IntN(sum + 1)
+		k := i
+		for run := 0; k < len(tier); k++ {
+			run += int(tier[k].Weight)
+			if run >= n {
+				break
+			}
+		}
+		picked := tier[k]
+		sum -= int(picked.Weight)
+		// Shift rather than swap to keep the remaining zero weight targets
+		// in front of the others.
+		copy(tier[i+1:k+1], tier[i:k])
+		tier[i] = picked
+	}
+}
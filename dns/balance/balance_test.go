@@ -0,0 +1,203 @@
+package balance
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+)
+
+func newRR(t *testing.T, s string) dns.RR {
+	rr, err := dns.NewRR(s)
+	assert.NoError(t, err)
+	return rr
+}
+
+func hosts(rrs []dns.RR) []string {
+	var out []string
+	for _, rr := range rrs {
+		out = append(out, (&Target{RR: rr}).Host())
+	}
+	return out
+}
+
+func TestGroupAdd(t *testing.T) {
+	r := assert.New(t)
+	g := NewGroup()
+	_, err := g.Add(newRR(t, "www.example.com. 60 IN A 192.0.2.1"))
+	r.NoError(err)
+	_, err = g.Add(newRR(t, "www.example.com. 60 IN AAAA 2001:db8::1"))
+	r.ErrorIs(err, ErrTypeMismatch)
+	_, err = g.Add(newRR(t, "www.example.com. 60 IN TXT hello"))
+	r.ErrorIs(err, ErrUnsupportedType)
+
+	srv := NewGroup()
+	target, err := srv.Add(newRR(t, "_sip._tcp.example.com. 60 IN SRV 10 60 5060 sip.example.com."))
+	r.NoError(err)
+	r.Equal(uint16(10), target.Priority)
+	r.Equal(uint16(60), target.Weight)
+	r.Equal("sip.example.com", target.Host())
+	r.Equal("sip.example.com:5060", target.Address(80))
+	r.True(target.Healthy())
+}
+
+func TestPickWeighted(t *testing.T) {
+	r := assert.New(t)
+	g := NewGroup()
+	g.Max = 1
+	for i, weight := range []uint16{1, 3, 0} {
+		_, err := g.AddWeighted(newRR(t, "www.example.com. 60 IN A 192.0.2."+strconv.Itoa(i+1)), 0, weight)
+		r.NoError(err)
+	}
+	backup, err := g.AddWeighted(newRR(t, "www.example.com. 60 IN A 198.51.100.1"), 1, 1)
+	r.NoError(err)
+
+	counts := map[string]int{}
+	for range 4000 {
+		rrs := g.Pick(nil)
+		r.Equal(1, len(rrs))
+		counts[hosts(rrs)[0]]++
+	}
+	// RFC 2782 draws from [0, sum] so weights 1:3:0 give 1/5, 3/5 and 1/5;
+	// the backup priority is never used.
+	r.True(counts["192.0.2.1"] > 600 && counts["192.0.2.1"] < 1000, counts)
+	r.True(counts["192.0.2.2"] > 2200 && counts["192.0.2.2"] < 2600, counts)
+	r.True(counts["192.0.2.3"] > 600 && counts["192.0.2.3"] < 1000, counts)
+	r.Equal(0, counts["198.51.100.1"])
+
+	// Without Max all records of the best priority are returned.
+	g.Max = 0
+	r.Equal(3, len(g.Pick(nil)))
+
+	// The backup priority takes over when the preferred targets fail.
+	for _, target := range g.Targets()[:3] {
+		target.SetHealthy(false)
+	}
+	r.DeepEqual([]string{"198.51.100.1"}, hosts(g.Pick(nil)))
+
+	// With no healthy target at all, every target is served.
+	backup.SetHealthy(false)
+	r.Equal(3, len(g.Pick(nil)))
+}
+
+func TestPickSRV(t *testing.T) {
+	r := assert.New(t)
+	g := NewGroup()
+	for _, s := range []string{
+		"_sip._tcp.example.com. 60 IN SRV 20 0 5060 c.example.com.",
+		"_sip._tcp.example.com. 60 IN SRV 10 50 5060 a.example.com.",
+		"_sip._tcp.example.com. 60 IN SRV 10 50 5060 b.example.com.",
+	} {
+		_, err := g.Add(newRR(t, s))
+		r.NoError(err)
+	}
+	// SRV answers keep every priority, ordered.
+	rrs := g.Pick(nil)
+	r.Equal(3, len(rrs))
+	r.Equal(uint16(10), rrs[0].(*dns.SRV).Priority)
+	r.Equal(uint16(10), rrs[1].(*dns.SRV).Priority)
+	r.Equal("c.example.com.", rrs[2].(*dns.SRV).Target)
+
+	g.Max = 2
+	r.Equal(2, len(g.Pick(nil)))
+}
+
+func TestMonitor(t *testing.T) {
+	r := assert.New(t)
+	g := NewGroup()
+	for _, s := range []string{"192.0.2.1", "192.0.2.2"} {
+		_, err := g.Add(newRR(t, "www.example.com. 60 IN A "+s))
+		r.NoError(err)
+	}
+	checker := NewFakeChecker()
+	m := NewMonitor(checker, time.Second)
+	m.Fall, m.Rise = 2, 1
+	var changes []bool
+	m.OnChange = func(_ *Target, healthy bool) { changes = append(changes, healthy) }
+	m.Watch(g)
+
+	ctx := context.Background()
+	checker.SetDown("192.0.2.2", true)
+	m.CheckAll(ctx)
+	r.Equal(2, len(g.Pick(nil)))
+	m.CheckAll(ctx)
+	r.DeepEqual([]string{"192.0.2.1"}, hosts(g.Pick(nil)))
+	r.Equal(2, checker.Probes("192.0.2.2"))
+
+	checker.SetDown("192.0.2.2", false)
+	m.CheckAll(ctx)
+	r.Equal(2, len(g.Pick(nil)))
+	r.DeepEqual([]bool{false, true}, changes)
+}
+
+func TestMonitorRun(t *testing.T) {
+	r := assert.New(t)
+	g := NewGroup()
+	target, err := g.Add(newRR(t, "www.example.com. 60 IN A 192.0.2.1"))
+	r.NoError(err)
+	checker := NewFakeChecker()
+	checker.SetDown("192.0.2.1", true)
+	m := NewMonitor(checker, 10*time.Millisecond)
+	m.Watch(g)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		m.Run(ctx)
+		close(done)
+	}()
+	for target.Healthy() {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+}
+
+func TestTCPChecker(t *testing.T) {
+	r := assert.New(t)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	r.NoError(err)
+	port := uint16(ln.Addr().(*net.TCPAddr).Port)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	target := &Target{RR: &dns.A{A: [4]byte{127, 0, 0, 1}}}
+	c := &TCPChecker{Port: port}
+	r.NoError(c.Check(context.Background(), target))
+	ln.Close()
+	r.Error(c.Check(context.Background(), target))
+}
+
+func TestHTTPChecker(t *testing.T) {
+	r := assert.New(t)
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/healthz" || req.Host != "www.example.com" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	ap := netip.MustParseAddrPort(srv.Listener.Addr().String())
+
+	target := &Target{RR: &dns.A{A: ap.Addr().As4()}}
+	c := &HTTPChecker{Path: "/healthz", Host: "www.example.com", Port: ap.Port()}
+	r.NoError(c.Check(context.Background(), target))
+	status = http.StatusServiceUnavailable
+	r.ErrorIs(c.Check(context.Background(), target), ErrUnhealthy)
+}
@@ -0,0 +1,223 @@
+package balance
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrUnhealthy is returned by checkers for a target that failed its probe.
+var ErrUnhealthy = errors.New("balance: target unhealthy")
+
+// Checker probes a target. A nil error means the target is healthy.
+type Checker interface {
+	Check(ctx context.Context, t *Target) error
+}
+
+// TCPChecker probes a target by opening a TCP connection.
+type TCPChecker struct {
+	// Port is dialed for address records; SRV targets use their own port.
+	Port   uint16
+	Dialer net.Dialer
+}
+
+// Check implements Checker.
+func (c *TCPChecker) Check(ctx context.Context, t *Target) error {
+	conn, err := c.Dialer.DialContext(ctx, "tcp", t.Address(c.Port))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// HTTPChecker probes a target with an HTTP GET request. Any 2xx or 3xx
+// status is healthy.
+type HTTPChecker struct {
+	// Client sends the probes; http.DefaultClient is used when nil.
+	Client *http.Client
+	// Scheme is "http" or "https"; "http" when empty.
+	Scheme string
+	// Path is the request path, e.g. "/healthz".
+	Path string
+	// Host overrides the Host header, which defaults to the target address.
+	Host string
+	// Port is used for address records; SRV targets use their own port.
+	Port uint16
+}
+
+// Check implements Checker.
+func (c *HTTPChecker) Check(ctx context.Context, t *Target) error {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	port := c.Port
+	if port == 0 && t.Port() == 0 {
+		port = 80
+		if scheme == "https" {
+			port = 443
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, scheme+"://"+t.Address(port)+c.Path, nil)
+	if err != nil {
+		return err
+	}
+	if c.Host != "" {
+		req.Host = c.Host
+	}
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return ErrUnhealthy
+	}
+	return nil
+}
+
+// FakeChecker is an in-process Checker for tests. Targets are healthy until
+// marked down by host.
+type FakeChecker struct {
+	mu     sync.Mutex
+	down   map[string]bool
+	probes map[string]int
+}
+
+// NewFakeChecker returns a checker that reports every target healthy.
+func NewFakeChecker() *FakeChecker {
+	return &FakeChecker{down: make(map[string]bool), probes: make(map[string]int)}
+}
+
+// SetDown marks the targets with the given host, see Target.Host, down or up.
+func (c *FakeChecker) SetDown(host string, down bool) {
+	c.mu.Lock()
+	c.down[host] = down
+	c.mu.Unlock()
+}
+
+// Probes returns how often targets with the given host were checked.
+func (c *FakeChecker) Probes(host string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.probes[host]
+}
+
+// Check implements Checker.
+func (c *FakeChecker) Check(ctx context.Context, t *Target) error {
+	host := t.Host()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.probes[host]++
+	if c.down[host] {
+		return ErrUnhealthy
+	}
+	return ctx.Err()
+}
+
+// Monitor periodically probes the targets of groups and updates their health.
+type Monitor struct {
+	checker Checker
+	mu      sync.Mutex
+	groups  []*Group
+	// Interval between probe rounds.
+	Interval time.Duration
+	// Timeout bounds a single probe; defaults to Interval.
+	Timeout time.Duration
+	// Fall is the number of consecutive failures that mark a target down,
+	// and Rise the number of consecutive successes that bring it back.
+	// Both default to 1.
+	Fall, Rise int
+	// OnChange, if set, is called when a target changes health. It may be
+	// called from several goroutines at once.
+	OnChange func(t *Target, healthy bool)
+}
+
+// NewMonitor returns a monitor probing with checker every interval.
+func NewMonitor(checker Checker, interval time.Duration) *Monitor {
+	return &Monitor{checker: checker, Interval: interval}
+}
+
+// Watch adds g to the monitored groups.
+func (m *Monitor) Watch(g *Group) {
+	m.mu.Lock()
+	m.groups = append(m.groups, g)
+	m.mu.Unlock()
+}
+
+// Run probes all targets every Interval until ctx is done.
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.Interval)
+	defer ticker.Stop()
+	for {
+		m.CheckAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckAll runs one round of probes, concurrently, and waits for it. Rounds
+// must not overlap, so CheckAll must not be called while Run is running.
+func (m *Monitor) CheckAll(ctx context.Context) {
+	m.mu.Lock()
+	groups := m.groups
+	m.mu.Unlock()
+
+	timeout := m.Timeout
+	if timeout <= 0 {
+		timeout = m.Interval
+	}
+	var wg sync.WaitGroup
+	for _, g := range groups {
+		for _, t := range g.Targets() {
+			wg.Go(func() {
+				pctx, cancel := ctx, context.CancelFunc(func() {})
+				if timeout > 0 {
+					pctx, cancel = context.WithTimeout(ctx, timeout)
+				}
+				err := m.checker.Check(pctx, t)
+				cancel()
+				if ctx.Err() != nil {
+					// Shutting down: the result says nothing about the target.
+					return
+				}
+				m.record(t, err == nil)
+			})
+		}
+	}
+	wg.Wait()
+}
+
+// record counts a probe result and flips the health of t past the thresholds.
+func (m *Monitor) record(t *Target, ok bool) {
+	if ok {
+		t.fails = 0
+		t.passes++
+		if !t.Healthy() && t.passes >= max(m.Rise, 1) {
+			m.set(t, true)
+		}
+		return
+	}
+	t.passes = 0
+	t.fails++
+	if t.Healthy() && t.fails >= max(m.Fall, 1) {
+		m.set(t, false)
+	}
+}
+
+func (m *Monitor) set(t *Target, healthy bool) {
+	t.SetHealthy(healthy)
+	if m.OnChange != nil {
+		m.OnChange(t, healthy)
+	}
+}
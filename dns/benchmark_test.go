@@ -3,6 +3,7 @@ package dns
 import (
 	"encoding/hex"
 	"net"
+	"net/netip"
 	"testing"
 )
 
@@ -314,3 +315,47 @@ func BenchmarkTXTLongString(b *testing.B) {
 		_, _ = rr.pack(msg, 0)
 	}
 }
+
+// Benchmarks for canonical name operations
+
+func BenchmarkCompareName(b *testing.B) {
+	x := EncodeDomain(nil, "www.Example.com")
+	y := EncodeDomain(nil, "mail.example.COM")
+	for b.Loop() {
+		CompareName(x, y)
+	}
+}
+
+func BenchmarkMakeNameKey(b *testing.B) {
+	wire := EncodeDomain(nil, "www.Example.com")
+	m := map[NameKey]int{MakeNameKey(wire): 1}
+	for b.Loop() {
+		_ = m[MakeNameKey(wire)]
+	}
+}
+
+// Benchmarks for reverse mapping names
+
+func BenchmarkReverseAddr(b *testing.B) {
+	v4 := netip.MustParseAddr("192.0.2.1")
+	v6 := netip.MustParseAddr("2001:db8::567:89ab")
+	b.Run("IPv4", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = ReverseAddr(v4)
+		}
+	})
+	b.Run("IPv6", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = ReverseAddr(v6)
+		}
+	})
+}
+
+func BenchmarkParseReverse(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = ParseReverse("1.2.0.192.in-addr.arpa.")
+	}
+}
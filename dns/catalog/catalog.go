@@ -0,0 +1,296 @@
+// Package catalog implements DNS catalog zones, see RFC 9432.
+//
+// A catalog zone lists the member zones a set of secondaries should serve:
+// every member is a PTR record at <unique-id>.zones.<catalog> naming the zone,
+// with optional group and coo (change of ownership) properties below it. A
+// Catalog is parsed from, or generated as, the records of such a zone, and a
+// Consumer follows the versions of one or more catalogs and reports which
+// member zones to add and remove.
+package catalog
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/trie"
+)
+
+// Version is the catalog zone schema version implemented, see RFC 9432,
+// section 4.2.1.
+const Version = "2"
+
+// TTL is the TTL of the generated records. Catalog zones are not meant to
+// be queried, so any value does.
+const TTL = 0
+
+// Labels of a catalog zone.
+const (
+	labelVersion = "version"
+	labelZones   = "zones"
+	labelGroup   = "group"
+	labelCoo     = "coo"
+)
+
+var (
+	// ErrOutOfZone is returned when a record is not below the catalog origin.
+	ErrOutOfZone = errors.New("catalog: record outside of catalog zone")
+	// ErrBadVersion is returned for a catalog without exactly one version
+	// property of a supported version.
+	ErrBadVersion = errors.New("catalog: missing or unsupported version")
+	// ErrBroken is returned for a catalog a consumer must not process, e.g.
+	// one with two member zones at a unique ID, see RFC 9432, section 5.
+	ErrBroken = errors.New("catalog: broken catalog zone")
+	// ErrDuplicateMember is returned when a zone is added to a catalog that
+	// already lists it.
+	ErrDuplicateMember = errors.New("catalog: duplicate member zone")
+)
+
+// Member is a member zone of a catalog.
+type Member struct {
+	// ID is the unique ID of the member, the label below zones.
+	ID string
+	// Zone is the fully qualified, lowercased name of the member zone.
+	Zone string
+	// Groups holds the names of the groups of the member, see RFC 9432,
+	// section 4.3.2.
+	Groups []string
+	// Coo names the catalog the member is migrating to, see RFC 9432,
+	// section 4.3.1; empty if none.
+	Coo string
+}
+
+// coo returns the fully qualified Coo, or "" if none.
+func (m *Member) coo() string {
+	if m.Coo == "" {
+		return ""
+	}
+	return dns.CanonicalFqdn(m.Coo)
+}
+
+// equal reports whether m and o have the same unique ID and properties.
+func (m *Member) equal(o *Member) bool {
+	return m.ID == o.ID && m.Zone == o.Zone && m.coo() == o.coo() && slices.Equal(m.Groups, o.Groups)
+}
+
+// Catalog is a version of a catalog zone.
+type Catalog struct {
+	origin string // fully qualified, lowercased
+	byID   map[string]*Member
+	zones  *trie.DomainTree[*Member]
+}
+
+// New returns an empty catalog with the given origin, e.g.
+// "catalog.example.".
+func New(origin string) *Catalog {
+	return &Catalog{
+		origin: dns.CanonicalFqdn(origin),
+		byID:   make(map[string]*Member),
+		zones:  trie.NewDomainTree[*Member](),
+	}
+}
+
+// Origin returns the origin of the catalog, fully qualified.
+func (c *Catalog) Origin() string {
+	return c.origin
+}
+
+// Len returns the number of member zones.
+func (c *Catalog) Len() int {
+	return len(c.byID)
+}
+
+// Member returns the member for zone.
+func (c *Catalog) Member(zone string) (*Member, bool) {
+	m, ok := c.zones.Lookup(key(zone))
+	// The tree treats "*" labels as wildcards, members are exact names.
+	if !ok || m.Zone != dns.CanonicalFqdn(zone) {
+		return nil, false
+	}
+	return m, true
+}
+
+// Members returns the members ordered by unique ID.
+func (c *Catalog) Members() []*Member {
+	members := make([]*Member, 0, len(c.byID))
+	for _, m := range c.byID {
+		members = append(members, m)
+	}
+	slices.SortFunc(members, func(a, b *Member) int { return strings.Compare(a.ID, b.ID) })
+	return members
+}
+
+// Add adds zone to the catalog and returns its member, for setting the
+// properties. The unique ID is derived from the zone name, so producers
+// that add the same zone choose the same ID.
+func (c *Catalog) Add(zone string) (*Member, error) {
+	zone = dns.CanonicalFqdn(zone)
+	if _, ok := c.Member(zone); ok {
+		return nil, ErrDuplicateMember
+	}
+	sum := sha256.Sum256([]byte(zone))
+	m := &Member{ID: hex.EncodeToString(sum[:8]), Zone: zone}
+	for c.byID[m.ID] != nil {
+		m.ID = randomID()
+	}
+	c.add(m)
+	return m, nil
+}
+
+func (c *Catalog) add(m *Member) {
+	c.byID[m.ID] = m
+	c.zones.Add(key(m.Zone), m)
+}
+
+// Remove removes zone from the catalog and reports whether it was a member.
+func (c *Catalog) Remove(zone string) bool {
+	m, ok := c.Member(zone)
+	if !ok {
+		return false
+	}
+	delete(c.byID, m.ID)
+	c.zones.Remove(key(m.Zone))
+	return true
+}
+
+// Reset gives the member for zone a new unique ID, which makes consumers
+// drop and transfer the zone anew, see RFC 9432, section 5.
+func (c *Catalog) Reset(zone string) bool {
+	m, ok := c.Member(zone)
+	if !ok {
+		return false
+	}
+	delete(c.byID, m.ID)
+	m.ID = randomID()
+	for c.byID[m.ID] != nil {
+		m.ID = randomID()
+	}
+	c.byID[m.ID] = m
+	return true
+}
+
+func randomID() string {
+	var b [8]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// RRs returns the records of the catalog zone with the given SOA serial:
+// the SOA and NS records required at the apex, the version property and
+// the members with their properties, ordered by unique ID.
+func (c *Catalog) RRs(serial uint32) []dns.RR {
+	hdr := func(name string, rrtype dns.Type) dns.RR_Header {
+		return dns.RR_Header{Name: name, Rrtype: rrtype, Class: dns.ClassINET, Ttl: TTL}
+	}
+	rrs := []dns.RR{
+		&dns.SOA{
+			Hdr:     hdr(c.origin, dns.TypeSOA),
+			Ns:      "invalid.",
+			Mbox:    "invalid.",
+			Serial:  serial,
+			Refresh: 3600,
+			Retry:   600,
+			Expire:  2147483646,
+		},
+		&dns.NS{Hdr: hdr(c.origin, dns.TypeNS), NS: "invalid."},
+		&dns.TXT{Hdr: hdr(labelVersion+"."+c.origin, dns.TypeTXT), TXT: []string{Version}},
+	}
+	for _, m := range c.Members() {
+		owner := m.ID + "." + labelZones + "." + c.origin
+		rrs = append(rrs, &dns.PTR{Hdr: hdr(owner, dns.TypePTR), Ptr: m.Zone})
+		if m.Coo != "" {
+			rrs = append(rrs, &dns.PTR{Hdr: hdr(labelCoo+"."+owner, dns.TypePTR), Ptr: m.coo()})
+		}
+		for _, g := range m.Groups {
+			rrs = append(rrs, &dns.TXT{Hdr: hdr(labelGroup+"."+owner, dns.TypeTXT), TXT: []string{g}})
+		}
+	}
+	return rrs
+}
+
+// Load reads a catalog zone in master file format from r.
+func Load(r io.Reader, origin string) (*Catalog, error) {
+	rrs, err := dns.ParseZone(r, dns.CanonicalFqdn(origin))
+	if err != nil {
+		return nil, err
+	}
+	return Parse(origin, rrs)
+}
+
+// Parse builds the catalog with the given origin from the records of its
+// zone. Records it does not know, like ext properties, are skipped; a
+// catalog without a supported version or with ambiguous members is an
+// error.
+func Parse(origin string, rrs []dns.RR) (*Catalog, error) {
+	c := New(origin)
+	var (
+		versions int
+		groups   = make(map[string][]string)
+		coos     = make(map[string][]string)
+	)
+	for _, rr := range rrs {
+		hdr := rr.Header()
+		owner, ok := c.relative(hdr.Name)
+		if !ok {
+			return nil, ErrOutOfZone
+		}
+		labels := strings.Split(owner, ".")
+		switch {
+		case owner == labelVersion && hdr.Rrtype == dns.TypeTXT:
+			versions++
+			if strings.Join(rr.(*dns.TXT).TXT, "") != Version {
+				return nil, ErrBadVersion
+			}
+		case len(labels) == 2 && labels[1] == labelZones && hdr.Rrtype == dns.TypePTR:
+			id := labels[0]
+			if c.byID[id] != nil {
+				return nil, ErrBroken
+			}
+			m := &Member{ID: id, Zone: dns.CanonicalFqdn(rr.(*dns.PTR).Ptr)}
+			if _, ok := c.Member(m.Zone); ok {
+				return nil, ErrBroken
+			}
+			c.add(m)
+		case len(labels) == 3 && labels[2] == labelZones && labels[0] == labelGroup && hdr.Rrtype == dns.TypeTXT:
+			groups[labels[1]] = append(groups[labels[1]], strings.Join(rr.(*dns.TXT).TXT, ""))
+		case len(labels) == 3 && labels[2] == labelZones && labels[0] == labelCoo && hdr.Rrtype == dns.TypePTR:
+			coos[labels[1]] = append(coos[labels[1]], dns.CanonicalFqdn(rr.(*dns.PTR).Ptr))
+		}
+	}
+	if versions != 1 {
+		return nil, ErrBadVersion
+	}
+	for id, m := range c.byID {
+		m.Groups = groups[id]
+		switch len(coos[id]) {
+		case 0:
+		case 1:
+			m.Coo = coos[id][0]
+		default:
+			return nil, ErrBroken
+		}
+	}
+	return c, nil
+}
+
+// relative strips the catalog origin from name.
+func (c *Catalog) relative(name string) (string, bool) {
+	name = dns.CanonicalFqdn(name)
+	if name == c.origin {
+		return "", true
+	}
+	if strings.HasSuffix(name, "."+c.origin) {
+		return name[:len(name)-len(c.origin)-1], true
+	}
+	return "", false
+}
+
+// key returns the DomainTree key of a zone name.
+func key(zone string) string {
+	return strings.TrimSuffix(dns.CanonicalFqdn(zone), ".")
+}
@@ -0,0 +1,197 @@
+package catalog
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+)
+
+const catalogZone = `$TTL 0
+@	IN SOA invalid. invalid. 1 3600 600 2147483646 0
+	IN NS invalid.
+version	IN TXT "2"
+a1.zones	IN PTR example.com.
+group.a1.zones	IN TXT "primary"
+group.a1.zones	IN TXT "signed"
+b2.zones	IN PTR Example.NET.
+coo.b2.zones	IN PTR catalog2.example.
+ext.b2.zones	IN TXT "ignored"
+`
+
+func loadCatalog(t *testing.T, zone string) *Catalog {
+	t.Helper()
+	c, err := Load(strings.NewReader(zone), "catalog.example.")
+	assert.NoError(t, err)
+	return c
+}
+
+func TestParse(t *testing.T) {
+	r := assert.New(t)
+	c := loadCatalog(t, catalogZone)
+	r.Equal("catalog.example.", c.Origin())
+	r.Equal(2, c.Len())
+
+	m, ok := c.Member("EXAMPLE.com")
+	r.True(ok)
+	r.Equal("a1", m.ID)
+	r.DeepEqual([]string{"primary", "signed"}, m.Groups)
+	r.Equal("", m.Coo)
+	m, ok = c.Member("example.net.")
+	r.True(ok)
+	r.Equal("example.net.", m.Zone)
+	r.Equal("catalog2.example.", m.Coo)
+	_, ok = c.Member("sub.example.com.")
+	r.False(ok)
+
+	for _, tt := range []struct {
+		zone string
+		err  error
+	}{
+		{"a1.zones IN PTR example.com.\n", ErrBadVersion},
+		{"version IN TXT \"1\"\n", ErrBadVersion},
+		{"version IN TXT \"2\"\nversion IN TXT \"2\"\n", ErrBadVersion},
+		{"version IN TXT \"2\"\na1.zones IN PTR example.com.\na1.zones IN PTR example.net.\n", ErrBroken},
+		{"version IN TXT \"2\"\na1.zones IN PTR example.com.\nb2.zones IN PTR example.com.\n", ErrBroken},
+		{"version IN TXT \"2\"\na1.zones IN PTR example.com.\ncoo.a1.zones IN PTR x.\ncoo.a1.zones IN PTR y.\n", ErrBroken},
+		{"version IN TXT \"2\"\nexample.org. IN PTR example.com.\n", ErrOutOfZone},
+	} {
+		_, err := Load(strings.NewReader(tt.zone), "catalog.example.")
+		r.ErrorIs(err, tt.err, tt.zone)
+	}
+}
+
+func TestProduce(t *testing.T) {
+	r := assert.New(t)
+	c := New("catalog.example")
+	m, err := c.Add("example.com")
+	r.NoError(err)
+	m.Groups = []string{"signed"}
+	m, err = c.Add("Example.NET.")
+	r.NoError(err)
+	m.Coo = "catalog2.example"
+	_, err = c.Add("example.com.")
+	r.ErrorIs(err, ErrDuplicateMember)
+
+	// Producers choose the same IDs.
+	other := New("catalog.example.")
+	om, _ := other.Add("example.net.")
+	r.Equal(m.ID, om.ID)
+
+	rrs := c.RRs(7)
+	r.Len(rrs, 7)
+	r.Equal(uint32(7), rrs[0].(*dns.SOA).Serial)
+	r.Equal("version.catalog.example.", rrs[2].Header().Name)
+
+	// The generated zone parses back to the same catalog.
+	back, err := Parse("catalog.example.", rrs)
+	r.NoError(err)
+	r.Equal(2, back.Len())
+	for _, m := range c.Members() {
+		bm, ok := back.Member(m.Zone)
+		r.True(ok)
+		r.True(m.equal(bm), m.Zone)
+	}
+
+	id := m.ID
+	r.True(c.Reset("example.net."))
+	r.True(id != m.ID)
+	r.Equal(2, len(c.Members()))
+	r.True(c.Remove("example.net."))
+	r.False(c.Remove("example.net."))
+	r.False(c.Reset("example.net."))
+	r.Equal(1, c.Len())
+	_, ok := c.Member("example.net.")
+	r.False(ok)
+}
+
+func zones(members []*Member) []string {
+	var s []string
+	for _, m := range members {
+		s = append(s, m.Zone)
+	}
+	return s
+}
+
+func TestConsumer(t *testing.T) {
+	r := assert.New(t)
+	c := NewConsumer()
+
+	d := c.Update(loadCatalog(t, catalogZone))
+	r.DeepEqual([]string{"example.com.", "example.net."}, zones(d.Added))
+	r.False(d.Empty())
+	r.True(c.Update(loadCatalog(t, catalogZone)).Empty())
+
+	// example.com. changes groups, example.net. its unique ID, example.org.
+	// is new and example.net. is gone.
+	d = c.Update(loadCatalog(t, `version IN TXT "2"
+a1.zones IN PTR example.com.
+group.a1.zones IN TXT "primary"
+c3.zones IN PTR example.net.
+d4.zones IN PTR example.org.
+`))
+	r.DeepEqual([]string{"example.com."}, zones(d.Updated))
+	r.DeepEqual([]string{"example.net."}, zones(d.Reset))
+	r.DeepEqual([]string{"example.org."}, zones(d.Added))
+	d = c.Update(loadCatalog(t, `version IN TXT "2"
+a1.zones IN PTR example.com.
+group.a1.zones IN TXT "primary"
+c3.zones IN PTR example.net.
+`))
+	r.DeepEqual([]string{"example.org."}, zones(d.Removed))
+	r.Len(d.Added, 0)
+
+	// A second catalog cannot take over example.com. without coo.
+	second := New("catalog2.example.")
+	second.Add("example.com.")
+	second.Add("example.org.")
+	d = c.Update(second)
+	r.DeepEqual([]string{"example.org."}, zones(d.Added))
+	r.DeepEqual([]string{"example.com."}, zones(d.Ignored))
+	origin, _, _ := c.Owner("example.com.")
+	r.Equal("catalog.example.", origin)
+
+	// With coo the first catalog hands example.com. over; the unique ID
+	// differs, so its data is reset.
+	d = c.Update(loadCatalog(t, `version IN TXT "2"
+a1.zones IN PTR example.com.
+coo.a1.zones IN PTR catalog2.example.
+c3.zones IN PTR example.net.
+`))
+	r.DeepEqual([]string{"example.com."}, zones(d.Reset))
+	origin, m, ok := c.Owner("example.com")
+	r.True(ok)
+	r.Equal("catalog2.example.", origin)
+	r.Equal(second.byID[m.ID], m)
+
+	// Dropping it from the first catalog no longer removes it.
+	d = c.Update(loadCatalog(t, `version IN TXT "2"
+c3.zones IN PTR example.net.
+`))
+	r.True(d.Empty())
+
+	d = c.Remove("catalog2.example")
+	removed := zones(d.Removed)
+	slices.Sort(removed)
+	r.DeepEqual([]string{"example.com.", "example.org."}, removed)
+	_, _, ok = c.Owner("example.com.")
+	r.False(ok)
+}
+
+func TestConsumerCooFirst(t *testing.T) {
+	r := assert.New(t)
+	c := NewConsumer()
+	c.Update(loadCatalog(t, catalogZone))
+
+	// example.net. points to catalog2.example. already, which takes it
+	// over with the same unique ID when it lists it.
+	second := New("catalog2.example.")
+	second.add(&Member{ID: "b2", Zone: "example.net."})
+	d := c.Update(second)
+	r.DeepEqual([]string{"example.net."}, zones(d.Updated))
+	r.Len(d.Added, 0)
+	origin, _, _ := c.Owner("example.net.")
+	r.Equal("catalog2.example.", origin)
+}
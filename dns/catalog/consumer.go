@@ -0,0 +1,150 @@
+package catalog
+
+import (
+	"sync"
+
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/trie"
+)
+
+// Diff is what a consumer has to do after a catalog update.
+type Diff struct {
+	// Added holds the member zones to start serving.
+	Added []*Member
+	// Removed holds the member zones to stop serving and delete.
+	Removed []*Member
+	// Reset holds the member zones whose unique ID changed: their data is
+	// to be dropped and transferred anew.
+	Reset []*Member
+	// Updated holds the member zones whose properties changed, or that
+	// moved to another catalog with their unique ID, which keeps their data.
+	Updated []*Member
+	// Ignored holds the member zones served from another catalog that did
+	// not hand them over with a coo property.
+	Ignored []*Member
+}
+
+// Empty reports whether d requires no action.
+func (d *Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Reset) == 0 && len(d.Updated) == 0
+}
+
+// owner is the catalog serving a member zone.
+type owner struct {
+	catalog string
+	member  *Member
+}
+
+// Consumer follows catalog zones and tracks which catalog every member zone
+// is served from. A zone listed by two catalogs is served from the first
+// one, until that catalog hands it over with a coo property naming the
+// other, see RFC 9432, section 5.
+type Consumer struct {
+	mu       sync.Mutex
+	catalogs map[string]*Catalog // latest version by origin
+	owners   *trie.DomainTree[owner]
+}
+
+// NewConsumer returns a consumer that follows no catalogs.
+func NewConsumer() *Consumer {
+	return &Consumer{
+		catalogs: make(map[string]*Catalog),
+		owners:   trie.NewDomainTree[owner](),
+	}
+}
+
+// Owner returns the catalog zone is served from and its member there.
+func (c *Consumer) Owner(zone string) (string, *Member, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	o, ok := c.owner(zone)
+	return o.catalog, o.member, ok
+}
+
+func (c *Consumer) owner(zone string) (owner, bool) {
+	o, ok := c.owners.Lookup(key(zone))
+	// The tree treats "*" labels as wildcards, members are exact names.
+	if !ok || o.member.Zone != dns.CanonicalFqdn(zone) {
+		return owner{}, false
+	}
+	return o, true
+}
+
+// Update makes cat the latest version of its catalog and returns the
+// changes from the previous version, or from nothing for a new catalog.
+// The consumer keeps cat, which must not be modified afterwards.
+func (c *Consumer) Update(cat *Catalog) *Diff {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	d := new(Diff)
+	origin := cat.Origin()
+	if old := c.catalogs[origin]; old != nil {
+		for _, m := range old.Members() {
+			if o, ok := c.owner(m.Zone); ok && o.catalog == origin {
+				if _, ok := cat.Member(m.Zone); !ok {
+					d.Removed = append(d.Removed, o.member)
+					c.owners.Remove(key(m.Zone))
+				}
+			}
+		}
+	}
+	c.catalogs[origin] = cat
+
+	for _, m := range cat.Members() {
+		o, ok := c.owner(m.Zone)
+		switch {
+		case !ok:
+			d.Added = append(d.Added, m)
+			c.owners.Add(key(m.Zone), owner{catalog: origin, member: m})
+		case o.catalog == origin:
+			// The member may be handed over to a catalog that lists it
+			// already.
+			if next := c.catalogs[m.coo()]; next != nil {
+				if n, ok := next.Member(m.Zone); ok {
+					d.change(o.member, n)
+					c.owners.Add(key(m.Zone), owner{catalog: next.Origin(), member: n})
+					continue
+				}
+			}
+			d.change(o.member, m)
+			c.owners.Add(key(m.Zone), owner{catalog: origin, member: m})
+		case o.member.coo() == origin:
+			d.change(o.member, m)
+			c.owners.Add(key(m.Zone), owner{catalog: origin, member: m})
+		default:
+			d.Ignored = append(d.Ignored, m)
+		}
+	}
+	return d
+}
+
+// change records the change from old to m of a served member zone.
+func (d *Diff) change(old, m *Member) {
+	switch {
+	case old.ID != m.ID:
+		d.Reset = append(d.Reset, m)
+	case !old.equal(m):
+		d.Updated = append(d.Updated, m)
+	}
+}
+
+// Remove stops following the catalog with the given origin and returns its
+// member zones, which are to be removed.
+func (c *Consumer) Remove(origin string) *Diff {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	origin = dns.CanonicalFqdn(origin)
+	d := new(Diff)
+	cat := c.catalogs[origin]
+	if cat == nil {
+		return d
+	}
+	delete(c.catalogs, origin)
+	for _, m := range cat.Members() {
+		if o, ok := c.owner(m.Zone); ok && o.catalog == origin {
+			d.Removed = append(d.Removed, o.member)
+			c.owners.Remove(key(m.Zone))
+		}
+	}
+	return d
+}
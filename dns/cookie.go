@@ -0,0 +1,419 @@
+package dns
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// Cookie sizes, see RFC 7873, section 4.
+const (
+	ClientCookieLen    = 8
+	MinServerCookieLen = 8
+	MaxServerCookieLen = 32
+	// serverCookieLen is the size of an RFC 9018 server cookie.
+	serverCookieLen = 16
+)
+
+// RFC 9018 server cookie lifetime rules, section 4.3.
+const (
+	cookieVersion = 1
+	// cookieMaxAge is how long a server cookie is accepted.
+	cookieMaxAge = time.Hour
+	// cookieMaxSkew is how far in the future a timestamp may be.
+	cookieMaxSkew = 5 * time.Minute
+	// cookieRefresh is the age after which a new server cookie is issued.
+	cookieRefresh = 30 * time.Minute
+)
+
+var (
+	// ErrBadCookie is returned for a malformed COOKIE option.
+	ErrBadCookie = errors.New("dns: bad cookie option")
+	// ErrCookieMismatch is returned when a response echoes a client cookie
+	// that was not sent, which indicates a spoofed or misdirected response.
+	ErrCookieMismatch = errors.New("dns: client cookie mismatch")
+)
+
+// Cookie is the content of a COOKIE option, see RFC 7873. Server is empty when
+// the client does not know a server cookie yet.
+type Cookie struct {
+	Server []byte
+	Client [ClientCookieLen]byte
+}
+
+// ParseCookie decodes the data of a COOKIE option. Server aliases data.
+func ParseCookie(data []byte) (Cookie, error) {
+	var c Cookie
+	n := len(data) - ClientCookieLen
+	if n < 0 || n > 0 && (n < MinServerCookieLen || n > MaxServerCookieLen) {
+		return c, ErrBadCookie
+	}
+	copy(c.Client[:], data)
+	if n > 0 {
+		c.Server = data[ClientCookieLen:]
+	}
+	return c, nil
+}
+
+// Pack returns the option data of c.
+func (c Cookie) Pack() []byte {
+	b := make([]byte, 0, ClientCookieLen+len(c.Server))
+	b = append(b, c.Client[:]...)
+	return append(b, c.Server...)
+}
+
+// Cookie returns the COOKIE option of the request. ok is false when there is
+// none; err is set when it is malformed.
+func (r *Request) Cookie() (c Cookie, ok bool, err error) {
+	for _, o := range r.OPT.Options {
+		if o.Code == OptionCodeCookie {
+			c, err = ParseCookie(o.Data)
+			return c, true, err
+		}
+	}
+	return Cookie{}, false, nil
+}
+
+// Cookie returns the COOKIE option of the response, see Request.Cookie.
+func (r *Response) Cookie() (c Cookie, ok bool, err error) {
+	opt := r.opt()
+	if opt == nil {
+		return Cookie{}, false, nil
+	}
+	for _, o := range opt.Options {
+		if o.Code == OptionCodeCookie {
+			c, err = ParseCookie(o.Data)
+			return c, true, err
+		}
+	}
+	return Cookie{}, false, nil
+}
+
+// SetEDNS0Cookie sets the COOKIE option of the response, replacing any
+// existing one. It does nothing when the response carries no OPT record.
+func (r *Response) SetEDNS0Cookie(c Cookie) {
+	r.setOption(OptionCodeCookie, c.Pack())
+}
+
+// CookieJar generates client cookies and remembers the server cookie learned
+// from each server, as a client does in RFC 7873, section 5.3. It is safe for
+// concurrent use.
+type CookieJar struct {
+	mu      sync.Mutex
+	servers map[netip.Addr][]byte
+	secret  [16]byte
+}
+
+// NewCookieJar returns a jar with a random client secret.
+func NewCookieJar() *CookieJar {
+	j := &CookieJar{servers: make(map[netip.Addr][]byte)}
+	rand.Read(j.secret[:])
+	return j
+}
+
+// ClientCookie returns the client cookie used towards server. It is derived
+// from the jar secret and the server address, so it differs per server and
+// does not let servers track the client across each other.
+func (j *CookieJar) ClientCookie(server netip.Addr) [ClientCookieLen]byte {
+	ip := server.Unmap().AsSlice()
+	var c [ClientCookieLen]byte
+	binary.LittleEndian.PutUint64(c[:], sipHash24(&j.secret, ip))
+	return c
+}
+
+// Cookie returns the cookie to send to server: the client cookie and the
+// last server cookie learned from it, if any.
+func (j *CookieJar) Cookie(server netip.Addr) Cookie {
+	server = server.Unmap()
+	j.mu.Lock()
+	sc := j.servers[server]
+	j.mu.Unlock()
+	return Cookie{Client: j.ClientCookie(server), Server: sc}
+}
+
+// SetCookie sets the cookie for server on the request, replacing the one
+// of a previous attempt. The request must have EDNS0 set.
+func (j *CookieJar) SetCookie(req *Request, server netip.Addr) {
+	req.OPT.SetOption(OptionCodeCookie, j.Cookie(server).Pack())
+}
+
+// Update learns the server cookie from a response of server. It returns
+// ErrCookieMismatch when the response echoes another client cookie; such a
+// response must be discarded. A response without a cookie leaves the jar
+// unchanged.
+//
+// A BADCOOKIE response carries a fresh server cookie, so after Update the
+// query can be retried once with SetCookie.
+func (j *CookieJar) Update(server netip.Addr, resp *Response) error {
+	c, ok, err := resp.Cookie()
+	if !ok || err != nil {
+		return err
+	}
+	server = server.Unmap()
+	if c.Client != j.ClientCookie(server) {
+		return ErrCookieMismatch
+	}
+	if len(c.Server) == 0 {
+		return nil
+	}
+	j.mu.Lock()
+	j.servers[server] = bytes.Clone(c.Server)
+	j.mu.Unlock()
+	return nil
+}
+
+// Forget drops the server cookie learned from server.
+func (j *CookieJar) Forget(server netip.Addr) {
+	j.mu.Lock()
+	delete(j.servers, server.Unmap())
+	j.mu.Unlock()
+}
+
+// CookieStatus is the result of checking the cookie of a query.
+type CookieStatus uint8
+
+const (
+	// CookieMissing means the query carries no COOKIE option.
+	CookieMissing CookieStatus = iota
+	// CookieMalformed means the COOKIE option has a bad length.
+	CookieMalformed
+	// CookieClientOnly means the query carries only a client cookie.
+	CookieClientOnly
+	// CookieInvalid means the server cookie is not one this server issued,
+	// or has expired.
+	CookieInvalid
+	// CookieValid means the server cookie was issued by this server to the
+	// client and is fresh.
+	CookieValid
+)
+
+func (s CookieStatus) String() string {
+	switch s {
+	case CookieMissing:
+		return "missing"
+	case CookieMalformed:
+		return "malformed"
+	case CookieClientOnly:
+		return "client-only"
+	case CookieInvalid:
+		return "invalid"
+	case CookieValid:
+		return "valid"
+	}
+	return "unknown"
+}
+
+// CookieServer issues and verifies RFC 9018 server cookies: a version,
+// a timestamp and a SipHash-2-4 MAC over the client cookie and client address.
+// Servers of an anycast set share Secret so each accepts cookies of the others.
+type CookieServer struct {
+	// Secret keys the MAC of new cookies.
+	Secret [16]byte
+	// Previous, if set, is still accepted to roll Secret over without
+	// invalidating the cookies clients hold.
+	Previous *[16]byte
+	// RequireAbove makes UDP responses larger than this many octets require
+	// a valid server cookie; 0 disables the check. Clients without one get
+	// BADCOOKIE, or a truncated response when they sent no cookie at all,
+	// which limits the amplification of spoofed queries.
+	RequireAbove int
+	// Require makes every UDP query with a COOKIE option but no valid server
+	// cookie be answered with BADCOOKIE.
+	Require bool
+
+	now func() time.Time
+}
+
+// NewCookieServer returns a server with the given secret.
+func NewCookieServer(secret [16]byte) *CookieServer {
+	return &CookieServer{Secret: secret}
+}
+
+func (s *CookieServer) time() time.Time {
+	if s.now != nil {
+		return s.now()
+	}
+	return time.Now()
+}
+
+// ServerCookie returns a new server cookie for the client cookie and client
+// address.
+func (s *CookieServer) ServerCookie(client [ClientCookieLen]byte, addr netip.Addr) []byte {
+	b := make([]byte, serverCookieLen)
+	b[0] = cookieVersion
+	binary.BigEndian.PutUint32(b[4:], uint32(s.time().Unix()))
+	binary.LittleEndian.PutUint64(b[8:], cookieMAC(&s.Secret, client, b[:8], addr))
+	return b
+}
+
+// Verify checks the cookie of a query from addr.
+func (s *CookieServer) Verify(c Cookie, addr netip.Addr) CookieStatus {
+	if len(c.Server) == 0 {
+		return CookieClientOnly
+	}
+	if len(c.Server) != serverCookieLen || c.Server[0] != cookieVersion {
+		return CookieInvalid
+	}
+	if !s.fresh(c.Server) {
+		return CookieInvalid
+	}
+	mac := binary.LittleEndian.Uint64(c.Server[8:])
+	if cookieMAC(&s.Secret, c.Client, c.Server[:8], addr) == mac {
+		return CookieValid
+	}
+	if s.Previous != nil && cookieMAC(s.Previous, c.Client, c.Server[:8], addr) == mac {
+		return CookieValid
+	}
+	return CookieInvalid
+}
+
+// fresh checks the timestamp of a server cookie with serial number
+// arithmetic, so it keeps working when the 32-bit timestamp wraps.
+func (s *CookieServer) fresh(server []byte) bool {
+	ts := binary.BigEndian.Uint32(server[4:])
+	age := time.Duration(int32(uint32(s.time().Unix())-ts)) * time.Second
+	return age <= cookieMaxAge && age >= -cookieMaxSkew
+}
+
+// Check verifies the cookie of a query from addr.
+func (s *CookieServer) Check(req *Request, addr netip.Addr) (Cookie, CookieStatus) {
+	c, ok, err := req.Cookie()
+	switch {
+	case !ok:
+		return c, CookieMissing
+	case err != nil:
+		return c, CookieMalformed
+	}
+	return c, s.Verify(c, addr)
+}
+
+// Respond applies the cookie rules of RFC 7873, section 5.2 to resp, the
+// answer to req from addr, once it is complete. udp tells whether the
+// response goes over UDP, where the policy of Require and RequireAbove
+// applies. It returns the status of the query cookie.
+//
+// A malformed cookie turns resp into FORMERR. Otherwise, when the query has a
+// client cookie, resp gets it back with a server cookie: the one of the query
+// if it is valid and recent, a new one if not.
+func (s *CookieServer) Respond(req *Request, addr netip.Addr, resp *Response, udp bool) CookieStatus {
+	c, status := s.Check(req, addr)
+	switch status {
+	case CookieMalformed:
+		clearSections(resp)
+		resp.SetRcode(RcodeFormatError)
+		return status
+	case CookieMissing:
+		if udp && s.tooLarge(resp) {
+			clearSections(resp)
+			resp.Header.SetTruncated()
+		}
+		return status
+	}
+
+	server := c.Server
+	if status != CookieValid || s.stale(server) {
+		server = s.ServerCookie(c.Client, addr)
+	}
+	if udp && status != CookieValid && (s.Require || s.tooLarge(resp)) {
+		clearSections(resp)
+		resp.SetRcode(RcodeBadCookie)
+	}
+	resp.SetEDNS0Cookie(Cookie{Client: c.Client, Server: server})
+	return status
+}
+
+// stale reports whether a valid server cookie should be replaced.
+func (s *CookieServer) stale(server []byte) bool {
+	ts := binary.BigEndian.Uint32(server[4:])
+	age := time.Duration(int32(uint32(s.time().Unix())-ts)) * time.Second
+	return age > cookieRefresh
+}
+
+func (s *CookieServer) tooLarge(resp *Response) bool {
+	if s.RequireAbove <= 0 {
+		return false
+	}
+	b, err := resp.PackTo(nil)
+	return err != nil || len(b) > s.RequireAbove
+}
+
+// clearSections empties the answer, authority and additional sections of resp
+// but keeps the OPT record.
+func clearSections(resp *Response) {
+	opt := resp.opt()
+	resp.Answer = resp.Answer[:0]
+	resp.Ns = resp.Ns[:0]
+	resp.Extra = resp.Extra[:0]
+	if opt != nil {
+		resp.Extra = append(resp.Extra, opt)
+	}
+	resp.Header.Ancount = 0
+	resp.Header.Nscount = 0
+	resp.Header.Arcount = uint16(len(resp.Extra))
+}
+
+// cookieMAC computes the RFC 9018 hash over the client cookie, the version,
+// reserved and timestamp fields (head) and the client address.
+func cookieMAC(secret *[16]byte, client [ClientCookieLen]byte, head []byte, addr netip.Addr) uint64 {
+	var buf [ClientCookieLen + 8 + 16]byte
+	b := append(buf[:0], client[:]...)
+	b = append(b, head...)
+	b = append(b, addr.Unmap().AsSlice()...)
+	return sipHash24(secret, b)
+}
+
+// sipHash24 returns the SipHash-2-4 MAC of msg with key.
+func sipHash24(key *[16]byte, msg []byte) uint64 {
+	k0 := binary.LittleEndian.Uint64(key[:8])
+	k1 := binary.LittleEndian.Uint64(key[8:])
+	v0 := k0 ^ 0x736f6d6570736575
+	v1 := k1 ^ 0x646f72616e646f6d
+	v2 := k0 ^ 0x6c7967656e657261
+	v3 := k1 ^ 0x7465646279746573
+
+	round := func() {
+		v0 += v1
+		v1 = bits.RotateLeft64(v1, 13)
+		v1 ^= v0
+		v0 = bits.RotateLeft64(v0, 32)
+		v2 += v3
+		v3 = bits.RotateLeft64(v3, 16)
+		v3 ^= v2
+		v0 += v3
+		v3 = bits.RotateLeft64(v3, 21)
+		v3 ^= v0
+		v2 += v1
+		v1 = bits.RotateLeft64(v1, 17)
+		v1 ^= v2
+		v2 = bits.RotateLeft64(v2, 32)
+	}
+
+	n := len(msg)
+	for ; len(msg) >= 8; msg = msg[8:] {
+		m := binary.LittleEndian.Uint64(msg)
+		v3 ^= m
+		round()
+		round()
+		v0 ^= m
+	}
+	var last [8]byte
+	copy(last[:], msg)
+	last[7] = byte(n)
+	m := binary.LittleEndian.Uint64(last[:])
+	v3 ^= m
+	round()
+	round()
+	v0 ^= m
+
+	v2 ^= 0xff
+	round()
+	round()
+	round()
+	round()
+	return v0 ^ v1 ^ v2 ^ v3
+}
@@ -0,0 +1,210 @@
+package dns
+
+import (
+	"encoding/hex"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/dnsoa/go/assert"
+)
+
+func TestSipHash24(t *testing.T) {
+	r := assert.New(t)
+	// Test vector from the SipHash paper, appendix A.
+	var key [16]byte
+	for i := range key {
+		key[i] = byte(i)
+	}
+	msg := make([]byte, 15)
+	for i := range msg {
+		msg[i] = byte(i)
+	}
+	r.Equal(uint64(0xa129ca6149be45e5), sipHash24(&key, msg))
+}
+
+func TestServerCookieRFC9018(t *testing.T) {
+	r := assert.New(t)
+	// RFC 9018, appendix A.1: learning a new server cookie.
+	var secret [16]byte
+	hex.Decode(secret[:], []byte("e5e973e5a6b2a43f48e7dc849e37bfcf"))
+	s := NewCookieServer(secret)
+	s.now = func() time.Time { return time.Unix(1559731985, 0) }
+
+	var client [ClientCookieLen]byte
+	hex.Decode(client[:], []byte("2464c4abcf10c957"))
+	addr := netip.MustParseAddr("198.51.100.100")
+	server := s.ServerCookie(client, addr)
+	r.Equal("010000005cf79f111f8130c3eee29480", hex.EncodeToString(server))
+
+	c := Cookie{Client: client, Server: server}
+	r.Equal(CookieValid, s.Verify(c, addr))
+	r.Equal(CookieInvalid, s.Verify(c, netip.MustParseAddr("198.51.100.101")))
+	r.Equal(CookieClientOnly, s.Verify(Cookie{Client: client}, addr))
+
+	// Too old, or too far in the future.
+	s.now = func() time.Time { return time.Unix(1559731985, 0).Add(61 * time.Minute) }
+	r.Equal(CookieInvalid, s.Verify(c, addr))
+	s.now = func() time.Time { return time.Unix(1559731985, 0).Add(-6 * time.Minute) }
+	r.Equal(CookieInvalid, s.Verify(c, addr))
+
+	// Cookies of the previous secret stay valid during a rollover.
+	s.now = func() time.Time { return time.Unix(1559731985, 0) }
+	s.Previous = &secret
+	s.Secret = [16]byte{1}
+	r.Equal(CookieValid, s.Verify(c, addr))
+	s.Previous = nil
+	r.Equal(CookieInvalid, s.Verify(c, addr))
+}
+
+func TestParseCookie(t *testing.T) {
+	r := assert.New(t)
+	for _, n := range []int{0, 7, 9, 15, 41} {
+		_, err := ParseCookie(make([]byte, n))
+		r.ErrorIs(err, ErrBadCookie, n)
+	}
+	for _, n := range []int{8, 16, 40} {
+		c, err := ParseCookie(make([]byte, n))
+		r.NoError(err, n)
+		r.Equal(n-8, len(c.Server))
+		r.Equal(n, len(c.Pack()))
+	}
+}
+
+// cookieQuery builds a query with the cookie of the jar and returns it parsed
+// from the wire, as a server sees it.
+func cookieQuery(t *testing.T, j *CookieJar, server netip.Addr) *Request {
+	q := new(Request)
+	q.SetEDNS0(1232, false)
+	if j != nil {
+		j.SetCookie(q, server)
+	}
+	q.SetQuestion("example.com", TypeTXT, ClassINET)
+	req := new(Request)
+	assert.NoError(t, req.Unpack(q.Raw))
+	return req
+}
+
+func cookieReply(size int) *Response {
+	resp := new(Response)
+	resp.Header.SetResponse()
+	resp.SetQuestion("example.com.", TypeTXT, ClassINET)
+	resp.Header.Qdcount = 1
+	resp.Answer = append(resp.Answer, &TXT{
+		Hdr: RR_Header{Name: "example.com.", Rrtype: TypeTXT, Class: ClassINET, Ttl: 60},
+		TXT: []string{string(make([]byte, size))},
+	})
+	resp.Header.Ancount = 1
+	resp.Extra = append(resp.Extra, &OPT{Hdr: RR_Header{Name: ".", Rrtype: TypeOPT, Class: 1232}})
+	resp.Header.Arcount = 1
+	return resp
+}
+
+func TestCookieExchange(t *testing.T) {
+	r := assert.New(t)
+	serverAddr := netip.MustParseAddr("192.0.2.53")
+	clientAddr := netip.MustParseAddr("198.51.100.7")
+	s := NewCookieServer([16]byte{42})
+	s.RequireAbove = 512
+	jar := NewCookieJar()
+
+	// First query: only a client cookie. The small answer is served with a
+	// server cookie the client learns.
+	resp := cookieReply(100)
+	r.Equal(CookieClientOnly, s.Respond(cookieQuery(t, jar, serverAddr), clientAddr, resp, true))
+	r.Equal(RcodeSuccess, resp.Rcode())
+	r.Equal(1, len(resp.Answer))
+	r.NoError(jar.Update(serverAddr, resp))
+	r.Equal(serverCookieLen, len(jar.Cookie(serverAddr).Server))
+
+	// A large answer over UDP needs a valid server cookie, which the client
+	// now has.
+	resp = cookieReply(1000)
+	r.Equal(CookieValid, s.Respond(cookieQuery(t, jar, serverAddr), clientAddr, resp, true))
+	r.Equal(RcodeSuccess, resp.Rcode())
+	r.Equal(1, len(resp.Answer))
+
+	// A client without a server cookie gets BADCOOKIE and a fresh cookie,
+	// and succeeds on retry.
+	other := NewCookieJar()
+	resp = cookieReply(1000)
+	r.Equal(CookieClientOnly, s.Respond(cookieQuery(t, other, serverAddr), clientAddr, resp, true))
+	r.Equal(RcodeBadCookie, resp.Rcode())
+	r.Equal(0, len(resp.Answer))
+	wire, err := resp.PackTo(nil)
+	r.NoError(err)
+	parsed := new(Response)
+	r.NoError(parsed.Unpack(wire))
+	r.Equal(RcodeBadCookie, parsed.Rcode())
+	r.NoError(other.Update(serverAddr, parsed))
+	resp = cookieReply(1000)
+	r.Equal(CookieValid, s.Respond(cookieQuery(t, other, serverAddr), clientAddr, resp, true))
+	r.Equal(1, len(resp.Answer))
+
+	// Over TCP the size does not matter.
+	resp = cookieReply(1000)
+	s.Respond(cookieQuery(t, NewCookieJar(), serverAddr), clientAddr, resp, false)
+	r.Equal(RcodeSuccess, resp.Rcode())
+
+	// Without any cookie a large UDP answer is truncated.
+	resp = cookieReply(1000)
+	r.Equal(CookieMissing, s.Respond(cookieQuery(t, nil, serverAddr), clientAddr, resp, true))
+	r.True(resp.Header.Truncated())
+	r.Equal(0, len(resp.Answer))
+
+	// Cookies are bound to the server address and spoofed echoes are caught.
+	r.True(jar.ClientCookie(serverAddr) != jar.ClientCookie(clientAddr))
+	r.ErrorIs(other.Update(clientAddr, parsed), ErrCookieMismatch)
+	jar.Forget(serverAddr)
+	r.Equal(0, len(jar.Cookie(serverAddr).Server))
+}
+
+func TestCookieJarRetry(t *testing.T) {
+	r := assert.New(t)
+	serverAddr := netip.MustParseAddr("192.0.2.53")
+	s := NewCookieServer([16]byte{7})
+	jar := NewCookieJar()
+
+	// The retry after BADCOOKIE reuses the request with the learned cookie.
+	q := new(Request)
+	q.SetEDNS0(1232, false)
+	jar.SetCookie(q, serverAddr)
+	q.SetQuestion("example.com", TypeTXT, ClassINET)
+	req := new(Request)
+	r.NoError(req.Unpack(q.Raw))
+	resp := cookieReply(100)
+	s.Respond(req, netip.MustParseAddr("198.51.100.7"), resp, true)
+	r.NoError(jar.Update(serverAddr, resp))
+
+	jar.SetCookie(q, serverAddr)
+	q.SetQuestion("example.com", TypeTXT, ClassINET)
+	req = new(Request)
+	r.NoError(req.Unpack(q.Raw))
+	r.Equal(1, len(req.OPT.Options))
+	r.Equal(uint16(4+ClientCookieLen+serverCookieLen), req.OPT.Hdr.Rdlength)
+	c, ok, err := req.Cookie()
+	r.True(ok)
+	r.NoError(err)
+	r.DeepEqual(jar.Cookie(serverAddr), c)
+}
+
+func TestCookieMalformed(t *testing.T) {
+	r := assert.New(t)
+	s := NewCookieServer([16]byte{1})
+	q := new(Request)
+	q.SetEDNS0(1232, false)
+	q.SetEDNS0Cookie(make([]byte, 12))
+	q.SetQuestion("example.com", TypeA, ClassINET)
+	req := new(Request)
+	r.NoError(req.Unpack(q.Raw))
+
+	resp := cookieReply(10)
+	r.Equal(CookieMalformed, s.Respond(req, netip.MustParseAddr("192.0.2.1"), resp, true))
+	r.Equal(RcodeFormatError, resp.Rcode())
+	r.Equal(0, len(resp.Answer))
+
+	s.Require = true
+	resp = cookieReply(10)
+	s.Respond(cookieQuery(t, NewCookieJar(), netip.MustParseAddr("192.0.2.1")), netip.MustParseAddr("192.0.2.1"), resp, true)
+	r.Equal(RcodeBadCookie, resp.Rcode())
+}
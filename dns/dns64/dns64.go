@@ -0,0 +1,308 @@
+// Package dns64 synthesizes AAAA records from A records for IPv6-only
+// clients behind a NAT64, see RFC 6147.
+//
+// A DNS64 sits between the client and the resolver. AAAA queries are passed
+// on; when the answer holds no usable AAAA record, the A records of the name
+// are looked up and mapped into the NAT64 prefix by the algorithm of RFC 6052.
+// PTR queries for addresses within the prefix are answered with a CNAME to
+// the in-addr.arpa name of the embedded IPv4 address.
+//
+//	d, err := dns64.New(dns64.WellKnownPrefix)
+//	resp, err := d.Exchange(ctx, req, func(ctx context.Context, req *dns.Request) (*dns.Response, error) {
+//		return client.Exchange(ctx, req, "192.0.2.53:53")
+//	})
+package dns64
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/dnsoa/go/dns"
+)
+
+var (
+	// WellKnownPrefix is the NAT64 prefix of RFC 6052, section 2.1. It must
+	// not embed non-global IPv4 addresses, so those are never synthesized
+	// with it.
+	WellKnownPrefix = netip.MustParsePrefix("64:ff9b::/96")
+
+	// ErrBadPrefix is returned by New for a prefix that is not an IPv6
+	// prefix of length 32, 40, 48, 56, 64 or 96, or that sets bits 64 to 71.
+	ErrBadPrefix = errors.New("dns64: bad NAT64 prefix")
+)
+
+// negativeTTL caps the TTL of synthesized records when the AAAA response
+// carries no SOA record, see RFC 6147, section 5.1.7.
+const negativeTTL = 600
+
+// ExchangeFunc sends req to the resolver behind the DNS64 and returns its
+// response.
+type ExchangeFunc func(ctx context.Context, req *dns.Request) (*dns.Response, error)
+
+// DNS64 synthesizes AAAA and PTR answers for a NAT64 prefix.
+type DNS64 struct {
+	prefix netip.Prefix
+	// ExcludeAAAA holds the networks of AAAA records that are treated as
+	// absent, so that the name gets synthesized records instead. New sets
+	// it to the IPv4-mapped addresses, ::ffff:0:0/96.
+	ExcludeAAAA []netip.Prefix
+	// ExcludeA holds the networks of A records that are not synthesized.
+	ExcludeA []netip.Prefix
+}
+
+// New returns a DNS64 that maps IPv4 addresses into prefix.
+func New(prefix netip.Prefix) (*DNS64, error) {
+	if !prefix.IsValid() || !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		return nil, ErrBadPrefix
+	}
+	switch prefix.Bits() {
+	case 32, 40, 48, 56, 64, 96:
+	default:
+		return nil, ErrBadPrefix
+	}
+	prefix = prefix.Masked()
+	if prefix.Addr().As16()[8] != 0 {
+		return nil, ErrBadPrefix
+	}
+	return &DNS64{
+		prefix:      prefix,
+		ExcludeAAAA: []netip.Prefix{netip.MustParsePrefix("::ffff:0:0/96")},
+	}, nil
+}
+
+// Prefix returns the NAT64 prefix.
+func (d *DNS64) Prefix() netip.Prefix {
+	return d.prefix
+}
+
+// Embed returns the IPv6 address of the IPv4 address v4 within the prefix,
+// see RFC 6052, section 2.2. Bits 64 to 71 are skipped and the suffix is
+// zero.
+func (d *DNS64) Embed(v4 netip.Addr) netip.Addr {
+	ip := d.prefix.Addr().As16()
+	a := v4.Unmap().As4()
+	j := d.prefix.Bits() / 8
+	for i := range a {
+		if j == 8 {
+			j++
+		}
+		ip[j] = a[i]
+		j++
+	}
+	return netip.AddrFrom16(ip)
+}
+
+// Extract returns the IPv4 address embedded in addr, and false when addr is
+// not within the prefix.
+func (d *DNS64) Extract(addr netip.Addr) (netip.Addr, bool) {
+	if !d.prefix.Contains(addr) {
+		return netip.Addr{}, false
+	}
+	ip := addr.As16()
+	var a [4]byte
+	j := d.prefix.Bits() / 8
+	for i := range a {
+		if j == 8 {
+			j++
+		}
+		a[i] = ip[j]
+		j++
+	}
+	return netip.AddrFrom4(a), true
+}
+
+// Exchange answers req by way of next. AAAA queries get synthesized records
+// when the resolver has no usable AAAA record for the name, and PTR queries
+// within the prefix are mapped to in-addr.arpa; other queries are passed on
+// unchanged. Nothing is synthesized for queries with both the CD and DO bits
+// set, since the client validates itself (RFC 6147, section 5.5), nor for
+// names that do not exist.
+func (d *DNS64) Exchange(ctx context.Context, req *dns.Request, next ExchangeFunc) (*dns.Response, error) {
+	if req.Question.Class != dns.ClassINET || (req.Header.CheckingDisabled() && req.DNSSECOK()) {
+		return next(ctx, req)
+	}
+	switch req.Question.Type {
+	case dns.TypeAAAA:
+		return d.exchangeAAAA(ctx, req, next)
+	case dns.TypePTR:
+		if addr, err := dns.ParseReverse(string(req.Domain)); err == nil {
+			if v4, ok := d.Extract(addr); ok {
+				return d.exchangePTR(ctx, req, v4, next)
+			}
+		}
+	}
+	return next(ctx, req)
+}
+
+func (d *DNS64) exchangeAAAA(ctx context.Context, req *dns.Request, next ExchangeFunc) (*dns.Response, error) {
+	resp, err := next(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	switch resp.Rcode() {
+	case dns.RcodeNameError:
+		return resp, nil
+	case dns.RcodeSuccess:
+		if d.filterAAAA(resp) {
+			return resp, nil
+		}
+	}
+	// Any other error is taken as an empty answer, see RFC 6147,
+	// section 5.1.2.
+	a, err := next(ctx, subquery(req, string(req.Domain), dns.TypeA))
+	if err != nil || a.Rcode() != dns.RcodeSuccess {
+		return resp, nil
+	}
+	out := new(dns.Response)
+	out.SetReply(req)
+	if a.Header.RecursionAvailable() {
+		out.Header.SetRecursionAvailable()
+	}
+	ttl := uint32(negativeTTL)
+	for _, rr := range resp.Ns {
+		if soa, ok := rr.(*dns.SOA); ok {
+			ttl = min(soa.Hdr.Ttl, soa.Minttl)
+		}
+	}
+	synthesized := false
+	for _, rr := range a.Answer {
+		switch rr := rr.(type) {
+		case *dns.CNAME:
+			out.Answer = append(out.Answer, rr)
+		case *dns.A:
+			v4 := netip.AddrFrom4(rr.A)
+			if d.excludedA(v4) {
+				continue
+			}
+			out.Answer = append(out.Answer, &dns.AAAA{
+				Hdr: dns.RR_Header{
+					Name:   rr.Hdr.Name,
+					Rrtype: dns.TypeAAAA,
+					Class:  rr.Hdr.Class,
+					Ttl:    min(rr.Hdr.Ttl, ttl),
+				},
+				AAAA: net.IP(d.Embed(v4).AsSlice()),
+			})
+			synthesized = true
+		}
+	}
+	if !synthesized {
+		return resp, nil
+	}
+	for _, rr := range a.Extra {
+		if rr.Header().Rrtype == dns.TypeOPT {
+			out.Extra = append(out.Extra, rr)
+		}
+	}
+	out.Header.Ancount = uint16(len(out.Answer))
+	out.Header.Arcount = uint16(len(out.Extra))
+	return out, nil
+}
+
+// filterAAAA removes the excluded AAAA records from the answer of resp and
+// reports whether any AAAA record is left.
+func (d *DNS64) filterAAAA(resp *dns.Response) bool {
+	found, excluded := false, false
+	for _, rr := range resp.Answer {
+		if rr, ok := rr.(*dns.AAAA); ok {
+			if d.excludedAAAA(rr) {
+				excluded = true
+			} else {
+				found = true
+			}
+		}
+	}
+	if found && excluded {
+		resp.Answer = slices.DeleteFunc(resp.Answer, func(rr dns.RR) bool {
+			aaaa, ok := rr.(*dns.AAAA)
+			return ok && d.excludedAAAA(aaaa)
+		})
+		resp.Header.Ancount = uint16(len(resp.Answer))
+	}
+	return found
+}
+
+func (d *DNS64) excludedAAAA(rr *dns.AAAA) bool {
+	addr, ok := netip.AddrFromSlice(rr.AAAA)
+	if !ok {
+		return true
+	}
+	if len(rr.AAAA) == net.IPv4len {
+		addr = netip.AddrFrom16(addr.As16())
+	}
+	return slices.ContainsFunc(d.ExcludeAAAA, func(p netip.Prefix) bool { return p.Contains(addr) })
+}
+
+func (d *DNS64) excludedA(addr netip.Addr) bool {
+	if d.prefix == WellKnownPrefix && !isGlobal(addr) {
+		return true
+	}
+	return slices.ContainsFunc(d.ExcludeA, func(p netip.Prefix) bool { return p.Contains(addr) })
+}
+
+// isGlobal reports whether addr is globally reachable, as the well-known
+// prefix requires, see RFC 6052, section 3.1.
+func isGlobal(addr netip.Addr) bool {
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !shared.Contains(addr)
+}
+
+// shared is the shared address space of RFC 6598.
+var shared = netip.MustParsePrefix("100.64.0.0/10")
+
+// exchangePTR answers a PTR query for an address within the prefix with a
+// CNAME to the in-addr.arpa name of v4 and the answer for that name, see RFC
+// 6147, section 5.3.1.
+func (d *DNS64) exchangePTR(ctx context.Context, req *dns.Request, v4 netip.Addr, next ExchangeFunc) (*dns.Response, error) {
+	target := dns.ReverseAddr(v4)
+	ptr, err := next(ctx, subquery(req, target, dns.TypePTR))
+	if err != nil {
+		return nil, err
+	}
+	ttl := uint32(negativeTTL)
+	for _, rr := range ptr.Answer {
+		ttl = min(ttl, rr.Header().Ttl)
+	}
+	out := new(dns.Response)
+	out.SetReply(req)
+	if ptr.Header.RecursionAvailable() {
+		out.Header.SetRecursionAvailable()
+	}
+	out.Answer = append(out.Answer, &dns.CNAME{
+		Hdr: dns.RR_Header{
+			Name:   dns.Fqdn(strings.ToLower(string(req.Domain))),
+			Rrtype: dns.TypeCNAME,
+			Class:  dns.ClassINET,
+			Ttl:    ttl,
+		},
+		CNAME: target,
+	})
+	out.Answer = append(out.Answer, ptr.Answer...)
+	out.Ns = ptr.Ns
+	for _, rr := range ptr.Extra {
+		if rr.Header().Rrtype == dns.TypeOPT {
+			out.Extra = append(out.Extra, rr)
+		}
+	}
+	out.Header.Ancount = uint16(len(out.Answer))
+	out.Header.Nscount = uint16(len(out.Ns))
+	out.Header.Arcount = uint16(len(out.Extra))
+	out.SetRcode(ptr.Rcode())
+	return out, nil
+}
+
+// subquery returns a query for name and qtype with the flags and EDNS of
+// req.
+func subquery(req *dns.Request, name string, qtype dns.Type) *dns.Request {
+	q := new(dns.Request)
+	if req.Header.CheckingDisabled() {
+		q.Header.SetCheckingDisabled()
+	}
+	q.OPT = req.OPT
+	q.OPT.Options = slices.Clone(req.OPT.Options)
+	q.SetQuestion(dns.Fqdn(name), qtype, dns.ClassINET)
+	return q
+}
@@ -0,0 +1,198 @@
+package dns64
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/dns/dnstest"
+	"github.com/dnsoa/go/dns/internal/client"
+)
+
+// TestEmbed checks the examples of RFC 6052, section 2.4.
+func TestEmbed(t *testing.T) {
+	r := assert.New(t)
+	v4 := netip.MustParseAddr("192.0.2.33")
+	tests := []struct {
+		prefix string
+		addr   string
+	}{
+		{"2001:db8::/32", "2001:db8:c000:221::"},
+		{"2001:db8:100::/40", "2001:db8:1c0:2:21::"},
+		{"2001:db8:122::/48", "2001:db8:122:c000:2:2100::"},
+		{"2001:db8:122:300::/56", "2001:db8:122:3c0:0:221::"},
+		{"2001:db8:122:344::/64", "2001:db8:122:344:c0:2:2100:0"},
+		{"2001:db8:122:344::/96", "2001:db8:122:344::192.0.2.33"},
+		{"64:ff9b::/96", "64:ff9b::192.0.2.33"},
+	}
+	for _, tt := range tests {
+		d, err := New(netip.MustParsePrefix(tt.prefix))
+		r.NoError(err, tt.prefix)
+		addr := d.Embed(v4)
+		r.Equal(netip.MustParseAddr(tt.addr), addr, tt.prefix)
+		got, ok := d.Extract(addr)
+		r.True(ok, tt.prefix)
+		r.Equal(v4, got, tt.prefix)
+	}
+
+	d, _ := New(WellKnownPrefix)
+	_, ok := d.Extract(netip.MustParseAddr("2001:db8::1"))
+	r.False(ok)
+}
+
+func TestNewBadPrefix(t *testing.T) {
+	r := assert.New(t)
+	for _, p := range []string{"2001:db8::/33", "2001:db8::/128", "192.0.2.0/24", "::ffff:0:0/96", "2001:db8:0:0:ff00::/96"} {
+		_, err := New(netip.MustParsePrefix(p))
+		r.ErrorIs(err, ErrBadPrefix, p)
+	}
+	_, err := New(netip.Prefix{})
+	r.ErrorIs(err, ErrBadPrefix)
+}
+
+func newDNS64(t *testing.T, srv *dnstest.Server) (*DNS64, ExchangeFunc) {
+	d, err := New(WellKnownPrefix)
+	assert.NoError(t, err)
+	c := new(client.Client)
+	return d, func(ctx context.Context, req *dns.Request) (*dns.Response, error) {
+		return c.Exchange(ctx, req, srv.Addr())
+	}
+}
+
+func query(name string, qtype dns.Type, cd bool) *dns.Request {
+	req := new(dns.Request)
+	if cd {
+		req.Header.SetCheckingDisabled()
+		req.SetEDNS0(1232, true)
+	}
+	req.SetQuestion(name, qtype, dns.ClassINET)
+	return req
+}
+
+const soa = "example. 3600 IN SOA ns.example. admin.example. 1 7200 900 1209600 300"
+
+func TestSynthesize(t *testing.T) {
+	r := assert.New(t)
+	srv := dnstest.NewServer(t)
+	d, next := newDNS64(t, srv)
+	ctx := context.Background()
+
+	// A name with AAAA records is passed on.
+	srv.Handle("v6.example.", dns.TypeAAAA, dnstest.Reply{Answer: dnstest.RRs(t, "v6.example. 60 IN AAAA 2001:db8::1")})
+	resp, err := d.Exchange(ctx, query("v6.example.", dns.TypeAAAA, false), next)
+	r.NoError(err)
+	assertAnswer(t, resp, "v6.example. 60 IN AAAA 2001:db8::1")
+
+	// NODATA: the A records are mapped, with the TTL capped by the SOA.
+	srv.Handle("www.example.", dns.TypeAAAA, dnstest.Reply{Ns: dnstest.RRs(t, soa)})
+	srv.Handle("www.example.", dns.TypeA, dnstest.Reply{Answer: dnstest.RRs(t,
+		"www.example. 3600 IN CNAME host.example.",
+		"host.example. 3600 IN A 192.0.2.33",
+		"host.example. 60 IN A 198.51.100.7",
+	)})
+	req := query("www.example.", dns.TypeAAAA, false)
+	resp, err = d.Exchange(ctx, req, next)
+	r.NoError(err)
+	r.Equal(req.Header.ID, resp.Header.ID)
+	dnstest.AssertRcode(t, resp, dns.RcodeSuccess)
+	assertAnswer(t, resp,
+		"www.example. 3600 IN CNAME host.example.",
+		"host.example. 300 IN AAAA 64:ff9b::c000:221",
+		"host.example. 60 IN AAAA 64:ff9b::c633:6407",
+	)
+
+	// NXDOMAIN is final.
+	srv.Handle("gone.example.", dns.TypeAAAA, dnstest.Reply{Rcode: dns.RcodeNameError, Ns: dnstest.RRs(t, soa)})
+	resp, err = d.Exchange(ctx, query("gone.example.", dns.TypeAAAA, false), next)
+	r.NoError(err)
+	dnstest.AssertRcode(t, resp, dns.RcodeNameError)
+
+	// Other queries are passed on.
+	resp, err = d.Exchange(ctx, query("www.example.", dns.TypeA, false), next)
+	r.NoError(err)
+	r.Len(resp.Answer, 3)
+}
+
+func TestExclude(t *testing.T) {
+	r := assert.New(t)
+	srv := dnstest.NewServer(t)
+	d, next := newDNS64(t, srv)
+	d.ExcludeA = []netip.Prefix{netip.MustParsePrefix("203.0.113.0/24")}
+	ctx := context.Background()
+
+	// Mapped AAAA records are ignored, so the name is synthesized.
+	srv.Handle("mapped.example.", dns.TypeAAAA, dnstest.Reply{Answer: dnstest.RRs(t, "mapped.example. 60 IN AAAA ::ffff:192.0.2.1")})
+	srv.Handle("mapped.example.", dns.TypeA, dnstest.Reply{Answer: dnstest.RRs(t, "mapped.example. 60 IN A 192.0.2.1")})
+	resp, err := d.Exchange(ctx, query("mapped.example.", dns.TypeAAAA, false), next)
+	r.NoError(err)
+	assertAnswer(t, resp, "mapped.example. 60 IN AAAA 64:ff9b::c000:201")
+
+	// Mixed: the mapped record is dropped.
+	srv.Handle("mixed.example.", dns.TypeAAAA, dnstest.Reply{Answer: dnstest.RRs(t,
+		"mixed.example. 60 IN AAAA ::ffff:192.0.2.1",
+		"mixed.example. 60 IN AAAA 2001:db8::1",
+	)})
+	resp, err = d.Exchange(ctx, query("mixed.example.", dns.TypeAAAA, false), next)
+	r.NoError(err)
+	assertAnswer(t, resp, "mixed.example. 60 IN AAAA 2001:db8::1")
+
+	// Excluded and, with the well-known prefix, private A records are not
+	// mapped.
+	for _, a := range []string{"203.0.113.5", "10.0.0.1", "100.64.0.1"} {
+		srv.Handle("excluded.example.", dns.TypeAAAA, dnstest.Reply{Ns: dnstest.RRs(t, soa)})
+		srv.Handle("excluded.example.", dns.TypeA, dnstest.Reply{Answer: dnstest.RRs(t, "excluded.example. 60 IN A "+a)})
+		resp, err = d.Exchange(ctx, query("excluded.example.", dns.TypeAAAA, false), next)
+		r.NoError(err)
+		r.Len(resp.Answer, 0, a)
+	}
+}
+
+func TestCheckingDisabled(t *testing.T) {
+	r := assert.New(t)
+	srv := dnstest.NewServer(t)
+	d, next := newDNS64(t, srv)
+	srv.Handle("www.example.", dns.TypeAAAA, dnstest.Reply{Ns: dnstest.RRs(t, soa)})
+	srv.Handle("www.example.", dns.TypeA, dnstest.Reply{Answer: dnstest.RRs(t, "www.example. 60 IN A 192.0.2.33")})
+
+	resp, err := d.Exchange(context.Background(), query("www.example.", dns.TypeAAAA, true), next)
+	r.NoError(err)
+	r.Len(resp.Answer, 0)
+	for _, q := range srv.Queries() {
+		r.Equal(dns.TypeAAAA, q.Type)
+	}
+}
+
+func TestPTR(t *testing.T) {
+	r := assert.New(t)
+	srv := dnstest.NewServer(t)
+	d, next := newDNS64(t, srv)
+	srv.Handle("33.2.0.192.in-addr.arpa.", dns.TypePTR, dnstest.Reply{Answer: dnstest.RRs(t, "33.2.0.192.in-addr.arpa. 120 IN PTR host.example.")})
+
+	name := dns.ReverseAddr(netip.MustParseAddr("64:ff9b::192.0.2.33"))
+	resp, err := d.Exchange(context.Background(), query(name, dns.TypePTR, false), next)
+	r.NoError(err)
+	dnstest.AssertRcode(t, resp, dns.RcodeSuccess)
+	assertAnswer(t, resp,
+		name+" 120 IN CNAME 33.2.0.192.in-addr.arpa.",
+		"33.2.0.192.in-addr.arpa. 120 IN PTR host.example.",
+	)
+
+	// Outside the prefix the query is passed on.
+	srv.Handle("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.", dns.TypePTR, dnstest.Reply{Answer: dnstest.RRs(t,
+		"1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa. 60 IN PTR v6.example.",
+	)})
+	resp, err = d.Exchange(context.Background(), query(dns.ReverseAddr(netip.MustParseAddr("2001:db8::1")), dns.TypePTR, false), next)
+	r.NoError(err)
+	r.Len(resp.Answer, 1)
+}
+
+// assertAnswer asserts that the answer of resp holds exactly rrs.
+func assertAnswer(t *testing.T, resp *dns.Response, rrs ...string) {
+	t.Helper()
+	dnstest.AssertAnswerLen(t, resp, len(rrs))
+	for _, rr := range rrs {
+		dnstest.AssertAnswerContains(t, resp, rr)
+	}
+}
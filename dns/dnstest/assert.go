@@ -0,0 +1,132 @@
+package dnstest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+)
+
+type tHelper interface {
+	Helper()
+}
+
+// RR parses a record in zone file format and fails the test if it is invalid.
+func RR(t assert.TestingT, s string) dns.RR {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	rr, err := dns.NewRR(s)
+	assert.NoError(t, err, s)
+	return rr
+}
+
+// RRs parses records in zone file format, see RR.
+func RRs(t assert.TestingT, records ...string) []dns.RR {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	out := make([]dns.RR, 0, len(records))
+	for _, s := range records {
+		out = append(out, RR(t, s))
+	}
+	return out
+}
+
+// AssertRcode asserts that the RCODE of resp, extended bits included, is rcode.
+func AssertRcode(t assert.TestingT, resp *dns.Response, rcode dns.Rcode, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.NotNil(t, resp, msgAndArgs...)
+	if got := resp.Rcode(); got != rcode {
+		assert.Fail(t, fmt.Sprintf("Unexpected rcode:\nexpected: %s\nactual  : %s", rcodeString(rcode), rcodeString(got)), msgAndArgs...)
+		t.FailNow()
+	}
+}
+
+// AssertAnswerContains asserts that the answer section of resp holds rr. The
+// record is a dns.RR or a string in zone file format; records are compared
+// in presentation format with owner names case-folded.
+func AssertAnswerContains(t assert.TestingT, resp *dns.Response, rr any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.NotNil(t, resp, msgAndArgs...)
+	want := rrString(t, rr)
+	if !containsRR(resp.Answer, want) {
+		assert.Fail(t, fmt.Sprintf("Answer does not contain:\nexpected: %s\nanswer  :\n%s", want, section(resp.Answer)), msgAndArgs...)
+		t.FailNow()
+	}
+}
+
+// AssertAnswerNotContains asserts that the answer section of resp does not
+// hold rr, see AssertAnswerContains.
+func AssertAnswerNotContains(t assert.TestingT, resp *dns.Response, rr any, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.NotNil(t, resp, msgAndArgs...)
+	want := rrString(t, rr)
+	if containsRR(resp.Answer, want) {
+		assert.Fail(t, fmt.Sprintf("Answer should not contain: %s", want), msgAndArgs...)
+		t.FailNow()
+	}
+}
+
+// AssertAnswerLen asserts that the answer section of resp holds n records.
+func AssertAnswerLen(t assert.TestingT, resp *dns.Response, n int, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	assert.NotNil(t, resp, msgAndArgs...)
+	if len(resp.Answer) != n {
+		assert.Fail(t, fmt.Sprintf("Answer should have %d record(s), but has %d:\n%s", n, len(resp.Answer), section(resp.Answer)), msgAndArgs...)
+		t.FailNow()
+	}
+}
+
+func rrString(t assert.TestingT, rr any) string {
+	switch v := rr.(type) {
+	case dns.RR:
+		return normalize(v)
+	case string:
+		return normalize(RR(t, v))
+	}
+	assert.Fail(t, fmt.Sprintf("Record must be a dns.RR or a string, not %T", rr))
+	t.FailNow()
+	return ""
+}
+
+func normalize(rr dns.RR) string {
+	s := rr.String()
+	if i := strings.IndexByte(s, '\t'); i >= 0 {
+		return strings.ToLower(s[:i]) + s[i:]
+	}
+	return s
+}
+
+func containsRR(rrs []dns.RR, want string) bool {
+	for _, rr := range rrs {
+		if normalize(rr) == want {
+			return true
+		}
+	}
+	return false
+}
+
+func section(rrs []dns.RR) string {
+	var b strings.Builder
+	for _, rr := range rrs {
+		b.WriteString("\t" + rr.String() + "\n")
+	}
+	return b.String()
+}
+
+func rcodeString(rcode dns.Rcode) string {
+	if s, ok := dns.RcodeToString[rcode]; ok {
+		return s
+	}
+	return fmt.Sprintf("RCODE%d", rcode)
+}
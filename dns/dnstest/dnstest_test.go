@@ -0,0 +1,125 @@
+package dnstest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/dns/internal/client"
+)
+
+func query(name string, qtype dns.Type) *dns.Request {
+	req := new(dns.Request)
+	req.SetEDNS0(1232, false)
+	req.SetQuestion(name, qtype, dns.ClassINET)
+	return req
+}
+
+func TestServer(t *testing.T) {
+	r := assert.New(t)
+	srv := NewServer(t)
+	srv.Handle("Example.COM", dns.TypeA, Reply{
+		Answer:        RRs(t, "example.com. 60 IN A 192.0.2.1", "example.com. 60 IN A 192.0.2.2"),
+		Authoritative: true,
+	})
+	srv.Handle("example.com", dns.TypeMX, ServFail)
+
+	c := &client.Client{}
+	ctx := context.Background()
+	resp, err := c.Exchange(ctx, query("example.com", dns.TypeA), srv.Addr())
+	r.NoError(err)
+	AssertRcode(t, resp, dns.RcodeSuccess)
+	AssertAnswerLen(t, resp, 2)
+	AssertAnswerContains(t, resp, "EXAMPLE.com. 60 IN A 192.0.2.2")
+	AssertAnswerContains(t, resp, RR(t, "example.com. 60 IN A 192.0.2.1"))
+	AssertAnswerNotContains(t, resp, "example.com. 60 IN A 192.0.2.3")
+	r.True(resp.Header.Authoritative())
+
+	resp, err = c.Exchange(ctx, query("example.com", dns.TypeMX), srv.Addr())
+	r.NoError(err)
+	AssertRcode(t, resp, dns.RcodeServerFailure)
+
+	resp, err = c.Exchange(ctx, query("unknown.example", dns.TypeA), srv.Addr())
+	r.NoError(err)
+	AssertRcode(t, resp, dns.RcodeRefused)
+
+	r.DeepEqual([]Query{
+		{Name: "example.com.", Type: dns.TypeA, Net: "udp"},
+		{Name: "example.com.", Type: dns.TypeMX, Net: "udp"},
+		{Name: "unknown.example.", Type: dns.TypeA, Net: "udp"},
+	}, srv.Queries())
+}
+
+func TestServerTruncate(t *testing.T) {
+	r := assert.New(t)
+	srv := NewServer(t)
+	srv.Handle("big.example", dns.TypeTXT, Reply{
+		Answer:   RRs(t, `big.example. 60 IN TXT "a lot of text"`),
+		Truncate: true,
+	})
+
+	resp, err := (&client.Client{}).Exchange(context.Background(), query("big.example", dns.TypeTXT), srv.Addr())
+	r.NoError(err)
+	AssertAnswerLen(t, resp, 1)
+	r.False(resp.Header.Truncated())
+	queries := srv.Queries()
+	r.Equal(2, len(queries))
+	r.Equal("udp", queries[0].Net)
+	r.Equal("tcp", queries[1].Net)
+
+	resp, err = (&client.Client{Net: "tcp"}).Exchange(context.Background(), query("big.example", dns.TypeTXT), srv.Addr())
+	r.NoError(err)
+	AssertAnswerLen(t, resp, 1)
+}
+
+func TestServerDropAndDelay(t *testing.T) {
+	r := assert.New(t)
+	srv := NewServer(t)
+	answer := Reply{Answer: RRs(t, "flaky.example. 60 IN A 192.0.2.1")}
+	srv.Handle("flaky.example", dns.TypeA, Reply{Drop: true}, answer)
+	srv.Handle("slow.example", dns.TypeA, Reply{Delay: 200 * time.Millisecond})
+
+	c := &client.Client{Timeout: 50 * time.Millisecond}
+	_, err := c.Exchange(context.Background(), query("flaky.example", dns.TypeA), srv.Addr())
+	r.ErrorIs(err, context.DeadlineExceeded)
+	resp, err := c.Exchange(context.Background(), query("flaky.example", dns.TypeA), srv.Addr())
+	r.NoError(err)
+	AssertAnswerContains(t, resp, "flaky.example. 60 IN A 192.0.2.1")
+
+	_, err = c.Exchange(context.Background(), query("slow.example", dns.TypeA), srv.Addr())
+	r.ErrorIs(err, context.DeadlineExceeded)
+	c.Timeout = time.Second
+	resp, err = c.Exchange(context.Background(), query("slow.example", dns.TypeA), srv.Addr())
+	r.NoError(err)
+	AssertRcode(t, resp, dns.RcodeSuccess)
+}
+
+// recorder is an assert.TestingT that records failures instead of failing.
+type recorder struct {
+	failed bool
+}
+
+func (r *recorder) Errorf(string, ...any) { r.failed = true }
+func (r *recorder) FailNow()              {}
+func (r *recorder) Helper()               {}
+
+func TestAssertFailures(t *testing.T) {
+	r := assert.New(t)
+	resp := new(dns.Response)
+	resp.Answer = RRs(t, "example.com. 60 IN A 192.0.2.1")
+
+	checks := []func(rec *recorder){
+		func(rec *recorder) { AssertRcode(rec, resp, dns.RcodeNameError) },
+		func(rec *recorder) { AssertAnswerLen(rec, resp, 2) },
+		func(rec *recorder) { AssertAnswerContains(rec, resp, "example.com. 60 IN A 192.0.2.9") },
+		func(rec *recorder) { AssertAnswerNotContains(rec, resp, "example.com. 60 IN A 192.0.2.1") },
+		func(rec *recorder) { RR(rec, "not a record") },
+	}
+	for i, check := range checks {
+		rec := new(recorder)
+		check(rec)
+		r.True(rec.failed, i)
+	}
+}
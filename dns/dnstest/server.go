@@ -0,0 +1,243 @@
+// Package dnstest provides a scripted in-process DNS server and assertions on
+// responses for tests of code built on package dns.
+//
+//	srv := dnstest.NewServer(t)
+//	srv.Handle("example.com.", dns.TypeA, dnstest.Reply{Answer: dnstest.RRs(t, "example.com. 60 IN A 192.0.2.1")})
+//	srv.Handle("slow.example.", dns.TypeA, dnstest.Reply{Drop: true}, dnstest.Reply{Delay: time.Second})
+//	resp, err := client.Exchange(ctx, req, srv.Addr())
+//	dnstest.AssertRcode(t, resp, dns.RcodeSuccess)
+package dnstest
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/dns/internal/transport"
+)
+
+// Reply scripts the answer to a query.
+type Reply struct {
+	Answer []dns.RR
+	Ns     []dns.RR
+	Extra  []dns.RR
+	// Delay holds the reply back.
+	Delay time.Duration
+	Rcode dns.Rcode
+	// Authoritative sets the AA bit.
+	Authoritative bool
+	// Truncate answers UDP queries with TC set and empty sections; TCP
+	// queries get the full reply.
+	Truncate bool
+	// Drop sends no reply at all.
+	Drop bool
+}
+
+// ServFail is a reply with RCODE SERVFAIL.
+var ServFail = Reply{Rcode: dns.RcodeServerFailure}
+
+// Query is a query received by the server.
+type Query struct {
+	Name string // lowercased, fully qualified
+	Type dns.Type
+	Net  string // "udp" or "tcp"
+}
+
+type scriptKey struct {
+	name string
+	typ  dns.Type
+}
+
+// Server is a DNS server on a loopback address that answers from a table of
+// scripted replies, over UDP and TCP on the same port.
+type Server struct {
+	udp     net.PacketConn
+	tcp     net.Listener
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	scripts map[scriptKey][]Reply
+	queries []Query
+	// Default answers queries without a script; it is REFUSED.
+	Default Reply
+	// Observer, if set before queries arrive, is told about every reply
+	// sent.
+	Observer dns.Observer
+}
+
+// NewServer starts a server and stops it when the test ends.
+func NewServer(tb testing.TB) *Server {
+	tb.Helper()
+	s, err := Start()
+	if err != nil {
+		tb.Fatalf("dnstest: %v", err)
+	}
+	tb.Cleanup(s.Close)
+	return s
+}
+
+// Start starts a server on a free port of 127.0.0.1. Close stops it.
+func Start() (*Server, error) {
+	s := &Server{
+		scripts: make(map[scriptKey][]Reply),
+		Default: Reply{Rcode: dns.RcodeRefused},
+	}
+	// The TCP listener must get the port of the UDP socket, which can be
+	// taken already; try a few ports.
+	var err error
+	for range 10 {
+		if s.udp, err = net.ListenPacket("udp", "127.0.0.1:0"); err != nil {
+			return nil, err
+		}
+		if s.tcp, err = net.Listen("tcp", s.udp.LocalAddr().String()); err == nil {
+			break
+		}
+		s.udp.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.wg.Add(2)
+	go s.serveUDP()
+	go s.serveTCP()
+	return s, nil
+}
+
+// Addr returns the host:port the server listens on.
+func (s *Server) Addr() string {
+	return s.udp.LocalAddr().String()
+}
+
+// Close stops the server and waits for pending replies.
+func (s *Server) Close() {
+	s.udp.Close()
+	s.tcp.Close()
+	s.wg.Wait()
+}
+
+// Handle scripts the replies to queries for name and qtype, case-insensitive.
+// Queries use the replies in order, and the last one for all further
+// queries, so Handle(name, qtype, Reply{Drop: true}, answer) drops the first
+// query and answers the retries. A later call replaces the script.
+func (s *Server) Handle(name string, qtype dns.Type, replies ...Reply) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scripts[scriptKey{name: strings.ToLower(dns.Fqdn(name)), typ: qtype}] = replies
+}
+
+// Queries returns the queries received so far.
+func (s *Server) Queries() []Query {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Query(nil), s.queries...)
+}
+
+// next records a query and returns the reply scripted for it.
+func (s *Server) next(q Query) Reply {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queries = append(s.queries, q)
+	k := scriptKey{name: q.Name, typ: q.Type}
+	script := s.scripts[k]
+	switch len(script) {
+	case 0:
+		return s.Default
+	case 1:
+		return script[0]
+	}
+	s.scripts[k] = script[1:]
+	return script[0]
+}
+
+func (s *Server) serveUDP() {
+	defer s.wg.Done()
+	buf := make([]byte, 65535)
+	for {
+		n, addr, err := s.udp.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			continue
+		}
+		msg := append([]byte(nil), buf[:n]...)
+		s.wg.Go(func() {
+			if out := s.reply(msg, "udp"); out != nil {
+				s.udp.WriteTo(out, addr)
+			}
+		})
+	}
+}
+
+func (s *Server) serveTCP() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.tcp.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			continue
+		}
+		s.wg.Go(func() {
+			defer conn.Close()
+			for {
+				msg, err := transport.ReadTCP(conn)
+				if err != nil {
+					return
+				}
+				if out := s.reply(msg, "tcp"); out != nil {
+					if transport.WriteTCP(conn, out) != nil {
+						return
+					}
+				}
+			}
+		})
+	}
+}
+
+// reply builds the wire response to msg, or returns nil to drop it.
+func (s *Server) reply(msg []byte, network string) []byte {
+	start := dns.QueryStart()
+	req := new(dns.Request)
+	if err := req.Unpack(msg); err != nil {
+		return nil
+	}
+	name := strings.ToLower(dns.Fqdn(string(req.Domain)))
+	r := s.next(Query{Name: name, Type: req.Question.Type, Net: network})
+	if r.Delay > 0 {
+		time.Sleep(r.Delay)
+	}
+	if r.Drop {
+		return nil
+	}
+
+	resp := new(dns.Response)
+	resp.SetReply(req)
+	if r.Authoritative {
+		resp.Header.SetAuthoritative()
+	}
+	if !r.Truncate || network == "tcp" {
+		resp.Answer = append(resp.Answer, r.Answer...)
+		resp.Ns = append(resp.Ns, r.Ns...)
+		resp.Extra = append(resp.Extra, r.Extra...)
+	} else {
+		resp.Header.SetTruncated()
+	}
+	if req.OPT.Hdr.Class != 0 {
+		resp.Extra = append(resp.Extra, &dns.OPT{Hdr: dns.RR_Header{Name: ".", Rrtype: dns.TypeOPT, Class: 1232}})
+	}
+	resp.SetRcode(r.Rcode)
+	resp.Header.Ancount = uint16(len(resp.Answer))
+	resp.Header.Nscount = uint16(len(resp.Ns))
+	resp.Header.Arcount = uint16(len(resp.Extra))
+	out, err := resp.PackTo(nil)
+	if err != nil {
+		return nil
+	}
+	dns.Observe(s.Observer, network, req.Question.Type, start, resp, len(out), nil)
+	return out
+}
@@ -264,3 +264,22 @@ func BenchmarkUnpackDomainName(b *testing.B) {
 		_, _, _ = UnpackDomainName(msg, 0)
 	}
 }
+
+func TestPackRRCompression(t *testing.T) {
+	r := assert.New(t)
+	msg := make([]byte, 512)
+	compression := make(map[string]int)
+	off, err := PackDomainName("_http._tcp.local.", msg, 0, compression)
+	r.NoError(err)
+	rr, err := NewRR("host._http._tcp.local. 120 IN TXT \"a=1\"")
+	r.NoError(err)
+	end, err := PackRR(rr, msg, off, compression)
+	r.NoError(err)
+	// "host" and a pointer to the name packed first.
+	r.Equal(off+1+4+2+10+4, end)
+
+	got, off2, err := UnpackRR(msg, off)
+	r.NoError(err)
+	r.Equal(end, off2)
+	r.Equal(rr.String(), got.String())
+}
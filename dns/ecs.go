@@ -0,0 +1,121 @@
+package dns
+
+import (
+	"encoding/binary"
+	"errors"
+	"net/netip"
+	"strings"
+)
+
+// ErrBadClientSubnet is returned for a malformed EDNS Client Subnet option.
+var ErrBadClientSubnet = errors.New("dns: bad client subnet option")
+
+// ClientSubnet is the content of an EDNS Client Subnet option, see RFC 7871.
+// Prefix holds the address and SOURCE PREFIX-LENGTH, Scope the SCOPE
+// PREFIX-LENGTH.
+type ClientSubnet struct {
+	Prefix netip.Prefix
+	Scope  uint8
+}
+
+// ParseClientSubnet decodes the data of an EDNS Client Subnet option. Address
+// bits beyond the source prefix length must be zero.
+func ParseClientSubnet(data []byte) (ClientSubnet, error) {
+	if len(data) < 4 {
+		return ClientSubnet{}, ErrBadClientSubnet
+	}
+	family := binary.BigEndian.Uint16(data)
+	source, scope := int(data[2]), data[3]
+	addr := data[4:]
+	if len(addr) != (source+7)/8 {
+		return ClientSubnet{}, ErrBadClientSubnet
+	}
+	var ip netip.Addr
+	switch family {
+	case 1:
+		if source > 32 || scope > 32 {
+			return ClientSubnet{}, ErrBadClientSubnet
+		}
+		var b [4]byte
+		copy(b[:], addr)
+		ip = netip.AddrFrom4(b)
+	case 2:
+		if source > 128 || scope > 128 {
+			return ClientSubnet{}, ErrBadClientSubnet
+		}
+		var b [16]byte
+		copy(b[:], addr)
+		ip = netip.AddrFrom16(b)
+	default:
+		return ClientSubnet{}, ErrBadClientSubnet
+	}
+	p := netip.PrefixFrom(ip, source)
+	if p.Masked() != p {
+		return ClientSubnet{}, ErrBadClientSubnet
+	}
+	return ClientSubnet{Prefix: p, Scope: scope}, nil
+}
+
+// ParsePrefix parses a network in CIDR notation, or a single address as a
+// host prefix. IPv4-mapped IPv6 networks are turned into IPv4 ones, see
+// UnmapPrefix.
+func ParsePrefix(s string) (netip.Prefix, error) {
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return UnmapPrefix(p), nil
+}
+
+// UnmapPrefix turns a prefix of IPv4-mapped IPv6 addresses, at least 96 bits
+// long, into the IPv4 prefix of the same addresses. Other prefixes are
+// returned as is.
+func UnmapPrefix(p netip.Prefix) netip.Prefix {
+	if addr := p.Addr(); addr.Is4In6() && p.Bits() >= 96 {
+		return netip.PrefixFrom(addr.Unmap(), p.Bits()-96)
+	}
+	return p
+}
+
+// Pack returns the option data of c. The address is masked to the source
+// prefix length.
+func (c ClientSubnet) Pack() []byte {
+	p := c.Prefix.Masked()
+	family, ip := uint16(2), p.Addr().AsSlice()
+	if p.Addr().Is4() {
+		family = 1
+	}
+	source := p.Bits()
+	b := make([]byte, 4, 4+(source+7)/8)
+	binary.BigEndian.PutUint16(b, family)
+	b[2] = uint8(source)
+	b[3] = c.Scope
+	return append(b, ip[:(source+7)/8]...)
+}
+
+// ClientSubnet returns the EDNS Client Subnet option of the request, if any.
+func (r *Request) ClientSubnet() (ClientSubnet, bool) {
+	for _, o := range r.OPT.Options {
+		if o.Code == OptionCodeEDNSClientSubnet {
+			cs, err := ParseClientSubnet(o.Data)
+			return cs, err == nil
+		}
+	}
+	return ClientSubnet{}, false
+}
+
+// SetClientSubnet sets the EDNS Client Subnet option of the response,
+// replacing any existing one. It does nothing when the response carries no
+// OPT record, since ECS must only be returned to clients that sent it.
+// Requests use SetEDNS0ClientSubnet, which builds the option from a prefix.
+func (r *Response) SetClientSubnet(c ClientSubnet) {
+	r.setOption(OptionCodeEDNSClientSubnet, c.Pack())
+}
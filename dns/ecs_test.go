@@ -0,0 +1,75 @@
+package dns
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+)
+
+func TestClientSubnet(t *testing.T) {
+	r := assert.New(t)
+
+	for _, s := range []string{"192.0.2.0/24", "192.0.2.128/25", "2001:db8::/56", "0.0.0.0/0"} {
+		want := ClientSubnet{Prefix: netip.MustParsePrefix(s), Scope: 16}
+		got, err := ParseClientSubnet(want.Pack())
+		r.NoError(err, s)
+		r.Equal(want, got, s)
+	}
+
+	// Address bits past the source prefix length, wrong address length,
+	// and unknown families are rejected.
+	for _, data := range [][]byte{
+		{0, 1, 24, 0, 192, 0, 2, 1},
+		{0, 1, 24, 0, 192, 0},
+		{0, 3, 0, 0},
+		{0, 1, 33, 0, 1, 2, 3, 4, 5},
+		{0, 1},
+	} {
+		_, err := ParseClientSubnet(data)
+		r.ErrorIs(err, ErrBadClientSubnet)
+	}
+
+	req := new(Request)
+	_, ok := req.ClientSubnet()
+	r.False(ok)
+	req.SetEDNS0(1232, false)
+	r.NoError(req.SetEDNS0ClientSubnet(netip.MustParsePrefix("198.51.100.0/24")))
+	cs, ok := req.ClientSubnet()
+	r.True(ok)
+	r.Equal(netip.MustParsePrefix("198.51.100.0/24"), cs.Prefix)
+
+	resp := new(Response)
+	resp.SetClientSubnet(cs)
+	r.Equal(0, len(resp.Extra))
+	opt := &OPT{Hdr: RR_Header{Name: ".", Rrtype: TypeOPT, Class: 1232}}
+	resp.Extra = append(resp.Extra, opt)
+	resp.SetClientSubnet(cs)
+	cs.Scope = 20
+	resp.SetClientSubnet(cs)
+	r.Equal(1, len(opt.Options))
+	r.Equal(opt.Hdr.Rdlength, uint16(4+len(opt.Options[0].Data)))
+	got, err := ParseClientSubnet(opt.Options[0].Data)
+	r.NoError(err)
+	r.Equal(uint8(20), got.Scope)
+}
+
+func TestParsePrefix(t *testing.T) {
+	r := assert.New(t)
+	for in, want := range map[string]string{
+		"192.0.2.1":            "192.0.2.1/32",
+		"::ffff:192.0.2.1":     "192.0.2.1/32",
+		"2001:db8::/32":        "2001:db8::/32",
+		"::ffff:192.0.2.0/120": "192.0.2.0/24",
+		"::ffff:0.0.0.0/96":    "0.0.0.0/0",
+		"::ffff:0:0/80":        "::ffff:0.0.0.0/80",
+	} {
+		p, err := ParsePrefix(in)
+		r.NoError(err, in)
+		r.Equal(want, p.String(), in)
+	}
+	_, err := ParsePrefix("192.0.2.0/33")
+	r.Error(err)
+	_, err = ParsePrefix("example.com")
+	r.Error(err)
+}
@@ -0,0 +1,104 @@
+package dns
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// Seed packets shared by the fuzz targets. More real-world packets live in
+// testdata/fuzz/<target>.
+var fuzzSeeds = []string{
+	// query axtqs.com A with EDNS0 cookie
+	"4ffd0120000100000000000105617874717303636f6d0000010001000029100000000000000c000a000874b82f2641563c8e",
+	// response axtqs.com A 1.1.1.1, 3.3.3.3 with OPT
+	"4ffd8500000100020000000105617874717303636f6d0000010001c00c0001000100000258000401010101c00c000100010000025800040303030300002904d0000000000000",
+}
+
+func addFuzzSeeds(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(b)
+	}
+}
+
+func FuzzUnpackRequest(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		req := AcquireRequest()
+		defer ReleaseRequest(req)
+		if err := req.Unpack(data); err != nil {
+			return
+		}
+		_ = req.String()
+	})
+}
+
+func FuzzUnpackResponse(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		resp := AcquireResponse()
+		defer ReleaseResponse(resp)
+		if err := resp.Unpack(data); err != nil {
+			return
+		}
+		_ = resp.String()
+		if _, err := resp.MarshalJSON(); err != nil {
+			t.Fatalf("MarshalJSON: %v", err)
+		}
+	})
+}
+
+func FuzzUnpackRR(f *testing.F) {
+	f.Add([]byte("\x07example\x03com\x00\x00\x01\x00\x01\x00\x00\x0e\x10\x00\x04\xc0\x00\x02\x01"), 0)
+	f.Add([]byte("\x00\x00\x10\x00\x01\x00\x00\x00\x3c\x00\x06\x05hello"), 0)
+	f.Add([]byte("\x00\x00\x0f\x00\x01\x00\x00\x00\x3c\x00\x05\x00\x0a\x01a\x00"), 0)
+	f.Add([]byte("\x00\x00\x29\x10\x00\x00\x00\x00\x00\x00\x08\x00\x0a\x00\x04\x01\x02\x03\x04"), 0)
+	f.Fuzz(func(t *testing.T, data []byte, off int) {
+		if off < 0 || off > len(data) {
+			return
+		}
+		rr, _, err := UnpackRR(data, off)
+		if err != nil || rr == nil {
+			return
+		}
+		_ = rr.String()
+	})
+}
+
+func FuzzPackUnpackRoundTrip(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		resp := new(Response)
+		if err := resp.Unpack(data); err != nil {
+			return
+		}
+		// Records without rdata (RFC 2136 prerequisites) decode to zero-valued
+		// fields, which pack as real rdata, so they cannot round trip.
+		for _, rrs := range [][]RR{resp.Answer, resp.Ns, resp.Extra} {
+			for _, rr := range rrs {
+				if noRdata(*rr.Header()) {
+					return
+				}
+			}
+		}
+		// Pack writes the header counts verbatim, so align them with what
+		// was actually decoded.
+		resp.Header.Ancount = uint16(len(resp.Answer))
+		resp.Header.Nscount = uint16(len(resp.Ns))
+		resp.Header.Arcount = uint16(len(resp.Extra))
+		packed, err := resp.PackTo(nil)
+		if err != nil {
+			return
+		}
+		again := new(Response)
+		if err := again.Unpack(packed); err != nil {
+			t.Fatalf("unpack of packed message failed: %v\n%x\n%s", err, packed, resp)
+		}
+		if got, want := again.String(), resp.String(); got != want {
+			t.Fatalf("round trip mismatch:\n%s\n---\n%s", want, got)
+		}
+	})
+}
@@ -0,0 +1,157 @@
+//go:build ignore
+
+// gen_idna generates idna_tables.go: the UTS #46 mapping table and the
+// Unicode normalization data used by mapIDNA. It reads the tables of
+// golang.org/x/net/idna and golang.org/x/text/unicode/norm, which are only
+// needed to run it:
+//
+//	go run -mod=mod gen_idna.go
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/unicode/rangetable"
+)
+
+// profile matches the mapping of ToASCII: UTS #46 non-transitional with
+// NFC, without validity checks.
+var profile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(false),
+	idna.StrictDomainName(false),
+	idna.ValidateLabels(false),
+)
+
+func main() {
+	var (
+		valid    []rune
+		mappings bytes.Buffer
+		ccc      bytes.Buffer
+		decomp   bytes.Buffer
+		comp     bytes.Buffer
+		pairs    [][3]rune
+	)
+	cccLo, cccHi, cccClass := rune(-1), rune(-1), uint8(0)
+	flushCCC := func() {
+		if cccClass != 0 {
+			fmt.Fprintf(&ccc, "\t{0x%04X, 0x%04X, %d},\n", cccLo, cccHi, cccClass)
+		}
+	}
+	for r := rune(0x80); r <= unicode.MaxRune; r++ {
+		if r >= 0xD800 && r <= 0xDFFF {
+			continue
+		}
+		s := string(r)
+
+		// UTS #46 mapping of a single code point.
+		switch m, err := profile.ToUnicode(s); {
+		case err != nil || strings.ContainsRune(m, '.') || r == '。' || r == '．' || r == '｡':
+			// Disallowed. The full stops are handled by splitIDNA.
+		case m == s:
+			valid = append(valid, r)
+		default:
+			fmt.Fprintf(&mappings, "\t{0x%04X, %q},\n", r, m)
+		}
+
+		// Canonical combining classes.
+		c := norm.NFD.PropertiesString(s).CCC()
+		if c == cccClass && r == cccHi+1 {
+			cccHi = r
+		} else {
+			flushCCC()
+			cccLo, cccHi, cccClass = r, r, c
+		}
+
+		// Canonical decompositions and the primary composites, except for
+		// the algorithmic Hangul syllables.
+		if r >= hangulBase && r < hangulBase+hangulCount {
+			continue
+		}
+		d := norm.NFD.String(s)
+		if d == s {
+			continue
+		}
+		fmt.Fprintf(&decomp, "\t{0x%04X, %q},\n", r, d)
+		if norm.NFC.String(s) != s {
+			continue
+		}
+		last, size := utf8.DecodeLastRuneInString(d)
+		first := norm.NFC.String(d[:len(d)-size])
+		if utf8.RuneCountInString(first) != 1 || norm.NFC.String(first+string(last)) != s {
+			log.Fatalf("no canonical pair for %U", r)
+		}
+		a, _ := utf8.DecodeRuneInString(first)
+		pairs = append(pairs, [3]rune{a, last, r})
+	}
+	flushCCC()
+	// compositions is searched by pair.
+	slices.SortFunc(pairs, func(x, y [3]rune) int {
+		return cmp.Or(cmp.Compare(x[0], y[0]), cmp.Compare(x[1], y[1]))
+	})
+	for _, p := range pairs {
+		fmt.Fprintf(&comp, "\t{0x%04X, 0x%04X, 0x%04X},\n", p[0], p[1], p[2])
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_idna.go; DO NOT EDIT.\n\n")
+	b.WriteString("package dns\n\nimport \"unicode\"\n\n")
+	b.WriteString("// idnaValid holds the non-ASCII code points that UTS #46 keeps as is.\n")
+	b.WriteString("var idnaValid = ")
+	writeRangeTable(&b, rangetable.New(valid...))
+	b.WriteString("\n// idnaMappings holds the non-ASCII code points that UTS #46 maps, with NFC\n")
+	b.WriteString("// applied to the mapping. An empty mapping means the code point is ignored.\n")
+	fmt.Fprintf(&b, "var idnaMappings = []runeMapping{\n%s}\n\n", mappings.Bytes())
+	b.WriteString("// combiningClasses holds the ranges of non-zero canonical combining classes.\n")
+	fmt.Fprintf(&b, "var combiningClasses = []combiningClass{\n%s}\n\n", ccc.Bytes())
+	b.WriteString("// decompositions holds the full canonical decompositions.\n")
+	fmt.Fprintf(&b, "var decompositions = []runeMapping{\n%s}\n\n", decomp.Bytes())
+	b.WriteString("// compositions holds the primary composites by their canonical pairs.\n")
+	fmt.Fprintf(&b, "var compositions = []composition{\n%s}\n", comp.Bytes())
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("idna_tables.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+const (
+	hangulBase  = 0xAC00
+	hangulCount = 11172
+)
+
+func writeRangeTable(b *bytes.Buffer, t *unicode.RangeTable) {
+	b.WriteString("&unicode.RangeTable{\n")
+	if len(t.R16) > 0 {
+		b.WriteString("R16: []unicode.Range16{\n")
+		for _, r := range t.R16 {
+			fmt.Fprintf(b, "{0x%04X, 0x%04X, %d},\n", r.Lo, r.Hi, r.Stride)
+		}
+		b.WriteString("},\n")
+	}
+	if len(t.R32) > 0 {
+		b.WriteString("R32: []unicode.Range32{\n")
+		for _, r := range t.R32 {
+			fmt.Fprintf(b, "{0x%X, 0x%X, %d},\n", r.Lo, r.Hi, r.Stride)
+		}
+		b.WriteString("},\n")
+	}
+	if t.LatinOffset > 0 {
+		fmt.Fprintf(b, "LatinOffset: %d,\n", t.LatinOffset)
+	}
+	b.WriteString("}\n")
+}
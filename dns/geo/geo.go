@@ -0,0 +1,126 @@
+// Package geo selects answers by client network, for GeoDNS and CDN routing.
+//
+// A Table maps client networks to view names by longest prefix match. A
+// Selector holds one set of RRsets per view and answers a query from the view
+// of the client: the EDNS Client Subnet of the request (RFC 7871) when present,
+// the source address of the query otherwise. ECS responses carry the scope
+// prefix length for which the answer holds so resolvers can cache it.
+package geo
+
+import (
+	"net/netip"
+
+	"github.com/dnsoa/go/dns"
+)
+
+type rrsetKey struct {
+	name dns.NameKey
+	typ  dns.Type
+}
+
+// View is a named set of RRsets.
+type View struct {
+	rrsets map[rrsetKey][]dns.RR
+	Name   string
+}
+
+// Add adds rr to the RRset of its owner name and type.
+func (v *View) Add(rr dns.RR) error {
+	hdr := rr.Header()
+	name, err := dns.NameKeyFromString(hdr.Name)
+	if err != nil {
+		return err
+	}
+	k := rrsetKey{name: name, typ: hdr.Rrtype}
+	v.rrsets[k] = append(v.rrsets[k], rr)
+	return nil
+}
+
+// Lookup returns the RRset of name and typ. When there is none, the CNAME at
+// name is returned instead, if any.
+func (v *View) Lookup(name dns.NameKey, typ dns.Type) []dns.RR {
+	if rrs := v.rrsets[rrsetKey{name: name, typ: typ}]; len(rrs) > 0 {
+		return rrs
+	}
+	return v.rrsets[rrsetKey{name: name, typ: dns.TypeCNAME}]
+}
+
+// Selector answers queries from the view that matches the client network.
+type Selector struct {
+	table *Table
+	views map[string]*View
+	// Default is the name of the view used when the client matches no network
+	// of the table, or its view has no records for the query.
+	Default string
+}
+
+// NewSelector returns a selector over table with an empty default view.
+func NewSelector(table *Table, defaultView string) *Selector {
+	s := &Selector{table: table, views: make(map[string]*View), Default: defaultView}
+	s.View(defaultView)
+	return s
+}
+
+// View returns the view called name, creating it if needed.
+func (s *Selector) View(name string) *View {
+	v, ok := s.views[name]
+	if !ok {
+		v = &View{Name: name, rrsets: make(map[rrsetKey][]dns.RR)}
+		s.views[name] = v
+	}
+	return v
+}
+
+// Select returns the view for a query from src. When the request carries an
+// EDNS Client Subnet with a non-zero source prefix length, the view is chosen
+// by that subnet, and ecs holds the option to return with the scope prefix
+// length set; ok is false otherwise.
+func (s *Selector) Select(req *dns.Request, src netip.Addr) (view *View, ecs dns.ClientSubnet, ok bool) {
+	client := netip.PrefixFrom(src.Unmap(), src.Unmap().BitLen())
+	cs, hasECS := req.ClientSubnet()
+	if hasECS && cs.Prefix.Bits() > 0 {
+		client = cs.Prefix
+	}
+
+	name, scope, found := s.table.Match(client)
+	if !found {
+		name = s.Default
+	}
+	view = s.views[name]
+	if view == nil {
+		view = s.views[s.Default]
+	}
+	if !hasECS {
+		return view, dns.ClientSubnet{}, false
+	}
+	// A zero source prefix length asks not to use client information, and
+	// must be answered with a zero scope.
+	if cs.Prefix.Bits() == 0 {
+		scope = 0
+	}
+	cs.Scope = uint8(scope)
+	return view, cs, true
+}
+
+// Answer fills resp with the RRset for the question of req from the view of
+// the client and reports whether one was found. The records are shared with
+// the selector and must not be modified. If the request carries an EDNS Client
+// Subnet and resp has an OPT record, the ECS option with its scope is set on
+// resp.
+func (s *Selector) Answer(req *dns.Request, src netip.Addr, resp *dns.Response) bool {
+	view, ecs, hasECS := s.Select(req, src)
+	if hasECS {
+		resp.SetClientSubnet(ecs)
+	}
+	name := dns.MakeNameKey(req.Question.Name)
+	rrs := view.Lookup(name, req.Question.Type)
+	if len(rrs) == 0 && view.Name != s.Default {
+		rrs = s.views[s.Default].Lookup(name, req.Question.Type)
+	}
+	if len(rrs) == 0 {
+		return false
+	}
+	resp.Answer = append(resp.Answer, rrs...)
+	resp.Header.Ancount = uint16(len(resp.Answer))
+	return true
+}
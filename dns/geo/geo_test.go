@@ -0,0 +1,182 @@
+package geo
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+)
+
+const networks = `network,view
+# Europe
+192.0.2.0/24, eu
+198.51.100.0/22,eu
+198.51.100.128/25,eu-west
+203.0.113.7,office
+2001:db8::/32,us
+::ffff:10.0.0.0/104,lan
+`
+
+func TestLoadCSV(t *testing.T) {
+	r := assert.New(t)
+	table, err := LoadCSV(strings.NewReader(networks))
+	r.NoError(err)
+	r.Equal(6, table.Len())
+
+	tests := []struct {
+		addr string
+		view string
+		ok   bool
+	}{
+		{"192.0.2.1", "eu", true},
+		{"198.51.101.1", "eu", true},
+		{"198.51.100.200", "eu-west", true},
+		{"203.0.113.7", "office", true},
+		{"203.0.113.8", "", false},
+		{"2001:db8::1", "us", true},
+		{"::ffff:192.0.2.9", "eu", true},
+		{"10.1.2.3", "lan", true},
+		{"2001:db9::1", "", false},
+	}
+	for _, tt := range tests {
+		view, ok := table.Lookup(netip.MustParseAddr(tt.addr))
+		r.Equal(tt.ok, ok, tt.addr)
+		r.Equal(tt.view, view, tt.addr)
+	}
+
+	_, err = LoadCSV(strings.NewReader("192.0.2.0/24,eu\nnot-a-network,us\n"))
+	r.ErrorIs(err, ErrBadPrefix)
+	_, err = LoadCSV(strings.NewReader("192.0.2.0/24\n"))
+	r.Error(err)
+}
+
+func TestMatchScope(t *testing.T) {
+	r := assert.New(t)
+	table, err := LoadCSV(strings.NewReader(networks))
+	r.NoError(err)
+
+	tests := []struct {
+		client string
+		view   string
+		scope  int
+		ok     bool
+	}{
+		// The /22 holds a more specific /25, so the answer only holds for the
+		// shortest prefix of the client that avoids it.
+		{"198.51.101.0/24", "eu", 24, true},
+		{"198.51.100.0/24", "eu", 24, true},
+		{"198.51.100.128/25", "eu-west", 25, true},
+		{"198.51.100.200/32", "eu-west", 25, true},
+		{"192.0.2.0/24", "eu", 24, true},
+		{"192.0.2.77/32", "eu", 24, true},
+		// Networks longer than the client are not used.
+		{"203.0.113.0/24", "", 24, false},
+		{"2001:db8:1::/48", "us", 32, true},
+		{"2001:db9::/48", "", 32, false},
+	}
+	for _, tt := range tests {
+		view, scope, ok := table.Match(netip.MustParsePrefix(tt.client))
+		r.Equal(tt.ok, ok, tt.client)
+		r.Equal(tt.view, view, tt.client)
+		r.Equal(tt.scope, scope, tt.client)
+	}
+
+	empty := NewTable()
+	_, scope, ok := empty.Match(netip.MustParsePrefix("192.0.2.0/24"))
+	r.False(ok)
+	r.Equal(0, scope)
+}
+
+func newSelector(t *testing.T) *Selector {
+	table, err := LoadCSV(strings.NewReader(networks))
+	assert.NoError(t, err)
+	s := NewSelector(table, "default")
+	records := map[string]string{
+		"default": "cdn.example.com. 60 IN A 192.0.2.100",
+		"eu":      "cdn.example.com. 60 IN A 192.0.2.1",
+		"us":      "cdn.example.com. 60 IN A 198.51.100.1",
+	}
+	for view, record := range records {
+		rr, err := dns.NewRR(record)
+		assert.NoError(t, err)
+		assert.NoError(t, s.View(view).Add(rr))
+	}
+	rr, err := dns.NewRR("www.example.com. 60 IN CNAME cdn.example.com.")
+	assert.NoError(t, err)
+	assert.NoError(t, s.View("default").Add(rr))
+	return s
+}
+
+func newQuery(t *testing.T, name string, subnet string) *dns.Request {
+	raw := new(dns.Request)
+	if subnet != "" {
+		raw.SetEDNS0(1232, false)
+		assert.NoError(t, raw.SetEDNS0ClientSubnet(netip.MustParsePrefix(subnet)))
+	}
+	raw.SetQuestion(name, dns.TypeA, dns.ClassINET)
+	req := new(dns.Request)
+	assert.NoError(t, req.Unpack(raw.Raw))
+	return req
+}
+
+func newReply() *dns.Response {
+	resp := new(dns.Response)
+	resp.Extra = append(resp.Extra, &dns.OPT{Hdr: dns.RR_Header{Name: ".", Rrtype: dns.TypeOPT, Class: 1232}})
+	return resp
+}
+
+func TestSelectorAnswer(t *testing.T) {
+	r := assert.New(t)
+	s := newSelector(t)
+
+	// Without ECS the source address selects the view.
+	resp := newReply()
+	r.True(s.Answer(newQuery(t, "CDN.example.com", ""), netip.MustParseAddr("2001:db8::53"), resp))
+	r.Equal(1, len(resp.Answer))
+	r.Equal([4]byte{198, 51, 100, 1}, resp.Answer[0].(*dns.A).A)
+	_, hasECS := clientSubnet(resp)
+	r.False(hasECS)
+
+	// ECS wins over the source address and is echoed with its scope.
+	resp = newReply()
+	r.True(s.Answer(newQuery(t, "cdn.example.com", "198.51.101.0/24"), netip.MustParseAddr("2001:db8::53"), resp))
+	r.Equal([4]byte{192, 0, 2, 1}, resp.Answer[0].(*dns.A).A)
+	cs, hasECS := clientSubnet(resp)
+	r.True(hasECS)
+	r.Equal(netip.MustParsePrefix("198.51.101.0/24"), cs.Prefix)
+	r.Equal(uint8(24), cs.Scope)
+
+	// Unknown clients, and views without the name, use the default view.
+	resp = newReply()
+	r.True(s.Answer(newQuery(t, "cdn.example.com", ""), netip.MustParseAddr("203.0.113.99"), resp))
+	r.Equal([4]byte{192, 0, 2, 100}, resp.Answer[0].(*dns.A).A)
+	resp = newReply()
+	r.True(s.Answer(newQuery(t, "www.example.com", "192.0.2.0/24"), netip.Addr{}, resp))
+	r.Equal(dns.TypeCNAME, resp.Answer[0].Header().Rrtype)
+	r.Equal(uint16(1), resp.Header.Ancount)
+
+	// A zero source prefix length is answered by source address with scope 0.
+	resp = newReply()
+	r.True(s.Answer(newQuery(t, "cdn.example.com", "0.0.0.0/0"), netip.MustParseAddr("192.0.2.53"), resp))
+	r.Equal([4]byte{192, 0, 2, 1}, resp.Answer[0].(*dns.A).A)
+	cs, _ = clientSubnet(resp)
+	r.Equal(uint8(0), cs.Scope)
+
+	r.False(s.Answer(newQuery(t, "missing.example.com", ""), netip.MustParseAddr("192.0.2.53"), newReply()))
+}
+
+func clientSubnet(resp *dns.Response) (dns.ClientSubnet, bool) {
+	for _, rr := range resp.Extra {
+		if opt, ok := rr.(*dns.OPT); ok {
+			for _, o := range opt.Options {
+				if o.Code == dns.OptionCodeEDNSClientSubnet {
+					cs, err := dns.ParseClientSubnet(o.Data)
+					return cs, err == nil
+				}
+			}
+		}
+	}
+	return dns.ClientSubnet{}, false
+}
@@ -0,0 +1,182 @@
+package geo
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/dnsoa/go/dns"
+)
+
+// ErrBadPrefix is returned by LoadCSV for a network that cannot be parsed.
+var ErrBadPrefix = errors.New("geo: bad network")
+
+// Table maps client networks to view names by longest prefix match.
+type Table struct {
+	views map[netip.Prefix]string
+	// covered holds, for every prefix length in use, the masked prefixes
+	// that contain a longer network. A match on a covered prefix does not
+	// hold for the whole prefix.
+	covered map[netip.Prefix]struct{}
+	// bits4 and bits6 list the IPv4 and IPv6 prefix lengths in use, shortest first.
+	bits4, bits6 []int
+}
+
+// NewTable returns an empty table.
+func NewTable() *Table {
+	return &Table{
+		views:   make(map[netip.Prefix]string),
+		covered: make(map[netip.Prefix]struct{}),
+	}
+}
+
+// LoadCSV reads a table from r. Each record holds a network in CIDR notation
+// or a single address, and a view name:
+//
+//	# network,view
+//	192.0.2.0/24,eu
+//	2001:db8::/32,us
+//
+// Lines starting with '#' are comments, and a first record whose network does
+// not parse is taken as a header and skipped.
+func LoadCSV(r io.Reader) (*Table, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = 2
+	cr.TrimLeadingSpace = true
+	cr.ReuseRecord = true
+
+	t := NewTable()
+	for first := true; ; first = false {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return t, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		p, err := dns.ParsePrefix(strings.TrimSpace(rec[0]))
+		if err != nil {
+			if first {
+				continue
+			}
+			line, _ := cr.FieldPos(0)
+			return nil, fmt.Errorf("%w on line %d: %q", ErrBadPrefix, line, rec[0])
+		}
+		t.Add(p, strings.TrimSpace(rec[1]))
+	}
+}
+
+// Len returns the number of networks in the table.
+func (t *Table) Len() int {
+	return len(t.views)
+}
+
+// Add maps the network p to view, replacing any previous view for p.
+func (t *Table) Add(p netip.Prefix, view string) {
+	p = dns.UnmapPrefix(p).Masked()
+	if _, ok := t.views[p]; !ok {
+		t.addBits(p)
+		t.cover(p)
+	}
+	t.views[p] = view
+}
+
+// addBits records the length of p. A new length is back-filled into covered
+// for the networks already in the table.
+func (t *Table) addBits(p netip.Prefix) {
+	bits := t.lengths(p.Addr())
+	i, found := slices.BinarySearch(*bits, p.Bits())
+	if found {
+		return
+	}
+	*bits = slices.Insert(*bits, i, p.Bits())
+	for q := range t.views {
+		if q.Addr().Is4() == p.Addr().Is4() && q.Bits() > p.Bits() {
+			t.covered[netip.PrefixFrom(q.Addr(), p.Bits()).Masked()] = struct{}{}
+		}
+	}
+}
+
+// cover marks the networks in use that contain p.
+func (t *Table) cover(p netip.Prefix) {
+	for _, bits := range *t.lengths(p.Addr()) {
+		if bits >= p.Bits() {
+			break
+		}
+		t.covered[netip.PrefixFrom(p.Addr(), bits).Masked()] = struct{}{}
+	}
+}
+
+func (t *Table) lengths(addr netip.Addr) *[]int {
+	if addr.Is4() {
+		return &t.bits4
+	}
+	return &t.bits6
+}
+
+// Lookup returns the view of the longest network containing addr.
+func (t *Table) Lookup(addr netip.Addr) (string, bool) {
+	addr = addr.Unmap()
+	view, _, ok := t.Match(netip.PrefixFrom(addr, addr.BitLen()))
+	return view, ok
+}
+
+// Match returns the view of the longest network that contains the client
+// network p, and the scope prefix length for which that answer holds, as
+// defined by RFC 7871: every address within the first scope bits of p would
+// select the same view. Networks longer than p are not considered.
+//
+// When no network matches, ok is false and scope still covers the addresses
+// that would fall through to the default view as well.
+func (t *Table) Match(p netip.Prefix) (view string, scope int, ok bool) {
+	p = dns.UnmapPrefix(p)
+	if !p.IsValid() {
+		return "", 0, false
+	}
+	p = p.Masked()
+	lengths := *t.lengths(p.Addr())
+	if len(lengths) == 0 {
+		// Nothing in the table for this address family.
+		return "", 0, false
+	}
+	source := p.Bits()
+	matched := -1
+	for i := len(lengths) - 1; i >= 0; i-- {
+		if lengths[i] > source {
+			continue
+		}
+		if v, found := t.views[netip.PrefixFrom(p.Addr(), lengths[i]).Masked()]; found {
+			view, matched, ok = v, lengths[i], true
+			break
+		}
+	}
+	if ok {
+		if _, nested := t.covered[netip.PrefixFrom(p.Addr(), matched).Masked()]; !nested {
+			return view, matched, true
+		}
+	}
+	// The answer holds for the shortest prefix of p, longer than the match,
+	// that neither is nor contains a network of the table.
+	for _, bits := range lengths {
+		if bits <= matched {
+			continue
+		}
+		if bits > source {
+			break
+		}
+		a := netip.PrefixFrom(p.Addr(), bits).Masked()
+		if _, nested := t.covered[a]; nested {
+			continue
+		}
+		if _, entry := t.views[a]; entry {
+			continue
+		}
+		return view, bits, ok
+	}
+	return view, source, ok
+}
@@ -1,8 +1,16 @@
 module github.com/dnsoa/go/dns
 
-go 1.24.4
+// trie and fasttime declare go 1.25.0.
+go 1.25.0
 
 require (
 	github.com/dnsoa/go/assert v1.1.2
+	github.com/dnsoa/go/fasttime v0.0.0-00010101000000-000000000000
 	github.com/dnsoa/go/sync v1.1.0
+	github.com/dnsoa/go/trie v0.0.0-00010101000000-000000000000
+)
+
+replace (
+	github.com/dnsoa/go/fasttime => ../fasttime
+	github.com/dnsoa/go/trie => ../trie
 )
@@ -0,0 +1,75 @@
+package dns
+
+import (
+	"bufio"
+	"io"
+	"net/netip"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Hosts maps host names to addresses, as a hosts file does, see hosts(5).
+type Hosts struct {
+	byName map[string][]netip.Addr // lowercased, fully qualified
+	byAddr map[netip.Addr][]string
+}
+
+// ReadHosts parses the hosts file at path.
+func ReadHosts(path string) (*Hosts, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseHosts(f)
+}
+
+// ParseHosts parses a hosts file from r: an address and its names on every
+// line, with comments starting with '#'. Lines with a bad address are
+// skipped.
+func ParseHosts(r io.Reader) (*Hosts, error) {
+	h := &Hosts{byName: make(map[string][]netip.Addr), byAddr: make(map[netip.Addr][]string)}
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		f := strings.Fields(line)
+		if len(f) < 2 {
+			continue
+		}
+		addr, err := netip.ParseAddr(f[0])
+		if err != nil {
+			continue
+		}
+		for _, name := range f[1:] {
+			key := strings.ToLower(Fqdn(name))
+			if !slices.Contains(h.byName[key], addr) {
+				h.byName[key] = append(h.byName[key], addr)
+			}
+			if !slices.Contains(h.byAddr[addr], Fqdn(name)) {
+				h.byAddr[addr] = append(h.byAddr[addr], Fqdn(name))
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// LookupHost returns the addresses of name, case-insensitive, in the order
+// of the file. A nil Hosts has no entries.
+func (h *Hosts) LookupHost(name string) []netip.Addr {
+	if h == nil {
+		return nil
+	}
+	return h.byName[strings.ToLower(Fqdn(name))]
+}
+
+// LookupAddr returns the fully qualified names of addr.
+func (h *Hosts) LookupAddr(addr netip.Addr) []string {
+	if h == nil {
+		return nil
+	}
+	return h.byAddr[addr]
+}
@@ -0,0 +1,603 @@
+package dns
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:generate go run -mod=mod gen_idna.go
+
+var (
+	// ErrPunycode is returned for malformed punycode input or overflow.
+	ErrPunycode = errors.New("dns: invalid punycode")
+	// ErrInvalidLabel is returned when a label violates IDNA validity rules.
+	ErrInvalidLabel = errors.New("dns: invalid IDNA label")
+	// ErrMixedScript is returned when a label mixes scripts that are not
+	// commonly used together (UTS #39, highly restrictive).
+	ErrMixedScript = errors.New("dns: label mixes scripts")
+)
+
+const (
+	acePrefix = "xn--"
+
+	// Punycode parameters, see RFC 3492 section 5.
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+)
+
+// ToASCII converts a domain name in presentation format to its ASCII (A-label)
+// form. Each label is mapped with UTS #46 non-transitional processing, checked
+// for validity and mixed scripts, and punycode encoded when it is not ASCII.
+//
+// The mapping uses the UTS #46 tables in idna_tables.go followed by NFC
+// normalization. A leading "*" label is kept as is for wildcard names.
+func ToASCII(name string) (string, error) {
+	labels, fqdn := splitIDNA(name)
+	for i, label := range labels {
+		if i == 0 && label == "*" {
+			continue
+		}
+		label, err := mapIDNA(label)
+		if err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(label, acePrefix) {
+			// Already an A-label.
+			if _, err := decodeALabel(label); err != nil {
+				return "", err
+			}
+			labels[i] = label
+			continue
+		}
+		if err := validateLabel(label); err != nil {
+			return "", err
+		}
+		if isASCII(label) {
+			labels[i] = label
+			continue
+		}
+		enc, err := EncodePunycode(label)
+		if err != nil {
+			return "", err
+		}
+		labels[i] = acePrefix + enc
+		if len(labels[i]) > 63 {
+			return "", ErrInvalidLabel
+		}
+	}
+	s := joinIDNA(labels, fqdn)
+	if len(s) > maxDomainNameWireOctets-1 {
+		return "", ErrLongDomain
+	}
+	return s, nil
+}
+
+// ToUnicode converts a domain name with A-labels into its Unicode form.
+// Labels that fail to decode or validate cause an error.
+func ToUnicode(name string) (string, error) {
+	labels, fqdn := splitIDNA(name)
+	for i, label := range labels {
+		if i == 0 && label == "*" {
+			continue
+		}
+		label, err := mapIDNA(label)
+		if err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(label, acePrefix) {
+			u, err := decodeALabel(label)
+			if err != nil {
+				return "", err
+			}
+			labels[i] = u
+			continue
+		}
+		if err := validateLabel(label); err != nil {
+			return "", err
+		}
+		labels[i] = label
+	}
+	return joinIDNA(labels, fqdn), nil
+}
+
+// decodeALabel decodes and validates an A-label, see RFC 5891, section
+// 5.4: it must decode to a valid U-label that is not all ASCII and encodes
+// back to the same A-label.
+func decodeALabel(label string) (string, error) {
+	u, err := DecodePunycode(label[len(acePrefix):])
+	if err != nil {
+		return "", err
+	}
+	if isASCII(u) {
+		return "", ErrInvalidLabel
+	}
+	if err := validateLabel(u); err != nil {
+		return "", err
+	}
+	enc, err := EncodePunycode(u)
+	if err != nil {
+		return "", err
+	}
+	if acePrefix+enc != label {
+		return "", ErrInvalidLabel
+	}
+	return u, nil
+}
+
+// EncodePunycode encodes a Unicode label with the punycode algorithm of RFC 3492.
+// The result does not include the "xn--" prefix.
+func EncodePunycode(s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", ErrPunycode
+	}
+	runes := []rune(s)
+	out := make([]byte, 0, len(s)+4)
+	for _, r := range runes {
+		if r < 0x80 {
+			out = append(out, byte(r))
+		}
+	}
+	b := len(out)
+	h := b
+	if b > 0 {
+		out = append(out, '-')
+	}
+
+	n, delta, bias := rune(punyInitialN), 0, punyInitialBias
+	for h < len(runes) {
+		m := rune(unicode.MaxRune + 1)
+		for _, r := range runes {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+		if int(m-n) > (1<<31-1-delta)/(h+1) {
+			return "", ErrPunycode
+		}
+		delta += int(m-n) * (h + 1)
+		n = m
+		for _, r := range runes {
+			if r < n {
+				delta++
+				if delta < 0 {
+					return "", ErrPunycode
+				}
+			}
+			if r != n {
+				continue
+			}
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := punyThreshold(k, bias)
+				if q < t {
+					break
+				}
+				out = append(out, punyDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			out = append(out, punyDigit(q))
+			bias = punyAdapt(delta, h+1, h == b)
+			delta = 0
+			h++
+		}
+		delta++
+		n++
+	}
+	return string(out), nil
+}
+
+// DecodePunycode decodes a punycode string (without the "xn--" prefix) as
+// described in RFC 3492.
+func DecodePunycode(s string) (string, error) {
+	var output []rune
+	pos := 0
+	if i := strings.LastIndexByte(s, '-'); i >= 0 {
+		for _, c := range []byte(s[:i]) {
+			if c >= 0x80 {
+				return "", ErrPunycode
+			}
+			output = append(output, rune(c))
+		}
+		pos = i + 1
+	}
+
+	n, i, bias := rune(punyInitialN), 0, punyInitialBias
+	for pos < len(s) {
+		oldi, w := i, 1
+		for k := punyBase; ; k += punyBase {
+			if pos >= len(s) {
+				return "", ErrPunycode
+			}
+			digit, ok := punyValue(s[pos])
+			pos++
+			if !ok || digit > (1<<31-1-i)/w {
+				return "", ErrPunycode
+			}
+			i += digit * w
+			t := punyThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			if w > (1<<31-1)/(punyBase-t) {
+				return "", ErrPunycode
+			}
+			w *= punyBase - t
+		}
+		x := len(output) + 1
+		bias = punyAdapt(i-oldi, x, oldi == 0)
+		if i/x > unicode.MaxRune-int(n) {
+			return "", ErrPunycode
+		}
+		n += rune(i / x)
+		i %= x
+		if n < 0x80 || n > unicode.MaxRune || (n >= 0xD800 && n <= 0xDFFF) {
+			return "", ErrPunycode
+		}
+		output = append(output, 0)
+		copy(output[i+1:], output[i:])
+		output[i] = n
+		i++
+	}
+	return string(output), nil
+}
+
+func punyThreshold(k, bias int) int {
+	switch {
+	case k <= bias:
+		return punyTMin
+	case k >= bias+punyTMax:
+		return punyTMax
+	}
+	return k - bias
+}
+
+func punyAdapt(delta, numPoints int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > ((punyBase-punyTMin)*punyTMax)/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+func punyValue(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c-'0') + 26, true
+	case c >= 'a' && c <= 'z':
+		return int(c - 'a'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c - 'A'), true
+	}
+	return 0, false
+}
+
+// splitIDNA splits name into labels on the full stop and its UTS #46 equivalents.
+func splitIDNA(name string) ([]string, bool) {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '\u3002', '\uFF0E', '\uFF61':
+			return '.'
+		}
+		return r
+	}, name)
+	fqdn := strings.HasSuffix(name, ".")
+	name = strings.TrimSuffix(name, ".")
+	if name == "" {
+		return nil, true
+	}
+	return strings.Split(name, "."), fqdn
+}
+
+func joinIDNA(labels []string, fqdn bool) string {
+	s := strings.Join(labels, ".")
+	if fqdn || len(labels) == 0 {
+		s += "."
+	}
+	return s
+}
+
+// mapIDNA applies the UTS #46 non-transitional mapping and NFC to a label.
+// Only the prefix of A-labels is lowercased, the rest is left to
+// DecodePunycode. Validity, including underscores, is checked by
+// validateLabel instead.
+func mapIDNA(label string) (string, error) {
+	if isASCII(label) {
+		return strings.ToLower(label), nil
+	}
+	if len(label) >= len(acePrefix) && strings.EqualFold(label[:len(acePrefix)], acePrefix) {
+		return acePrefix + label[len(acePrefix):], nil
+	}
+	var b strings.Builder
+	for _, r := range label {
+		switch {
+		case r < utf8.RuneSelf:
+			if r >= 'A' && r <= 'Z' {
+				r += 'a' - 'A'
+			}
+			b.WriteRune(r)
+		case unicode.Is(idnaValid, r):
+			b.WriteRune(r)
+		default:
+			i, ok := slices.BinarySearchFunc(idnaMappings, r, searchMapping)
+			if !ok {
+				return "", ErrInvalidLabel
+			}
+			b.WriteString(idnaMappings[i].s)
+		}
+	}
+	return nfc(b.String()), nil
+}
+
+// runeMapping maps a code point to a string.
+type runeMapping struct {
+	r rune
+	s string
+}
+
+func searchMapping(m runeMapping, r rune) int { return cmp.Compare(m.r, r) }
+
+// combiningClass is a range of code points with the same canonical
+// combining class.
+type combiningClass struct {
+	lo, hi rune
+	ccc    uint8
+}
+
+// composition is a canonical pair and its primary composite.
+type composition struct {
+	a, b, r rune
+}
+
+// Hangul syllable parameters, see the Unicode Standard, section 3.12.
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// nfc returns s in Normalization Form C: canonically decomposed, reordered
+// and recomposed, see UAX #15.
+func nfc(s string) string {
+	runes := make([]rune, 0, len(s))
+	for _, r := range s {
+		runes = decompose(runes, r)
+	}
+	// Canonical ordering: stable sort each run of non-starters.
+	for i := 1; i < len(runes); i++ {
+		c := ccc(runes[i])
+		for j := i; c != 0 && j > 0 && ccc(runes[j-1]) > c; j-- {
+			runes[j-1], runes[j] = runes[j], runes[j-1]
+		}
+	}
+	// Canonical composition: a character combines with the last starter
+	// unless a character in between has a class of zero or at least its own.
+	out := runes[:0]
+	starter, last := -1, uint8(0)
+	for _, r := range runes {
+		c := ccc(r)
+		if starter >= 0 && (starter == len(out)-1 || last != 0 && last < c) {
+			if p, ok := compose(out[starter], r); ok {
+				out[starter] = p
+				continue
+			}
+		}
+		if c == 0 {
+			starter = len(out)
+		}
+		last = c
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// decompose appends the full canonical decomposition of r to runes.
+func decompose(runes []rune, r rune) []rune {
+	if s := r - hangulSBase; s >= 0 && s < hangulSCount {
+		runes = append(runes, hangulLBase+s/hangulNCount, hangulVBase+s%hangulNCount/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			runes = append(runes, hangulTBase+t)
+		}
+		return runes
+	}
+	if i, ok := slices.BinarySearchFunc(decompositions, r, searchMapping); ok {
+		return append(runes, []rune(decompositions[i].s)...)
+	}
+	return append(runes, r)
+}
+
+// compose returns the primary composite of the pair a, b.
+func compose(a, b rune) (rune, bool) {
+	if l, v := a-hangulLBase, b-hangulVBase; l >= 0 && l < hangulLCount && v >= 0 && v < hangulVCount {
+		return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+	}
+	if s, t := a-hangulSBase, b-hangulTBase; s >= 0 && s < hangulSCount && s%hangulTCount == 0 && t > 0 && t < hangulTCount {
+		return a + t, true
+	}
+	i, ok := slices.BinarySearchFunc(compositions, composition{a: a, b: b}, func(c, key composition) int {
+		return cmp.Or(cmp.Compare(c.a, key.a), cmp.Compare(c.b, key.b))
+	})
+	if !ok {
+		return 0, false
+	}
+	return compositions[i].r, true
+}
+
+// ccc returns the canonical combining class of r.
+func ccc(r rune) uint8 {
+	if r < 0x300 {
+		return 0
+	}
+	i, ok := slices.BinarySearchFunc(combiningClasses, r, func(c combiningClass, r rune) int {
+		switch {
+		case c.hi < r:
+			return -1
+		case c.lo > r:
+			return 1
+		}
+		return 0
+	})
+	if !ok {
+		return 0
+	}
+	return combiningClasses[i].ccc
+}
+
+// validateLabel checks IDNA2008 label validity for a mapped label. Plain
+// ASCII labels only need to be LDH, with underscores for service names, and
+// are not subject to the hyphen rules of U-labels: names such as
+// r3---sn-abc.googlevideo.com exist.
+func validateLabel(label string) error {
+	if label == "" || len(label) > 63*4 {
+		return ErrInvalidLabel
+	}
+	if isASCII(label) {
+		if len(label) > 63 {
+			return ErrInvalidLabel
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return ErrInvalidLabel
+			}
+		}
+		return nil
+	}
+	// RFC 5891, section 4.2.3.1.
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return ErrInvalidLabel
+	}
+	if r := []rune(label); len(r) >= 4 && r[2] == '-' && r[3] == '-' {
+		return ErrInvalidLabel
+	}
+	for i, r := range label {
+		switch {
+		case i == 0 && unicode.Is(unicode.M, r):
+			return ErrInvalidLabel
+		case r == '\u200C' || r == '\u200D':
+			// ZWNJ and ZWJ are only valid in context (RFC 5892, appendix A.1
+			// and A.2). A preceding nonspacing mark stands in for the virama.
+			prev, _ := utf8.DecodeLastRuneInString(label[:i])
+			if !unicode.In(prev, unicode.Mn) {
+				return ErrInvalidLabel
+			}
+		case r == '-', unicode.In(r, unicode.L, unicode.M, unicode.Nd):
+		default:
+			return ErrInvalidLabel
+		}
+	}
+	return CheckScripts(label)
+}
+
+// scriptSets are the script combinations allowed in one label besides a single
+// script, following the UTS #39 highly restrictive profile.
+var scriptSets = [][]*unicode.RangeTable{
+	{unicode.Latin, unicode.Han, unicode.Hiragana, unicode.Katakana},
+	{unicode.Latin, unicode.Han, unicode.Bopomofo},
+	{unicode.Latin, unicode.Han, unicode.Hangul},
+}
+
+// commonScripts are tried before the full unicode.Scripts table.
+var commonScripts = []*unicode.RangeTable{
+	unicode.Latin, unicode.Greek, unicode.Cyrillic, unicode.Armenian,
+	unicode.Hebrew, unicode.Arabic, unicode.Devanagari, unicode.Bengali,
+	unicode.Thai, unicode.Georgian, unicode.Hangul, unicode.Hiragana,
+	unicode.Katakana, unicode.Bopomofo, unicode.Han, unicode.Tamil,
+	unicode.Telugu, unicode.Ethiopic, unicode.Khmer, unicode.Lao,
+}
+
+// CheckScripts returns ErrMixedScript when label contains letters from more
+// than one script, unless the combination is a common CJK one (Latin with Han
+// and Hiragana/Katakana, Bopomofo or Hangul). Digits, hyphens and combining
+// marks are script neutral.
+func CheckScripts(label string) error {
+	var seen []*unicode.RangeTable
+	for _, r := range label {
+		if r < 0x80 && !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			continue
+		}
+		if unicode.In(r, unicode.Common, unicode.Inherited) {
+			continue
+		}
+		script := scriptOf(r)
+		if script != nil && !containsTable(seen, script) {
+			seen = append(seen, script)
+		}
+	}
+	if len(seen) <= 1 {
+		return nil
+	}
+	for _, set := range scriptSets {
+		ok := true
+		for _, s := range seen {
+			if !containsTable(set, s) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return nil
+		}
+	}
+	return ErrMixedScript
+}
+
+func scriptOf(r rune) *unicode.RangeTable {
+	for _, s := range commonScripts {
+		if unicode.Is(s, r) {
+			return s
+		}
+	}
+	for _, s := range unicode.Scripts {
+		if unicode.Is(s, r) {
+			return s
+		}
+	}
+	return nil
+}
+
+func containsTable(tables []*unicode.RangeTable, t *unicode.RangeTable) bool {
+	for _, v := range tables {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,10663 @@
+// Code generated by gen_idna.go; DO NOT EDIT.
+
+package dns
+
+import "unicode"
+
+// idnaValid holds the non-ASCII code points that UTS #46 keeps as is.
+var idnaValid = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00A1, 0x00A7, 1},
+		{0x00A9, 0x00AB, 2},
+		{0x00AC, 0x00B0, 2},
+		{0x00B1, 0x00B6, 5},
+		{0x00B7, 0x00BF, 4},
+		{0x00D7, 0x00DF, 8},
+		{0x00E0, 0x00FF, 1},
+		{0x0101, 0x0131, 2},
+		{0x0135, 0x0137, 2},
+		{0x0138, 0x013E, 2},
+		{0x0142, 0x0148, 2},
+		{0x014B, 0x0177, 2},
+		{0x017A, 0x0180, 2},
+		{0x0183, 0x0185, 2},
+		{0x0188, 0x018C, 4},
+		{0x018D, 0x0192, 5},
+		{0x0195, 0x0199, 4},
+		{0x019A, 0x019B, 1},
+		{0x019E, 0x01A1, 3},
+		{0x01A3, 0x01A5, 2},
+		{0x01A8, 0x01AA, 2},
+		{0x01AB, 0x01AD, 2},
+		{0x01B0, 0x01B4, 4},
+		{0x01B6, 0x01B9, 3},
+		{0x01BA, 0x01BB, 1},
+		{0x01BD, 0x01C3, 1},
+		{0x01CE, 0x01DC, 2},
+		{0x01DD, 0x01EF, 2},
+		{0x01F0, 0x01F5, 5},
+		{0x01F9, 0x0233, 2},
+		{0x0234, 0x0239, 1},
+		{0x023C, 0x023F, 3},
+		{0x0240, 0x0242, 2},
+		{0x0247, 0x024F, 2},
+		{0x0250, 0x02AF, 1},
+		{0x02B9, 0x02D7, 1},
+		{0x02DE, 0x02DF, 1},
+		{0x02E5, 0x033F, 1},
+		{0x0342, 0x0346, 4},
+		{0x0347, 0x034E, 1},
+		{0x0350, 0x036F, 1},
+		{0x0371, 0x0377, 2},
+		{0x037B, 0x037D, 1},
+		{0x0390, 0x03AC, 28},
+		{0x03AD, 0x03CE, 1},
+		{0x03D7, 0x03EF, 2},
+		{0x03F3, 0x03F6, 3},
+		{0x03F8, 0x03FB, 3},
+		{0x03FC, 0x0430, 52},
+		{0x0431, 0x045F, 1},
+		{0x0461, 0x0481, 2},
+		{0x0482, 0x0489, 1},
+		{0x048B, 0x04BF, 2},
+		{0x04C2, 0x04CE, 2},
+		{0x04CF, 0x052F, 2},
+		{0x0559, 0x0586, 1},
+		{0x0588, 0x058A, 1},
+		{0x058D, 0x058F, 1},
+		{0x0591, 0x05C7, 1},
+		{0x05D0, 0x05EA, 1},
+		{0x05EF, 0x05F4, 1},
+		{0x0606, 0x061B, 1},
+		{0x061D, 0x0674, 1},
+		{0x0679, 0x06DC, 1},
+		{0x06DE, 0x070D, 1},
+		{0x0710, 0x074A, 1},
+		{0x074D, 0x07B1, 1},
+		{0x07C0, 0x07FA, 1},
+		{0x07FD, 0x082D, 1},
+		{0x0830, 0x083E, 1},
+		{0x0840, 0x085B, 1},
+		{0x085E, 0x0860, 2},
+		{0x0861, 0x086A, 1},
+		{0x0870, 0x088E, 1},
+		{0x0898, 0x08E1, 1},
+		{0x08E3, 0x0957, 1},
+		{0x0960, 0x0983, 1},
+		{0x0985, 0x098C, 1},
+		{0x098F, 0x0990, 1},
+		{0x0993, 0x09A8, 1},
+		{0x09AA, 0x09B0, 1},
+		{0x09B2, 0x09B6, 4},
+		{0x09B7, 0x09B9, 1},
+		{0x09BC, 0x09C4, 1},
+		{0x09C7, 0x09C8, 1},
+		{0x09CB, 0x09CE, 1},
+		{0x09D7, 0x09E0, 9},
+		{0x09E1, 0x09E3, 1},
+		{0x09E6, 0x09FE, 1},
+		{0x0A01, 0x0A03, 1},
+		{0x0A05, 0x0A0A, 1},
+		{0x0A0F, 0x0A10, 1},
+		{0x0A13, 0x0A28, 1},
+		{0x0A2A, 0x0A30, 1},
+		{0x0A32, 0x0A38, 3},
+		{0x0A39, 0x0A3C, 3},
+		{0x0A3E, 0x0A42, 1},
+		{0x0A47, 0x0A48, 1},
+		{0x0A4B, 0x0A4D, 1},
+		{0x0A51, 0x0A5C, 11},
+		{0x0A66, 0x0A76, 1},
+		{0x0A81, 0x0A83, 1},
+		{0x0A85, 0x0A8D, 1},
+		{0x0A8F, 0x0A91, 1},
+		{0x0A93, 0x0AA8, 1},
+		{0x0AAA, 0x0AB0, 1},
+		{0x0AB2, 0x0AB3, 1},
+		{0x0AB5, 0x0AB9, 1},
+		{0x0ABC, 0x0AC5, 1},
+		{0x0AC7, 0x0AC9, 1},
+		{0x0ACB, 0x0ACD, 1},
+		{0x0AD0, 0x0AE0, 16},
+		{0x0AE1, 0x0AE3, 1},
+		{0x0AE6, 0x0AF1, 1},
+		{0x0AF9, 0x0AFF, 1},
+		{0x0B01, 0x0B03, 1},
+		{0x0B05, 0x0B0C, 1},
+		{0x0B0F, 0x0B10, 1},
+		{0x0B13, 0x0B28, 1},
+		{0x0B2A, 0x0B30, 1},
+		{0x0B32, 0x0B33, 1},
+		{0x0B35, 0x0B39, 1},
+		{0x0B3C, 0x0B44, 1},
+		{0x0B47, 0x0B48, 1},
+		{0x0B4B, 0x0B4D, 1},
+		{0x0B55, 0x0B57, 1},
+		{0x0B5F, 0x0B63, 1},
+		{0x0B66, 0x0B77, 1},
+		{0x0B82, 0x0B83, 1},
+		{0x0B85, 0x0B8A, 1},
+		{0x0B8E, 0x0B90, 1},
+		{0x0B92, 0x0B95, 1},
+		{0x0B99, 0x0B9A, 1},
+		{0x0B9C, 0x0B9E, 2},
+		{0x0B9F, 0x0BA3, 4},
+		{0x0BA4, 0x0BA8, 4},
+		{0x0BA9, 0x0BAA, 1},
+		{0x0BAE, 0x0BB9, 1},
+		{0x0BBE, 0x0BC2, 1},
+		{0x0BC6, 0x0BC8, 1},
+		{0x0BCA, 0x0BCD, 1},
+		{0x0BD0, 0x0BD7, 7},
+		{0x0BE6, 0x0BFA, 1},
+		{0x0C00, 0x0C0C, 1},
+		{0x0C0E, 0x0C10, 1},
+		{0x0C12, 0x0C28, 1},
+		{0x0C2A, 0x0C39, 1},
+		{0x0C3C, 0x0C44, 1},
+		{0x0C46, 0x0C48, 1},
+		{0x0C4A, 0x0C4D, 1},
+		{0x0C55, 0x0C56, 1},
+		{0x0C58, 0x0C5A, 1},
+		{0x0C5D, 0x0C60, 3},
+		{0x0C61, 0x0C63, 1},
+		{0x0C66, 0x0C6F, 1},
+		{0x0C77, 0x0C8C, 1},
+		{0x0C8E, 0x0C90, 1},
+		{0x0C92, 0x0CA8, 1},
+		{0x0CAA, 0x0CB3, 1},
+		{0x0CB5, 0x0CB9, 1},
+		{0x0CBC, 0x0CC4, 1},
+		{0x0CC6, 0x0CC8, 1},
+		{0x0CCA, 0x0CCD, 1},
+		{0x0CD5, 0x0CD6, 1},
+		{0x0CDD, 0x0CDE, 1},
+		{0x0CE0, 0x0CE3, 1},
+		{0x0CE6, 0x0CEF, 1},
+		{0x0CF1, 0x0CF3, 1},
+		{0x0D00, 0x0D0C, 1},
+		{0x0D0E, 0x0D10, 1},
+		{0x0D12, 0x0D44, 1},
+		{0x0D46, 0x0D48, 1},
+		{0x0D4A, 0x0D4F, 1},
+		{0x0D54, 0x0D63, 1},
+		{0x0D66, 0x0D7F, 1},
+		{0x0D81, 0x0D83, 1},
+		{0x0D85, 0x0D96, 1},
+		{0x0D9A, 0x0DB1, 1},
+		{0x0DB3, 0x0DBB, 1},
+		{0x0DBD, 0x0DC0, 3},
+		{0x0DC1, 0x0DC6, 1},
+		{0x0DCA, 0x0DCF, 5},
+		{0x0DD0, 0x0DD4, 1},
+		{0x0DD6, 0x0DD8, 2},
+		{0x0DD9, 0x0DDF, 1},
+		{0x0DE6, 0x0DEF, 1},
+		{0x0DF2, 0x0DF4, 1},
+		{0x0E01, 0x0E32, 1},
+		{0x0E34, 0x0E3A, 1},
+		{0x0E3F, 0x0E5B, 1},
+		{0x0E81, 0x0E82, 1},
+		{0x0E84, 0x0E86, 2},
+		{0x0E87, 0x0E8A, 1},
+		{0x0E8C, 0x0EA3, 1},
+		{0x0EA5, 0x0EA7, 2},
+		{0x0EA8, 0x0EB2, 1},
+		{0x0EB4, 0x0EBD, 1},
+		{0x0EC0, 0x0EC4, 1},
+		{0x0EC6, 0x0EC8, 2},
+		{0x0EC9, 0x0ECE, 1},
+		{0x0ED0, 0x0ED9, 1},
+		{0x0EDE, 0x0EDF, 1},
+		{0x0F00, 0x0F0B, 1},
+		{0x0F0D, 0x0F42, 1},
+		{0x0F44, 0x0F47, 1},
+		{0x0F49, 0x0F4C, 1},
+		{0x0F4E, 0x0F51, 1},
+		{0x0F53, 0x0F56, 1},
+		{0x0F58, 0x0F5B, 1},
+		{0x0F5D, 0x0F68, 1},
+		{0x0F6A, 0x0F6C, 1},
+		{0x0F71, 0x0F72, 1},
+		{0x0F74, 0x0F7A, 6},
+		{0x0F7B, 0x0F80, 1},
+		{0x0F82, 0x0F92, 1},
+		{0x0F94, 0x0F97, 1},
+		{0x0F99, 0x0F9C, 1},
+		{0x0F9E, 0x0FA1, 1},
+		{0x0FA3, 0x0FA6, 1},
+		{0x0FA8, 0x0FAB, 1},
+		{0x0FAD, 0x0FB8, 1},
+		{0x0FBA, 0x0FBC, 1},
+		{0x0FBE, 0x0FCC, 1},
+		{0x0FCE, 0x0FDA, 1},
+		{0x1000, 0x109F, 1},
+		{0x10D0, 0x10FB, 1},
+		{0x10FD, 0x115E, 1},
+		{0x1161, 0x1248, 1},
+		{0x124A, 0x124D, 1},
+		{0x1250, 0x1256, 1},
+		{0x1258, 0x125A, 2},
+		{0x125B, 0x125D, 1},
+		{0x1260, 0x1288, 1},
+		{0x128A, 0x128D, 1},
+		{0x1290, 0x12B0, 1},
+		{0x12B2, 0x12B5, 1},
+		{0x12B8, 0x12BE, 1},
+		{0x12C0, 0x12C2, 2},
+		{0x12C3, 0x12C5, 1},
+		{0x12C8, 0x12D6, 1},
+		{0x12D8, 0x1310, 1},
+		{0x1312, 0x1315, 1},
+		{0x1318, 0x135A, 1},
+		{0x135D, 0x137C, 1},
+		{0x1380, 0x1399, 1},
+		{0x13A0, 0x13F5, 1},
+		{0x1400, 0x167F, 1},
+		{0x1681, 0x169C, 1},
+		{0x16A0, 0x16F8, 1},
+		{0x1700, 0x1715, 1},
+		{0x171F, 0x1736, 1},
+		{0x1740, 0x1753, 1},
+		{0x1760, 0x176C, 1},
+		{0x176E, 0x1770, 1},
+		{0x1772, 0x1773, 1},
+		{0x1780, 0x17B3, 1},
+		{0x17B6, 0x17DD, 1},
+		{0x17E0, 0x17E9, 1},
+		{0x17F0, 0x17F9, 1},
+		{0x1800, 0x1805, 1},
+		{0x1807, 0x180A, 1},
+		{0x1810, 0x1819, 1},
+		{0x1820, 0x1878, 1},
+		{0x1880, 0x18AA, 1},
+		{0x18B0, 0x18F5, 1},
+		{0x1900, 0x191E, 1},
+		{0x1920, 0x192B, 1},
+		{0x1930, 0x193B, 1},
+		{0x1940, 0x1944, 4},
+		{0x1945, 0x196D, 1},
+		{0x1970, 0x1974, 1},
+		{0x1980, 0x19AB, 1},
+		{0x19B0, 0x19C9, 1},
+		{0x19D0, 0x19DA, 1},
+		{0x19DE, 0x1A1B, 1},
+		{0x1A1E, 0x1A5E, 1},
+		{0x1A60, 0x1A7C, 1},
+		{0x1A7F, 0x1A89, 1},
+		{0x1A90, 0x1A99, 1},
+		{0x1AA0, 0x1AAD, 1},
+		{0x1AB0, 0x1ACE, 1},
+		{0x1B00, 0x1B4C, 1},
+		{0x1B50, 0x1B7E, 1},
+		{0x1B80, 0x1BF3, 1},
+		{0x1BFC, 0x1C37, 1},
+		{0x1C3B, 0x1C49, 1},
+		{0x1C4D, 0x1C7F, 1},
+		{0x1CC0, 0x1CC7, 1},
+		{0x1CD0, 0x1CFA, 1},
+		{0x1D00, 0x1D2B, 1},
+		{0x1D2F, 0x1D3B, 12},
+		{0x1D4E, 0x1D6B, 29},
+		{0x1D6C, 0x1D77, 1},
+		{0x1D79, 0x1D9A, 1},
+		{0x1DC0, 0x1DFF, 1},
+		{0x1E01, 0x1E95, 2},
+		{0x1E96, 0x1E99, 1},
+		{0x1E9C, 0x1E9D, 1},
+		{0x1E9F, 0x1EFF, 2},
+		{0x1F00, 0x1F07, 1},
+		{0x1F10, 0x1F15, 1},
+		{0x1F20, 0x1F27, 1},
+		{0x1F30, 0x1F37, 1},
+		{0x1F40, 0x1F45, 1},
+		{0x1F50, 0x1F57, 1},
+		{0x1F60, 0x1F67, 1},
+		{0x1F70, 0x1F7C, 2},
+		{0x1FB0, 0x1FB1, 1},
+		{0x1FB6, 0x1FC6, 16},
+		{0x1FD0, 0x1FD2, 1},
+		{0x1FD6, 0x1FD7, 1},
+		{0x1FE0, 0x1FE2, 1},
+		{0x1FE4, 0x1FE7, 1},
+		{0x1FF6, 0x200C, 22},
+		{0x200D, 0x2010, 3},
+		{0x2012, 0x2016, 1},
+		{0x2018, 0x2023, 1},
+		{0x2027, 0x2030, 9},
+		{0x2031, 0x2032, 1},
+		{0x2035, 0x2038, 3},
+		{0x2039, 0x203B, 1},
+		{0x203D, 0x203F, 2},
+		{0x2040, 0x2046, 1},
+		{0x204A, 0x2056, 1},
+		{0x2058, 0x205E, 1},
+		{0x20A0, 0x20A7, 1},
+		{0x20A9, 0x20C0, 1},
+		{0x20D0, 0x20F0, 1},
+		{0x2104, 0x2108, 4},
+		{0x2114, 0x2117, 3},
+		{0x2118, 0x211E, 6},
+		{0x211F, 0x2123, 4},
+		{0x2125, 0x2129, 2},
+		{0x212E, 0x213A, 12},
+		{0x2141, 0x2144, 1},
+		{0x214A, 0x214F, 1},
+		{0x2180, 0x2182, 1},
+		{0x2184, 0x2188, 1},
+		{0x218A, 0x218B, 1},
+		{0x2190, 0x222B, 1},
+		{0x222E, 0x2231, 3},
+		{0x2232, 0x2328, 1},
+		{0x232B, 0x2426, 1},
+		{0x2440, 0x244A, 1},
+		{0x24EB, 0x2A0B, 1},
+		{0x2A0D, 0x2A73, 1},
+		{0x2A77, 0x2ADB, 1},
+		{0x2ADD, 0x2B73, 1},
+		{0x2B76, 0x2B95, 1},
+		{0x2B97, 0x2BFF, 1},
+		{0x2C30, 0x2C5F, 1},
+		{0x2C61, 0x2C65, 4},
+		{0x2C66, 0x2C6C, 2},
+		{0x2C71, 0x2C73, 2},
+		{0x2C74, 0x2C76, 2},
+		{0x2C77, 0x2C7B, 1},
+		{0x2C81, 0x2CE3, 2},
+		{0x2CE4, 0x2CEA, 1},
+		{0x2CEC, 0x2CEE, 2},
+		{0x2CEF, 0x2CF1, 1},
+		{0x2CF3, 0x2CF9, 6},
+		{0x2CFA, 0x2D25, 1},
+		{0x2D27, 0x2D2D, 6},
+		{0x2D30, 0x2D67, 1},
+		{0x2D70, 0x2D7F, 15},
+		{0x2D80, 0x2D96, 1},
+		{0x2DA0, 0x2DA6, 1},
+		{0x2DA8, 0x2DAE, 1},
+		{0x2DB0, 0x2DB6, 1},
+		{0x2DB8, 0x2DBE, 1},
+		{0x2DC0, 0x2DC6, 1},
+		{0x2DC8, 0x2DCE, 1},
+		{0x2DD0, 0x2DD6, 1},
+		{0x2DD8, 0x2DDE, 1},
+		{0x2DE0, 0x2E5D, 1},
+		{0x2E80, 0x2E99, 1},
+		{0x2E9B, 0x2E9E, 1},
+		{0x2EA0, 0x2EF2, 1},
+		{0x3001, 0x3003, 2},
+		{0x3004, 0x3035, 1},
+		{0x3037, 0x303B, 4},
+		{0x303C, 0x303F, 1},
+		{0x3041, 0x3096, 1},
+		{0x3099, 0x309A, 1},
+		{0x309D, 0x309E, 1},
+		{0x30A0, 0x30FE, 1},
+		{0x3105, 0x312F, 1},
+		{0x3190, 0x3191, 1},
+		{0x31A0, 0x31E3, 1},
+		{0x31F0, 0x31FF, 1},
+		{0x3248, 0x324F, 1},
+		{0x327F, 0x3400, 385},
+		{0x3401, 0xA48C, 1},
+		{0xA490, 0xA4C6, 1},
+		{0xA4D0, 0xA62B, 1},
+		{0xA641, 0xA66D, 2},
+		{0xA66E, 0xA67F, 1},
+		{0xA681, 0xA69B, 2},
+		{0xA69E, 0xA6F7, 1},
+		{0xA700, 0xA721, 1},
+		{0xA723, 0xA72F, 2},
+		{0xA730, 0xA731, 1},
+		{0xA733, 0xA771, 2},
+		{0xA772, 0xA778, 1},
+		{0xA77A, 0xA77C, 2},
+		{0xA77F, 0xA787, 2},
+		{0xA788, 0xA78A, 1},
+		{0xA78C, 0xA78E, 2},
+		{0xA78F, 0xA793, 2},
+		{0xA794, 0xA795, 1},
+		{0xA797, 0xA7A9, 2},
+		{0xA7AF, 0xA7B5, 6},
+		{0xA7B7, 0xA7C3, 2},
+		{0xA7C8, 0xA7CA, 2},
+		{0xA7D1, 0xA7D9, 2},
+		{0xA7F6, 0xA7F7, 1},
+		{0xA7FA, 0xA82C, 1},
+		{0xA830, 0xA839, 1},
+		{0xA840, 0xA877, 1},
+		{0xA880, 0xA8C5, 1},
+		{0xA8CE, 0xA8D9, 1},
+		{0xA8E0, 0xA953, 1},
+		{0xA95F, 0xA97C, 1},
+		{0xA980, 0xA9CD, 1},
+		{0xA9CF, 0xA9D9, 1},
+		{0xA9DE, 0xA9FE, 1},
+		{0xAA00, 0xAA36, 1},
+		{0xAA40, 0xAA4D, 1},
+		{0xAA50, 0xAA59, 1},
+		{0xAA5C, 0xAAC2, 1},
+		{0xAADB, 0xAAF6, 1},
+		{0xAB01, 0xAB06, 1},
+		{0xAB09, 0xAB0E, 1},
+		{0xAB11, 0xAB16, 1},
+		{0xAB20, 0xAB26, 1},
+		{0xAB28, 0xAB2E, 1},
+		{0xAB30, 0xAB5B, 1},
+		{0xAB60, 0xAB68, 1},
+		{0xAB6A, 0xAB6B, 1},
+		{0xABC0, 0xABED, 1},
+		{0xABF0, 0xABF9, 1},
+		{0xAC00, 0xD7A3, 1},
+		{0xD7B0, 0xD7C6, 1},
+		{0xD7CB, 0xD7FB, 1},
+		{0xFA0E, 0xFA0F, 1},
+		{0xFA11, 0xFA13, 2},
+		{0xFA14, 0xFA1F, 11},
+		{0xFA21, 0xFA23, 2},
+		{0xFA24, 0xFA27, 3},
+		{0xFA28, 0xFA29, 1},
+		{0xFB1E, 0xFBB2, 148},
+		{0xFBB3, 0xFBC2, 1},
+		{0xFD3E, 0xFD4F, 1},
+		{0xFDCF, 0xFDFD, 46},
+		{0xFDFE, 0xFDFF, 1},
+		{0xFE20, 0xFE2F, 1},
+		{0xFE45, 0xFE46, 1},
+		{0xFE73, 0xFE73, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10000, 0x1000B, 1},
+		{0x1000D, 0x10026, 1},
+		{0x10028, 0x1003A, 1},
+		{0x1003C, 0x1003D, 1},
+		{0x1003F, 0x1004D, 1},
+		{0x10050, 0x1005D, 1},
+		{0x10080, 0x100FA, 1},
+		{0x10100, 0x10102, 1},
+		{0x10107, 0x10133, 1},
+		{0x10137, 0x1018E, 1},
+		{0x10190, 0x1019C, 1},
+		{0x101A0, 0x101D0, 48},
+		{0x101D1, 0x101FD, 1},
+		{0x10280, 0x1029C, 1},
+		{0x102A0, 0x102D0, 1},
+		{0x102E0, 0x102FB, 1},
+		{0x10300, 0x10323, 1},
+		{0x1032D, 0x1034A, 1},
+		{0x10350, 0x1037A, 1},
+		{0x10380, 0x1039D, 1},
+		{0x1039F, 0x103C3, 1},
+		{0x103C8, 0x103D5, 1},
+		{0x10428, 0x1049D, 1},
+		{0x104A0, 0x104A9, 1},
+		{0x104D8, 0x104FB, 1},
+		{0x10500, 0x10527, 1},
+		{0x10530, 0x10563, 1},
+		{0x1056F, 0x10597, 40},
+		{0x10598, 0x105A1, 1},
+		{0x105A3, 0x105B1, 1},
+		{0x105B3, 0x105B9, 1},
+		{0x105BB, 0x105BC, 1},
+		{0x10600, 0x10736, 1},
+		{0x10740, 0x10755, 1},
+		{0x10760, 0x10767, 1},
+		{0x10780, 0x10800, 128},
+		{0x10801, 0x10805, 1},
+		{0x10808, 0x1080A, 2},
+		{0x1080B, 0x10835, 1},
+		{0x10837, 0x10838, 1},
+		{0x1083C, 0x1083F, 3},
+		{0x10840, 0x10855, 1},
+		{0x10857, 0x1089E, 1},
+		{0x108A7, 0x108AF, 1},
+		{0x108E0, 0x108F2, 1},
+		{0x108F4, 0x108F5, 1},
+		{0x108FB, 0x1091B, 1},
+		{0x1091F, 0x10939, 1},
+		{0x1093F, 0x10980, 65},
+		{0x10981, 0x109B7, 1},
+		{0x109BC, 0x109CF, 1},
+		{0x109D2, 0x10A03, 1},
+		{0x10A05, 0x10A06, 1},
+		{0x10A0C, 0x10A13, 1},
+		{0x10A15, 0x10A17, 1},
+		{0x10A19, 0x10A35, 1},
+		{0x10A38, 0x10A3A, 1},
+		{0x10A3F, 0x10A48, 1},
+		{0x10A50, 0x10A58, 1},
+		{0x10A60, 0x10A9F, 1},
+		{0x10AC0, 0x10AE6, 1},
+		{0x10AEB, 0x10AF6, 1},
+		{0x10B00, 0x10B35, 1},
+		{0x10B39, 0x10B55, 1},
+		{0x10B58, 0x10B72, 1},
+		{0x10B78, 0x10B91, 1},
+		{0x10B99, 0x10B9C, 1},
+		{0x10BA9, 0x10BAF, 1},
+		{0x10C00, 0x10C48, 1},
+		{0x10CC0, 0x10CF2, 1},
+		{0x10CFA, 0x10D27, 1},
+		{0x10D30, 0x10D39, 1},
+		{0x10E60, 0x10E7E, 1},
+		{0x10E80, 0x10EA9, 1},
+		{0x10EAB, 0x10EAD, 1},
+		{0x10EB0, 0x10EB1, 1},
+		{0x10EFD, 0x10F27, 1},
+		{0x10F30, 0x10F59, 1},
+		{0x10F70, 0x10F89, 1},
+		{0x10FB0, 0x10FCB, 1},
+		{0x10FE0, 0x10FF6, 1},
+		{0x11000, 0x1104D, 1},
+		{0x11052, 0x11075, 1},
+		{0x1107F, 0x110BC, 1},
+		{0x110BE, 0x110C2, 1},
+		{0x110D0, 0x110E8, 1},
+		{0x110F0, 0x110F9, 1},
+		{0x11100, 0x11134, 1},
+		{0x11136, 0x11147, 1},
+		{0x11150, 0x11176, 1},
+		{0x11180, 0x111DF, 1},
+		{0x111E1, 0x111F4, 1},
+		{0x11200, 0x11211, 1},
+		{0x11213, 0x11241, 1},
+		{0x11280, 0x11286, 1},
+		{0x11288, 0x1128A, 2},
+		{0x1128B, 0x1128D, 1},
+		{0x1128F, 0x1129D, 1},
+		{0x1129F, 0x112A9, 1},
+		{0x112B0, 0x112EA, 1},
+		{0x112F0, 0x112F9, 1},
+		{0x11300, 0x11303, 1},
+		{0x11305, 0x1130C, 1},
+		{0x1130F, 0x11310, 1},
+		{0x11313, 0x11328, 1},
+		{0x1132A, 0x11330, 1},
+		{0x11332, 0x11333, 1},
+		{0x11335, 0x11339, 1},
+		{0x1133B, 0x11344, 1},
+		{0x11347, 0x11348, 1},
+		{0x1134B, 0x1134D, 1},
+		{0x11350, 0x11357, 7},
+		{0x1135D, 0x11363, 1},
+		{0x11366, 0x1136C, 1},
+		{0x11370, 0x11374, 1},
+		{0x11400, 0x1145B, 1},
+		{0x1145D, 0x11461, 1},
+		{0x11480, 0x114C7, 1},
+		{0x114D0, 0x114D9, 1},
+		{0x11580, 0x115B5, 1},
+		{0x115B8, 0x115DD, 1},
+		{0x11600, 0x11644, 1},
+		{0x11650, 0x11659, 1},
+		{0x11660, 0x1166C, 1},
+		{0x11680, 0x116B9, 1},
+		{0x116C0, 0x116C9, 1},
+		{0x11700, 0x1171A, 1},
+		{0x1171D, 0x1172B, 1},
+		{0x11730, 0x11746, 1},
+		{0x11800, 0x1183B, 1},
+		{0x118C0, 0x118F2, 1},
+		{0x118FF, 0x11906, 1},
+		{0x11909, 0x1190C, 3},
+		{0x1190D, 0x11913, 1},
+		{0x11915, 0x11916, 1},
+		{0x11918, 0x11935, 1},
+		{0x11937, 0x11938, 1},
+		{0x1193B, 0x11946, 1},
+		{0x11950, 0x11959, 1},
+		{0x119A0, 0x119A7, 1},
+		{0x119AA, 0x119D7, 1},
+		{0x119DA, 0x119E4, 1},
+		{0x11A00, 0x11A47, 1},
+		{0x11A50, 0x11AA2, 1},
+		{0x11AB0, 0x11AF8, 1},
+		{0x11B00, 0x11B09, 1},
+		{0x11C00, 0x11C08, 1},
+		{0x11C0A, 0x11C36, 1},
+		{0x11C38, 0x11C45, 1},
+		{0x11C50, 0x11C6C, 1},
+		{0x11C70, 0x11C8F, 1},
+		{0x11C92, 0x11CA7, 1},
+		{0x11CA9, 0x11CB6, 1},
+		{0x11D00, 0x11D06, 1},
+		{0x11D08, 0x11D09, 1},
+		{0x11D0B, 0x11D36, 1},
+		{0x11D3A, 0x11D3C, 2},
+		{0x11D3D, 0x11D3F, 2},
+		{0x11D40, 0x11D47, 1},
+		{0x11D50, 0x11D59, 1},
+		{0x11D60, 0x11D65, 1},
+		{0x11D67, 0x11D68, 1},
+		{0x11D6A, 0x11D8E, 1},
+		{0x11D90, 0x11D91, 1},
+		{0x11D93, 0x11D98, 1},
+		{0x11DA0, 0x11DA9, 1},
+		{0x11EE0, 0x11EF8, 1},
+		{0x11F00, 0x11F10, 1},
+		{0x11F12, 0x11F3A, 1},
+		{0x11F3E, 0x11F59, 1},
+		{0x11FB0, 0x11FC0, 16},
+		{0x11FC1, 0x11FF1, 1},
+		{0x11FFF, 0x12399, 1},
+		{0x12400, 0x1246E, 1},
+		{0x12470, 0x12474, 1},
+		{0x12480, 0x12543, 1},
+		{0x12F90, 0x12FF2, 1},
+		{0x13000, 0x1342F, 1},
+		{0x13440, 0x13455, 1},
+		{0x14400, 0x14646, 1},
+		{0x16800, 0x16A38, 1},
+		{0x16A40, 0x16A5E, 1},
+		{0x16A60, 0x16A69, 1},
+		{0x16A6E, 0x16ABE, 1},
+		{0x16AC0, 0x16AC9, 1},
+		{0x16AD0, 0x16AED, 1},
+		{0x16AF0, 0x16AF5, 1},
+		{0x16B00, 0x16B45, 1},
+		{0x16B50, 0x16B59, 1},
+		{0x16B5B, 0x16B61, 1},
+		{0x16B63, 0x16B77, 1},
+		{0x16B7D, 0x16B8F, 1},
+		{0x16E60, 0x16E9A, 1},
+		{0x16F00, 0x16F4A, 1},
+		{0x16F4F, 0x16F87, 1},
+		{0x16F8F, 0x16F9F, 1},
+		{0x16FE0, 0x16FE4, 1},
+		{0x16FF0, 0x16FF1, 1},
+		{0x17000, 0x187F7, 1},
+		{0x18800, 0x18CD5, 1},
+		{0x18D00, 0x18D08, 1},
+		{0x1AFF0, 0x1AFF3, 1},
+		{0x1AFF5, 0x1AFFB, 1},
+		{0x1AFFD, 0x1AFFE, 1},
+		{0x1B000, 0x1B122, 1},
+		{0x1B132, 0x1B150, 30},
+		{0x1B151, 0x1B152, 1},
+		{0x1B155, 0x1B164, 15},
+		{0x1B165, 0x1B167, 1},
+		{0x1B170, 0x1B2FB, 1},
+		{0x1BC00, 0x1BC6A, 1},
+		{0x1BC70, 0x1BC7C, 1},
+		{0x1BC80, 0x1BC88, 1},
+		{0x1BC90, 0x1BC99, 1},
+		{0x1BC9C, 0x1BC9F, 1},
+		{0x1CF00, 0x1CF2D, 1},
+		{0x1CF30, 0x1CF46, 1},
+		{0x1CF50, 0x1CFC3, 1},
+		{0x1D000, 0x1D0F5, 1},
+		{0x1D100, 0x1D126, 1},
+		{0x1D129, 0x1D15D, 1},
+		{0x1D165, 0x1D172, 1},
+		{0x1D17B, 0x1D1BA, 1},
+		{0x1D1C1, 0x1D1EA, 1},
+		{0x1D200, 0x1D245, 1},
+		{0x1D2C0, 0x1D2D3, 1},
+		{0x1D2E0, 0x1D2F3, 1},
+		{0x1D300, 0x1D356, 1},
+		{0x1D360, 0x1D378, 1},
+		{0x1D800, 0x1DA8B, 1},
+		{0x1DA9B, 0x1DA9F, 1},
+		{0x1DAA1, 0x1DAAF, 1},
+		{0x1DF00, 0x1DF1E, 1},
+		{0x1DF25, 0x1DF2A, 1},
+		{0x1E000, 0x1E006, 1},
+		{0x1E008, 0x1E018, 1},
+		{0x1E01B, 0x1E021, 1},
+		{0x1E023, 0x1E024, 1},
+		{0x1E026, 0x1E02A, 1},
+		{0x1E08F, 0x1E100, 113},
+		{0x1E101, 0x1E12C, 1},
+		{0x1E130, 0x1E13D, 1},
+		{0x1E140, 0x1E149, 1},
+		{0x1E14E, 0x1E14F, 1},
+		{0x1E290, 0x1E2AE, 1},
+		{0x1E2C0, 0x1E2F9, 1},
+		{0x1E2FF, 0x1E4D0, 465},
+		{0x1E4D1, 0x1E4F9, 1},
+		{0x1E7E0, 0x1E7E6, 1},
+		{0x1E7E8, 0x1E7EB, 1},
+		{0x1E7ED, 0x1E7EE, 1},
+		{0x1E7F0, 0x1E7FE, 1},
+		{0x1E800, 0x1E8C4, 1},
+		{0x1E8C7, 0x1E8D6, 1},
+		{0x1E922, 0x1E94B, 1},
+		{0x1E950, 0x1E959, 1},
+		{0x1E95E, 0x1E95F, 1},
+		{0x1EC71, 0x1ECB4, 1},
+		{0x1ED01, 0x1ED3D, 1},
+		{0x1EEF0, 0x1EEF1, 1},
+		{0x1F000, 0x1F02B, 1},
+		{0x1F030, 0x1F093, 1},
+		{0x1F0A0, 0x1F0AE, 1},
+		{0x1F0B1, 0x1F0BF, 1},
+		{0x1F0C1, 0x1F0CF, 1},
+		{0x1F0D1, 0x1F0F5, 1},
+		{0x1F10B, 0x1F10F, 1},
+		{0x1F12F, 0x1F150, 33},
+		{0x1F151, 0x1F169, 1},
+		{0x1F16D, 0x1F18F, 1},
+		{0x1F191, 0x1F1AD, 1},
+		{0x1F1E6, 0x1F1FF, 1},
+		{0x1F260, 0x1F265, 1},
+		{0x1F300, 0x1F6D7, 1},
+		{0x1F6DC, 0x1F6EC, 1},
+		{0x1F6F0, 0x1F6FC, 1},
+		{0x1F700, 0x1F776, 1},
+		{0x1F77B, 0x1F7D9, 1},
+		{0x1F7E0, 0x1F7EB, 1},
+		{0x1F7F0, 0x1F800, 16},
+		{0x1F801, 0x1F80B, 1},
+		{0x1F810, 0x1F847, 1},
+		{0x1F850, 0x1F859, 1},
+		{0x1F860, 0x1F887, 1},
+		{0x1F890, 0x1F8AD, 1},
+		{0x1F8B0, 0x1F8B1, 1},
+		{0x1F900, 0x1FA53, 1},
+		{0x1FA60, 0x1FA6D, 1},
+		{0x1FA70, 0x1FA7C, 1},
+		{0x1FA80, 0x1FA88, 1},
+		{0x1FA90, 0x1FABD, 1},
+		{0x1FABF, 0x1FAC5, 1},
+		{0x1FACE, 0x1FADB, 1},
+		{0x1FAE0, 0x1FAE8, 1},
+		{0x1FAF0, 0x1FAF8, 1},
+		{0x1FB00, 0x1FB92, 1},
+		{0x1FB94, 0x1FBCA, 1},
+		{0x20000, 0x2A6DF, 1},
+		{0x2A700, 0x2B739, 1},
+		{0x2B740, 0x2B81D, 1},
+		{0x2B820, 0x2CEA1, 1},
+		{0x2CEB0, 0x2EBE0, 1},
+		{0x30000, 0x3134A, 1},
+		{0x31350, 0x323AF, 1},
+	},
+	LatinOffset: 7,
+}
+
+// idnaMappings holds the non-ASCII code points that UTS #46 maps, with NFC
+// applied to the mapping. An empty mapping means the code point is ignored.
+var idnaMappings = []runeMapping{
+	{0x00A0, " "},
+	{0x00A8, " ̈"},
+	{0x00AA, "a"},
+	{0x00AD, ""},
+	{0x00AF, " ̄"},
+	{0x00B2, "2"},
+	{0x00B3, "3"},
+	{0x00B4, " ́"},
+	{0x00B5, "μ"},
+	{0x00B8, " ̧"},
+	{0x00B9, "1"},
+	{0x00BA, "o"},
+	{0x00BC, "1⁄4"},
+	{0x00BD, "1⁄2"},
+	{0x00BE, "3⁄4"},
+	{0x00C0, "à"},
+	{0x00C1, "á"},
+	{0x00C2, "â"},
+	{0x00C3, "ã"},
+	{0x00C4, "ä"},
+	{0x00C5, "å"},
+	{0x00C6, "æ"},
+	{0x00C7, "ç"},
+	{0x00C8, "è"},
+	{0x00C9, "é"},
+	{0x00CA, "ê"},
+	{0x00CB, "ë"},
+	{0x00CC, "ì"},
+	{0x00CD, "í"},
+	{0x00CE, "î"},
+	{0x00CF, "ï"},
+	{0x00D0, "ð"},
+	{0x00D1, "ñ"},
+	{0x00D2, "ò"},
+	{0x00D3, "ó"},
+	{0x00D4, "ô"},
+	{0x00D5, "õ"},
+	{0x00D6, "ö"},
+	{0x00D8, "ø"},
+	{0x00D9, "ù"},
+	{0x00DA, "ú"},
+	{0x00DB, "û"},
+	{0x00DC, "ü"},
+	{0x00DD, "ý"},
+	{0x00DE, "þ"},
+	{0x0100, "ā"},
+	{0x0102, "ă"},
+	{0x0104, "ą"},
+	{0x0106, "ć"},
+	{0x0108, "ĉ"},
+	{0x010A, "ċ"},
+	{0x010C, "č"},
+	{0x010E, "ď"},
+	{0x0110, "đ"},
+	{0x0112, "ē"},
+	{0x0114, "ĕ"},
+	{0x0116, "ė"},
+	{0x0118, "ę"},
+	{0x011A, "ě"},
+	{0x011C, "ĝ"},
+	{0x011E, "ğ"},
+	{0x0120, "ġ"},
+	{0x0122, "ģ"},
+	{0x0124, "ĥ"},
+	{0x0126, "ħ"},
+	{0x0128, "ĩ"},
+	{0x012A, "ī"},
+	{0x012C, "ĭ"},
+	{0x012E, "į"},
+	{0x0130, "i̇"},
+	{0x0132, "ij"},
+	{0x0133, "ij"},
+	{0x0134, "ĵ"},
+	{0x0136, "ķ"},
+	{0x0139, "ĺ"},
+	{0x013B, "ļ"},
+	{0x013D, "ľ"},
+	{0x013F, "l·"},
+	{0x0140, "l·"},
+	{0x0141, "ł"},
+	{0x0143, "ń"},
+	{0x0145, "ņ"},
+	{0x0147, "ň"},
+	{0x0149, "ʼn"},
+	{0x014A, "ŋ"},
+	{0x014C, "ō"},
+	{0x014E, "ŏ"},
+	{0x0150, "ő"},
+	{0x0152, "œ"},
+	{0x0154, "ŕ"},
+	{0x0156, "ŗ"},
+	{0x0158, "ř"},
+	{0x015A, "ś"},
+	{0x015C, "ŝ"},
+	{0x015E, "ş"},
+	{0x0160, "š"},
+	{0x0162, "ţ"},
+	{0x0164, "ť"},
+	{0x0166, "ŧ"},
+	{0x0168, "ũ"},
+	{0x016A, "ū"},
+	{0x016C, "ŭ"},
+	{0x016E, "ů"},
+	{0x0170, "ű"},
+	{0x0172, "ų"},
+	{0x0174, "ŵ"},
+	{0x0176, "ŷ"},
+	{0x0178, "ÿ"},
+	{0x0179, "ź"},
+	{0x017B, "ż"},
+	{0x017D, "ž"},
+	{0x017F, "s"},
+	{0x0181, "ɓ"},
+	{0x0182, "ƃ"},
+	{0x0184, "ƅ"},
+	{0x0186, "ɔ"},
+	{0x0187, "ƈ"},
+	{0x0189, "ɖ"},
+	{0x018A, "ɗ"},
+	{0x018B, "ƌ"},
+	{0x018E, "ǝ"},
+	{0x018F, "ə"},
+	{0x0190, "ɛ"},
+	{0x0191, "ƒ"},
+	{0x0193, "ɠ"},
+	{0x0194, "ɣ"},
+	{0x0196, "ɩ"},
+	{0x0197, "ɨ"},
+	{0x0198, "ƙ"},
+	{0x019C, "ɯ"},
+	{0x019D, "ɲ"},
+	{0x019F, "ɵ"},
+	{0x01A0, "ơ"},
+	{0x01A2, "ƣ"},
+	{0x01A4, "ƥ"},
+	{0x01A6, "ʀ"},
+	{0x01A7, "ƨ"},
+	{0x01A9, "ʃ"},
+	{0x01AC, "ƭ"},
+	{0x01AE, "ʈ"},
+	{0x01AF, "ư"},
+	{0x01B1, "ʊ"},
+	{0x01B2, "ʋ"},
+	{0x01B3, "ƴ"},
+	{0x01B5, "ƶ"},
+	{0x01B7, "ʒ"},
+	{0x01B8, "ƹ"},
+	{0x01BC, "ƽ"},
+	{0x01C4, "dž"},
+	{0x01C5, "dž"},
+	{0x01C6, "dž"},
+	{0x01C7, "lj"},
+	{0x01C8, "lj"},
+	{0x01C9, "lj"},
+	{0x01CA, "nj"},
+	{0x01CB, "nj"},
+	{0x01CC, "nj"},
+	{0x01CD, "ǎ"},
+	{0x01CF, "ǐ"},
+	{0x01D1, "ǒ"},
+	{0x01D3, "ǔ"},
+	{0x01D5, "ǖ"},
+	{0x01D7, "ǘ"},
+	{0x01D9, "ǚ"},
+	{0x01DB, "ǜ"},
+	{0x01DE, "ǟ"},
+	{0x01E0, "ǡ"},
+	{0x01E2, "ǣ"},
+	{0x01E4, "ǥ"},
+	{0x01E6, "ǧ"},
+	{0x01E8, "ǩ"},
+	{0x01EA, "ǫ"},
+	{0x01EC, "ǭ"},
+	{0x01EE, "ǯ"},
+	{0x01F1, "dz"},
+	{0x01F2, "dz"},
+	{0x01F3, "dz"},
+	{0x01F4, "ǵ"},
+	{0x01F6, "ƕ"},
+	{0x01F7, "ƿ"},
+	{0x01F8, "ǹ"},
+	{0x01FA, "ǻ"},
+	{0x01FC, "ǽ"},
+	{0x01FE, "ǿ"},
+	{0x0200, "ȁ"},
+	{0x0202, "ȃ"},
+	{0x0204, "ȅ"},
+	{0x0206, "ȇ"},
+	{0x0208, "ȉ"},
+	{0x020A, "ȋ"},
+	{0x020C, "ȍ"},
+	{0x020E, "ȏ"},
+	{0x0210, "ȑ"},
+	{0x0212, "ȓ"},
+	{0x0214, "ȕ"},
+	{0x0216, "ȗ"},
+	{0x0218, "ș"},
+	{0x021A, "ț"},
+	{0x021C, "ȝ"},
+	{0x021E, "ȟ"},
+	{0x0220, "ƞ"},
+	{0x0222, "ȣ"},
+	{0x0224, "ȥ"},
+	{0x0226, "ȧ"},
+	{0x0228, "ȩ"},
+	{0x022A, "ȫ"},
+	{0x022C, "ȭ"},
+	{0x022E, "ȯ"},
+	{0x0230, "ȱ"},
+	{0x0232, "ȳ"},
+	{0x023A, "ⱥ"},
+	{0x023B, "ȼ"},
+	{0x023D, "ƚ"},
+	{0x023E, "ⱦ"},
+	{0x0241, "ɂ"},
+	{0x0243, "ƀ"},
+	{0x0244, "ʉ"},
+	{0x0245, "ʌ"},
+	{0x0246, "ɇ"},
+	{0x0248, "ɉ"},
+	{0x024A, "ɋ"},
+	{0x024C, "ɍ"},
+	{0x024E, "ɏ"},
+	{0x02B0, "h"},
+	{0x02B1, "ɦ"},
+	{0x02B2, "j"},
+	{0x02B3, "r"},
+	{0x02B4, "ɹ"},
+	{0x02B5, "ɻ"},
+	{0x02B6, "ʁ"},
+	{0x02B7, "w"},
+	{0x02B8, "y"},
+	{0x02D8, " ̆"},
+	{0x02D9, " ̇"},
+	{0x02DA, " ̊"},
+	{0x02DB, " ̨"},
+	{0x02DC, " ̃"},
+	{0x02DD, " ̋"},
+	{0x02E0, "ɣ"},
+	{0x02E1, "l"},
+	{0x02E2, "s"},
+	{0x02E3, "x"},
+	{0x02E4, "ʕ"},
+	{0x0340, "̀"},
+	{0x0341, "́"},
+	{0x0343, "̓"},
+	{0x0344, "̈́"},
+	{0x0345, "ι"},
+	{0x034F, ""},
+	{0x0370, "ͱ"},
+	{0x0372, "ͳ"},
+	{0x0374, "ʹ"},
+	{0x0376, "ͷ"},
+	{0x037A, " ι"},
+	{0x037E, ";"},
+	{0x037F, "ϳ"},
+	{0x0384, " ́"},
+	{0x0385, " ̈́"},
+	{0x0386, "ά"},
+	{0x0387, "·"},
+	{0x0388, "έ"},
+	{0x0389, "ή"},
+	{0x038A, "ί"},
+	{0x038C, "ό"},
+	{0x038E, "ύ"},
+	{0x038F, "ώ"},
+	{0x0391, "α"},
+	{0x0392, "β"},
+	{0x0393, "γ"},
+	{0x0394, "δ"},
+	{0x0395, "ε"},
+	{0x0396, "ζ"},
+	{0x0397, "η"},
+	{0x0398, "θ"},
+	{0x0399, "ι"},
+	{0x039A, "κ"},
+	{0x039B, "λ"},
+	{0x039C, "μ"},
+	{0x039D, "ν"},
+	{0x039E, "ξ"},
+	{0x039F, "ο"},
+	{0x03A0, "π"},
+	{0x03A1, "ρ"},
+	{0x03A3, "σ"},
+	{0x03A4, "τ"},
+	{0x03A5, "υ"},
+	{0x03A6, "φ"},
+	{0x03A7, "χ"},
+	{0x03A8, "ψ"},
+	{0x03A9, "ω"},
+	{0x03AA, "ϊ"},
+	{0x03AB, "ϋ"},
+	{0x03CF, "ϗ"},
+	{0x03D0, "β"},
+	{0x03D1, "θ"},
+	{0x03D2, "υ"},
+	{0x03D3, "ύ"},
+	{0x03D4, "ϋ"},
+	{0x03D5, "φ"},
+	{0x03D6, "π"},
+	{0x03D8, "ϙ"},
+	{0x03DA, "ϛ"},
+	{0x03DC, "ϝ"},
+	{0x03DE, "ϟ"},
+	{0x03E0, "ϡ"},
+	{0x03E2, "ϣ"},
+	{0x03E4, "ϥ"},
+	{0x03E6, "ϧ"},
+	{0x03E8, "ϩ"},
+	{0x03EA, "ϫ"},
+	{0x03EC, "ϭ"},
+	{0x03EE, "ϯ"},
+	{0x03F0, "κ"},
+	{0x03F1, "ρ"},
+	{0x03F2, "σ"},
+	{0x03F4, "θ"},
+	{0x03F5, "ε"},
+	{0x03F7, "ϸ"},
+	{0x03F9, "σ"},
+	{0x03FA, "ϻ"},
+	{0x03FD, "ͻ"},
+	{0x03FE, "ͼ"},
+	{0x03FF, "ͽ"},
+	{0x0400, "ѐ"},
+	{0x0401, "ё"},
+	{0x0402, "ђ"},
+	{0x0403, "ѓ"},
+	{0x0404, "є"},
+	{0x0405, "ѕ"},
+	{0x0406, "і"},
+	{0x0407, "ї"},
+	{0x0408, "ј"},
+	{0x0409, "љ"},
+	{0x040A, "њ"},
+	{0x040B, "ћ"},
+	{0x040C, "ќ"},
+	{0x040D, "ѝ"},
+	{0x040E, "ў"},
+	{0x040F, "џ"},
+	{0x0410, "а"},
+	{0x0411, "б"},
+	{0x0412, "в"},
+	{0x0413, "г"},
+	{0x0414, "д"},
+	{0x0415, "е"},
+	{0x0416, "ж"},
+	{0x0417, "з"},
+	{0x0418, "и"},
+	{0x0419, "й"},
+	{0x041A, "к"},
+	{0x041B, "л"},
+	{0x041C, "м"},
+	{0x041D, "н"},
+	{0x041E, "о"},
+	{0x041F, "п"},
+	{0x0420, "р"},
+	{0x0421, "с"},
+	{0x0422, "т"},
+	{0x0423, "у"},
+	{0x0424, "ф"},
+	{0x0425, "х"},
+	{0x0426, "ц"},
+	{0x0427, "ч"},
+	{0x0428, "ш"},
+	{0x0429, "щ"},
+	{0x042A, "ъ"},
+	{0x042B, "ы"},
+	{0x042C, "ь"},
+	{0x042D, "э"},
+	{0x042E, "ю"},
+	{0x042F, "я"},
+	{0x0460, "ѡ"},
+	{0x0462, "ѣ"},
+	{0x0464, "ѥ"},
+	{0x0466, "ѧ"},
+	{0x0468, "ѩ"},
+	{0x046A, "ѫ"},
+	{0x046C, "ѭ"},
+	{0x046E, "ѯ"},
+	{0x0470, "ѱ"},
+	{0x0472, "ѳ"},
+	{0x0474, "ѵ"},
+	{0x0476, "ѷ"},
+	{0x0478, "ѹ"},
+	{0x047A, "ѻ"},
+	{0x047C, "ѽ"},
+	{0x047E, "ѿ"},
+	{0x0480, "ҁ"},
+	{0x048A, "ҋ"},
+	{0x048C, "ҍ"},
+	{0x048E, "ҏ"},
+	{0x0490, "ґ"},
+	{0x0492, "ғ"},
+	{0x0494, "ҕ"},
+	{0x0496, "җ"},
+	{0x0498, "ҙ"},
+	{0x049A, "қ"},
+	{0x049C, "ҝ"},
+	{0x049E, "ҟ"},
+	{0x04A0, "ҡ"},
+	{0x04A2, "ң"},
+	{0x04A4, "ҥ"},
+	{0x04A6, "ҧ"},
+	{0x04A8, "ҩ"},
+	{0x04AA, "ҫ"},
+	{0x04AC, "ҭ"},
+	{0x04AE, "ү"},
+	{0x04B0, "ұ"},
+	{0x04B2, "ҳ"},
+	{0x04B4, "ҵ"},
+	{0x04B6, "ҷ"},
+	{0x04B8, "ҹ"},
+	{0x04BA, "һ"},
+	{0x04BC, "ҽ"},
+	{0x04BE, "ҿ"},
+	{0x04C1, "ӂ"},
+	{0x04C3, "ӄ"},
+	{0x04C5, "ӆ"},
+	{0x04C7, "ӈ"},
+	{0x04C9, "ӊ"},
+	{0x04CB, "ӌ"},
+	{0x04CD, "ӎ"},
+	{0x04D0, "ӑ"},
+	{0x04D2, "ӓ"},
+	{0x04D4, "ӕ"},
+	{0x04D6, "ӗ"},
+	{0x04D8, "ә"},
+	{0x04DA, "ӛ"},
+	{0x04DC, "ӝ"},
+	{0x04DE, "ӟ"},
+	{0x04E0, "ӡ"},
+	{0x04E2, "ӣ"},
+	{0x04E4, "ӥ"},
+	{0x04E6, "ӧ"},
+	{0x04E8, "ө"},
+	{0x04EA, "ӫ"},
+	{0x04EC, "ӭ"},
+	{0x04EE, "ӯ"},
+	{0x04F0, "ӱ"},
+	{0x04F2, "ӳ"},
+	{0x04F4, "ӵ"},
+	{0x04F6, "ӷ"},
+	{0x04F8, "ӹ"},
+	{0x04FA, "ӻ"},
+	{0x04FC, "ӽ"},
+	{0x04FE, "ӿ"},
+	{0x0500, "ԁ"},
+	{0x0502, "ԃ"},
+	{0x0504, "ԅ"},
+	{0x0506, "ԇ"},
+	{0x0508, "ԉ"},
+	{0x050A, "ԋ"},
+	{0x050C, "ԍ"},
+	{0x050E, "ԏ"},
+	{0x0510, "ԑ"},
+	{0x0512, "ԓ"},
+	{0x0514, "ԕ"},
+	{0x0516, "ԗ"},
+	{0x0518, "ԙ"},
+	{0x051A, "ԛ"},
+	{0x051C, "ԝ"},
+	{0x051E, "ԟ"},
+	{0x0520, "ԡ"},
+	{0x0522, "ԣ"},
+	{0x0524, "ԥ"},
+	{0x0526, "ԧ"},
+	{0x0528, "ԩ"},
+	{0x052A, "ԫ"},
+	{0x052C, "ԭ"},
+	{0x052E, "ԯ"},
+	{0x0531, "ա"},
+	{0x0532, "բ"},
+	{0x0533, "գ"},
+	{0x0534, "դ"},
+	{0x0535, "ե"},
+	{0x0536, "զ"},
+	{0x0537, "է"},
+	{0x0538, "ը"},
+	{0x0539, "թ"},
+	{0x053A, "ժ"},
+	{0x053B, "ի"},
+	{0x053C, "լ"},
+	{0x053D, "խ"},
+	{0x053E, "ծ"},
+	{0x053F, "կ"},
+	{0x0540, "հ"},
+	{0x0541, "ձ"},
+	{0x0542, "ղ"},
+	{0x0543, "ճ"},
+	{0x0544, "մ"},
+	{0x0545, "յ"},
+	{0x0546, "ն"},
+	{0x0547, "շ"},
+	{0x0548, "ո"},
+	{0x0549, "չ"},
+	{0x054A, "պ"},
+	{0x054B, "ջ"},
+	{0x054C, "ռ"},
+	{0x054D, "ս"},
+	{0x054E, "վ"},
+	{0x054F, "տ"},
+	{0x0550, "ր"},
+	{0x0551, "ց"},
+	{0x0552, "ւ"},
+	{0x0553, "փ"},
+	{0x0554, "ք"},
+	{0x0555, "օ"},
+	{0x0556, "ֆ"},
+	{0x0587, "եւ"},
+	{0x0675, "اٴ"},
+	{0x0676, "وٴ"},
+	{0x0677, "ۇٴ"},
+	{0x0678, "يٴ"},
+	{0x0958, "क़"},
+	{0x0959, "ख़"},
+	{0x095A, "ग़"},
+	{0x095B, "ज़"},
+	{0x095C, "ड़"},
+	{0x095D, "ढ़"},
+	{0x095E, "फ़"},
+	{0x095F, "य़"},
+	{0x09DC, "ড়"},
+	{0x09DD, "ঢ়"},
+	{0x09DF, "য়"},
+	{0x0A33, "ਲ਼"},
+	{0x0A36, "ਸ਼"},
+	{0x0A59, "ਖ਼"},
+	{0x0A5A, "ਗ਼"},
+	{0x0A5B, "ਜ਼"},
+	{0x0A5E, "ਫ਼"},
+	{0x0B5C, "ଡ଼"},
+	{0x0B5D, "ଢ଼"},
+	{0x0E33, "ํา"},
+	{0x0EB3, "ໍາ"},
+	{0x0EDC, "ຫນ"},
+	{0x0EDD, "ຫມ"},
+	{0x0F0C, "་"},
+	{0x0F43, "གྷ"},
+	{0x0F4D, "ཌྷ"},
+	{0x0F52, "དྷ"},
+	{0x0F57, "བྷ"},
+	{0x0F5C, "ཛྷ"},
+	{0x0F69, "ཀྵ"},
+	{0x0F73, "ཱི"},
+	{0x0F75, "ཱུ"},
+	{0x0F76, "ྲྀ"},
+	{0x0F77, "ྲཱྀ"},
+	{0x0F78, "ླྀ"},
+	{0x0F79, "ླཱྀ"},
+	{0x0F81, "ཱྀ"},
+	{0x0F93, "ྒྷ"},
+	{0x0F9D, "ྜྷ"},
+	{0x0FA2, "ྡྷ"},
+	{0x0FA7, "ྦྷ"},
+	{0x0FAC, "ྫྷ"},
+	{0x0FB9, "ྐྵ"},
+	{0x10C7, "ⴧ"},
+	{0x10CD, "ⴭ"},
+	{0x10FC, "ნ"},
+	{0x13F8, "Ᏸ"},
+	{0x13F9, "Ᏹ"},
+	{0x13FA, "Ᏺ"},
+	{0x13FB, "Ᏻ"},
+	{0x13FC, "Ᏼ"},
+	{0x13FD, "Ᏽ"},
+	{0x180B, ""},
+	{0x180C, ""},
+	{0x180D, ""},
+	{0x180F, ""},
+	{0x1C80, "в"},
+	{0x1C81, "д"},
+	{0x1C82, "о"},
+	{0x1C83, "с"},
+	{0x1C84, "т"},
+	{0x1C85, "т"},
+	{0x1C86, "ъ"},
+	{0x1C87, "ѣ"},
+	{0x1C88, "ꙋ"},
+	{0x1C90, "ა"},
+	{0x1C91, "ბ"},
+	{0x1C92, "გ"},
+	{0x1C93, "დ"},
+	{0x1C94, "ე"},
+	{0x1C95, "ვ"},
+	{0x1C96, "ზ"},
+	{0x1C97, "თ"},
+	{0x1C98, "ი"},
+	{0x1C99, "კ"},
+	{0x1C9A, "ლ"},
+	{0x1C9B, "მ"},
+	{0x1C9C, "ნ"},
+	{0x1C9D, "ო"},
+	{0x1C9E, "პ"},
+	{0x1C9F, "ჟ"},
+	{0x1CA0, "რ"},
+	{0x1CA1, "ს"},
+	{0x1CA2, "ტ"},
+	{0x1CA3, "უ"},
+	{0x1CA4, "ფ"},
+	{0x1CA5, "ქ"},
+	{0x1CA6, "ღ"},
+	{0x1CA7, "ყ"},
+	{0x1CA8, "შ"},
+	{0x1CA9, "ჩ"},
+	{0x1CAA, "ც"},
+	{0x1CAB, "ძ"},
+	{0x1CAC, "წ"},
+	{0x1CAD, "ჭ"},
+	{0x1CAE, "ხ"},
+	{0x1CAF, "ჯ"},
+	{0x1CB0, "ჰ"},
+	{0x1CB1, "ჱ"},
+	{0x1CB2, "ჲ"},
+	{0x1CB3, "ჳ"},
+	{0x1CB4, "ჴ"},
+	{0x1CB5, "ჵ"},
+	{0x1CB6, "ჶ"},
+	{0x1CB7, "ჷ"},
+	{0x1CB8, "ჸ"},
+	{0x1CB9, "ჹ"},
+	{0x1CBA, "ჺ"},
+	{0x1CBD, "ჽ"},
+	{0x1CBE, "ჾ"},
+	{0x1CBF, "ჿ"},
+	{0x1D2C, "a"},
+	{0x1D2D, "æ"},
+	{0x1D2E, "b"},
+	{0x1D30, "d"},
+	{0x1D31, "e"},
+	{0x1D32, "ǝ"},
+	{0x1D33, "g"},
+	{0x1D34, "h"},
+	{0x1D35, "i"},
+	{0x1D36, "j"},
+	{0x1D37, "k"},
+	{0x1D38, "l"},
+	{0x1D39, "m"},
+	{0x1D3A, "n"},
+	{0x1D3C, "o"},
+	{0x1D3D, "ȣ"},
+	{0x1D3E, "p"},
+	{0x1D3F, "r"},
+	{0x1D40, "t"},
+	{0x1D41, "u"},
+	{0x1D42, "w"},
+	{0x1D43, "a"},
+	{0x1D44, "ɐ"},
+	{0x1D45, "ɑ"},
+	{0x1D46, "ᴂ"},
+	{0x1D47, "b"},
+	{0x1D48, "d"},
+	{0x1D49, "e"},
+	{0x1D4A, "ə"},
+	{0x1D4B, "ɛ"},
+	{0x1D4C, "ɜ"},
+	{0x1D4D, "g"},
+	{0x1D4F, "k"},
+	{0x1D50, "m"},
+	{0x1D51, "ŋ"},
+	{0x1D52, "o"},
+	{0x1D53, "ɔ"},
+	{0x1D54, "ᴖ"},
+	{0x1D55, "ᴗ"},
+	{0x1D56, "p"},
+	{0x1D57, "t"},
+	{0x1D58, "u"},
+	{0x1D59, "ᴝ"},
+	{0x1D5A, "ɯ"},
+	{0x1D5B, "v"},
+	{0x1D5C, "ᴥ"},
+	{0x1D5D, "β"},
+	{0x1D5E, "γ"},
+	{0x1D5F, "δ"},
+	{0x1D60, "φ"},
+	{0x1D61, "χ"},
+	{0x1D62, "i"},
+	{0x1D63, "r"},
+	{0x1D64, "u"},
+	{0x1D65, "v"},
+	{0x1D66, "β"},
+	{0x1D67, "γ"},
+	{0x1D68, "ρ"},
+	{0x1D69, "φ"},
+	{0x1D6A, "χ"},
+	{0x1D78, "н"},
+	{0x1D9B, "ɒ"},
+	{0x1D9C, "c"},
+	{0x1D9D, "ɕ"},
+	{0x1D9E, "ð"},
+	{0x1D9F, "ɜ"},
+	{0x1DA0, "f"},
+	{0x1DA1, "ɟ"},
+	{0x1DA2, "ɡ"},
+	{0x1DA3, "ɥ"},
+	{0x1DA4, "ɨ"},
+	{0x1DA5, "ɩ"},
+	{0x1DA6, "ɪ"},
+	{0x1DA7, "ᵻ"},
+	{0x1DA8, "ʝ"},
+	{0x1DA9, "ɭ"},
+	{0x1DAA, "ᶅ"},
+	{0x1DAB, "ʟ"},
+	{0x1DAC, "ɱ"},
+	{0x1DAD, "ɰ"},
+	{0x1DAE, "ɲ"},
+	{0x1DAF, "ɳ"},
+	{0x1DB0, "ɴ"},
+	{0x1DB1, "ɵ"},
+	{0x1DB2, "ɸ"},
+	{0x1DB3, "ʂ"},
+	{0x1DB4, "ʃ"},
+	{0x1DB5, "ƫ"},
+	{0x1DB6, "ʉ"},
+	{0x1DB7, "ʊ"},
+	{0x1DB8, "ᴜ"},
+	{0x1DB9, "ʋ"},
+	{0x1DBA, "ʌ"},
+	{0x1DBB, "z"},
+	{0x1DBC, "ʐ"},
+	{0x1DBD, "ʑ"},
+	{0x1DBE, "ʒ"},
+	{0x1DBF, "θ"},
+	{0x1E00, "ḁ"},
+	{0x1E02, "ḃ"},
+	{0x1E04, "ḅ"},
+	{0x1E06, "ḇ"},
+	{0x1E08, "ḉ"},
+	{0x1E0A, "ḋ"},
+	{0x1E0C, "ḍ"},
+	{0x1E0E, "ḏ"},
+	{0x1E10, "ḑ"},
+	{0x1E12, "ḓ"},
+	{0x1E14, "ḕ"},
+	{0x1E16, "ḗ"},
+	{0x1E18, "ḙ"},
+	{0x1E1A, "ḛ"},
+	{0x1E1C, "ḝ"},
+	{0x1E1E, "ḟ"},
+	{0x1E20, "ḡ"},
+	{0x1E22, "ḣ"},
+	{0x1E24, "ḥ"},
+	{0x1E26, "ḧ"},
+	{0x1E28, "ḩ"},
+	{0x1E2A, "ḫ"},
+	{0x1E2C, "ḭ"},
+	{0x1E2E, "ḯ"},
+	{0x1E30, "ḱ"},
+	{0x1E32, "ḳ"},
+	{0x1E34, "ḵ"},
+	{0x1E36, "ḷ"},
+	{0x1E38, "ḹ"},
+	{0x1E3A, "ḻ"},
+	{0x1E3C, "ḽ"},
+	{0x1E3E, "ḿ"},
+	{0x1E40, "ṁ"},
+	{0x1E42, "ṃ"},
+	{0x1E44, "ṅ"},
+	{0x1E46, "ṇ"},
+	{0x1E48, "ṉ"},
+	{0x1E4A, "ṋ"},
+	{0x1E4C, "ṍ"},
+	{0x1E4E, "ṏ"},
+	{0x1E50, "ṑ"},
+	{0x1E52, "ṓ"},
+	{0x1E54, "ṕ"},
+	{0x1E56, "ṗ"},
+	{0x1E58, "ṙ"},
+	{0x1E5A, "ṛ"},
+	{0x1E5C, "ṝ"},
+	{0x1E5E, "ṟ"},
+	{0x1E60, "ṡ"},
+	{0x1E62, "ṣ"},
+	{0x1E64, "ṥ"},
+	{0x1E66, "ṧ"},
+	{0x1E68, "ṩ"},
+	{0x1E6A, "ṫ"},
+	{0x1E6C, "ṭ"},
+	{0x1E6E, "ṯ"},
+	{0x1E70, "ṱ"},
+	{0x1E72, "ṳ"},
+	{0x1E74, "ṵ"},
+	{0x1E76, "ṷ"},
+	{0x1E78, "ṹ"},
+	{0x1E7A, "ṻ"},
+	{0x1E7C, "ṽ"},
+	{0x1E7E, "ṿ"},
+	{0x1E80, "ẁ"},
+	{0x1E82, "ẃ"},
+	{0x1E84, "ẅ"},
+	{0x1E86, "ẇ"},
+	{0x1E88, "ẉ"},
+	{0x1E8A, "ẋ"},
+	{0x1E8C, "ẍ"},
+	{0x1E8E, "ẏ"},
+	{0x1E90, "ẑ"},
+	{0x1E92, "ẓ"},
+	{0x1E94, "ẕ"},
+	{0x1E9A, "aʾ"},
+	{0x1E9B, "ṡ"},
+	{0x1E9E, "ss"},
+	{0x1EA0, "ạ"},
+	{0x1EA2, "ả"},
+	{0x1EA4, "ấ"},
+	{0x1EA6, "ầ"},
+	{0x1EA8, "ẩ"},
+	{0x1EAA, "ẫ"},
+	{0x1EAC, "ậ"},
+	{0x1EAE, "ắ"},
+	{0x1EB0, "ằ"},
+	{0x1EB2, "ẳ"},
+	{0x1EB4, "ẵ"},
+	{0x1EB6, "ặ"},
+	{0x1EB8, "ẹ"},
+	{0x1EBA, "ẻ"},
+	{0x1EBC, "ẽ"},
+	{0x1EBE, "ế"},
+	{0x1EC0, "ề"},
+	{0x1EC2, "ể"},
+	{0x1EC4, "ễ"},
+	{0x1EC6, "ệ"},
+	{0x1EC8, "ỉ"},
+	{0x1ECA, "ị"},
+	{0x1ECC, "ọ"},
+	{0x1ECE, "ỏ"},
+	{0x1ED0, "ố"},
+	{0x1ED2, "ồ"},
+	{0x1ED4, "ổ"},
+	{0x1ED6, "ỗ"},
+	{0x1ED8, "ộ"},
+	{0x1EDA, "ớ"},
+	{0x1EDC, "ờ"},
+	{0x1EDE, "ở"},
+	{0x1EE0, "ỡ"},
+	{0x1EE2, "ợ"},
+	{0x1EE4, "ụ"},
+	{0x1EE6, "ủ"},
+	{0x1EE8, "ứ"},
+	{0x1EEA, "ừ"},
+	{0x1EEC, "ử"},
+	{0x1EEE, "ữ"},
+	{0x1EF0, "ự"},
+	{0x1EF2, "ỳ"},
+	{0x1EF4, "ỵ"},
+	{0x1EF6, "ỷ"},
+	{0x1EF8, "ỹ"},
+	{0x1EFA, "ỻ"},
+	{0x1EFC, "ỽ"},
+	{0x1EFE, "ỿ"},
+	{0x1F08, "ἀ"},
+	{0x1F09, "ἁ"},
+	{0x1F0A, "ἂ"},
+	{0x1F0B, "ἃ"},
+	{0x1F0C, "ἄ"},
+	{0x1F0D, "ἅ"},
+	{0x1F0E, "ἆ"},
+	{0x1F0F, "ἇ"},
+	{0x1F18, "ἐ"},
+	{0x1F19, "ἑ"},
+	{0x1F1A, "ἒ"},
+	{0x1F1B, "ἓ"},
+	{0x1F1C, "ἔ"},
+	{0x1F1D, "ἕ"},
+	{0x1F28, "ἠ"},
+	{0x1F29, "ἡ"},
+	{0x1F2A, "ἢ"},
+	{0x1F2B, "ἣ"},
+	{0x1F2C, "ἤ"},
+	{0x1F2D, "ἥ"},
+	{0x1F2E, "ἦ"},
+	{0x1F2F, "ἧ"},
+	{0x1F38, "ἰ"},
+	{0x1F39, "ἱ"},
+	{0x1F3A, "ἲ"},
+	{0x1F3B, "ἳ"},
+	{0x1F3C, "ἴ"},
+	{0x1F3D, "ἵ"},
+	{0x1F3E, "ἶ"},
+	{0x1F3F, "ἷ"},
+	{0x1F48, "ὀ"},
+	{0x1F49, "ὁ"},
+	{0x1F4A, "ὂ"},
+	{0x1F4B, "ὃ"},
+	{0x1F4C, "ὄ"},
+	{0x1F4D, "ὅ"},
+	{0x1F59, "ὑ"},
+	{0x1F5B, "ὓ"},
+	{0x1F5D, "ὕ"},
+	{0x1F5F, "ὗ"},
+	{0x1F68, "ὠ"},
+	{0x1F69, "ὡ"},
+	{0x1F6A, "ὢ"},
+	{0x1F6B, "ὣ"},
+	{0x1F6C, "ὤ"},
+	{0x1F6D, "ὥ"},
+	{0x1F6E, "ὦ"},
+	{0x1F6F, "ὧ"},
+	{0x1F71, "ά"},
+	{0x1F73, "έ"},
+	{0x1F75, "ή"},
+	{0x1F77, "ί"},
+	{0x1F79, "ό"},
+	{0x1F7B, "ύ"},
+	{0x1F7D, "ώ"},
+	{0x1F80, "ἀι"},
+	{0x1F81, "ἁι"},
+	{0x1F82, "ἂι"},
+	{0x1F83, "ἃι"},
+	{0x1F84, "ἄι"},
+	{0x1F85, "ἅι"},
+	{0x1F86, "ἆι"},
+	{0x1F87, "ἇι"},
+	{0x1F88, "ἀι"},
+	{0x1F89, "ἁι"},
+	{0x1F8A, "ἂι"},
+	{0x1F8B, "ἃι"},
+	{0x1F8C, "ἄι"},
+	{0x1F8D, "ἅι"},
+	{0x1F8E, "ἆι"},
+	{0x1F8F, "ἇι"},
+	{0x1F90, "ἠι"},
+	{0x1F91, "ἡι"},
+	{0x1F92, "ἢι"},
+	{0x1F93, "ἣι"},
+	{0x1F94, "ἤι"},
+	{0x1F95, "ἥι"},
+	{0x1F96, "ἦι"},
+	{0x1F97, "ἧι"},
+	{0x1F98, "ἠι"},
+	{0x1F99, "ἡι"},
+	{0x1F9A, "ἢι"},
+	{0x1F9B, "ἣι"},
+	{0x1F9C, "ἤι"},
+	{0x1F9D, "ἥι"},
+	{0x1F9E, "ἦι"},
+	{0x1F9F, "ἧι"},
+	{0x1FA0, "ὠι"},
+	{0x1FA1, "ὡι"},
+	{0x1FA2, "ὢι"},
+	{0x1FA3, "ὣι"},
+	{0x1FA4, "ὤι"},
+	{0x1FA5, "ὥι"},
+	{0x1FA6, "ὦι"},
+	{0x1FA7, "ὧι"},
+	{0x1FA8, "ὠι"},
+	{0x1FA9, "ὡι"},
+	{0x1FAA, "ὢι"},
+	{0x1FAB, "ὣι"},
+	{0x1FAC, "ὤι"},
+	{0x1FAD, "ὥι"},
+	{0x1FAE, "ὦι"},
+	{0x1FAF, "ὧι"},
+	{0x1FB2, "ὰι"},
+	{0x1FB3, "αι"},
+	{0x1FB4, "άι"},
+	{0x1FB7, "ᾶι"},
+	{0x1FB8, "ᾰ"},
+	{0x1FB9, "ᾱ"},
+	{0x1FBA, "ὰ"},
+	{0x1FBB, "ά"},
+	{0x1FBC, "αι"},
+	{0x1FBD, " ̓"},
+	{0x1FBE, "ι"},
+	{0x1FBF, " ̓"},
+	{0x1FC0, " ͂"},
+	{0x1FC1, " ̈͂"},
+	{0x1FC2, "ὴι"},
+	{0x1FC3, "ηι"},
+	{0x1FC4, "ήι"},
+	{0x1FC7, "ῆι"},
+	{0x1FC8, "ὲ"},
+	{0x1FC9, "έ"},
+	{0x1FCA, "ὴ"},
+	{0x1FCB, "ή"},
+	{0x1FCC, "ηι"},
+	{0x1FCD, " ̓̀"},
+	{0x1FCE, " ̓́"},
+	{0x1FCF, " ̓͂"},
+	{0x1FD3, "ΐ"},
+	{0x1FD8, "ῐ"},
+	{0x1FD9, "ῑ"},
+	{0x1FDA, "ὶ"},
+	{0x1FDB, "ί"},
+	{0x1FDD, " ̔̀"},
+	{0x1FDE, " ̔́"},
+	{0x1FDF, " ̔͂"},
+	{0x1FE3, "ΰ"},
+	{0x1FE8, "ῠ"},
+	{0x1FE9, "ῡ"},
+	{0x1FEA, "ὺ"},
+	{0x1FEB, "ύ"},
+	{0x1FEC, "ῥ"},
+	{0x1FED, " ̈̀"},
+	{0x1FEE, " ̈́"},
+	{0x1FEF, "`"},
+	{0x1FF2, "ὼι"},
+	{0x1FF3, "ωι"},
+	{0x1FF4, "ώι"},
+	{0x1FF7, "ῶι"},
+	{0x1FF8, "ὸ"},
+	{0x1FF9, "ό"},
+	{0x1FFA, "ὼ"},
+	{0x1FFB, "ώ"},
+	{0x1FFC, "ωι"},
+	{0x1FFD, " ́"},
+	{0x1FFE, " ̔"},
+	{0x2000, " "},
+	{0x2001, " "},
+	{0x2002, " "},
+	{0x2003, " "},
+	{0x2004, " "},
+	{0x2005, " "},
+	{0x2006, " "},
+	{0x2007, " "},
+	{0x2008, " "},
+	{0x2009, " "},
+	{0x200A, " "},
+	{0x200B, ""},
+	{0x2011, "‐"},
+	{0x2017, " ̳"},
+	{0x202F, " "},
+	{0x2033, "′′"},
+	{0x2034, "′′′"},
+	{0x2036, "‵‵"},
+	{0x2037, "‵‵‵"},
+	{0x203C, "!!"},
+	{0x203E, " ̅"},
+	{0x2047, "??"},
+	{0x2048, "?!"},
+	{0x2049, "!?"},
+	{0x2057, "′′′′"},
+	{0x205F, " "},
+	{0x2060, ""},
+	{0x2064, ""},
+	{0x2070, "0"},
+	{0x2071, "i"},
+	{0x2074, "4"},
+	{0x2075, "5"},
+	{0x2076, "6"},
+	{0x2077, "7"},
+	{0x2078, "8"},
+	{0x2079, "9"},
+	{0x207A, "+"},
+	{0x207B, "−"},
+	{0x207C, "="},
+	{0x207D, "("},
+	{0x207E, ")"},
+	{0x207F, "n"},
+	{0x2080, "0"},
+	{0x2081, "1"},
+	{0x2082, "2"},
+	{0x2083, "3"},
+	{0x2084, "4"},
+	{0x2085, "5"},
+	{0x2086, "6"},
+	{0x2087, "7"},
+	{0x2088, "8"},
+	{0x2089, "9"},
+	{0x208A, "+"},
+	{0x208B, "−"},
+	{0x208C, "="},
+	{0x208D, "("},
+	{0x208E, ")"},
+	{0x2090, "a"},
+	{0x2091, "e"},
+	{0x2092, "o"},
+	{0x2093, "x"},
+	{0x2094, "ə"},
+	{0x2095, "h"},
+	{0x2096, "k"},
+	{0x2097, "l"},
+	{0x2098, "m"},
+	{0x2099, "n"},
+	{0x209A, "p"},
+	{0x209B, "s"},
+	{0x209C, "t"},
+	{0x20A8, "rs"},
+	{0x2100, "a/c"},
+	{0x2101, "a/s"},
+	{0x2102, "c"},
+	{0x2103, "°c"},
+	{0x2105, "c/o"},
+	{0x2106, "c/u"},
+	{0x2107, "ɛ"},
+	{0x2109, "°f"},
+	{0x210A, "g"},
+	{0x210B, "h"},
+	{0x210C, "h"},
+	{0x210D, "h"},
+	{0x210E, "h"},
+	{0x210F, "ħ"},
+	{0x2110, "i"},
+	{0x2111, "i"},
+	{0x2112, "l"},
+	{0x2113, "l"},
+	{0x2115, "n"},
+	{0x2116, "no"},
+	{0x2119, "p"},
+	{0x211A, "q"},
+	{0x211B, "r"},
+	{0x211C, "r"},
+	{0x211D, "r"},
+	{0x2120, "sm"},
+	{0x2121, "tel"},
+	{0x2122, "tm"},
+	{0x2124, "z"},
+	{0x2126, "ω"},
+	{0x2128, "z"},
+	{0x212A, "k"},
+	{0x212B, "å"},
+	{0x212C, "b"},
+	{0x212D, "c"},
+	{0x212F, "e"},
+	{0x2130, "e"},
+	{0x2131, "f"},
+	{0x2133, "m"},
+	{0x2134, "o"},
+	{0x2135, "א"},
+	{0x2136, "ב"},
+	{0x2137, "ג"},
+	{0x2138, "ד"},
+	{0x2139, "i"},
+	{0x213B, "fax"},
+	{0x213C, "π"},
+	{0x213D, "γ"},
+	{0x213E, "γ"},
+	{0x213F, "π"},
+	{0x2140, "∑"},
+	{0x2145, "d"},
+	{0x2146, "d"},
+	{0x2147, "e"},
+	{0x2148, "i"},
+	{0x2149, "j"},
+	{0x2150, "1⁄7"},
+	{0x2151, "1⁄9"},
+	{0x2152, "1⁄10"},
+	{0x2153, "1⁄3"},
+	{0x2154, "2⁄3"},
+	{0x2155, "1⁄5"},
+	{0x2156, "2⁄5"},
+	{0x2157, "3⁄5"},
+	{0x2158, "4⁄5"},
+	{0x2159, "1⁄6"},
+	{0x215A, "5⁄6"},
+	{0x215B, "1⁄8"},
+	{0x215C, "3⁄8"},
+	{0x215D, "5⁄8"},
+	{0x215E, "7⁄8"},
+	{0x215F, "1⁄"},
+	{0x2160, "i"},
+	{0x2161, "ii"},
+	{0x2162, "iii"},
+	{0x2163, "iv"},
+	{0x2164, "v"},
+	{0x2165, "vi"},
+	{0x2166, "vii"},
+	{0x2167, "viii"},
+	{0x2168, "ix"},
+	{0x2169, "x"},
+	{0x216A, "xi"},
+	{0x216B, "xii"},
+	{0x216C, "l"},
+	{0x216D, "c"},
+	{0x216E, "d"},
+	{0x216F, "m"},
+	{0x2170, "i"},
+	{0x2171, "ii"},
+	{0x2172, "iii"},
+	{0x2173, "iv"},
+	{0x2174, "v"},
+	{0x2175, "vi"},
+	{0x2176, "vii"},
+	{0x2177, "viii"},
+	{0x2178, "ix"},
+	{0x2179, "x"},
+	{0x217A, "xi"},
+	{0x217B, "xii"},
+	{0x217C, "l"},
+	{0x217D, "c"},
+	{0x217E, "d"},
+	{0x217F, "m"},
+	{0x2189, "0⁄3"},
+	{0x222C, "∫∫"},
+	{0x222D, "∫∫∫"},
+	{0x222F, "∮∮"},
+	{0x2230, "∮∮∮"},
+	{0x2329, "〈"},
+	{0x232A, "〉"},
+	{0x2460, "1"},
+	{0x2461, "2"},
+	{0x2462, "3"},
+	{0x2463, "4"},
+	{0x2464, "5"},
+	{0x2465, "6"},
+	{0x2466, "7"},
+	{0x2467, "8"},
+	{0x2468, "9"},
+	{0x2469, "10"},
+	{0x246A, "11"},
+	{0x246B, "12"},
+	{0x246C, "13"},
+	{0x246D, "14"},
+	{0x246E, "15"},
+	{0x246F, "16"},
+	{0x2470, "17"},
+	{0x2471, "18"},
+	{0x2472, "19"},
+	{0x2473, "20"},
+	{0x2474, "(1)"},
+	{0x2475, "(2)"},
+	{0x2476, "(3)"},
+	{0x2477, "(4)"},
+	{0x2478, "(5)"},
+	{0x2479, "(6)"},
+	{0x247A, "(7)"},
+	{0x247B, "(8)"},
+	{0x247C, "(9)"},
+	{0x247D, "(10)"},
+	{0x247E, "(11)"},
+	{0x247F, "(12)"},
+	{0x2480, "(13)"},
+	{0x2481, "(14)"},
+	{0x2482, "(15)"},
+	{0x2483, "(16)"},
+	{0x2484, "(17)"},
+	{0x2485, "(18)"},
+	{0x2486, "(19)"},
+	{0x2487, "(20)"},
+	{0x249C, "(a)"},
+	{0x249D, "(b)"},
+	{0x249E, "(c)"},
+	{0x249F, "(d)"},
+	{0x24A0, "(e)"},
+	{0x24A1, "(f)"},
+	{0x24A2, "(g)"},
+	{0x24A3, "(h)"},
+	{0x24A4, "(i)"},
+	{0x24A5, "(j)"},
+	{0x24A6, "(k)"},
+	{0x24A7, "(l)"},
+	{0x24A8, "(m)"},
+	{0x24A9, "(n)"},
+	{0x24AA, "(o)"},
+	{0x24AB, "(p)"},
+	{0x24AC, "(q)"},
+	{0x24AD, "(r)"},
+	{0x24AE, "(s)"},
+	{0x24AF, "(t)"},
+	{0x24B0, "(u)"},
+	{0x24B1, "(v)"},
+	{0x24B2, "(w)"},
+	{0x24B3, "(x)"},
+	{0x24B4, "(y)"},
+	{0x24B5, "(z)"},
+	{0x24B6, "a"},
+	{0x24B7, "b"},
+	{0x24B8, "c"},
+	{0x24B9, "d"},
+	{0x24BA, "e"},
+	{0x24BB, "f"},
+	{0x24BC, "g"},
+	{0x24BD, "h"},
+	{0x24BE, "i"},
+	{0x24BF, "j"},
+	{0x24C0, "k"},
+	{0x24C1, "l"},
+	{0x24C2, "m"},
+	{0x24C3, "n"},
+	{0x24C4, "o"},
+	{0x24C5, "p"},
+	{0x24C6, "q"},
+	{0x24C7, "r"},
+	{0x24C8, "s"},
+	{0x24C9, "t"},
+	{0x24CA, "u"},
+	{0x24CB, "v"},
+	{0x24CC, "w"},
+	{0x24CD, "x"},
+	{0x24CE, "y"},
+	{0x24CF, "z"},
+	{0x24D0, "a"},
+	{0x24D1, "b"},
+	{0x24D2, "c"},
+	{0x24D3, "d"},
+	{0x24D4, "e"},
+	{0x24D5, "f"},
+	{0x24D6, "g"},
+	{0x24D7, "h"},
+	{0x24D8, "i"},
+	{0x24D9, "j"},
+	{0x24DA, "k"},
+	{0x24DB, "l"},
+	{0x24DC, "m"},
+	{0x24DD, "n"},
+	{0x24DE, "o"},
+	{0x24DF, "p"},
+	{0x24E0, "q"},
+	{0x24E1, "r"},
+	{0x24E2, "s"},
+	{0x24E3, "t"},
+	{0x24E4, "u"},
+	{0x24E5, "v"},
+	{0x24E6, "w"},
+	{0x24E7, "x"},
+	{0x24E8, "y"},
+	{0x24E9, "z"},
+	{0x24EA, "0"},
+	{0x2A0C, "∫∫∫∫"},
+	{0x2A74, "::="},
+	{0x2A75, "=="},
+	{0x2A76, "==="},
+	{0x2ADC, "⫝̸"},
+	{0x2C00, "ⰰ"},
+	{0x2C01, "ⰱ"},
+	{0x2C02, "ⰲ"},
+	{0x2C03, "ⰳ"},
+	{0x2C04, "ⰴ"},
+	{0x2C05, "ⰵ"},
+	{0x2C06, "ⰶ"},
+	{0x2C07, "ⰷ"},
+	{0x2C08, "ⰸ"},
+	{0x2C09, "ⰹ"},
+	{0x2C0A, "ⰺ"},
+	{0x2C0B, "ⰻ"},
+	{0x2C0C, "ⰼ"},
+	{0x2C0D, "ⰽ"},
+	{0x2C0E, "ⰾ"},
+	{0x2C0F, "ⰿ"},
+	{0x2C10, "ⱀ"},
+	{0x2C11, "ⱁ"},
+	{0x2C12, "ⱂ"},
+	{0x2C13, "ⱃ"},
+	{0x2C14, "ⱄ"},
+	{0x2C15, "ⱅ"},
+	{0x2C16, "ⱆ"},
+	{0x2C17, "ⱇ"},
+	{0x2C18, "ⱈ"},
+	{0x2C19, "ⱉ"},
+	{0x2C1A, "ⱊ"},
+	{0x2C1B, "ⱋ"},
+	{0x2C1C, "ⱌ"},
+	{0x2C1D, "ⱍ"},
+	{0x2C1E, "ⱎ"},
+	{0x2C1F, "ⱏ"},
+	{0x2C20, "ⱐ"},
+	{0x2C21, "ⱑ"},
+	{0x2C22, "ⱒ"},
+	{0x2C23, "ⱓ"},
+	{0x2C24, "ⱔ"},
+	{0x2C25, "ⱕ"},
+	{0x2C26, "ⱖ"},
+	{0x2C27, "ⱗ"},
+	{0x2C28, "ⱘ"},
+	{0x2C29, "ⱙ"},
+	{0x2C2A, "ⱚ"},
+	{0x2C2B, "ⱛ"},
+	{0x2C2C, "ⱜ"},
+	{0x2C2D, "ⱝ"},
+	{0x2C2E, "ⱞ"},
+	{0x2C2F, "ⱟ"},
+	{0x2C60, "ⱡ"},
+	{0x2C62, "ɫ"},
+	{0x2C63, "ᵽ"},
+	{0x2C64, "ɽ"},
+	{0x2C67, "ⱨ"},
+	{0x2C69, "ⱪ"},
+	{0x2C6B, "ⱬ"},
+	{0x2C6D, "ɑ"},
+	{0x2C6E, "ɱ"},
+	{0x2C6F, "ɐ"},
+	{0x2C70, "ɒ"},
+	{0x2C72, "ⱳ"},
+	{0x2C75, "ⱶ"},
+	{0x2C7C, "j"},
+	{0x2C7D, "v"},
+	{0x2C7E, "ȿ"},
+	{0x2C7F, "ɀ"},
+	{0x2C80, "ⲁ"},
+	{0x2C82, "ⲃ"},
+	{0x2C84, "ⲅ"},
+	{0x2C86, "ⲇ"},
+	{0x2C88, "ⲉ"},
+	{0x2C8A, "ⲋ"},
+	{0x2C8C, "ⲍ"},
+	{0x2C8E, "ⲏ"},
+	{0x2C90, "ⲑ"},
+	{0x2C92, "ⲓ"},
+	{0x2C94, "ⲕ"},
+	{0x2C96, "ⲗ"},
+	{0x2C98, "ⲙ"},
+	{0x2C9A, "ⲛ"},
+	{0x2C9C, "ⲝ"},
+	{0x2C9E, "ⲟ"},
+	{0x2CA0, "ⲡ"},
+	{0x2CA2, "ⲣ"},
+	{0x2CA4, "ⲥ"},
+	{0x2CA6, "ⲧ"},
+	{0x2CA8, "ⲩ"},
+	{0x2CAA, "ⲫ"},
+	{0x2CAC, "ⲭ"},
+	{0x2CAE, "ⲯ"},
+	{0x2CB0, "ⲱ"},
+	{0x2CB2, "ⲳ"},
+	{0x2CB4, "ⲵ"},
+	{0x2CB6, "ⲷ"},
+	{0x2CB8, "ⲹ"},
+	{0x2CBA, "ⲻ"},
+	{0x2CBC, "ⲽ"},
+	{0x2CBE, "ⲿ"},
+	{0x2CC0, "ⳁ"},
+	{0x2CC2, "ⳃ"},
+	{0x2CC4, "ⳅ"},
+	{0x2CC6, "ⳇ"},
+	{0x2CC8, "ⳉ"},
+	{0x2CCA, "ⳋ"},
+	{0x2CCC, "ⳍ"},
+	{0x2CCE, "ⳏ"},
+	{0x2CD0, "ⳑ"},
+	{0x2CD2, "ⳓ"},
+	{0x2CD4, "ⳕ"},
+	{0x2CD6, "ⳗ"},
+	{0x2CD8, "ⳙ"},
+	{0x2CDA, "ⳛ"},
+	{0x2CDC, "ⳝ"},
+	{0x2CDE, "ⳟ"},
+	{0x2CE0, "ⳡ"},
+	{0x2CE2, "ⳣ"},
+	{0x2CEB, "ⳬ"},
+	{0x2CED, "ⳮ"},
+	{0x2CF2, "ⳳ"},
+	{0x2D6F, "ⵡ"},
+	{0x2E9F, "母"},
+	{0x2EF3, "龟"},
+	{0x2F00, "一"},
+	{0x2F01, "丨"},
+	{0x2F02, "丶"},
+	{0x2F03, "丿"},
+	{0x2F04, "乙"},
+	{0x2F05, "亅"},
+	{0x2F06, "二"},
+	{0x2F07, "亠"},
+	{0x2F08, "人"},
+	{0x2F09, "儿"},
+	{0x2F0A, "入"},
+	{0x2F0B, "八"},
+	{0x2F0C, "冂"},
+	{0x2F0D, "冖"},
+	{0x2F0E, "冫"},
+	{0x2F0F, "几"},
+	{0x2F10, "凵"},
+	{0x2F11, "刀"},
+	{0x2F12, "力"},
+	{0x2F13, "勹"},
+	{0x2F14, "匕"},
+	{0x2F15, "匚"},
+	{0x2F16, "匸"},
+	{0x2F17, "十"},
+	{0x2F18, "卜"},
+	{0x2F19, "卩"},
+	{0x2F1A, "厂"},
+	{0x2F1B, "厶"},
+	{0x2F1C, "又"},
+	{0x2F1D, "口"},
+	{0x2F1E, "囗"},
+	{0x2F1F, "土"},
+	{0x2F20, "士"},
+	{0x2F21, "夂"},
+	{0x2F22, "夊"},
+	{0x2F23, "夕"},
+	{0x2F24, "大"},
+	{0x2F25, "女"},
+	{0x2F26, "子"},
+	{0x2F27, "宀"},
+	{0x2F28, "寸"},
+	{0x2F29, "小"},
+	{0x2F2A, "尢"},
+	{0x2F2B, "尸"},
+	{0x2F2C, "屮"},
+	{0x2F2D, "山"},
+	{0x2F2E, "巛"},
+	{0x2F2F, "工"},
+	{0x2F30, "己"},
+	{0x2F31, "巾"},
+	{0x2F32, "干"},
+	{0x2F33, "幺"},
+	{0x2F34, "广"},
+	{0x2F35, "廴"},
+	{0x2F36, "廾"},
+	{0x2F37, "弋"},
+	{0x2F38, "弓"},
+	{0x2F39, "彐"},
+	{0x2F3A, "彡"},
+	{0x2F3B, "彳"},
+	{0x2F3C, "心"},
+	{0x2F3D, "戈"},
+	{0x2F3E, "戶"},
+	{0x2F3F, "手"},
+	{0x2F40, "支"},
+	{0x2F41, "攴"},
+	{0x2F42, "文"},
+	{0x2F43, "斗"},
+	{0x2F44, "斤"},
+	{0x2F45, "方"},
+	{0x2F46, "无"},
+	{0x2F47, "日"},
+	{0x2F48, "曰"},
+	{0x2F49, "月"},
+	{0x2F4A, "木"},
+	{0x2F4B, "欠"},
+	{0x2F4C, "止"},
+	{0x2F4D, "歹"},
+	{0x2F4E, "殳"},
+	{0x2F4F, "毋"},
+	{0x2F50, "比"},
+	{0x2F51, "毛"},
+	{0x2F52, "氏"},
+	{0x2F53, "气"},
+	{0x2F54, "水"},
+	{0x2F55, "火"},
+	{0x2F56, "爪"},
+	{0x2F57, "父"},
+	{0x2F58, "爻"},
+	{0x2F59, "爿"},
+	{0x2F5A, "片"},
+	{0x2F5B, "牙"},
+	{0x2F5C, "牛"},
+	{0x2F5D, "犬"},
+	{0x2F5E, "玄"},
+	{0x2F5F, "玉"},
+	{0x2F60, "瓜"},
+	{0x2F61, "瓦"},
+	{0x2F62, "甘"},
+	{0x2F63, "生"},
+	{0x2F64, "用"},
+	{0x2F65, "田"},
+	{0x2F66, "疋"},
+	{0x2F67, "疒"},
+	{0x2F68, "癶"},
+	{0x2F69, "白"},
+	{0x2F6A, "皮"},
+	{0x2F6B, "皿"},
+	{0x2F6C, "目"},
+	{0x2F6D, "矛"},
+	{0x2F6E, "矢"},
+	{0x2F6F, "石"},
+	{0x2F70, "示"},
+	{0x2F71, "禸"},
+	{0x2F72, "禾"},
+	{0x2F73, "穴"},
+	{0x2F74, "立"},
+	{0x2F75, "竹"},
+	{0x2F76, "米"},
+	{0x2F77, "糸"},
+	{0x2F78, "缶"},
+	{0x2F79, "网"},
+	{0x2F7A, "羊"},
+	{0x2F7B, "羽"},
+	{0x2F7C, "老"},
+	{0x2F7D, "而"},
+	{0x2F7E, "耒"},
+	{0x2F7F, "耳"},
+	{0x2F80, "聿"},
+	{0x2F81, "肉"},
+	{0x2F82, "臣"},
+	{0x2F83, "自"},
+	{0x2F84, "至"},
+	{0x2F85, "臼"},
+	{0x2F86, "舌"},
+	{0x2F87, "舛"},
+	{0x2F88, "舟"},
+	{0x2F89, "艮"},
+	{0x2F8A, "色"},
+	{0x2F8B, "艸"},
+	{0x2F8C, "虍"},
+	{0x2F8D, "虫"},
+	{0x2F8E, "血"},
+	{0x2F8F, "行"},
+	{0x2F90, "衣"},
+	{0x2F91, "襾"},
+	{0x2F92, "見"},
+	{0x2F93, "角"},
+	{0x2F94, "言"},
+	{0x2F95, "谷"},
+	{0x2F96, "豆"},
+	{0x2F97, "豕"},
+	{0x2F98, "豸"},
+	{0x2F99, "貝"},
+	{0x2F9A, "赤"},
+	{0x2F9B, "走"},
+	{0x2F9C, "足"},
+	{0x2F9D, "身"},
+	{0x2F9E, "車"},
+	{0x2F9F, "辛"},
+	{0x2FA0, "辰"},
+	{0x2FA1, "辵"},
+	{0x2FA2, "邑"},
+	{0x2FA3, "酉"},
+	{0x2FA4, "釆"},
+	{0x2FA5, "里"},
+	{0x2FA6, "金"},
+	{0x2FA7, "長"},
+	{0x2FA8, "門"},
+	{0x2FA9, "阜"},
+	{0x2FAA, "隶"},
+	{0x2FAB, "隹"},
+	{0x2FAC, "雨"},
+	{0x2FAD, "靑"},
+	{0x2FAE, "非"},
+	{0x2FAF, "面"},
+	{0x2FB0, "革"},
+	{0x2FB1, "韋"},
+	{0x2FB2, "韭"},
+	{0x2FB3, "音"},
+	{0x2FB4, "頁"},
+	{0x2FB5, "風"},
+	{0x2FB6, "飛"},
+	{0x2FB7, "食"},
+	{0x2FB8, "首"},
+	{0x2FB9, "香"},
+	{0x2FBA, "馬"},
+	{0x2FBB, "骨"},
+	{0x2FBC, "高"},
+	{0x2FBD, "髟"},
+	{0x2FBE, "鬥"},
+	{0x2FBF, "鬯"},
+	{0x2FC0, "鬲"},
+	{0x2FC1, "鬼"},
+	{0x2FC2, "魚"},
+	{0x2FC3, "鳥"},
+	{0x2FC4, "鹵"},
+	{0x2FC5, "鹿"},
+	{0x2FC6, "麥"},
+	{0x2FC7, "麻"},
+	{0x2FC8, "黃"},
+	{0x2FC9, "黍"},
+	{0x2FCA, "黑"},
+	{0x2FCB, "黹"},
+	{0x2FCC, "黽"},
+	{0x2FCD, "鼎"},
+	{0x2FCE, "鼓"},
+	{0x2FCF, "鼠"},
+	{0x2FD0, "鼻"},
+	{0x2FD1, "齊"},
+	{0x2FD2, "齒"},
+	{0x2FD3, "龍"},
+	{0x2FD4, "龜"},
+	{0x2FD5, "龠"},
+	{0x3000, " "},
+	{0x3036, "〒"},
+	{0x3038, "十"},
+	{0x3039, "卄"},
+	{0x303A, "卅"},
+	{0x309B, " ゙"},
+	{0x309C, " ゚"},
+	{0x309F, "より"},
+	{0x30FF, "コト"},
+	{0x3131, "ᄀ"},
+	{0x3132, "ᄁ"},
+	{0x3133, "ᆪ"},
+	{0x3134, "ᄂ"},
+	{0x3135, "ᆬ"},
+	{0x3136, "ᆭ"},
+	{0x3137, "ᄃ"},
+	{0x3138, "ᄄ"},
+	{0x3139, "ᄅ"},
+	{0x313A, "ᆰ"},
+	{0x313B, "ᆱ"},
+	{0x313C, "ᆲ"},
+	{0x313D, "ᆳ"},
+	{0x313E, "ᆴ"},
+	{0x313F, "ᆵ"},
+	{0x3140, "ᄚ"},
+	{0x3141, "ᄆ"},
+	{0x3142, "ᄇ"},
+	{0x3143, "ᄈ"},
+	{0x3144, "ᄡ"},
+	{0x3145, "ᄉ"},
+	{0x3146, "ᄊ"},
+	{0x3147, "ᄋ"},
+	{0x3148, "ᄌ"},
+	{0x3149, "ᄍ"},
+	{0x314A, "ᄎ"},
+	{0x314B, "ᄏ"},
+	{0x314C, "ᄐ"},
+	{0x314D, "ᄑ"},
+	{0x314E, "ᄒ"},
+	{0x314F, "ᅡ"},
+	{0x3150, "ᅢ"},
+	{0x3151, "ᅣ"},
+	{0x3152, "ᅤ"},
+	{0x3153, "ᅥ"},
+	{0x3154, "ᅦ"},
+	{0x3155, "ᅧ"},
+	{0x3156, "ᅨ"},
+	{0x3157, "ᅩ"},
+	{0x3158, "ᅪ"},
+	{0x3159, "ᅫ"},
+	{0x315A, "ᅬ"},
+	{0x315B, "ᅭ"},
+	{0x315C, "ᅮ"},
+	{0x315D, "ᅯ"},
+	{0x315E, "ᅰ"},
+	{0x315F, "ᅱ"},
+	{0x3160, "ᅲ"},
+	{0x3161, "ᅳ"},
+	{0x3162, "ᅴ"},
+	{0x3163, "ᅵ"},
+	{0x3165, "ᄔ"},
+	{0x3166, "ᄕ"},
+	{0x3167, "ᇇ"},
+	{0x3168, "ᇈ"},
+	{0x3169, "ᇌ"},
+	{0x316A, "ᇎ"},
+	{0x316B, "ᇓ"},
+	{0x316C, "ᇗ"},
+	{0x316D, "ᇙ"},
+	{0x316E, "ᄜ"},
+	{0x316F, "ᇝ"},
+	{0x3170, "ᇟ"},
+	{0x3171, "ᄝ"},
+	{0x3172, "ᄞ"},
+	{0x3173, "ᄠ"},
+	{0x3174, "ᄢ"},
+	{0x3175, "ᄣ"},
+	{0x3176, "ᄧ"},
+	{0x3177, "ᄩ"},
+	{0x3178, "ᄫ"},
+	{0x3179, "ᄬ"},
+	{0x317A, "ᄭ"},
+	{0x317B, "ᄮ"},
+	{0x317C, "ᄯ"},
+	{0x317D, "ᄲ"},
+	{0x317E, "ᄶ"},
+	{0x317F, "ᅀ"},
+	{0x3180, "ᅇ"},
+	{0x3181, "ᅌ"},
+	{0x3182, "ᇱ"},
+	{0x3183, "ᇲ"},
+	{0x3184, "ᅗ"},
+	{0x3185, "ᅘ"},
+	{0x3186, "ᅙ"},
+	{0x3187, "ᆄ"},
+	{0x3188, "ᆅ"},
+	{0x3189, "ᆈ"},
+	{0x318A, "ᆑ"},
+	{0x318B, "ᆒ"},
+	{0x318C, "ᆔ"},
+	{0x318D, "ᆞ"},
+	{0x318E, "ᆡ"},
+	{0x3192, "一"},
+	{0x3193, "二"},
+	{0x3194, "三"},
+	{0x3195, "四"},
+	{0x3196, "上"},
+	{0x3197, "中"},
+	{0x3198, "下"},
+	{0x3199, "甲"},
+	{0x319A, "乙"},
+	{0x319B, "丙"},
+	{0x319C, "丁"},
+	{0x319D, "天"},
+	{0x319E, "地"},
+	{0x319F, "人"},
+	{0x3200, "(ᄀ)"},
+	{0x3201, "(ᄂ)"},
+	{0x3202, "(ᄃ)"},
+	{0x3203, "(ᄅ)"},
+	{0x3204, "(ᄆ)"},
+	{0x3205, "(ᄇ)"},
+	{0x3206, "(ᄉ)"},
+	{0x3207, "(ᄋ)"},
+	{0x3208, "(ᄌ)"},
+	{0x3209, "(ᄎ)"},
+	{0x320A, "(ᄏ)"},
+	{0x320B, "(ᄐ)"},
+	{0x320C, "(ᄑ)"},
+	{0x320D, "(ᄒ)"},
+	{0x320E, "(가)"},
+	{0x320F, "(나)"},
+	{0x3210, "(다)"},
+	{0x3211, "(라)"},
+	{0x3212, "(마)"},
+	{0x3213, "(바)"},
+	{0x3214, "(사)"},
+	{0x3215, "(아)"},
+	{0x3216, "(자)"},
+	{0x3217, "(차)"},
+	{0x3218, "(카)"},
+	{0x3219, "(타)"},
+	{0x321A, "(파)"},
+	{0x321B, "(하)"},
+	{0x321C, "(주)"},
+	{0x321D, "(오전)"},
+	{0x321E, "(오후)"},
+	{0x3220, "(一)"},
+	{0x3221, "(二)"},
+	{0x3222, "(三)"},
+	{0x3223, "(四)"},
+	{0x3224, "(五)"},
+	{0x3225, "(六)"},
+	{0x3226, "(七)"},
+	{0x3227, "(八)"},
+	{0x3228, "(九)"},
+	{0x3229, "(十)"},
+	{0x322A, "(月)"},
+	{0x322B, "(火)"},
+	{0x322C, "(水)"},
+	{0x322D, "(木)"},
+	{0x322E, "(金)"},
+	{0x322F, "(土)"},
+	{0x3230, "(日)"},
+	{0x3231, "(株)"},
+	{0x3232, "(有)"},
+	{0x3233, "(社)"},
+	{0x3234, "(名)"},
+	{0x3235, "(特)"},
+	{0x3236, "(財)"},
+	{0x3237, "(祝)"},
+	{0x3238, "(労)"},
+	{0x3239, "(代)"},
+	{0x323A, "(呼)"},
+	{0x323B, "(学)"},
+	{0x323C, "(監)"},
+	{0x323D, "(企)"},
+	{0x323E, "(資)"},
+	{0x323F, "(協)"},
+	{0x3240, "(祭)"},
+	{0x3241, "(休)"},
+	{0x3242, "(自)"},
+	{0x3243, "(至)"},
+	{0x3244, "問"},
+	{0x3245, "幼"},
+	{0x3246, "文"},
+	{0x3247, "箏"},
+	{0x3250, "pte"},
+	{0x3251, "21"},
+	{0x3252, "22"},
+	{0x3253, "23"},
+	{0x3254, "24"},
+	{0x3255, "25"},
+	{0x3256, "26"},
+	{0x3257, "27"},
+	{0x3258, "28"},
+	{0x3259, "29"},
+	{0x325A, "30"},
+	{0x325B, "31"},
+	{0x325C, "32"},
+	{0x325D, "33"},
+	{0x325E, "34"},
+	{0x325F, "35"},
+	{0x3260, "ᄀ"},
+	{0x3261, "ᄂ"},
+	{0x3262, "ᄃ"},
+	{0x3263, "ᄅ"},
+	{0x3264, "ᄆ"},
+	{0x3265, "ᄇ"},
+	{0x3266, "ᄉ"},
+	{0x3267, "ᄋ"},
+	{0x3268, "ᄌ"},
+	{0x3269, "ᄎ"},
+	{0x326A, "ᄏ"},
+	{0x326B, "ᄐ"},
+	{0x326C, "ᄑ"},
+	{0x326D, "ᄒ"},
+	{0x326E, "가"},
+	{0x326F, "나"},
+	{0x3270, "다"},
+	{0x3271, "라"},
+	{0x3272, "마"},
+	{0x3273, "바"},
+	{0x3274, "사"},
+	{0x3275, "아"},
+	{0x3276, "자"},
+	{0x3277, "차"},
+	{0x3278, "카"},
+	{0x3279, "타"},
+	{0x327A, "파"},
+	{0x327B, "하"},
+	{0x327C, "참고"},
+	{0x327D, "주의"},
+	{0x327E, "우"},
+	{0x3280, "一"},
+	{0x3281, "二"},
+	{0x3282, "三"},
+	{0x3283, "四"},
+	{0x3284, "五"},
+	{0x3285, "六"},
+	{0x3286, "七"},
+	{0x3287, "八"},
+	{0x3288, "九"},
+	{0x3289, "十"},
+	{0x328A, "月"},
+	{0x328B, "火"},
+	{0x328C, "水"},
+	{0x328D, "木"},
+	{0x328E, "金"},
+	{0x328F, "土"},
+	{0x3290, "日"},
+	{0x3291, "株"},
+	{0x3292, "有"},
+	{0x3293, "社"},
+	{0x3294, "名"},
+	{0x3295, "特"},
+	{0x3296, "財"},
+	{0x3297, "祝"},
+	{0x3298, "労"},
+	{0x3299, "秘"},
+	{0x329A, "男"},
+	{0x329B, "女"},
+	{0x329C, "適"},
+	{0x329D, "優"},
+	{0x329E, "印"},
+	{0x329F, "注"},
+	{0x32A0, "項"},
+	{0x32A1, "休"},
+	{0x32A2, "写"},
+	{0x32A3, "正"},
+	{0x32A4, "上"},
+	{0x32A5, "中"},
+	{0x32A6, "下"},
+	{0x32A7, "左"},
+	{0x32A8, "右"},
+	{0x32A9, "医"},
+	{0x32AA, "宗"},
+	{0x32AB, "学"},
+	{0x32AC, "監"},
+	{0x32AD, "企"},
+	{0x32AE, "資"},
+	{0x32AF, "協"},
+	{0x32B0, "夜"},
+	{0x32B1, "36"},
+	{0x32B2, "37"},
+	{0x32B3, "38"},
+	{0x32B4, "39"},
+	{0x32B5, "40"},
+	{0x32B6, "41"},
+	{0x32B7, "42"},
+	{0x32B8, "43"},
+	{0x32B9, "44"},
+	{0x32BA, "45"},
+	{0x32BB, "46"},
+	{0x32BC, "47"},
+	{0x32BD, "48"},
+	{0x32BE, "49"},
+	{0x32BF, "50"},
+	{0x32C0, "1月"},
+	{0x32C1, "2月"},
+	{0x32C2, "3月"},
+	{0x32C3, "4月"},
+	{0x32C4, "5月"},
+	{0x32C5, "6月"},
+	{0x32C6, "7月"},
+	{0x32C7, "8月"},
+	{0x32C8, "9月"},
+	{0x32C9, "10月"},
+	{0x32CA, "11月"},
+	{0x32CB, "12月"},
+	{0x32CC, "hg"},
+	{0x32CD, "erg"},
+	{0x32CE, "ev"},
+	{0x32CF, "ltd"},
+	{0x32D0, "ア"},
+	{0x32D1, "イ"},
+	{0x32D2, "ウ"},
+	{0x32D3, "エ"},
+	{0x32D4, "オ"},
+	{0x32D5, "カ"},
+	{0x32D6, "キ"},
+	{0x32D7, "ク"},
+	{0x32D8, "ケ"},
+	{0x32D9, "コ"},
+	{0x32DA, "サ"},
+	{0x32DB, "シ"},
+	{0x32DC, "ス"},
+	{0x32DD, "セ"},
+	{0x32DE, "ソ"},
+	{0x32DF, "タ"},
+	{0x32E0, "チ"},
+	{0x32E1, "ツ"},
+	{0x32E2, "テ"},
+	{0x32E3, "ト"},
+	{0x32E4, "ナ"},
+	{0x32E5, "ニ"},
+	{0x32E6, "ヌ"},
+	{0x32E7, "ネ"},
+	{0x32E8, "ノ"},
+	{0x32E9, "ハ"},
+	{0x32EA, "ヒ"},
+	{0x32EB, "フ"},
+	{0x32EC, "ヘ"},
+	{0x32ED, "ホ"},
+	{0x32EE, "マ"},
+	{0x32EF, "ミ"},
+	{0x32F0, "ム"},
+	{0x32F1, "メ"},
+	{0x32F2, "モ"},
+	{0x32F3, "ヤ"},
+	{0x32F4, "ユ"},
+	{0x32F5, "ヨ"},
+	{0x32F6, "ラ"},
+	{0x32F7, "リ"},
+	{0x32F8, "ル"},
+	{0x32F9, "レ"},
+	{0x32FA, "ロ"},
+	{0x32FB, "ワ"},
+	{0x32FC, "ヰ"},
+	{0x32FD, "ヱ"},
+	{0x32FE, "ヲ"},
+	{0x32FF, "令和"},
+	{0x3300, "アパート"},
+	{0x3301, "アルファ"},
+	{0x3302, "アンペア"},
+	{0x3303, "アール"},
+	{0x3304, "イニング"},
+	{0x3305, "インチ"},
+	{0x3306, "ウォン"},
+	{0x3307, "エスクード"},
+	{0x3308, "エーカー"},
+	{0x3309, "オンス"},
+	{0x330A, "オーム"},
+	{0x330B, "カイリ"},
+	{0x330C, "カラット"},
+	{0x330D, "カロリー"},
+	{0x330E, "ガロン"},
+	{0x330F, "ガンマ"},
+	{0x3310, "ギガ"},
+	{0x3311, "ギニー"},
+	{0x3312, "キュリー"},
+	{0x3313, "ギルダー"},
+	{0x3314, "キロ"},
+	{0x3315, "キログラム"},
+	{0x3316, "キロメートル"},
+	{0x3317, "キロワット"},
+	{0x3318, "グラム"},
+	{0x3319, "グラムトン"},
+	{0x331A, "クルゼイロ"},
+	{0x331B, "クローネ"},
+	{0x331C, "ケース"},
+	{0x331D, "コルナ"},
+	{0x331E, "コーポ"},
+	{0x331F, "サイクル"},
+	{0x3320, "サンチーム"},
+	{0x3321, "シリング"},
+	{0x3322, "センチ"},
+	{0x3323, "セント"},
+	{0x3324, "ダース"},
+	{0x3325, "デシ"},
+	{0x3326, "ドル"},
+	{0x3327, "トン"},
+	{0x3328, "ナノ"},
+	{0x3329, "ノット"},
+	{0x332A, "ハイツ"},
+	{0x332B, "パーセント"},
+	{0x332C, "パーツ"},
+	{0x332D, "バーレル"},
+	{0x332E, "ピアストル"},
+	{0x332F, "ピクル"},
+	{0x3330, "ピコ"},
+	{0x3331, "ビル"},
+	{0x3332, "ファラッド"},
+	{0x3333, "フィート"},
+	{0x3334, "ブッシェル"},
+	{0x3335, "フラン"},
+	{0x3336, "ヘクタール"},
+	{0x3337, "ペソ"},
+	{0x3338, "ペニヒ"},
+	{0x3339, "ヘルツ"},
+	{0x333A, "ペンス"},
+	{0x333B, "ページ"},
+	{0x333C, "ベータ"},
+	{0x333D, "ポイント"},
+	{0x333E, "ボルト"},
+	{0x333F, "ホン"},
+	{0x3340, "ポンド"},
+	{0x3341, "ホール"},
+	{0x3342, "ホーン"},
+	{0x3343, "マイクロ"},
+	{0x3344, "マイル"},
+	{0x3345, "マッハ"},
+	{0x3346, "マルク"},
+	{0x3347, "マンション"},
+	{0x3348, "ミクロン"},
+	{0x3349, "ミリ"},
+	{0x334A, "ミリバール"},
+	{0x334B, "メガ"},
+	{0x334C, "メガトン"},
+	{0x334D, "メートル"},
+	{0x334E, "ヤード"},
+	{0x334F, "ヤール"},
+	{0x3350, "ユアン"},
+	{0x3351, "リットル"},
+	{0x3352, "リラ"},
+	{0x3353, "ルピー"},
+	{0x3354, "ルーブル"},
+	{0x3355, "レム"},
+	{0x3356, "レントゲン"},
+	{0x3357, "ワット"},
+	{0x3358, "0点"},
+	{0x3359, "1点"},
+	{0x335A, "2点"},
+	{0x335B, "3点"},
+	{0x335C, "4点"},
+	{0x335D, "5点"},
+	{0x335E, "6点"},
+	{0x335F, "7点"},
+	{0x3360, "8点"},
+	{0x3361, "9点"},
+	{0x3362, "10点"},
+	{0x3363, "11点"},
+	{0x3364, "12点"},
+	{0x3365, "13点"},
+	{0x3366, "14点"},
+	{0x3367, "15点"},
+	{0x3368, "16点"},
+	{0x3369, "17点"},
+	{0x336A, "18点"},
+	{0x336B, "19点"},
+	{0x336C, "20点"},
+	{0x336D, "21点"},
+	{0x336E, "22点"},
+	{0x336F, "23点"},
+	{0x3370, "24点"},
+	{0x3371, "hpa"},
+	{0x3372, "da"},
+	{0x3373, "au"},
+	{0x3374, "bar"},
+	{0x3375, "ov"},
+	{0x3376, "pc"},
+	{0x3377, "dm"},
+	{0x3378, "dm2"},
+	{0x3379, "dm3"},
+	{0x337A, "iu"},
+	{0x337B, "平成"},
+	{0x337C, "昭和"},
+	{0x337D, "大正"},
+	{0x337E, "明治"},
+	{0x337F, "株式会社"},
+	{0x3380, "pa"},
+	{0x3381, "na"},
+	{0x3382, "μa"},
+	{0x3383, "ma"},
+	{0x3384, "ka"},
+	{0x3385, "kb"},
+	{0x3386, "mb"},
+	{0x3387, "gb"},
+	{0x3388, "cal"},
+	{0x3389, "kcal"},
+	{0x338A, "pf"},
+	{0x338B, "nf"},
+	{0x338C, "μf"},
+	{0x338D, "μg"},
+	{0x338E, "mg"},
+	{0x338F, "kg"},
+	{0x3390, "hz"},
+	{0x3391, "khz"},
+	{0x3392, "mhz"},
+	{0x3393, "ghz"},
+	{0x3394, "thz"},
+	{0x3395, "μl"},
+	{0x3396, "ml"},
+	{0x3397, "dl"},
+	{0x3398, "kl"},
+	{0x3399, "fm"},
+	{0x339A, "nm"},
+	{0x339B, "μm"},
+	{0x339C, "mm"},
+	{0x339D, "cm"},
+	{0x339E, "km"},
+	{0x339F, "mm2"},
+	{0x33A0, "cm2"},
+	{0x33A1, "m2"},
+	{0x33A2, "km2"},
+	{0x33A3, "mm3"},
+	{0x33A4, "cm3"},
+	{0x33A5, "m3"},
+	{0x33A6, "km3"},
+	{0x33A7, "m∕s"},
+	{0x33A8, "m∕s2"},
+	{0x33A9, "pa"},
+	{0x33AA, "kpa"},
+	{0x33AB, "mpa"},
+	{0x33AC, "gpa"},
+	{0x33AD, "rad"},
+	{0x33AE, "rad∕s"},
+	{0x33AF, "rad∕s2"},
+	{0x33B0, "ps"},
+	{0x33B1, "ns"},
+	{0x33B2, "μs"},
+	{0x33B3, "ms"},
+	{0x33B4, "pv"},
+	{0x33B5, "nv"},
+	{0x33B6, "μv"},
+	{0x33B7, "mv"},
+	{0x33B8, "kv"},
+	{0x33B9, "mv"},
+	{0x33BA, "pw"},
+	{0x33BB, "nw"},
+	{0x33BC, "μw"},
+	{0x33BD, "mw"},
+	{0x33BE, "kw"},
+	{0x33BF, "mw"},
+	{0x33C0, "kω"},
+	{0x33C1, "mω"},
+	{0x33C3, "bq"},
+	{0x33C4, "cc"},
+	{0x33C5, "cd"},
+	{0x33C6, "c∕kg"},
+	{0x33C8, "db"},
+	{0x33C9, "gy"},
+	{0x33CA, "ha"},
+	{0x33CB, "hp"},
+	{0x33CC, "in"},
+	{0x33CD, "kk"},
+	{0x33CE, "km"},
+	{0x33CF, "kt"},
+	{0x33D0, "lm"},
+	{0x33D1, "ln"},
+	{0x33D2, "log"},
+	{0x33D3, "lx"},
+	{0x33D4, "mb"},
+	{0x33D5, "mil"},
+	{0x33D6, "mol"},
+	{0x33D7, "ph"},
+	{0x33D9, "ppm"},
+	{0x33DA, "pr"},
+	{0x33DB, "sr"},
+	{0x33DC, "sv"},
+	{0x33DD, "wb"},
+	{0x33DE, "v∕m"},
+	{0x33DF, "a∕m"},
+	{0x33E0, "1日"},
+	{0x33E1, "2日"},
+	{0x33E2, "3日"},
+	{0x33E3, "4日"},
+	{0x33E4, "5日"},
+	{0x33E5, "6日"},
+	{0x33E6, "7日"},
+	{0x33E7, "8日"},
+	{0x33E8, "9日"},
+	{0x33E9, "10日"},
+	{0x33EA, "11日"},
+	{0x33EB, "12日"},
+	{0x33EC, "13日"},
+	{0x33ED, "14日"},
+	{0x33EE, "15日"},
+	{0x33EF, "16日"},
+	{0x33F0, "17日"},
+	{0x33F1, "18日"},
+	{0x33F2, "19日"},
+	{0x33F3, "20日"},
+	{0x33F4, "21日"},
+	{0x33F5, "22日"},
+	{0x33F6, "23日"},
+	{0x33F7, "24日"},
+	{0x33F8, "25日"},
+	{0x33F9, "26日"},
+	{0x33FA, "27日"},
+	{0x33FB, "28日"},
+	{0x33FC, "29日"},
+	{0x33FD, "30日"},
+	{0x33FE, "31日"},
+	{0x33FF, "gal"},
+	{0xA640, "ꙁ"},
+	{0xA642, "ꙃ"},
+	{0xA644, "ꙅ"},
+	{0xA646, "ꙇ"},
+	{0xA648, "ꙉ"},
+	{0xA64A, "ꙋ"},
+	{0xA64C, "ꙍ"},
+	{0xA64E, "ꙏ"},
+	{0xA650, "ꙑ"},
+	{0xA652, "ꙓ"},
+	{0xA654, "ꙕ"},
+	{0xA656, "ꙗ"},
+	{0xA658, "ꙙ"},
+	{0xA65A, "ꙛ"},
+	{0xA65C, "ꙝ"},
+	{0xA65E, "ꙟ"},
+	{0xA660, "ꙡ"},
+	{0xA662, "ꙣ"},
+	{0xA664, "ꙥ"},
+	{0xA666, "ꙧ"},
+	{0xA668, "ꙩ"},
+	{0xA66A, "ꙫ"},
+	{0xA66C, "ꙭ"},
+	{0xA680, "ꚁ"},
+	{0xA682, "ꚃ"},
+	{0xA684, "ꚅ"},
+	{0xA686, "ꚇ"},
+	{0xA688, "ꚉ"},
+	{0xA68A, "ꚋ"},
+	{0xA68C, "ꚍ"},
+	{0xA68E, "ꚏ"},
+	{0xA690, "ꚑ"},
+	{0xA692, "ꚓ"},
+	{0xA694, "ꚕ"},
+	{0xA696, "ꚗ"},
+	{0xA698, "ꚙ"},
+	{0xA69A, "ꚛ"},
+	{0xA69C, "ъ"},
+	{0xA69D, "ь"},
+	{0xA722, "ꜣ"},
+	{0xA724, "ꜥ"},
+	{0xA726, "ꜧ"},
+	{0xA728, "ꜩ"},
+	{0xA72A, "ꜫ"},
+	{0xA72C, "ꜭ"},
+	{0xA72E, "ꜯ"},
+	{0xA732, "ꜳ"},
+	{0xA734, "ꜵ"},
+	{0xA736, "ꜷ"},
+	{0xA738, "ꜹ"},
+	{0xA73A, "ꜻ"},
+	{0xA73C, "ꜽ"},
+	{0xA73E, "ꜿ"},
+	{0xA740, "ꝁ"},
+	{0xA742, "ꝃ"},
+	{0xA744, "ꝅ"},
+	{0xA746, "ꝇ"},
+	{0xA748, "ꝉ"},
+	{0xA74A, "ꝋ"},
+	{0xA74C, "ꝍ"},
+	{0xA74E, "ꝏ"},
+	{0xA750, "ꝑ"},
+	{0xA752, "ꝓ"},
+	{0xA754, "ꝕ"},
+	{0xA756, "ꝗ"},
+	{0xA758, "ꝙ"},
+	{0xA75A, "ꝛ"},
+	{0xA75C, "ꝝ"},
+	{0xA75E, "ꝟ"},
+	{0xA760, "ꝡ"},
+	{0xA762, "ꝣ"},
+	{0xA764, "ꝥ"},
+	{0xA766, "ꝧ"},
+	{0xA768, "ꝩ"},
+	{0xA76A, "ꝫ"},
+	{0xA76C, "ꝭ"},
+	{0xA76E, "ꝯ"},
+	{0xA770, "ꝯ"},
+	{0xA779, "ꝺ"},
+	{0xA77B, "ꝼ"},
+	{0xA77D, "ᵹ"},
+	{0xA77E, "ꝿ"},
+	{0xA780, "ꞁ"},
+	{0xA782, "ꞃ"},
+	{0xA784, "ꞅ"},
+	{0xA786, "ꞇ"},
+	{0xA78B, "ꞌ"},
+	{0xA78D, "ɥ"},
+	{0xA790, "ꞑ"},
+	{0xA792, "ꞓ"},
+	{0xA796, "ꞗ"},
+	{0xA798, "ꞙ"},
+	{0xA79A, "ꞛ"},
+	{0xA79C, "ꞝ"},
+	{0xA79E, "ꞟ"},
+	{0xA7A0, "ꞡ"},
+	{0xA7A2, "ꞣ"},
+	{0xA7A4, "ꞥ"},
+	{0xA7A6, "ꞧ"},
+	{0xA7A8, "ꞩ"},
+	{0xA7AA, "ɦ"},
+	{0xA7AB, "ɜ"},
+	{0xA7AC, "ɡ"},
+	{0xA7AD, "ɬ"},
+	{0xA7AE, "ɪ"},
+	{0xA7B0, "ʞ"},
+	{0xA7B1, "ʇ"},
+	{0xA7B2, "ʝ"},
+	{0xA7B3, "ꭓ"},
+	{0xA7B4, "ꞵ"},
+	{0xA7B6, "ꞷ"},
+	{0xA7B8, "ꞹ"},
+	{0xA7BA, "ꞻ"},
+	{0xA7BC, "ꞽ"},
+	{0xA7BE, "ꞿ"},
+	{0xA7C0, "ꟁ"},
+	{0xA7C2, "ꟃ"},
+	{0xA7C4, "ꞔ"},
+	{0xA7C5, "ʂ"},
+	{0xA7C6, "ᶎ"},
+	{0xA7C7, "ꟈ"},
+	{0xA7C9, "ꟊ"},
+	{0xA7D0, "ꟑ"},
+	{0xA7D6, "ꟗ"},
+	{0xA7D8, "ꟙ"},
+	{0xA7F2, "c"},
+	{0xA7F3, "f"},
+	{0xA7F4, "q"},
+	{0xA7F5, "ꟶ"},
+	{0xA7F8, "ħ"},
+	{0xA7F9, "œ"},
+	{0xAB5C, "ꜧ"},
+	{0xAB5D, "ꬷ"},
+	{0xAB5E, "ɫ"},
+	{0xAB5F, "ꭒ"},
+	{0xAB69, "ʍ"},
+	{0xAB70, "Ꭰ"},
+	{0xAB71, "Ꭱ"},
+	{0xAB72, "Ꭲ"},
+	{0xAB73, "Ꭳ"},
+	{0xAB74, "Ꭴ"},
+	{0xAB75, "Ꭵ"},
+	{0xAB76, "Ꭶ"},
+	{0xAB77, "Ꭷ"},
+	{0xAB78, "Ꭸ"},
+	{0xAB79, "Ꭹ"},
+	{0xAB7A, "Ꭺ"},
+	{0xAB7B, "Ꭻ"},
+	{0xAB7C, "Ꭼ"},
+	{0xAB7D, "Ꭽ"},
+	{0xAB7E, "Ꭾ"},
+	{0xAB7F, "Ꭿ"},
+	{0xAB80, "Ꮀ"},
+	{0xAB81, "Ꮁ"},
+	{0xAB82, "Ꮂ"},
+	{0xAB83, "Ꮃ"},
+	{0xAB84, "Ꮄ"},
+	{0xAB85, "Ꮅ"},
+	{0xAB86, "Ꮆ"},
+	{0xAB87, "Ꮇ"},
+	{0xAB88, "Ꮈ"},
+	{0xAB89, "Ꮉ"},
+	{0xAB8A, "Ꮊ"},
+	{0xAB8B, "Ꮋ"},
+	{0xAB8C, "Ꮌ"},
+	{0xAB8D, "Ꮍ"},
+	{0xAB8E, "Ꮎ"},
+	{0xAB8F, "Ꮏ"},
+	{0xAB90, "Ꮐ"},
+	{0xAB91, "Ꮑ"},
+	{0xAB92, "Ꮒ"},
+	{0xAB93, "Ꮓ"},
+	{0xAB94, "Ꮔ"},
+	{0xAB95, "Ꮕ"},
+	{0xAB96, "Ꮖ"},
+	{0xAB97, "Ꮗ"},
+	{0xAB98, "Ꮘ"},
+	{0xAB99, "Ꮙ"},
+	{0xAB9A, "Ꮚ"},
+	{0xAB9B, "Ꮛ"},
+	{0xAB9C, "Ꮜ"},
+	{0xAB9D, "Ꮝ"},
+	{0xAB9E, "Ꮞ"},
+	{0xAB9F, "Ꮟ"},
+	{0xABA0, "Ꮠ"},
+	{0xABA1, "Ꮡ"},
+	{0xABA2, "Ꮢ"},
+	{0xABA3, "Ꮣ"},
+	{0xABA4, "Ꮤ"},
+	{0xABA5, "Ꮥ"},
+	{0xABA6, "Ꮦ"},
+	{0xABA7, "Ꮧ"},
+	{0xABA8, "Ꮨ"},
+	{0xABA9, "Ꮩ"},
+	{0xABAA, "Ꮪ"},
+	{0xABAB, "Ꮫ"},
+	{0xABAC, "Ꮬ"},
+	{0xABAD, "Ꮭ"},
+	{0xABAE, "Ꮮ"},
+	{0xABAF, "Ꮯ"},
+	{0xABB0, "Ꮰ"},
+	{0xABB1, "Ꮱ"},
+	{0xABB2, "Ꮲ"},
+	{0xABB3, "Ꮳ"},
+	{0xABB4, "Ꮴ"},
+	{0xABB5, "Ꮵ"},
+	{0xABB6, "Ꮶ"},
+	{0xABB7, "Ꮷ"},
+	{0xABB8, "Ꮸ"},
+	{0xABB9, "Ꮹ"},
+	{0xABBA, "Ꮺ"},
+	{0xABBB, "Ꮻ"},
+	{0xABBC, "Ꮼ"},
+	{0xABBD, "Ꮽ"},
+	{0xABBE, "Ꮾ"},
+	{0xABBF, "Ꮿ"},
+	{0xF900, "豈"},
+	{0xF901, "更"},
+	{0xF902, "車"},
+	{0xF903, "賈"},
+	{0xF904, "滑"},
+	{0xF905, "串"},
+	{0xF906, "句"},
+	{0xF907, "龜"},
+	{0xF908, "龜"},
+	{0xF909, "契"},
+	{0xF90A, "金"},
+	{0xF90B, "喇"},
+	{0xF90C, "奈"},
+	{0xF90D, "懶"},
+	{0xF90E, "癩"},
+	{0xF90F, "羅"},
+	{0xF910, "蘿"},
+	{0xF911, "螺"},
+	{0xF912, "裸"},
+	{0xF913, "邏"},
+	{0xF914, "樂"},
+	{0xF915, "洛"},
+	{0xF916, "烙"},
+	{0xF917, "珞"},
+	{0xF918, "落"},
+	{0xF919, "酪"},
+	{0xF91A, "駱"},
+	{0xF91B, "亂"},
+	{0xF91C, "卵"},
+	{0xF91D, "欄"},
+	{0xF91E, "爛"},
+	{0xF91F, "蘭"},
+	{0xF920, "鸞"},
+	{0xF921, "嵐"},
+	{0xF922, "濫"},
+	{0xF923, "藍"},
+	{0xF924, "襤"},
+	{0xF925, "拉"},
+	{0xF926, "臘"},
+	{0xF927, "蠟"},
+	{0xF928, "廊"},
+	{0xF929, "朗"},
+	{0xF92A, "浪"},
+	{0xF92B, "狼"},
+	{0xF92C, "郎"},
+	{0xF92D, "來"},
+	{0xF92E, "冷"},
+	{0xF92F, "勞"},
+	{0xF930, "擄"},
+	{0xF931, "櫓"},
+	{0xF932, "爐"},
+	{0xF933, "盧"},
+	{0xF934, "老"},
+	{0xF935, "蘆"},
+	{0xF936, "虜"},
+	{0xF937, "路"},
+	{0xF938, "露"},
+	{0xF939, "魯"},
+	{0xF93A, "鷺"},
+	{0xF93B, "碌"},
+	{0xF93C, "祿"},
+	{0xF93D, "綠"},
+	{0xF93E, "菉"},
+	{0xF93F, "錄"},
+	{0xF940, "鹿"},
+	{0xF941, "論"},
+	{0xF942, "壟"},
+	{0xF943, "弄"},
+	{0xF944, "籠"},
+	{0xF945, "聾"},
+	{0xF946, "牢"},
+	{0xF947, "磊"},
+	{0xF948, "賂"},
+	{0xF949, "雷"},
+	{0xF94A, "壘"},
+	{0xF94B, "屢"},
+	{0xF94C, "樓"},
+	{0xF94D, "淚"},
+	{0xF94E, "漏"},
+	{0xF94F, "累"},
+	{0xF950, "縷"},
+	{0xF951, "陋"},
+	{0xF952, "勒"},
+	{0xF953, "肋"},
+	{0xF954, "凜"},
+	{0xF955, "凌"},
+	{0xF956, "稜"},
+	{0xF957, "綾"},
+	{0xF958, "菱"},
+	{0xF959, "陵"},
+	{0xF95A, "讀"},
+	{0xF95B, "拏"},
+	{0xF95C, "樂"},
+	{0xF95D, "諾"},
+	{0xF95E, "丹"},
+	{0xF95F, "寧"},
+	{0xF960, "怒"},
+	{0xF961, "率"},
+	{0xF962, "異"},
+	{0xF963, "北"},
+	{0xF964, "磻"},
+	{0xF965, "便"},
+	{0xF966, "復"},
+	{0xF967, "不"},
+	{0xF968, "泌"},
+	{0xF969, "數"},
+	{0xF96A, "索"},
+	{0xF96B, "參"},
+	{0xF96C, "塞"},
+	{0xF96D, "省"},
+	{0xF96E, "葉"},
+	{0xF96F, "說"},
+	{0xF970, "殺"},
+	{0xF971, "辰"},
+	{0xF972, "沈"},
+	{0xF973, "拾"},
+	{0xF974, "若"},
+	{0xF975, "掠"},
+	{0xF976, "略"},
+	{0xF977, "亮"},
+	{0xF978, "兩"},
+	{0xF979, "凉"},
+	{0xF97A, "梁"},
+	{0xF97B, "糧"},
+	{0xF97C, "良"},
+	{0xF97D, "諒"},
+	{0xF97E, "量"},
+	{0xF97F, "勵"},
+	{0xF980, "呂"},
+	{0xF981, "女"},
+	{0xF982, "廬"},
+	{0xF983, "旅"},
+	{0xF984, "濾"},
+	{0xF985, "礪"},
+	{0xF986, "閭"},
+	{0xF987, "驪"},
+	{0xF988, "麗"},
+	{0xF989, "黎"},
+	{0xF98A, "力"},
+	{0xF98B, "曆"},
+	{0xF98C, "歷"},
+	{0xF98D, "轢"},
+	{0xF98E, "年"},
+	{0xF98F, "憐"},
+	{0xF990, "戀"},
+	{0xF991, "撚"},
+	{0xF992, "漣"},
+	{0xF993, "煉"},
+	{0xF994, "璉"},
+	{0xF995, "秊"},
+	{0xF996, "練"},
+	{0xF997, "聯"},
+	{0xF998, "輦"},
+	{0xF999, "蓮"},
+	{0xF99A, "連"},
+	{0xF99B, "鍊"},
+	{0xF99C, "列"},
+	{0xF99D, "劣"},
+	{0xF99E, "咽"},
+	{0xF99F, "烈"},
+	{0xF9A0, "裂"},
+	{0xF9A1, "說"},
+	{0xF9A2, "廉"},
+	{0xF9A3, "念"},
+	{0xF9A4, "捻"},
+	{0xF9A5, "殮"},
+	{0xF9A6, "簾"},
+	{0xF9A7, "獵"},
+	{0xF9A8, "令"},
+	{0xF9A9, "囹"},
+	{0xF9AA, "寧"},
+	{0xF9AB, "嶺"},
+	{0xF9AC, "怜"},
+	{0xF9AD, "玲"},
+	{0xF9AE, "瑩"},
+	{0xF9AF, "羚"},
+	{0xF9B0, "聆"},
+	{0xF9B1, "鈴"},
+	{0xF9B2, "零"},
+	{0xF9B3, "靈"},
+	{0xF9B4, "領"},
+	{0xF9B5, "例"},
+	{0xF9B6, "禮"},
+	{0xF9B7, "醴"},
+	{0xF9B8, "隸"},
+	{0xF9B9, "惡"},
+	{0xF9BA, "了"},
+	{0xF9BB, "僚"},
+	{0xF9BC, "寮"},
+	{0xF9BD, "尿"},
+	{0xF9BE, "料"},
+	{0xF9BF, "樂"},
+	{0xF9C0, "燎"},
+	{0xF9C1, "療"},
+	{0xF9C2, "蓼"},
+	{0xF9C3, "遼"},
+	{0xF9C4, "龍"},
+	{0xF9C5, "暈"},
+	{0xF9C6, "阮"},
+	{0xF9C7, "劉"},
+	{0xF9C8, "杻"},
+	{0xF9C9, "柳"},
+	{0xF9CA, "流"},
+	{0xF9CB, "溜"},
+	{0xF9CC, "琉"},
+	{0xF9CD, "留"},
+	{0xF9CE, "硫"},
+	{0xF9CF, "紐"},
+	{0xF9D0, "類"},
+	{0xF9D1, "六"},
+	{0xF9D2, "戮"},
+	{0xF9D3, "陸"},
+	{0xF9D4, "倫"},
+	{0xF9D5, "崙"},
+	{0xF9D6, "淪"},
+	{0xF9D7, "輪"},
+	{0xF9D8, "律"},
+	{0xF9D9, "慄"},
+	{0xF9DA, "栗"},
+	{0xF9DB, "率"},
+	{0xF9DC, "隆"},
+	{0xF9DD, "利"},
+	{0xF9DE, "吏"},
+	{0xF9DF, "履"},
+	{0xF9E0, "易"},
+	{0xF9E1, "李"},
+	{0xF9E2, "梨"},
+	{0xF9E3, "泥"},
+	{0xF9E4, "理"},
+	{0xF9E5, "痢"},
+	{0xF9E6, "罹"},
+	{0xF9E7, "裏"},
+	{0xF9E8, "裡"},
+	{0xF9E9, "里"},
+	{0xF9EA, "離"},
+	{0xF9EB, "匿"},
+	{0xF9EC, "溺"},
+	{0xF9ED, "吝"},
+	{0xF9EE, "燐"},
+	{0xF9EF, "璘"},
+	{0xF9F0, "藺"},
+	{0xF9F1, "隣"},
+	{0xF9F2, "鱗"},
+	{0xF9F3, "麟"},
+	{0xF9F4, "林"},
+	{0xF9F5, "淋"},
+	{0xF9F6, "臨"},
+	{0xF9F7, "立"},
+	{0xF9F8, "笠"},
+	{0xF9F9, "粒"},
+	{0xF9FA, "狀"},
+	{0xF9FB, "炙"},
+	{0xF9FC, "識"},
+	{0xF9FD, "什"},
+	{0xF9FE, "茶"},
+	{0xF9FF, "刺"},
+	{0xFA00, "切"},
+	{0xFA01, "度"},
+	{0xFA02, "拓"},
+	{0xFA03, "糖"},
+	{0xFA04, "宅"},
+	{0xFA05, "洞"},
+	{0xFA06, "暴"},
+	{0xFA07, "輻"},
+	{0xFA08, "行"},
+	{0xFA09, "降"},
+	{0xFA0A, "見"},
+	{0xFA0B, "廓"},
+	{0xFA0C, "兀"},
+	{0xFA0D, "嗀"},
+	{0xFA10, "塚"},
+	{0xFA12, "晴"},
+	{0xFA15, "凞"},
+	{0xFA16, "猪"},
+	{0xFA17, "益"},
+	{0xFA18, "礼"},
+	{0xFA19, "神"},
+	{0xFA1A, "祥"},
+	{0xFA1B, "福"},
+	{0xFA1C, "靖"},
+	{0xFA1D, "精"},
+	{0xFA1E, "羽"},
+	{0xFA20, "蘒"},
+	{0xFA22, "諸"},
+	{0xFA25, "逸"},
+	{0xFA26, "都"},
+	{0xFA2A, "飯"},
+	{0xFA2B, "飼"},
+	{0xFA2C, "館"},
+	{0xFA2D, "鶴"},
+	{0xFA2E, "郞"},
+	{0xFA2F, "隷"},
+	{0xFA30, "侮"},
+	{0xFA31, "僧"},
+	{0xFA32, "免"},
+	{0xFA33, "勉"},
+	{0xFA34, "勤"},
+	{0xFA35, "卑"},
+	{0xFA36, "喝"},
+	{0xFA37, "嘆"},
+	{0xFA38, "器"},
+	{0xFA39, "塀"},
+	{0xFA3A, "墨"},
+	{0xFA3B, "層"},
+	{0xFA3C, "屮"},
+	{0xFA3D, "悔"},
+	{0xFA3E, "慨"},
+	{0xFA3F, "憎"},
+	{0xFA40, "懲"},
+	{0xFA41, "敏"},
+	{0xFA42, "既"},
+	{0xFA43, "暑"},
+	{0xFA44, "梅"},
+	{0xFA45, "海"},
+	{0xFA46, "渚"},
+	{0xFA47, "漢"},
+	{0xFA48, "煮"},
+	{0xFA49, "爫"},
+	{0xFA4A, "琢"},
+	{0xFA4B, "碑"},
+	{0xFA4C, "社"},
+	{0xFA4D, "祉"},
+	{0xFA4E, "祈"},
+	{0xFA4F, "祐"},
+	{0xFA50, "祖"},
+	{0xFA51, "祝"},
+	{0xFA52, "禍"},
+	{0xFA53, "禎"},
+	{0xFA54, "穀"},
+	{0xFA55, "突"},
+	{0xFA56, "節"},
+	{0xFA57, "練"},
+	{0xFA58, "縉"},
+	{0xFA59, "繁"},
+	{0xFA5A, "署"},
+	{0xFA5B, "者"},
+	{0xFA5C, "臭"},
+	{0xFA5D, "艹"},
+	{0xFA5E, "艹"},
+	{0xFA5F, "著"},
+	{0xFA60, "褐"},
+	{0xFA61, "視"},
+	{0xFA62, "謁"},
+	{0xFA63, "謹"},
+	{0xFA64, "賓"},
+	{0xFA65, "贈"},
+	{0xFA66, "辶"},
+	{0xFA67, "逸"},
+	{0xFA68, "難"},
+	{0xFA69, "響"},
+	{0xFA6A, "頻"},
+	{0xFA6B, "恵"},
+	{0xFA6C, "𤋮"},
+	{0xFA6D, "舘"},
+	{0xFA70, "並"},
+	{0xFA71, "况"},
+	{0xFA72, "全"},
+	{0xFA73, "侀"},
+	{0xFA74, "充"},
+	{0xFA75, "冀"},
+	{0xFA76, "勇"},
+	{0xFA77, "勺"},
+	{0xFA78, "喝"},
+	{0xFA79, "啕"},
+	{0xFA7A, "喙"},
+	{0xFA7B, "嗢"},
+	{0xFA7C, "塚"},
+	{0xFA7D, "墳"},
+	{0xFA7E, "奄"},
+	{0xFA7F, "奔"},
+	{0xFA80, "婢"},
+	{0xFA81, "嬨"},
+	{0xFA82, "廒"},
+	{0xFA83, "廙"},
+	{0xFA84, "彩"},
+	{0xFA85, "徭"},
+	{0xFA86, "惘"},
+	{0xFA87, "慎"},
+	{0xFA88, "愈"},
+	{0xFA89, "憎"},
+	{0xFA8A, "慠"},
+	{0xFA8B, "懲"},
+	{0xFA8C, "戴"},
+	{0xFA8D, "揄"},
+	{0xFA8E, "搜"},
+	{0xFA8F, "摒"},
+	{0xFA90, "敖"},
+	{0xFA91, "晴"},
+	{0xFA92, "朗"},
+	{0xFA93, "望"},
+	{0xFA94, "杖"},
+	{0xFA95, "歹"},
+	{0xFA96, "殺"},
+	{0xFA97, "流"},
+	{0xFA98, "滛"},
+	{0xFA99, "滋"},
+	{0xFA9A, "漢"},
+	{0xFA9B, "瀞"},
+	{0xFA9C, "煮"},
+	{0xFA9D, "瞧"},
+	{0xFA9E, "爵"},
+	{0xFA9F, "犯"},
+	{0xFAA0, "猪"},
+	{0xFAA1, "瑱"},
+	{0xFAA2, "甆"},
+	{0xFAA3, "画"},
+	{0xFAA4, "瘝"},
+	{0xFAA5, "瘟"},
+	{0xFAA6, "益"},
+	{0xFAA7, "盛"},
+	{0xFAA8, "直"},
+	{0xFAA9, "睊"},
+	{0xFAAA, "着"},
+	{0xFAAB, "磌"},
+	{0xFAAC, "窱"},
+	{0xFAAD, "節"},
+	{0xFAAE, "类"},
+	{0xFAAF, "絛"},
+	{0xFAB0, "練"},
+	{0xFAB1, "缾"},
+	{0xFAB2, "者"},
+	{0xFAB3, "荒"},
+	{0xFAB4, "華"},
+	{0xFAB5, "蝹"},
+	{0xFAB6, "襁"},
+	{0xFAB7, "覆"},
+	{0xFAB8, "視"},
+	{0xFAB9, "調"},
+	{0xFABA, "諸"},
+	{0xFABB, "請"},
+	{0xFABC, "謁"},
+	{0xFABD, "諾"},
+	{0xFABE, "諭"},
+	{0xFABF, "謹"},
+	{0xFAC0, "變"},
+	{0xFAC1, "贈"},
+	{0xFAC2, "輸"},
+	{0xFAC3, "遲"},
+	{0xFAC4, "醙"},
+	{0xFAC5, "鉶"},
+	{0xFAC6, "陼"},
+	{0xFAC7, "難"},
+	{0xFAC8, "靖"},
+	{0xFAC9, "韛"},
+	{0xFACA, "響"},
+	{0xFACB, "頋"},
+	{0xFACC, "頻"},
+	{0xFACD, "鬒"},
+	{0xFACE, "龜"},
+	{0xFACF, "𢡊"},
+	{0xFAD0, "𢡄"},
+	{0xFAD1, "𣏕"},
+	{0xFAD2, "㮝"},
+	{0xFAD3, "䀘"},
+	{0xFAD4, "䀹"},
+	{0xFAD5, "𥉉"},
+	{0xFAD6, "𥳐"},
+	{0xFAD7, "𧻓"},
+	{0xFAD8, "齃"},
+	{0xFAD9, "龎"},
+	{0xFB00, "ff"},
+	{0xFB01, "fi"},
+	{0xFB02, "fl"},
+	{0xFB03, "ffi"},
+	{0xFB04, "ffl"},
+	{0xFB05, "st"},
+	{0xFB06, "st"},
+	{0xFB13, "մն"},
+	{0xFB14, "մե"},
+	{0xFB15, "մի"},
+	{0xFB16, "վն"},
+	{0xFB17, "մխ"},
+	{0xFB1D, "יִ"},
+	{0xFB1F, "ײַ"},
+	{0xFB20, "ע"},
+	{0xFB21, "א"},
+	{0xFB22, "ד"},
+	{0xFB23, "ה"},
+	{0xFB24, "כ"},
+	{0xFB25, "ל"},
+	{0xFB26, "ם"},
+	{0xFB27, "ר"},
+	{0xFB28, "ת"},
+	{0xFB29, "+"},
+	{0xFB2A, "שׁ"},
+	{0xFB2B, "שׂ"},
+	{0xFB2C, "שּׁ"},
+	{0xFB2D, "שּׂ"},
+	{0xFB2E, "אַ"},
+	{0xFB2F, "אָ"},
+	{0xFB30, "אּ"},
+	{0xFB31, "בּ"},
+	{0xFB32, "גּ"},
+	{0xFB33, "דּ"},
+	{0xFB34, "הּ"},
+	{0xFB35, "וּ"},
+	{0xFB36, "זּ"},
+	{0xFB38, "טּ"},
+	{0xFB39, "יּ"},
+	{0xFB3A, "ךּ"},
+	{0xFB3B, "כּ"},
+	{0xFB3C, "לּ"},
+	{0xFB3E, "מּ"},
+	{0xFB40, "נּ"},
+	{0xFB41, "סּ"},
+	{0xFB43, "ףּ"},
+	{0xFB44, "פּ"},
+	{0xFB46, "צּ"},
+	{0xFB47, "קּ"},
+	{0xFB48, "רּ"},
+	{0xFB49, "שּ"},
+	{0xFB4A, "תּ"},
+	{0xFB4B, "וֹ"},
+	{0xFB4C, "בֿ"},
+	{0xFB4D, "כֿ"},
+	{0xFB4E, "פֿ"},
+	{0xFB4F, "אל"},
+	{0xFB50, "ٱ"},
+	{0xFB51, "ٱ"},
+	{0xFB52, "ٻ"},
+	{0xFB53, "ٻ"},
+	{0xFB54, "ٻ"},
+	{0xFB55, "ٻ"},
+	{0xFB56, "پ"},
+	{0xFB57, "پ"},
+	{0xFB58, "پ"},
+	{0xFB59, "پ"},
+	{0xFB5A, "ڀ"},
+	{0xFB5B, "ڀ"},
+	{0xFB5C, "ڀ"},
+	{0xFB5D, "ڀ"},
+	{0xFB5E, "ٺ"},
+	{0xFB5F, "ٺ"},
+	{0xFB60, "ٺ"},
+	{0xFB61, "ٺ"},
+	{0xFB62, "ٿ"},
+	{0xFB63, "ٿ"},
+	{0xFB64, "ٿ"},
+	{0xFB65, "ٿ"},
+	{0xFB66, "ٹ"},
+	{0xFB67, "ٹ"},
+	{0xFB68, "ٹ"},
+	{0xFB69, "ٹ"},
+	{0xFB6A, "ڤ"},
+	{0xFB6B, "ڤ"},
+	{0xFB6C, "ڤ"},
+	{0xFB6D, "ڤ"},
+	{0xFB6E, "ڦ"},
+	{0xFB6F, "ڦ"},
+	{0xFB70, "ڦ"},
+	{0xFB71, "ڦ"},
+	{0xFB72, "ڄ"},
+	{0xFB73, "ڄ"},
+	{0xFB74, "ڄ"},
+	{0xFB75, "ڄ"},
+	{0xFB76, "ڃ"},
+	{0xFB77, "ڃ"},
+	{0xFB78, "ڃ"},
+	{0xFB79, "ڃ"},
+	{0xFB7A, "چ"},
+	{0xFB7B, "چ"},
+	{0xFB7C, "چ"},
+	{0xFB7D, "چ"},
+	{0xFB7E, "ڇ"},
+	{0xFB7F, "ڇ"},
+	{0xFB80, "ڇ"},
+	{0xFB81, "ڇ"},
+	{0xFB82, "ڍ"},
+	{0xFB83, "ڍ"},
+	{0xFB84, "ڌ"},
+	{0xFB85, "ڌ"},
+	{0xFB86, "ڎ"},
+	{0xFB87, "ڎ"},
+	{0xFB88, "ڈ"},
+	{0xFB89, "ڈ"},
+	{0xFB8A, "ژ"},
+	{0xFB8B, "ژ"},
+	{0xFB8C, "ڑ"},
+	{0xFB8D, "ڑ"},
+	{0xFB8E, "ک"},
+	{0xFB8F, "ک"},
+	{0xFB90, "ک"},
+	{0xFB91, "ک"},
+	{0xFB92, "گ"},
+	{0xFB93, "گ"},
+	{0xFB94, "گ"},
+	{0xFB95, "گ"},
+	{0xFB96, "ڳ"},
+	{0xFB97, "ڳ"},
+	{0xFB98, "ڳ"},
+	{0xFB99, "ڳ"},
+	{0xFB9A, "ڱ"},
+	{0xFB9B, "ڱ"},
+	{0xFB9C, "ڱ"},
+	{0xFB9D, "ڱ"},
+	{0xFB9E, "ں"},
+	{0xFB9F, "ں"},
+	{0xFBA0, "ڻ"},
+	{0xFBA1, "ڻ"},
+	{0xFBA2, "ڻ"},
+	{0xFBA3, "ڻ"},
+	{0xFBA4, "ۀ"},
+	{0xFBA5, "ۀ"},
+	{0xFBA6, "ہ"},
+	{0xFBA7, "ہ"},
+	{0xFBA8, "ہ"},
+	{0xFBA9, "ہ"},
+	{0xFBAA, "ھ"},
+	{0xFBAB, "ھ"},
+	{0xFBAC, "ھ"},
+	{0xFBAD, "ھ"},
+	{0xFBAE, "ے"},
+	{0xFBAF, "ے"},
+	{0xFBB0, "ۓ"},
+	{0xFBB1, "ۓ"},
+	{0xFBD3, "ڭ"},
+	{0xFBD4, "ڭ"},
+	{0xFBD5, "ڭ"},
+	{0xFBD6, "ڭ"},
+	{0xFBD7, "ۇ"},
+	{0xFBD8, "ۇ"},
+	{0xFBD9, "ۆ"},
+	{0xFBDA, "ۆ"},
+	{0xFBDB, "ۈ"},
+	{0xFBDC, "ۈ"},
+	{0xFBDD, "ۇٴ"},
+	{0xFBDE, "ۋ"},
+	{0xFBDF, "ۋ"},
+	{0xFBE0, "ۅ"},
+	{0xFBE1, "ۅ"},
+	{0xFBE2, "ۉ"},
+	{0xFBE3, "ۉ"},
+	{0xFBE4, "ې"},
+	{0xFBE5, "ې"},
+	{0xFBE6, "ې"},
+	{0xFBE7, "ې"},
+	{0xFBE8, "ى"},
+	{0xFBE9, "ى"},
+	{0xFBEA, "ئا"},
+	{0xFBEB, "ئا"},
+	{0xFBEC, "ئە"},
+	{0xFBED, "ئە"},
+	{0xFBEE, "ئو"},
+	{0xFBEF, "ئو"},
+	{0xFBF0, "ئۇ"},
+	{0xFBF1, "ئۇ"},
+	{0xFBF2, "ئۆ"},
+	{0xFBF3, "ئۆ"},
+	{0xFBF4, "ئۈ"},
+	{0xFBF5, "ئۈ"},
+	{0xFBF6, "ئې"},
+	{0xFBF7, "ئې"},
+	{0xFBF8, "ئې"},
+	{0xFBF9, "ئى"},
+	{0xFBFA, "ئى"},
+	{0xFBFB, "ئى"},
+	{0xFBFC, "ی"},
+	{0xFBFD, "ی"},
+	{0xFBFE, "ی"},
+	{0xFBFF, "ی"},
+	{0xFC00, "ئج"},
+	{0xFC01, "ئح"},
+	{0xFC02, "ئم"},
+	{0xFC03, "ئى"},
+	{0xFC04, "ئي"},
+	{0xFC05, "بج"},
+	{0xFC06, "بح"},
+	{0xFC07, "بخ"},
+	{0xFC08, "بم"},
+	{0xFC09, "بى"},
+	{0xFC0A, "بي"},
+	{0xFC0B, "تج"},
+	{0xFC0C, "تح"},
+	{0xFC0D, "تخ"},
+	{0xFC0E, "تم"},
+	{0xFC0F, "تى"},
+	{0xFC10, "تي"},
+	{0xFC11, "ثج"},
+	{0xFC12, "ثم"},
+	{0xFC13, "ثى"},
+	{0xFC14, "ثي"},
+	{0xFC15, "جح"},
+	{0xFC16, "جم"},
+	{0xFC17, "حج"},
+	{0xFC18, "حم"},
+	{0xFC19, "خج"},
+	{0xFC1A, "خح"},
+	{0xFC1B, "خم"},
+	{0xFC1C, "سج"},
+	{0xFC1D, "سح"},
+	{0xFC1E, "سخ"},
+	{0xFC1F, "سم"},
+	{0xFC20, "صح"},
+	{0xFC21, "صم"},
+	{0xFC22, "ضج"},
+	{0xFC23, "ضح"},
+	{0xFC24, "ضخ"},
+	{0xFC25, "ضم"},
+	{0xFC26, "طح"},
+	{0xFC27, "طم"},
+	{0xFC28, "ظم"},
+	{0xFC29, "عج"},
+	{0xFC2A, "عم"},
+	{0xFC2B, "غج"},
+	{0xFC2C, "غم"},
+	{0xFC2D, "فج"},
+	{0xFC2E, "فح"},
+	{0xFC2F, "فخ"},
+	{0xFC30, "فم"},
+	{0xFC31, "فى"},
+	{0xFC32, "في"},
+	{0xFC33, "قح"},
+	{0xFC34, "قم"},
+	{0xFC35, "قى"},
+	{0xFC36, "قي"},
+	{0xFC37, "كا"},
+	{0xFC38, "كج"},
+	{0xFC39, "كح"},
+	{0xFC3A, "كخ"},
+	{0xFC3B, "كل"},
+	{0xFC3C, "كم"},
+	{0xFC3D, "كى"},
+	{0xFC3E, "كي"},
+	{0xFC3F, "لج"},
+	{0xFC40, "لح"},
+	{0xFC41, "لخ"},
+	{0xFC42, "لم"},
+	{0xFC43, "لى"},
+	{0xFC44, "لي"},
+	{0xFC45, "مج"},
+	{0xFC46, "مح"},
+	{0xFC47, "مخ"},
+	{0xFC48, "مم"},
+	{0xFC49, "مى"},
+	{0xFC4A, "مي"},
+	{0xFC4B, "نج"},
+	{0xFC4C, "نح"},
+	{0xFC4D, "نخ"},
+	{0xFC4E, "نم"},
+	{0xFC4F, "نى"},
+	{0xFC50, "ني"},
+	{0xFC51, "هج"},
+	{0xFC52, "هم"},
+	{0xFC53, "هى"},
+	{0xFC54, "هي"},
+	{0xFC55, "يج"},
+	{0xFC56, "يح"},
+	{0xFC57, "يخ"},
+	{0xFC58, "يم"},
+	{0xFC59, "يى"},
+	{0xFC5A, "يي"},
+	{0xFC5B, "ذٰ"},
+	{0xFC5C, "رٰ"},
+	{0xFC5D, "ىٰ"},
+	{0xFC5E, " ٌّ"},
+	{0xFC5F, " ٍّ"},
+	{0xFC60, " َّ"},
+	{0xFC61, " ُّ"},
+	{0xFC62, " ِّ"},
+	{0xFC63, " ّٰ"},
+	{0xFC64, "ئر"},
+	{0xFC65, "ئز"},
+	{0xFC66, "ئم"},
+	{0xFC67, "ئن"},
+	{0xFC68, "ئى"},
+	{0xFC69, "ئي"},
+	{0xFC6A, "بر"},
+	{0xFC6B, "بز"},
+	{0xFC6C, "بم"},
+	{0xFC6D, "بن"},
+	{0xFC6E, "بى"},
+	{0xFC6F, "بي"},
+	{0xFC70, "تر"},
+	{0xFC71, "تز"},
+	{0xFC72, "تم"},
+	{0xFC73, "تن"},
+	{0xFC74, "تى"},
+	{0xFC75, "تي"},
+	{0xFC76, "ثر"},
+	{0xFC77, "ثز"},
+	{0xFC78, "ثم"},
+	{0xFC79, "ثن"},
+	{0xFC7A, "ثى"},
+	{0xFC7B, "ثي"},
+	{0xFC7C, "فى"},
+	{0xFC7D, "في"},
+	{0xFC7E, "قى"},
+	{0xFC7F, "قي"},
+	{0xFC80, "كا"},
+	{0xFC81, "كل"},
+	{0xFC82, "كم"},
+	{0xFC83, "كى"},
+	{0xFC84, "كي"},
+	{0xFC85, "لم"},
+	{0xFC86, "لى"},
+	{0xFC87, "لي"},
+	{0xFC88, "ما"},
+	{0xFC89, "مم"},
+	{0xFC8A, "نر"},
+	{0xFC8B, "نز"},
+	{0xFC8C, "نم"},
+	{0xFC8D, "نن"},
+	{0xFC8E, "نى"},
+	{0xFC8F, "ني"},
+	{0xFC90, "ىٰ"},
+	{0xFC91, "ير"},
+	{0xFC92, "يز"},
+	{0xFC93, "يم"},
+	{0xFC94, "ين"},
+	{0xFC95, "يى"},
+	{0xFC96, "يي"},
+	{0xFC97, "ئج"},
+	{0xFC98, "ئح"},
+	{0xFC99, "ئخ"},
+	{0xFC9A, "ئم"},
+	{0xFC9B, "ئه"},
+	{0xFC9C, "بج"},
+	{0xFC9D, "بح"},
+	{0xFC9E, "بخ"},
+	{0xFC9F, "بم"},
+	{0xFCA0, "به"},
+	{0xFCA1, "تج"},
+	{0xFCA2, "تح"},
+	{0xFCA3, "تخ"},
+	{0xFCA4, "تم"},
+	{0xFCA5, "ته"},
+	{0xFCA6, "ثم"},
+	{0xFCA7, "جح"},
+	{0xFCA8, "جم"},
+	{0xFCA9, "حج"},
+	{0xFCAA, "حم"},
+	{0xFCAB, "خج"},
+	{0xFCAC, "خم"},
+	{0xFCAD, "سج"},
+	{0xFCAE, "سح"},
+	{0xFCAF, "سخ"},
+	{0xFCB0, "سم"},
+	{0xFCB1, "صح"},
+	{0xFCB2, "صخ"},
+	{0xFCB3, "صم"},
+	{0xFCB4, "ضج"},
+	{0xFCB5, "ضح"},
+	{0xFCB6, "ضخ"},
+	{0xFCB7, "ضم"},
+	{0xFCB8, "طح"},
+	{0xFCB9, "ظم"},
+	{0xFCBA, "عج"},
+	{0xFCBB, "عم"},
+	{0xFCBC, "غج"},
+	{0xFCBD, "غم"},
+	{0xFCBE, "فج"},
+	{0xFCBF, "فح"},
+	{0xFCC0, "فخ"},
+	{0xFCC1, "فم"},
+	{0xFCC2, "قح"},
+	{0xFCC3, "قم"},
+	{0xFCC4, "كج"},
+	{0xFCC5, "كح"},
+	{0xFCC6, "كخ"},
+	{0xFCC7, "كل"},
+	{0xFCC8, "كم"},
+	{0xFCC9, "لج"},
+	{0xFCCA, "لح"},
+	{0xFCCB, "لخ"},
+	{0xFCCC, "لم"},
+	{0xFCCD, "له"},
+	{0xFCCE, "مج"},
+	{0xFCCF, "مح"},
+	{0xFCD0, "مخ"},
+	{0xFCD1, "مم"},
+	{0xFCD2, "نج"},
+	{0xFCD3, "نح"},
+	{0xFCD4, "نخ"},
+	{0xFCD5, "نم"},
+	{0xFCD6, "نه"},
+	{0xFCD7, "هج"},
+	{0xFCD8, "هم"},
+	{0xFCD9, "هٰ"},
+	{0xFCDA, "يج"},
+	{0xFCDB, "يح"},
+	{0xFCDC, "يخ"},
+	{0xFCDD, "يم"},
+	{0xFCDE, "يه"},
+	{0xFCDF, "ئم"},
+	{0xFCE0, "ئه"},
+	{0xFCE1, "بم"},
+	{0xFCE2, "به"},
+	{0xFCE3, "تم"},
+	{0xFCE4, "ته"},
+	{0xFCE5, "ثم"},
+	{0xFCE6, "ثه"},
+	{0xFCE7, "سم"},
+	{0xFCE8, "سه"},
+	{0xFCE9, "شم"},
+	{0xFCEA, "شه"},
+	{0xFCEB, "كل"},
+	{0xFCEC, "كم"},
+	{0xFCED, "لم"},
+	{0xFCEE, "نم"},
+	{0xFCEF, "نه"},
+	{0xFCF0, "يم"},
+	{0xFCF1, "يه"},
+	{0xFCF2, "ـَّ"},
+	{0xFCF3, "ـُّ"},
+	{0xFCF4, "ـِّ"},
+	{0xFCF5, "طى"},
+	{0xFCF6, "طي"},
+	{0xFCF7, "عى"},
+	{0xFCF8, "عي"},
+	{0xFCF9, "غى"},
+	{0xFCFA, "غي"},
+	{0xFCFB, "سى"},
+	{0xFCFC, "سي"},
+	{0xFCFD, "شى"},
+	{0xFCFE, "شي"},
+	{0xFCFF, "حى"},
+	{0xFD00, "حي"},
+	{0xFD01, "جى"},
+	{0xFD02, "جي"},
+	{0xFD03, "خى"},
+	{0xFD04, "خي"},
+	{0xFD05, "صى"},
+	{0xFD06, "صي"},
+	{0xFD07, "ضى"},
+	{0xFD08, "ضي"},
+	{0xFD09, "شج"},
+	{0xFD0A, "شح"},
+	{0xFD0B, "شخ"},
+	{0xFD0C, "شم"},
+	{0xFD0D, "شر"},
+	{0xFD0E, "سر"},
+	{0xFD0F, "صر"},
+	{0xFD10, "ضر"},
+	{0xFD11, "طى"},
+	{0xFD12, "طي"},
+	{0xFD13, "عى"},
+	{0xFD14, "عي"},
+	{0xFD15, "غى"},
+	{0xFD16, "غي"},
+	{0xFD17, "سى"},
+	{0xFD18, "سي"},
+	{0xFD19, "شى"},
+	{0xFD1A, "شي"},
+	{0xFD1B, "حى"},
+	{0xFD1C, "حي"},
+	{0xFD1D, "جى"},
+	{0xFD1E, "جي"},
+	{0xFD1F, "خى"},
+	{0xFD20, "خي"},
+	{0xFD21, "صى"},
+	{0xFD22, "صي"},
+	{0xFD23, "ضى"},
+	{0xFD24, "ضي"},
+	{0xFD25, "شج"},
+	{0xFD26, "شح"},
+	{0xFD27, "شخ"},
+	{0xFD28, "شم"},
+	{0xFD29, "شر"},
+	{0xFD2A, "سر"},
+	{0xFD2B, "صر"},
+	{0xFD2C, "ضر"},
+	{0xFD2D, "شج"},
+	{0xFD2E, "شح"},
+	{0xFD2F, "شخ"},
+	{0xFD30, "شم"},
+	{0xFD31, "سه"},
+	{0xFD32, "شه"},
+	{0xFD33, "طم"},
+	{0xFD34, "سج"},
+	{0xFD35, "سح"},
+	{0xFD36, "سخ"},
+	{0xFD37, "شج"},
+	{0xFD38, "شح"},
+	{0xFD39, "شخ"},
+	{0xFD3A, "طم"},
+	{0xFD3B, "ظم"},
+	{0xFD3C, "اً"},
+	{0xFD3D, "اً"},
+	{0xFD50, "تجم"},
+	{0xFD51, "تحج"},
+	{0xFD52, "تحج"},
+	{0xFD53, "تحم"},
+	{0xFD54, "تخم"},
+	{0xFD55, "تمج"},
+	{0xFD56, "تمح"},
+	{0xFD57, "تمخ"},
+	{0xFD58, "جمح"},
+	{0xFD59, "جمح"},
+	{0xFD5A, "حمي"},
+	{0xFD5B, "حمى"},
+	{0xFD5C, "سحج"},
+	{0xFD5D, "سجح"},
+	{0xFD5E, "سجى"},
+	{0xFD5F, "سمح"},
+	{0xFD60, "سمح"},
+	{0xFD61, "سمج"},
+	{0xFD62, "سمم"},
+	{0xFD63, "سمم"},
+	{0xFD64, "صحح"},
+	{0xFD65, "صحح"},
+	{0xFD66, "صمم"},
+	{0xFD67, "شحم"},
+	{0xFD68, "شحم"},
+	{0xFD69, "شجي"},
+	{0xFD6A, "شمخ"},
+	{0xFD6B, "شمخ"},
+	{0xFD6C, "شمم"},
+	{0xFD6D, "شمم"},
+	{0xFD6E, "ضحى"},
+	{0xFD6F, "ضخم"},
+	{0xFD70, "ضخم"},
+	{0xFD71, "طمح"},
+	{0xFD72, "طمح"},
+	{0xFD73, "طمم"},
+	{0xFD74, "طمي"},
+	{0xFD75, "عجم"},
+	{0xFD76, "عمم"},
+	{0xFD77, "عمم"},
+	{0xFD78, "عمى"},
+	{0xFD79, "غمم"},
+	{0xFD7A, "غمي"},
+	{0xFD7B, "غمى"},
+	{0xFD7C, "فخم"},
+	{0xFD7D, "فخم"},
+	{0xFD7E, "قمح"},
+	{0xFD7F, "قمم"},
+	{0xFD80, "لحم"},
+	{0xFD81, "لحي"},
+	{0xFD82, "لحى"},
+	{0xFD83, "لجج"},
+	{0xFD84, "لجج"},
+	{0xFD85, "لخم"},
+	{0xFD86, "لخم"},
+	{0xFD87, "لمح"},
+	{0xFD88, "لمح"},
+	{0xFD89, "محج"},
+	{0xFD8A, "محم"},
+	{0xFD8B, "محي"},
+	{0xFD8C, "مجح"},
+	{0xFD8D, "مجم"},
+	{0xFD8E, "مخج"},
+	{0xFD8F, "مخم"},
+	{0xFD92, "مجخ"},
+	{0xFD93, "همج"},
+	{0xFD94, "همم"},
+	{0xFD95, "نحم"},
+	{0xFD96, "نحى"},
+	{0xFD97, "نجم"},
+	{0xFD98, "نجم"},
+	{0xFD99, "نجى"},
+	{0xFD9A, "نمي"},
+	{0xFD9B, "نمى"},
+	{0xFD9C, "يمم"},
+	{0xFD9D, "يمم"},
+	{0xFD9E, "بخي"},
+	{0xFD9F, "تجي"},
+	{0xFDA0, "تجى"},
+	{0xFDA1, "تخي"},
+	{0xFDA2, "تخى"},
+	{0xFDA3, "تمي"},
+	{0xFDA4, "تمى"},
+	{0xFDA5, "جمي"},
+	{0xFDA6, "جحى"},
+	{0xFDA7, "جمى"},
+	{0xFDA8, "سخى"},
+	{0xFDA9, "صحي"},
+	{0xFDAA, "شحي"},
+	{0xFDAB, "ضحي"},
+	{0xFDAC, "لجي"},
+	{0xFDAD, "لمي"},
+	{0xFDAE, "يحي"},
+	{0xFDAF, "يجي"},
+	{0xFDB0, "يمي"},
+	{0xFDB1, "ممي"},
+	{0xFDB2, "قمي"},
+	{0xFDB3, "نحي"},
+	{0xFDB4, "قمح"},
+	{0xFDB5, "لحم"},
+	{0xFDB6, "عمي"},
+	{0xFDB7, "كمي"},
+	{0xFDB8, "نجح"},
+	{0xFDB9, "مخي"},
+	{0xFDBA, "لجم"},
+	{0xFDBB, "كمم"},
+	{0xFDBC, "لجم"},
+	{0xFDBD, "نجح"},
+	{0xFDBE, "جحي"},
+	{0xFDBF, "حجي"},
+	{0xFDC0, "مجي"},
+	{0xFDC1, "فمي"},
+	{0xFDC2, "بحي"},
+	{0xFDC3, "كمم"},
+	{0xFDC4, "عجم"},
+	{0xFDC5, "صمم"},
+	{0xFDC6, "سخي"},
+	{0xFDC7, "نجي"},
+	{0xFDF0, "صلے"},
+	{0xFDF1, "قلے"},
+	{0xFDF2, "الله"},
+	{0xFDF3, "اكبر"},
+	{0xFDF4, "محمد"},
+	{0xFDF5, "صلعم"},
+	{0xFDF6, "رسول"},
+	{0xFDF7, "عليه"},
+	{0xFDF8, "وسلم"},
+	{0xFDF9, "صلى"},
+	{0xFDFA, "صلى الله عليه وسلم"},
+	{0xFDFB, "جل جلاله"},
+	{0xFDFC, "ریال"},
+	{0xFE00, ""},
+	{0xFE01, ""},
+	{0xFE02, ""},
+	{0xFE03, ""},
+	{0xFE04, ""},
+	{0xFE05, ""},
+	{0xFE06, ""},
+	{0xFE07, ""},
+	{0xFE08, ""},
+	{0xFE09, ""},
+	{0xFE0A, ""},
+	{0xFE0B, ""},
+	{0xFE0C, ""},
+	{0xFE0D, ""},
+	{0xFE0E, ""},
+	{0xFE0F, ""},
+	{0xFE10, ","},
+	{0xFE11, "、"},
+	{0xFE13, ":"},
+	{0xFE14, ";"},
+	{0xFE15, "!"},
+	{0xFE16, "?"},
+	{0xFE17, "〖"},
+	{0xFE18, "〗"},
+	{0xFE31, "—"},
+	{0xFE32, "–"},
+	{0xFE33, "_"},
+	{0xFE34, "_"},
+	{0xFE35, "("},
+	{0xFE36, ")"},
+	{0xFE37, "{"},
+	{0xFE38, "}"},
+	{0xFE39, "〔"},
+	{0xFE3A, "〕"},
+	{0xFE3B, "【"},
+	{0xFE3C, "】"},
+	{0xFE3D, "《"},
+	{0xFE3E, "》"},
+	{0xFE3F, "〈"},
+	{0xFE40, "〉"},
+	{0xFE41, "「"},
+	{0xFE42, "」"},
+	{0xFE43, "『"},
+	{0xFE44, "』"},
+	{0xFE47, "["},
+	{0xFE48, "]"},
+	{0xFE49, " ̅"},
+	{0xFE4A, " ̅"},
+	{0xFE4B, " ̅"},
+	{0xFE4C, " ̅"},
+	{0xFE4D, "_"},
+	{0xFE4E, "_"},
+	{0xFE4F, "_"},
+	{0xFE50, ","},
+	{0xFE51, "、"},
+	{0xFE54, ";"},
+	{0xFE55, ":"},
+	{0xFE56, "?"},
+	{0xFE57, "!"},
+	{0xFE58, "—"},
+	{0xFE59, "("},
+	{0xFE5A, ")"},
+	{0xFE5B, "{"},
+	{0xFE5C, "}"},
+	{0xFE5D, "〔"},
+	{0xFE5E, "〕"},
+	{0xFE5F, "#"},
+	{0xFE60, "&"},
+	{0xFE61, "*"},
+	{0xFE62, "+"},
+	{0xFE63, "-"},
+	{0xFE64, "<"},
+	{0xFE65, ">"},
+	{0xFE66, "="},
+	{0xFE68, "\\"},
+	{0xFE69, "$"},
+	{0xFE6A, "%"},
+	{0xFE6B, "@"},
+	{0xFE70, " ً"},
+	{0xFE71, "ـً"},
+	{0xFE72, " ٌ"},
+	{0xFE74, " ٍ"},
+	{0xFE76, " َ"},
+	{0xFE77, "ـَ"},
+	{0xFE78, " ُ"},
+	{0xFE79, "ـُ"},
+	{0xFE7A, " ِ"},
+	{0xFE7B, "ـِ"},
+	{0xFE7C, " ّ"},
+	{0xFE7D, "ـّ"},
+	{0xFE7E, " ْ"},
+	{0xFE7F, "ـْ"},
+	{0xFE80, "ء"},
+	{0xFE81, "آ"},
+	{0xFE82, "آ"},
+	{0xFE83, "أ"},
+	{0xFE84, "أ"},
+	{0xFE85, "ؤ"},
+	{0xFE86, "ؤ"},
+	{0xFE87, "إ"},
+	{0xFE88, "إ"},
+	{0xFE89, "ئ"},
+	{0xFE8A, "ئ"},
+	{0xFE8B, "ئ"},
+	{0xFE8C, "ئ"},
+	{0xFE8D, "ا"},
+	{0xFE8E, "ا"},
+	{0xFE8F, "ب"},
+	{0xFE90, "ب"},
+	{0xFE91, "ب"},
+	{0xFE92, "ب"},
+	{0xFE93, "ة"},
+	{0xFE94, "ة"},
+	{0xFE95, "ت"},
+	{0xFE96, "ت"},
+	{0xFE97, "ت"},
+	{0xFE98, "ت"},
+	{0xFE99, "ث"},
+	{0xFE9A, "ث"},
+	{0xFE9B, "ث"},
+	{0xFE9C, "ث"},
+	{0xFE9D, "ج"},
+	{0xFE9E, "ج"},
+	{0xFE9F, "ج"},
+	{0xFEA0, "ج"},
+	{0xFEA1, "ح"},
+	{0xFEA2, "ح"},
+	{0xFEA3, "ح"},
+	{0xFEA4, "ح"},
+	{0xFEA5, "خ"},
+	{0xFEA6, "خ"},
+	{0xFEA7, "خ"},
+	{0xFEA8, "خ"},
+	{0xFEA9, "د"},
+	{0xFEAA, "د"},
+	{0xFEAB, "ذ"},
+	{0xFEAC, "ذ"},
+	{0xFEAD, "ر"},
+	{0xFEAE, "ر"},
+	{0xFEAF, "ز"},
+	{0xFEB0, "ز"},
+	{0xFEB1, "س"},
+	{0xFEB2, "س"},
+	{0xFEB3, "س"},
+	{0xFEB4, "س"},
+	{0xFEB5, "ش"},
+	{0xFEB6, "ش"},
+	{0xFEB7, "ش"},
+	{0xFEB8, "ش"},
+	{0xFEB9, "ص"},
+	{0xFEBA, "ص"},
+	{0xFEBB, "ص"},
+	{0xFEBC, "ص"},
+	{0xFEBD, "ض"},
+	{0xFEBE, "ض"},
+	{0xFEBF, "ض"},
+	{0xFEC0, "ض"},
+	{0xFEC1, "ط"},
+	{0xFEC2, "ط"},
+	{0xFEC3, "ط"},
+	{0xFEC4, "ط"},
+	{0xFEC5, "ظ"},
+	{0xFEC6, "ظ"},
+	{0xFEC7, "ظ"},
+	{0xFEC8, "ظ"},
+	{0xFEC9, "ع"},
+	{0xFECA, "ع"},
+	{0xFECB, "ع"},
+	{0xFECC, "ع"},
+	{0xFECD, "غ"},
+	{0xFECE, "غ"},
+	{0xFECF, "غ"},
+	{0xFED0, "غ"},
+	{0xFED1, "ف"},
+	{0xFED2, "ف"},
+	{0xFED3, "ف"},
+	{0xFED4, "ف"},
+	{0xFED5, "ق"},
+	{0xFED6, "ق"},
+	{0xFED7, "ق"},
+	{0xFED8, "ق"},
+	{0xFED9, "ك"},
+	{0xFEDA, "ك"},
+	{0xFEDB, "ك"},
+	{0xFEDC, "ك"},
+	{0xFEDD, "ل"},
+	{0xFEDE, "ل"},
+	{0xFEDF, "ل"},
+	{0xFEE0, "ل"},
+	{0xFEE1, "م"},
+	{0xFEE2, "م"},
+	{0xFEE3, "م"},
+	{0xFEE4, "م"},
+	{0xFEE5, "ن"},
+	{0xFEE6, "ن"},
+	{0xFEE7, "ن"},
+	{0xFEE8, "ن"},
+	{0xFEE9, "ه"},
+	{0xFEEA, "ه"},
+	{0xFEEB, "ه"},
+	{0xFEEC, "ه"},
+	{0xFEED, "و"},
+	{0xFEEE, "و"},
+	{0xFEEF, "ى"},
+	{0xFEF0, "ى"},
+	{0xFEF1, "ي"},
+	{0xFEF2, "ي"},
+	{0xFEF3, "ي"},
+	{0xFEF4, "ي"},
+	{0xFEF5, "لآ"},
+	{0xFEF6, "لآ"},
+	{0xFEF7, "لأ"},
+	{0xFEF8, "لأ"},
+	{0xFEF9, "لإ"},
+	{0xFEFA, "لإ"},
+	{0xFEFB, "لا"},
+	{0xFEFC, "لا"},
+	{0xFEFF, ""},
+	{0xFF01, "!"},
+	{0xFF02, "\""},
+	{0xFF03, "#"},
+	{0xFF04, "$"},
+	{0xFF05, "%"},
+	{0xFF06, "&"},
+	{0xFF07, "'"},
+	{0xFF08, "("},
+	{0xFF09, ")"},
+	{0xFF0A, "*"},
+	{0xFF0B, "+"},
+	{0xFF0C, ","},
+	{0xFF0D, "-"},
+	{0xFF0F, "/"},
+	{0xFF10, "0"},
+	{0xFF11, "1"},
+	{0xFF12, "2"},
+	{0xFF13, "3"},
+	{0xFF14, "4"},
+	{0xFF15, "5"},
+	{0xFF16, "6"},
+	{0xFF17, "7"},
+	{0xFF18, "8"},
+	{0xFF19, "9"},
+	{0xFF1A, ":"},
+	{0xFF1B, ";"},
+	{0xFF1C, "<"},
+	{0xFF1D, "="},
+	{0xFF1E, ">"},
+	{0xFF1F, "?"},
+	{0xFF20, "@"},
+	{0xFF21, "a"},
+	{0xFF22, "b"},
+	{0xFF23, "c"},
+	{0xFF24, "d"},
+	{0xFF25, "e"},
+	{0xFF26, "f"},
+	{0xFF27, "g"},
+	{0xFF28, "h"},
+	{0xFF29, "i"},
+	{0xFF2A, "j"},
+	{0xFF2B, "k"},
+	{0xFF2C, "l"},
+	{0xFF2D, "m"},
+	{0xFF2E, "n"},
+	{0xFF2F, "o"},
+	{0xFF30, "p"},
+	{0xFF31, "q"},
+	{0xFF32, "r"},
+	{0xFF33, "s"},
+	{0xFF34, "t"},
+	{0xFF35, "u"},
+	{0xFF36, "v"},
+	{0xFF37, "w"},
+	{0xFF38, "x"},
+	{0xFF39, "y"},
+	{0xFF3A, "z"},
+	{0xFF3B, "["},
+	{0xFF3C, "\\"},
+	{0xFF3D, "]"},
+	{0xFF3E, "^"},
+	{0xFF3F, "_"},
+	{0xFF40, "`"},
+	{0xFF41, "a"},
+	{0xFF42, "b"},
+	{0xFF43, "c"},
+	{0xFF44, "d"},
+	{0xFF45, "e"},
+	{0xFF46, "f"},
+	{0xFF47, "g"},
+	{0xFF48, "h"},
+	{0xFF49, "i"},
+	{0xFF4A, "j"},
+	{0xFF4B, "k"},
+	{0xFF4C, "l"},
+	{0xFF4D, "m"},
+	{0xFF4E, "n"},
+	{0xFF4F, "o"},
+	{0xFF50, "p"},
+	{0xFF51, "q"},
+	{0xFF52, "r"},
+	{0xFF53, "s"},
+	{0xFF54, "t"},
+	{0xFF55, "u"},
+	{0xFF56, "v"},
+	{0xFF57, "w"},
+	{0xFF58, "x"},
+	{0xFF59, "y"},
+	{0xFF5A, "z"},
+	{0xFF5B, "{"},
+	{0xFF5C, "|"},
+	{0xFF5D, "}"},
+	{0xFF5E, "~"},
+	{0xFF5F, "⦅"},
+	{0xFF60, "⦆"},
+	{0xFF62, "「"},
+	{0xFF63, "」"},
+	{0xFF64, "、"},
+	{0xFF65, "・"},
+	{0xFF66, "ヲ"},
+	{0xFF67, "ァ"},
+	{0xFF68, "ィ"},
+	{0xFF69, "ゥ"},
+	{0xFF6A, "ェ"},
+	{0xFF6B, "ォ"},
+	{0xFF6C, "ャ"},
+	{0xFF6D, "ュ"},
+	{0xFF6E, "ョ"},
+	{0xFF6F, "ッ"},
+	{0xFF70, "ー"},
+	{0xFF71, "ア"},
+	{0xFF72, "イ"},
+	{0xFF73, "ウ"},
+	{0xFF74, "エ"},
+	{0xFF75, "オ"},
+	{0xFF76, "カ"},
+	{0xFF77, "キ"},
+	{0xFF78, "ク"},
+	{0xFF79, "ケ"},
+	{0xFF7A, "コ"},
+	{0xFF7B, "サ"},
+	{0xFF7C, "シ"},
+	{0xFF7D, "ス"},
+	{0xFF7E, "セ"},
+	{0xFF7F, "ソ"},
+	{0xFF80, "タ"},
+	{0xFF81, "チ"},
+	{0xFF82, "ツ"},
+	{0xFF83, "テ"},
+	{0xFF84, "ト"},
+	{0xFF85, "ナ"},
+	{0xFF86, "ニ"},
+	{0xFF87, "ヌ"},
+	{0xFF88, "ネ"},
+	{0xFF89, "ノ"},
+	{0xFF8A, "ハ"},
+	{0xFF8B, "ヒ"},
+	{0xFF8C, "フ"},
+	{0xFF8D, "ヘ"},
+	{0xFF8E, "ホ"},
+	{0xFF8F, "マ"},
+	{0xFF90, "ミ"},
+	{0xFF91, "ム"},
+	{0xFF92, "メ"},
+	{0xFF93, "モ"},
+	{0xFF94, "ヤ"},
+	{0xFF95, "ユ"},
+	{0xFF96, "ヨ"},
+	{0xFF97, "ラ"},
+	{0xFF98, "リ"},
+	{0xFF99, "ル"},
+	{0xFF9A, "レ"},
+	{0xFF9B, "ロ"},
+	{0xFF9C, "ワ"},
+	{0xFF9D, "ン"},
+	{0xFF9E, "゙"},
+	{0xFF9F, "゚"},
+	{0xFFA1, "ᄀ"},
+	{0xFFA2, "ᄁ"},
+	{0xFFA3, "ᆪ"},
+	{0xFFA4, "ᄂ"},
+	{0xFFA5, "ᆬ"},
+	{0xFFA6, "ᆭ"},
+	{0xFFA7, "ᄃ"},
+	{0xFFA8, "ᄄ"},
+	{0xFFA9, "ᄅ"},
+	{0xFFAA, "ᆰ"},
+	{0xFFAB, "ᆱ"},
+	{0xFFAC, "ᆲ"},
+	{0xFFAD, "ᆳ"},
+	{0xFFAE, "ᆴ"},
+	{0xFFAF, "ᆵ"},
+	{0xFFB0, "ᄚ"},
+	{0xFFB1, "ᄆ"},
+	{0xFFB2, "ᄇ"},
+	{0xFFB3, "ᄈ"},
+	{0xFFB4, "ᄡ"},
+	{0xFFB5, "ᄉ"},
+	{0xFFB6, "ᄊ"},
+	{0xFFB7, "ᄋ"},
+	{0xFFB8, "ᄌ"},
+	{0xFFB9, "ᄍ"},
+	{0xFFBA, "ᄎ"},
+	{0xFFBB, "ᄏ"},
+	{0xFFBC, "ᄐ"},
+	{0xFFBD, "ᄑ"},
+	{0xFFBE, "ᄒ"},
+	{0xFFC2, "ᅡ"},
+	{0xFFC3, "ᅢ"},
+	{0xFFC4, "ᅣ"},
+	{0xFFC5, "ᅤ"},
+	{0xFFC6, "ᅥ"},
+	{0xFFC7, "ᅦ"},
+	{0xFFCA, "ᅧ"},
+	{0xFFCB, "ᅨ"},
+	{0xFFCC, "ᅩ"},
+	{0xFFCD, "ᅪ"},
+	{0xFFCE, "ᅫ"},
+	{0xFFCF, "ᅬ"},
+	{0xFFD2, "ᅭ"},
+	{0xFFD3, "ᅮ"},
+	{0xFFD4, "ᅯ"},
+	{0xFFD5, "ᅰ"},
+	{0xFFD6, "ᅱ"},
+	{0xFFD7, "ᅲ"},
+	{0xFFDA, "ᅳ"},
+	{0xFFDB, "ᅴ"},
+	{0xFFDC, "ᅵ"},
+	{0xFFE0, "¢"},
+	{0xFFE1, "£"},
+	{0xFFE2, "¬"},
+	{0xFFE3, " ̄"},
+	{0xFFE4, "¦"},
+	{0xFFE5, "¥"},
+	{0xFFE6, "₩"},
+	{0xFFE8, "│"},
+	{0xFFE9, "←"},
+	{0xFFEA, "↑"},
+	{0xFFEB, "→"},
+	{0xFFEC, "↓"},
+	{0xFFED, "■"},
+	{0xFFEE, "○"},
+	{0x10400, "𐐨"},
+	{0x10401, "𐐩"},
+	{0x10402, "𐐪"},
+	{0x10403, "𐐫"},
+	{0x10404, "𐐬"},
+	{0x10405, "𐐭"},
+	{0x10406, "𐐮"},
+	{0x10407, "𐐯"},
+	{0x10408, "𐐰"},
+	{0x10409, "𐐱"},
+	{0x1040A, "𐐲"},
+	{0x1040B, "𐐳"},
+	{0x1040C, "𐐴"},
+	{0x1040D, "𐐵"},
+	{0x1040E, "𐐶"},
+	{0x1040F, "𐐷"},
+	{0x10410, "𐐸"},
+	{0x10411, "𐐹"},
+	{0x10412, "𐐺"},
+	{0x10413, "𐐻"},
+	{0x10414, "𐐼"},
+	{0x10415, "𐐽"},
+	{0x10416, "𐐾"},
+	{0x10417, "𐐿"},
+	{0x10418, "𐑀"},
+	{0x10419, "𐑁"},
+	{0x1041A, "𐑂"},
+	{0x1041B, "𐑃"},
+	{0x1041C, "𐑄"},
+	{0x1041D, "𐑅"},
+	{0x1041E, "𐑆"},
+	{0x1041F, "𐑇"},
+	{0x10420, "𐑈"},
+	{0x10421, "𐑉"},
+	{0x10422, "𐑊"},
+	{0x10423, "𐑋"},
+	{0x10424, "𐑌"},
+	{0x10425, "𐑍"},
+	{0x10426, "𐑎"},
+	{0x10427, "𐑏"},
+	{0x104B0, "𐓘"},
+	{0x104B1, "𐓙"},
+	{0x104B2, "𐓚"},
+	{0x104B3, "𐓛"},
+	{0x104B4, "𐓜"},
+	{0x104B5, "𐓝"},
+	{0x104B6, "𐓞"},
+	{0x104B7, "𐓟"},
+	{0x104B8, "𐓠"},
+	{0x104B9, "𐓡"},
+	{0x104BA, "𐓢"},
+	{0x104BB, "𐓣"},
+	{0x104BC, "𐓤"},
+	{0x104BD, "𐓥"},
+	{0x104BE, "𐓦"},
+	{0x104BF, "𐓧"},
+	{0x104C0, "𐓨"},
+	{0x104C1, "𐓩"},
+	{0x104C2, "𐓪"},
+	{0x104C3, "𐓫"},
+	{0x104C4, "𐓬"},
+	{0x104C5, "𐓭"},
+	{0x104C6, "𐓮"},
+	{0x104C7, "𐓯"},
+	{0x104C8, "𐓰"},
+	{0x104C9, "𐓱"},
+	{0x104CA, "𐓲"},
+	{0x104CB, "𐓳"},
+	{0x104CC, "𐓴"},
+	{0x104CD, "𐓵"},
+	{0x104CE, "𐓶"},
+	{0x104CF, "𐓷"},
+	{0x104D0, "𐓸"},
+	{0x104D1, "𐓹"},
+	{0x104D2, "𐓺"},
+	{0x104D3, "𐓻"},
+	{0x10570, "𐖗"},
+	{0x10571, "𐖘"},
+	{0x10572, "𐖙"},
+	{0x10573, "𐖚"},
+	{0x10574, "𐖛"},
+	{0x10575, "𐖜"},
+	{0x10576, "𐖝"},
+	{0x10577, "𐖞"},
+	{0x10578, "𐖟"},
+	{0x10579, "𐖠"},
+	{0x1057A, "𐖡"},
+	{0x1057C, "𐖣"},
+	{0x1057D, "𐖤"},
+	{0x1057E, "𐖥"},
+	{0x1057F, "𐖦"},
+	{0x10580, "𐖧"},
+	{0x10581, "𐖨"},
+	{0x10582, "𐖩"},
+	{0x10583, "𐖪"},
+	{0x10584, "𐖫"},
+	{0x10585, "𐖬"},
+	{0x10586, "𐖭"},
+	{0x10587, "𐖮"},
+	{0x10588, "𐖯"},
+	{0x10589, "𐖰"},
+	{0x1058A, "𐖱"},
+	{0x1058C, "𐖳"},
+	{0x1058D, "𐖴"},
+	{0x1058E, "𐖵"},
+	{0x1058F, "𐖶"},
+	{0x10590, "𐖷"},
+	{0x10591, "𐖸"},
+	{0x10592, "𐖹"},
+	{0x10594, "𐖻"},
+	{0x10595, "𐖼"},
+	{0x10781, "ː"},
+	{0x10782, "ˑ"},
+	{0x10783, "æ"},
+	{0x10784, "ʙ"},
+	{0x10785, "ɓ"},
+	{0x10787, "ʣ"},
+	{0x10788, "ꭦ"},
+	{0x10789, "ʥ"},
+	{0x1078A, "ʤ"},
+	{0x1078B, "ɖ"},
+	{0x1078C, "ɗ"},
+	{0x1078D, "ᶑ"},
+	{0x1078E, "ɘ"},
+	{0x1078F, "ɞ"},
+	{0x10790, "ʩ"},
+	{0x10791, "ɤ"},
+	{0x10792, "ɢ"},
+	{0x10793, "ɠ"},
+	{0x10794, "ʛ"},
+	{0x10795, "ħ"},
+	{0x10796, "ʜ"},
+	{0x10797, "ɧ"},
+	{0x10798, "ʄ"},
+	{0x10799, "ʪ"},
+	{0x1079A, "ʫ"},
+	{0x1079B, "ɬ"},
+	{0x1079C, "𝼄"},
+	{0x1079D, "ꞎ"},
+	{0x1079E, "ɮ"},
+	{0x1079F, "𝼅"},
+	{0x107A0, "ʎ"},
+	{0x107A1, "𝼆"},
+	{0x107A2, "ø"},
+	{0x107A3, "ɶ"},
+	{0x107A4, "ɷ"},
+	{0x107A5, "q"},
+	{0x107A6, "ɺ"},
+	{0x107A7, "𝼈"},
+	{0x107A8, "ɽ"},
+	{0x107A9, "ɾ"},
+	{0x107AA, "ʀ"},
+	{0x107AB, "ʨ"},
+	{0x107AC, "ʦ"},
+	{0x107AD, "ꭧ"},
+	{0x107AE, "ʧ"},
+	{0x107AF, "ʈ"},
+	{0x107B0, "ⱱ"},
+	{0x107B2, "ʏ"},
+	{0x107B3, "ʡ"},
+	{0x107B4, "ʢ"},
+	{0x107B5, "ʘ"},
+	{0x107B6, "ǀ"},
+	{0x107B7, "ǁ"},
+	{0x107B8, "ǂ"},
+	{0x107B9, "𝼊"},
+	{0x107BA, "𝼞"},
+	{0x10C80, "𐳀"},
+	{0x10C81, "𐳁"},
+	{0x10C82, "𐳂"},
+	{0x10C83, "𐳃"},
+	{0x10C84, "𐳄"},
+	{0x10C85, "𐳅"},
+	{0x10C86, "𐳆"},
+	{0x10C87, "𐳇"},
+	{0x10C88, "𐳈"},
+	{0x10C89, "𐳉"},
+	{0x10C8A, "𐳊"},
+	{0x10C8B, "𐳋"},
+	{0x10C8C, "𐳌"},
+	{0x10C8D, "𐳍"},
+	{0x10C8E, "𐳎"},
+	{0x10C8F, "𐳏"},
+	{0x10C90, "𐳐"},
+	{0x10C91, "𐳑"},
+	{0x10C92, "𐳒"},
+	{0x10C93, "𐳓"},
+	{0x10C94, "𐳔"},
+	{0x10C95, "𐳕"},
+	{0x10C96, "𐳖"},
+	{0x10C97, "𐳗"},
+	{0x10C98, "𐳘"},
+	{0x10C99, "𐳙"},
+	{0x10C9A, "𐳚"},
+	{0x10C9B, "𐳛"},
+	{0x10C9C, "𐳜"},
+	{0x10C9D, "𐳝"},
+	{0x10C9E, "𐳞"},
+	{0x10C9F, "𐳟"},
+	{0x10CA0, "𐳠"},
+	{0x10CA1, "𐳡"},
+	{0x10CA2, "𐳢"},
+	{0x10CA3, "𐳣"},
+	{0x10CA4, "𐳤"},
+	{0x10CA5, "𐳥"},
+	{0x10CA6, "𐳦"},
+	{0x10CA7, "𐳧"},
+	{0x10CA8, "𐳨"},
+	{0x10CA9, "𐳩"},
+	{0x10CAA, "𐳪"},
+	{0x10CAB, "𐳫"},
+	{0x10CAC, "𐳬"},
+	{0x10CAD, "𐳭"},
+	{0x10CAE, "𐳮"},
+	{0x10CAF, "𐳯"},
+	{0x10CB0, "𐳰"},
+	{0x10CB1, "𐳱"},
+	{0x10CB2, "𐳲"},
+	{0x118A0, "𑣀"},
+	{0x118A1, "𑣁"},
+	{0x118A2, "𑣂"},
+	{0x118A3, "𑣃"},
+	{0x118A4, "𑣄"},
+	{0x118A5, "𑣅"},
+	{0x118A6, "𑣆"},
+	{0x118A7, "𑣇"},
+	{0x118A8, "𑣈"},
+	{0x118A9, "𑣉"},
+	{0x118AA, "𑣊"},
+	{0x118AB, "𑣋"},
+	{0x118AC, "𑣌"},
+	{0x118AD, "𑣍"},
+	{0x118AE, "𑣎"},
+	{0x118AF, "𑣏"},
+	{0x118B0, "𑣐"},
+	{0x118B1, "𑣑"},
+	{0x118B2, "𑣒"},
+	{0x118B3, "𑣓"},
+	{0x118B4, "𑣔"},
+	{0x118B5, "𑣕"},
+	{0x118B6, "𑣖"},
+	{0x118B7, "𑣗"},
+	{0x118B8, "𑣘"},
+	{0x118B9, "𑣙"},
+	{0x118BA, "𑣚"},
+	{0x118BB, "𑣛"},
+	{0x118BC, "𑣜"},
+	{0x118BD, "𑣝"},
+	{0x118BE, "𑣞"},
+	{0x118BF, "𑣟"},
+	{0x16E40, "𖹠"},
+	{0x16E41, "𖹡"},
+	{0x16E42, "𖹢"},
+	{0x16E43, "𖹣"},
+	{0x16E44, "𖹤"},
+	{0x16E45, "𖹥"},
+	{0x16E46, "𖹦"},
+	{0x16E47, "𖹧"},
+	{0x16E48, "𖹨"},
+	{0x16E49, "𖹩"},
+	{0x16E4A, "𖹪"},
+	{0x16E4B, "𖹫"},
+	{0x16E4C, "𖹬"},
+	{0x16E4D, "𖹭"},
+	{0x16E4E, "𖹮"},
+	{0x16E4F, "𖹯"},
+	{0x16E50, "𖹰"},
+	{0x16E51, "𖹱"},
+	{0x16E52, "𖹲"},
+	{0x16E53, "𖹳"},
+	{0x16E54, "𖹴"},
+	{0x16E55, "𖹵"},
+	{0x16E56, "𖹶"},
+	{0x16E57, "𖹷"},
+	{0x16E58, "𖹸"},
+	{0x16E59, "𖹹"},
+	{0x16E5A, "𖹺"},
+	{0x16E5B, "𖹻"},
+	{0x16E5C, "𖹼"},
+	{0x16E5D, "𖹽"},
+	{0x16E5E, "𖹾"},
+	{0x16E5F, "𖹿"},
+	{0x1BCA0, ""},
+	{0x1BCA1, ""},
+	{0x1BCA2, ""},
+	{0x1BCA3, ""},
+	{0x1D15E, "𝅗𝅥"},
+	{0x1D15F, "𝅘𝅥"},
+	{0x1D160, "𝅘𝅥𝅮"},
+	{0x1D161, "𝅘𝅥𝅯"},
+	{0x1D162, "𝅘𝅥𝅰"},
+	{0x1D163, "𝅘𝅥𝅱"},
+	{0x1D164, "𝅘𝅥𝅲"},
+	{0x1D1BB, "𝆹𝅥"},
+	{0x1D1BC, "𝆺𝅥"},
+	{0x1D1BD, "𝆹𝅥𝅮"},
+	{0x1D1BE, "𝆺𝅥𝅮"},
+	{0x1D1BF, "𝆹𝅥𝅯"},
+	{0x1D1C0, "𝆺𝅥𝅯"},
+	{0x1D400, "a"},
+	{0x1D401, "b"},
+	{0x1D402, "c"},
+	{0x1D403, "d"},
+	{0x1D404, "e"},
+	{0x1D405, "f"},
+	{0x1D406, "g"},
+	{0x1D407, "h"},
+	{0x1D408, "i"},
+	{0x1D409, "j"},
+	{0x1D40A, "k"},
+	{0x1D40B, "l"},
+	{0x1D40C, "m"},
+	{0x1D40D, "n"},
+	{0x1D40E, "o"},
+	{0x1D40F, "p"},
+	{0x1D410, "q"},
+	{0x1D411, "r"},
+	{0x1D412, "s"},
+	{0x1D413, "t"},
+	{0x1D414, "u"},
+	{0x1D415, "v"},
+	{0x1D416, "w"},
+	{0x1D417, "x"},
+	{0x1D418, "y"},
+	{0x1D419, "z"},
+	{0x1D41A, "a"},
+	{0x1D41B, "b"},
+	{0x1D41C, "c"},
+	{0x1D41D, "d"},
+	{0x1D41E, "e"},
+	{0x1D41F, "f"},
+	{0x1D420, "g"},
+	{0x1D421, "h"},
+	{0x1D422, "i"},
+	{0x1D423, "j"},
+	{0x1D424, "k"},
+	{0x1D425, "l"},
+	{0x1D426, "m"},
+	{0x1D427, "n"},
+	{0x1D428, "o"},
+	{0x1D429, "p"},
+	{0x1D42A, "q"},
+	{0x1D42B, "r"},
+	{0x1D42C, "s"},
+	{0x1D42D, "t"},
+	{0x1D42E, "u"},
+	{0x1D42F, "v"},
+	{0x1D430, "w"},
+	{0x1D431, "x"},
+	{0x1D432, "y"},
+	{0x1D433, "z"},
+	{0x1D434, "a"},
+	{0x1D435, "b"},
+	{0x1D436, "c"},
+	{0x1D437, "d"},
+	{0x1D438, "e"},
+	{0x1D439, "f"},
+	{0x1D43A, "g"},
+	{0x1D43B, "h"},
+	{0x1D43C, "i"},
+	{0x1D43D, "j"},
+	{0x1D43E, "k"},
+	{0x1D43F, "l"},
+	{0x1D440, "m"},
+	{0x1D441, "n"},
+	{0x1D442, "o"},
+	{0x1D443, "p"},
+	{0x1D444, "q"},
+	{0x1D445, "r"},
+	{0x1D446, "s"},
+	{0x1D447, "t"},
+	{0x1D448, "u"},
+	{0x1D449, "v"},
+	{0x1D44A, "w"},
+	{0x1D44B, "x"},
+	{0x1D44C, "y"},
+	{0x1D44D, "z"},
+	{0x1D44E, "a"},
+	{0x1D44F, "b"},
+	{0x1D450, "c"},
+	{0x1D451, "d"},
+	{0x1D452, "e"},
+	{0x1D453, "f"},
+	{0x1D454, "g"},
+	{0x1D456, "i"},
+	{0x1D457, "j"},
+	{0x1D458, "k"},
+	{0x1D459, "l"},
+	{0x1D45A, "m"},
+	{0x1D45B, "n"},
+	{0x1D45C, "o"},
+	{0x1D45D, "p"},
+	{0x1D45E, "q"},
+	{0x1D45F, "r"},
+	{0x1D460, "s"},
+	{0x1D461, "t"},
+	{0x1D462, "u"},
+	{0x1D463, "v"},
+	{0x1D464, "w"},
+	{0x1D465, "x"},
+	{0x1D466, "y"},
+	{0x1D467, "z"},
+	{0x1D468, "a"},
+	{0x1D469, "b"},
+	{0x1D46A, "c"},
+	{0x1D46B, "d"},
+	{0x1D46C, "e"},
+	{0x1D46D, "f"},
+	{0x1D46E, "g"},
+	{0x1D46F, "h"},
+	{0x1D470, "i"},
+	{0x1D471, "j"},
+	{0x1D472, "k"},
+	{0x1D473, "l"},
+	{0x1D474, "m"},
+	{0x1D475, "n"},
+	{0x1D476, "o"},
+	{0x1D477, "p"},
+	{0x1D478, "q"},
+	{0x1D479, "r"},
+	{0x1D47A, "s"},
+	{0x1D47B, "t"},
+	{0x1D47C, "u"},
+	{0x1D47D, "v"},
+	{0x1D47E, "w"},
+	{0x1D47F, "x"},
+	{0x1D480, "y"},
+	{0x1D481, "z"},
+	{0x1D482, "a"},
+	{0x1D483, "b"},
+	{0x1D484, "c"},
+	{0x1D485, "d"},
+	{0x1D486, "e"},
+	{0x1D487, "f"},
+	{0x1D488, "g"},
+	{0x1D489, "h"},
+	{0x1D48A, "i"},
+	{0x1D48B, "j"},
+	{0x1D48C, "k"},
+	{0x1D48D, "l"},
+	{0x1D48E, "m"},
+	{0x1D48F, "n"},
+	{0x1D490, "o"},
+	{0x1D491, "p"},
+	{0x1D492, "q"},
+	{0x1D493, "r"},
+	{0x1D494, "s"},
+	{0x1D495, "t"},
+	{0x1D496, "u"},
+	{0x1D497, "v"},
+	{0x1D498, "w"},
+	{0x1D499, "x"},
+	{0x1D49A, "y"},
+	{0x1D49B, "z"},
+	{0x1D49C, "a"},
+	{0x1D49E, "c"},
+	{0x1D49F, "d"},
+	{0x1D4A2, "g"},
+	{0x1D4A5, "j"},
+	{0x1D4A6, "k"},
+	{0x1D4A9, "n"},
+	{0x1D4AA, "o"},
+	{0x1D4AB, "p"},
+	{0x1D4AC, "q"},
+	{0x1D4AE, "s"},
+	{0x1D4AF, "t"},
+	{0x1D4B0, "u"},
+	{0x1D4B1, "v"},
+	{0x1D4B2, "w"},
+	{0x1D4B3, "x"},
+	{0x1D4B4, "y"},
+	{0x1D4B5, "z"},
+	{0x1D4B6, "a"},
+	{0x1D4B7, "b"},
+	{0x1D4B8, "c"},
+	{0x1D4B9, "d"},
+	{0x1D4BB, "f"},
+	{0x1D4BD, "h"},
+	{0x1D4BE, "i"},
+	{0x1D4BF, "j"},
+	{0x1D4C0, "k"},
+	{0x1D4C1, "l"},
+	{0x1D4C2, "m"},
+	{0x1D4C3, "n"},
+	{0x1D4C5, "p"},
+	{0x1D4C6, "q"},
+	{0x1D4C7, "r"},
+	{0x1D4C8, "s"},
+	{0x1D4C9, "t"},
+	{0x1D4CA, "u"},
+	{0x1D4CB, "v"},
+	{0x1D4CC, "w"},
+	{0x1D4CD, "x"},
+	{0x1D4CE, "y"},
+	{0x1D4CF, "z"},
+	{0x1D4D0, "a"},
+	{0x1D4D1, "b"},
+	{0x1D4D2, "c"},
+	{0x1D4D3, "d"},
+	{0x1D4D4, "e"},
+	{0x1D4D5, "f"},
+	{0x1D4D6, "g"},
+	{0x1D4D7, "h"},
+	{0x1D4D8, "i"},
+	{0x1D4D9, "j"},
+	{0x1D4DA, "k"},
+	{0x1D4DB, "l"},
+	{0x1D4DC, "m"},
+	{0x1D4DD, "n"},
+	{0x1D4DE, "o"},
+	{0x1D4DF, "p"},
+	{0x1D4E0, "q"},
+	{0x1D4E1, "r"},
+	{0x1D4E2, "s"},
+	{0x1D4E3, "t"},
+	{0x1D4E4, "u"},
+	{0x1D4E5, "v"},
+	{0x1D4E6, "w"},
+	{0x1D4E7, "x"},
+	{0x1D4E8, "y"},
+	{0x1D4E9, "z"},
+	{0x1D4EA, "a"},
+	{0x1D4EB, "b"},
+	{0x1D4EC, "c"},
+	{0x1D4ED, "d"},
+	{0x1D4EE, "e"},
+	{0x1D4EF, "f"},
+	{0x1D4F0, "g"},
+	{0x1D4F1, "h"},
+	{0x1D4F2, "i"},
+	{0x1D4F3, "j"},
+	{0x1D4F4, "k"},
+	{0x1D4F5, "l"},
+	{0x1D4F6, "m"},
+	{0x1D4F7, "n"},
+	{0x1D4F8, "o"},
+	{0x1D4F9, "p"},
+	{0x1D4FA, "q"},
+	{0x1D4FB, "r"},
+	{0x1D4FC, "s"},
+	{0x1D4FD, "t"},
+	{0x1D4FE, "u"},
+	{0x1D4FF, "v"},
+	{0x1D500, "w"},
+	{0x1D501, "x"},
+	{0x1D502, "y"},
+	{0x1D503, "z"},
+	{0x1D504, "a"},
+	{0x1D505, "b"},
+	{0x1D507, "d"},
+	{0x1D508, "e"},
+	{0x1D509, "f"},
+	{0x1D50A, "g"},
+	{0x1D50D, "j"},
+	{0x1D50E, "k"},
+	{0x1D50F, "l"},
+	{0x1D510, "m"},
+	{0x1D511, "n"},
+	{0x1D512, "o"},
+	{0x1D513, "p"},
+	{0x1D514, "q"},
+	{0x1D516, "s"},
+	{0x1D517, "t"},
+	{0x1D518, "u"},
+	{0x1D519, "v"},
+	{0x1D51A, "w"},
+	{0x1D51B, "x"},
+	{0x1D51C, "y"},
+	{0x1D51E, "a"},
+	{0x1D51F, "b"},
+	{0x1D520, "c"},
+	{0x1D521, "d"},
+	{0x1D522, "e"},
+	{0x1D523, "f"},
+	{0x1D524, "g"},
+	{0x1D525, "h"},
+	{0x1D526, "i"},
+	{0x1D527, "j"},
+	{0x1D528, "k"},
+	{0x1D529, "l"},
+	{0x1D52A, "m"},
+	{0x1D52B, "n"},
+	{0x1D52C, "o"},
+	{0x1D52D, "p"},
+	{0x1D52E, "q"},
+	{0x1D52F, "r"},
+	{0x1D530, "s"},
+	{0x1D531, "t"},
+	{0x1D532, "u"},
+	{0x1D533, "v"},
+	{0x1D534, "w"},
+	{0x1D535, "x"},
+	{0x1D536, "y"},
+	{0x1D537, "z"},
+	{0x1D538, "a"},
+	{0x1D539, "b"},
+	{0x1D53B, "d"},
+	{0x1D53C, "e"},
+	{0x1D53D, "f"},
+	{0x1D53E, "g"},
+	{0x1D540, "i"},
+	{0x1D541, "j"},
+	{0x1D542, "k"},
+	{0x1D543, "l"},
+	{0x1D544, "m"},
+	{0x1D546, "o"},
+	{0x1D54A, "s"},
+	{0x1D54B, "t"},
+	{0x1D54C, "u"},
+	{0x1D54D, "v"},
+	{0x1D54E, "w"},
+	{0x1D54F, "x"},
+	{0x1D550, "y"},
+	{0x1D552, "a"},
+	{0x1D553, "b"},
+	{0x1D554, "c"},
+	{0x1D555, "d"},
+	{0x1D556, "e"},
+	{0x1D557, "f"},
+	{0x1D558, "g"},
+	{0x1D559, "h"},
+	{0x1D55A, "i"},
+	{0x1D55B, "j"},
+	{0x1D55C, "k"},
+	{0x1D55D, "l"},
+	{0x1D55E, "m"},
+	{0x1D55F, "n"},
+	{0x1D560, "o"},
+	{0x1D561, "p"},
+	{0x1D562, "q"},
+	{0x1D563, "r"},
+	{0x1D564, "s"},
+	{0x1D565, "t"},
+	{0x1D566, "u"},
+	{0x1D567, "v"},
+	{0x1D568, "w"},
+	{0x1D569, "x"},
+	{0x1D56A, "y"},
+	{0x1D56B, "z"},
+	{0x1D56C, "a"},
+	{0x1D56D, "b"},
+	{0x1D56E, "c"},
+	{0x1D56F, "d"},
+	{0x1D570, "e"},
+	{0x1D571, "f"},
+	{0x1D572, "g"},
+	{0x1D573, "h"},
+	{0x1D574, "i"},
+	{0x1D575, "j"},
+	{0x1D576, "k"},
+	{0x1D577, "l"},
+	{0x1D578, "m"},
+	{0x1D579, "n"},
+	{0x1D57A, "o"},
+	{0x1D57B, "p"},
+	{0x1D57C, "q"},
+	{0x1D57D, "r"},
+	{0x1D57E, "s"},
+	{0x1D57F, "t"},
+	{0x1D580, "u"},
+	{0x1D581, "v"},
+	{0x1D582, "w"},
+	{0x1D583, "x"},
+	{0x1D584, "y"},
+	{0x1D585, "z"},
+	{0x1D586, "a"},
+	{0x1D587, "b"},
+	{0x1D588, "c"},
+	{0x1D589, "d"},
+	{0x1D58A, "e"},
+	{0x1D58B, "f"},
+	{0x1D58C, "g"},
+	{0x1D58D, "h"},
+	{0x1D58E, "i"},
+	{0x1D58F, "j"},
+	{0x1D590, "k"},
+	{0x1D591, "l"},
+	{0x1D592, "m"},
+	{0x1D593, "n"},
+	{0x1D594, "o"},
+	{0x1D595, "p"},
+	{0x1D596, "q"},
+	{0x1D597, "r"},
+	{0x1D598, "s"},
+	{0x1D599, "t"},
+	{0x1D59A, "u"},
+	{0x1D59B, "v"},
+	{0x1D59C, "w"},
+	{0x1D59D, "x"},
+	{0x1D59E, "y"},
+	{0x1D59F, "z"},
+	{0x1D5A0, "a"},
+	{0x1D5A1, "b"},
+	{0x1D5A2, "c"},
+	{0x1D5A3, "d"},
+	{0x1D5A4, "e"},
+	{0x1D5A5, "f"},
+	{0x1D5A6, "g"},
+	{0x1D5A7, "h"},
+	{0x1D5A8, "i"},
+	{0x1D5A9, "j"},
+	{0x1D5AA, "k"},
+	{0x1D5AB, "l"},
+	{0x1D5AC, "m"},
+	{0x1D5AD, "n"},
+	{0x1D5AE, "o"},
+	{0x1D5AF, "p"},
+	{0x1D5B0, "q"},
+	{0x1D5B1, "r"},
+	{0x1D5B2, "s"},
+	{0x1D5B3, "t"},
+	{0x1D5B4, "u"},
+	{0x1D5B5, "v"},
+	{0x1D5B6, "w"},
+	{0x1D5B7, "x"},
+	{0x1D5B8, "y"},
+	{0x1D5B9, "z"},
+	{0x1D5BA, "a"},
+	{0x1D5BB, "b"},
+	{0x1D5BC, "c"},
+	{0x1D5BD, "d"},
+	{0x1D5BE, "e"},
+	{0x1D5BF, "f"},
+	{0x1D5C0, "g"},
+	{0x1D5C1, "h"},
+	{0x1D5C2, "i"},
+	{0x1D5C3, "j"},
+	{0x1D5C4, "k"},
+	{0x1D5C5, "l"},
+	{0x1D5C6, "m"},
+	{0x1D5C7, "n"},
+	{0x1D5C8, "o"},
+	{0x1D5C9, "p"},
+	{0x1D5CA, "q"},
+	{0x1D5CB, "r"},
+	{0x1D5CC, "s"},
+	{0x1D5CD, "t"},
+	{0x1D5CE, "u"},
+	{0x1D5CF, "v"},
+	{0x1D5D0, "w"},
+	{0x1D5D1, "x"},
+	{0x1D5D2, "y"},
+	{0x1D5D3, "z"},
+	{0x1D5D4, "a"},
+	{0x1D5D5, "b"},
+	{0x1D5D6, "c"},
+	{0x1D5D7, "d"},
+	{0x1D5D8, "e"},
+	{0x1D5D9, "f"},
+	{0x1D5DA, "g"},
+	{0x1D5DB, "h"},
+	{0x1D5DC, "i"},
+	{0x1D5DD, "j"},
+	{0x1D5DE, "k"},
+	{0x1D5DF, "l"},
+	{0x1D5E0, "m"},
+	{0x1D5E1, "n"},
+	{0x1D5E2, "o"},
+	{0x1D5E3, "p"},
+	{0x1D5E4, "q"},
+	{0x1D5E5, "r"},
+	{0x1D5E6, "s"},
+	{0x1D5E7, "t"},
+	{0x1D5E8, "u"},
+	{0x1D5E9, "v"},
+	{0x1D5EA, "w"},
+	{0x1D5EB, "x"},
+	{0x1D5EC, "y"},
+	{0x1D5ED, "z"},
+	{0x1D5EE, "a"},
+	{0x1D5EF, "b"},
+	{0x1D5F0, "c"},
+	{0x1D5F1, "d"},
+	{0x1D5F2, "e"},
+	{0x1D5F3, "f"},
+	{0x1D5F4, "g"},
+	{0x1D5F5, "h"},
+	{0x1D5F6, "i"},
+	{0x1D5F7, "j"},
+	{0x1D5F8, "k"},
+	{0x1D5F9, "l"},
+	{0x1D5FA, "m"},
+	{0x1D5FB, "n"},
+	{0x1D5FC, "o"},
+	{0x1D5FD, "p"},
+	{0x1D5FE, "q"},
+	{0x1D5FF, "r"},
+	{0x1D600, "s"},
+	{0x1D601, "t"},
+	{0x1D602, "u"},
+	{0x1D603, "v"},
+	{0x1D604, "w"},
+	{0x1D605, "x"},
+	{0x1D606, "y"},
+	{0x1D607, "z"},
+	{0x1D608, "a"},
+	{0x1D609, "b"},
+	{0x1D60A, "c"},
+	{0x1D60B, "d"},
+	{0x1D60C, "e"},
+	{0x1D60D, "f"},
+	{0x1D60E, "g"},
+	{0x1D60F, "h"},
+	{0x1D610, "i"},
+	{0x1D611, "j"},
+	{0x1D612, "k"},
+	{0x1D613, "l"},
+	{0x1D614, "m"},
+	{0x1D615, "n"},
+	{0x1D616, "o"},
+	{0x1D617, "p"},
+	{0x1D618, "q"},
+	{0x1D619, "r"},
+	{0x1D61A, "s"},
+	{0x1D61B, "t"},
+	{0x1D61C, "u"},
+	{0x1D61D, "v"},
+	{0x1D61E, "w"},
+	{0x1D61F, "x"},
+	{0x1D620, "y"},
+	{0x1D621, "z"},
+	{0x1D622, "a"},
+	{0x1D623, "b"},
+	{0x1D624, "c"},
+	{0x1D625, "d"},
+	{0x1D626, "e"},
+	{0x1D627, "f"},
+	{0x1D628, "g"},
+	{0x1D629, "h"},
+	{0x1D62A, "i"},
+	{0x1D62B, "j"},
+	{0x1D62C, "k"},
+	{0x1D62D, "l"},
+	{0x1D62E, "m"},
+	{0x1D62F, "n"},
+	{0x1D630, "o"},
+	{0x1D631, "p"},
+	{0x1D632, "q"},
+	{0x1D633, "r"},
+	{0x1D634, "s"},
+	{0x1D635, "t"},
+	{0x1D636, "u"},
+	{0x1D637, "v"},
+	{0x1D638, "w"},
+	{0x1D639, "x"},
+	{0x1D63A, "y"},
+	{0x1D63B, "z"},
+	{0x1D63C, "a"},
+	{0x1D63D, "b"},
+	{0x1D63E, "c"},
+	{0x1D63F, "d"},
+	{0x1D640, "e"},
+	{0x1D641, "f"},
+	{0x1D642, "g"},
+	{0x1D643, "h"},
+	{0x1D644, "i"},
+	{0x1D645, "j"},
+	{0x1D646, "k"},
+	{0x1D647, "l"},
+	{0x1D648, "m"},
+	{0x1D649, "n"},
+	{0x1D64A, "o"},
+	{0x1D64B, "p"},
+	{0x1D64C, "q"},
+	{0x1D64D, "r"},
+	{0x1D64E, "s"},
+	{0x1D64F, "t"},
+	{0x1D650, "u"},
+	{0x1D651, "v"},
+	{0x1D652, "w"},
+	{0x1D653, "x"},
+	{0x1D654, "y"},
+	{0x1D655, "z"},
+	{0x1D656, "a"},
+	{0x1D657, "b"},
+	{0x1D658, "c"},
+	{0x1D659, "d"},
+	{0x1D65A, "e"},
+	{0x1D65B, "f"},
+	{0x1D65C, "g"},
+	{0x1D65D, "h"},
+	{0x1D65E, "i"},
+	{0x1D65F, "j"},
+	{0x1D660, "k"},
+	{0x1D661, "l"},
+	{0x1D662, "m"},
+	{0x1D663, "n"},
+	{0x1D664, "o"},
+	{0x1D665, "p"},
+	{0x1D666, "q"},
+	{0x1D667, "r"},
+	{0x1D668, "s"},
+	{0x1D669, "t"},
+	{0x1D66A, "u"},
+	{0x1D66B, "v"},
+	{0x1D66C, "w"},
+	{0x1D66D, "x"},
+	{0x1D66E, "y"},
+	{0x1D66F, "z"},
+	{0x1D670, "a"},
+	{0x1D671, "b"},
+	{0x1D672, "c"},
+	{0x1D673, "d"},
+	{0x1D674, "e"},
+	{0x1D675, "f"},
+	{0x1D676, "g"},
+	{0x1D677, "h"},
+	{0x1D678, "i"},
+	{0x1D679, "j"},
+	{0x1D67A, "k"},
+	{0x1D67B, "l"},
+	{0x1D67C, "m"},
+	{0x1D67D, "n"},
+	{0x1D67E, "o"},
+	{0x1D67F, "p"},
+	{0x1D680, "q"},
+	{0x1D681, "r"},
+	{0x1D682, "s"},
+	{0x1D683, "t"},
+	{0x1D684, "u"},
+	{0x1D685, "v"},
+	{0x1D686, "w"},
+	{0x1D687, "x"},
+	{0x1D688, "y"},
+	{0x1D689, "z"},
+	{0x1D68A, "a"},
+	{0x1D68B, "b"},
+	{0x1D68C, "c"},
+	{0x1D68D, "d"},
+	{0x1D68E, "e"},
+	{0x1D68F, "f"},
+	{0x1D690, "g"},
+	{0x1D691, "h"},
+	{0x1D692, "i"},
+	{0x1D693, "j"},
+	{0x1D694, "k"},
+	{0x1D695, "l"},
+	{0x1D696, "m"},
+	{0x1D697, "n"},
+	{0x1D698, "o"},
+	{0x1D699, "p"},
+	{0x1D69A, "q"},
+	{0x1D69B, "r"},
+	{0x1D69C, "s"},
+	{0x1D69D, "t"},
+	{0x1D69E, "u"},
+	{0x1D69F, "v"},
+	{0x1D6A0, "w"},
+	{0x1D6A1, "x"},
+	{0x1D6A2, "y"},
+	{0x1D6A3, "z"},
+	{0x1D6A4, "ı"},
+	{0x1D6A5, "ȷ"},
+	{0x1D6A8, "α"},
+	{0x1D6A9, "β"},
+	{0x1D6AA, "γ"},
+	{0x1D6AB, "δ"},
+	{0x1D6AC, "ε"},
+	{0x1D6AD, "ζ"},
+	{0x1D6AE, "η"},
+	{0x1D6AF, "θ"},
+	{0x1D6B0, "ι"},
+	{0x1D6B1, "κ"},
+	{0x1D6B2, "λ"},
+	{0x1D6B3, "μ"},
+	{0x1D6B4, "ν"},
+	{0x1D6B5, "ξ"},
+	{0x1D6B6, "ο"},
+	{0x1D6B7, "π"},
+	{0x1D6B8, "ρ"},
+	{0x1D6B9, "θ"},
+	{0x1D6BA, "σ"},
+	{0x1D6BB, "τ"},
+	{0x1D6BC, "υ"},
+	{0x1D6BD, "φ"},
+	{0x1D6BE, "χ"},
+	{0x1D6BF, "ψ"},
+	{0x1D6C0, "ω"},
+	{0x1D6C1, "∇"},
+	{0x1D6C2, "α"},
+	{0x1D6C3, "β"},
+	{0x1D6C4, "γ"},
+	{0x1D6C5, "δ"},
+	{0x1D6C6, "ε"},
+	{0x1D6C7, "ζ"},
+	{0x1D6C8, "η"},
+	{0x1D6C9, "θ"},
+	{0x1D6CA, "ι"},
+	{0x1D6CB, "κ"},
+	{0x1D6CC, "λ"},
+	{0x1D6CD, "μ"},
+	{0x1D6CE, "ν"},
+	{0x1D6CF, "ξ"},
+	{0x1D6D0, "ο"},
+	{0x1D6D1, "π"},
+	{0x1D6D2, "ρ"},
+	{0x1D6D3, "σ"},
+	{0x1D6D4, "σ"},
+	{0x1D6D5, "τ"},
+	{0x1D6D6, "υ"},
+	{0x1D6D7, "φ"},
+	{0x1D6D8, "χ"},
+	{0x1D6D9, "ψ"},
+	{0x1D6DA, "ω"},
+	{0x1D6DB, "∂"},
+	{0x1D6DC, "ε"},
+	{0x1D6DD, "θ"},
+	{0x1D6DE, "κ"},
+	{0x1D6DF, "φ"},
+	{0x1D6E0, "ρ"},
+	{0x1D6E1, "π"},
+	{0x1D6E2, "α"},
+	{0x1D6E3, "β"},
+	{0x1D6E4, "γ"},
+	{0x1D6E5, "δ"},
+	{0x1D6E6, "ε"},
+	{0x1D6E7, "ζ"},
+	{0x1D6E8, "η"},
+	{0x1D6E9, "θ"},
+	{0x1D6EA, "ι"},
+	{0x1D6EB, "κ"},
+	{0x1D6EC, "λ"},
+	{0x1D6ED, "μ"},
+	{0x1D6EE, "ν"},
+	{0x1D6EF, "ξ"},
+	{0x1D6F0, "ο"},
+	{0x1D6F1, "π"},
+	{0x1D6F2, "ρ"},
+	{0x1D6F3, "θ"},
+	{0x1D6F4, "σ"},
+	{0x1D6F5, "τ"},
+	{0x1D6F6, "υ"},
+	{0x1D6F7, "φ"},
+	{0x1D6F8, "χ"},
+	{0x1D6F9, "ψ"},
+	{0x1D6FA, "ω"},
+	{0x1D6FB, "∇"},
+	{0x1D6FC, "α"},
+	{0x1D6FD, "β"},
+	{0x1D6FE, "γ"},
+	{0x1D6FF, "δ"},
+	{0x1D700, "ε"},
+	{0x1D701, "ζ"},
+	{0x1D702, "η"},
+	{0x1D703, "θ"},
+	{0x1D704, "ι"},
+	{0x1D705, "κ"},
+	{0x1D706, "λ"},
+	{0x1D707, "μ"},
+	{0x1D708, "ν"},
+	{0x1D709, "ξ"},
+	{0x1D70A, "ο"},
+	{0x1D70B, "π"},
+	{0x1D70C, "ρ"},
+	{0x1D70D, "σ"},
+	{0x1D70E, "σ"},
+	{0x1D70F, "τ"},
+	{0x1D710, "υ"},
+	{0x1D711, "φ"},
+	{0x1D712, "χ"},
+	{0x1D713, "ψ"},
+	{0x1D714, "ω"},
+	{0x1D715, "∂"},
+	{0x1D716, "ε"},
+	{0x1D717, "θ"},
+	{0x1D718, "κ"},
+	{0x1D719, "φ"},
+	{0x1D71A, "ρ"},
+	{0x1D71B, "π"},
+	{0x1D71C, "α"},
+	{0x1D71D, "β"},
+	{0x1D71E, "γ"},
+	{0x1D71F, "δ"},
+	{0x1D720, "ε"},
+	{0x1D721, "ζ"},
+	{0x1D722, "η"},
+	{0x1D723, "θ"},
+	{0x1D724, "ι"},
+	{0x1D725, "κ"},
+	{0x1D726, "λ"},
+	{0x1D727, "μ"},
+	{0x1D728, "ν"},
+	{0x1D729, "ξ"},
+	{0x1D72A, "ο"},
+	{0x1D72B, "π"},
+	{0x1D72C, "ρ"},
+	{0x1D72D, "θ"},
+	{0x1D72E, "σ"},
+	{0x1D72F, "τ"},
+	{0x1D730, "υ"},
+	{0x1D731, "φ"},
+	{0x1D732, "χ"},
+	{0x1D733, "ψ"},
+	{0x1D734, "ω"},
+	{0x1D735, "∇"},
+	{0x1D736, "α"},
+	{0x1D737, "β"},
+	{0x1D738, "γ"},
+	{0x1D739, "δ"},
+	{0x1D73A, "ε"},
+	{0x1D73B, "ζ"},
+	{0x1D73C, "η"},
+	{0x1D73D, "θ"},
+	{0x1D73E, "ι"},
+	{0x1D73F, "κ"},
+	{0x1D740, "λ"},
+	{0x1D741, "μ"},
+	{0x1D742, "ν"},
+	{0x1D743, "ξ"},
+	{0x1D744, "ο"},
+	{0x1D745, "π"},
+	{0x1D746, "ρ"},
+	{0x1D747, "σ"},
+	{0x1D748, "σ"},
+	{0x1D749, "τ"},
+	{0x1D74A, "υ"},
+	{0x1D74B, "φ"},
+	{0x1D74C, "χ"},
+	{0x1D74D, "ψ"},
+	{0x1D74E, "ω"},
+	{0x1D74F, "∂"},
+	{0x1D750, "ε"},
+	{0x1D751, "θ"},
+	{0x1D752, "κ"},
+	{0x1D753, "φ"},
+	{0x1D754, "ρ"},
+	{0x1D755, "π"},
+	{0x1D756, "α"},
+	{0x1D757, "β"},
+	{0x1D758, "γ"},
+	{0x1D759, "δ"},
+	{0x1D75A, "ε"},
+	{0x1D75B, "ζ"},
+	{0x1D75C, "η"},
+	{0x1D75D, "θ"},
+	{0x1D75E, "ι"},
+	{0x1D75F, "κ"},
+	{0x1D760, "λ"},
+	{0x1D761, "μ"},
+	{0x1D762, "ν"},
+	{0x1D763, "ξ"},
+	{0x1D764, "ο"},
+	{0x1D765, "π"},
+	{0x1D766, "ρ"},
+	{0x1D767, "θ"},
+	{0x1D768, "σ"},
+	{0x1D769, "τ"},
+	{0x1D76A, "υ"},
+	{0x1D76B, "φ"},
+	{0x1D76C, "χ"},
+	{0x1D76D, "ψ"},
+	{0x1D76E, "ω"},
+	{0x1D76F, "∇"},
+	{0x1D770, "α"},
+	{0x1D771, "β"},
+	{0x1D772, "γ"},
+	{0x1D773, "δ"},
+	{0x1D774, "ε"},
+	{0x1D775, "ζ"},
+	{0x1D776, "η"},
+	{0x1D777, "θ"},
+	{0x1D778, "ι"},
+	{0x1D779, "κ"},
+	{0x1D77A, "λ"},
+	{0x1D77B, "μ"},
+	{0x1D77C, "ν"},
+	{0x1D77D, "ξ"},
+	{0x1D77E, "ο"},
+	{0x1D77F, "π"},
+	{0x1D780, "ρ"},
+	{0x1D781, "σ"},
+	{0x1D782, "σ"},
+	{0x1D783, "τ"},
+	{0x1D784, "υ"},
+	{0x1D785, "φ"},
+	{0x1D786, "χ"},
+	{0x1D787, "ψ"},
+	{0x1D788, "ω"},
+	{0x1D789, "∂"},
+	{0x1D78A, "ε"},
+	{0x1D78B, "θ"},
+	{0x1D78C, "κ"},
+	{0x1D78D, "φ"},
+	{0x1D78E, "ρ"},
+	{0x1D78F, "π"},
+	{0x1D790, "α"},
+	{0x1D791, "β"},
+	{0x1D792, "γ"},
+	{0x1D793, "δ"},
+	{0x1D794, "ε"},
+	{0x1D795, "ζ"},
+	{0x1D796, "η"},
+	{0x1D797, "θ"},
+	{0x1D798, "ι"},
+	{0x1D799, "κ"},
+	{0x1D79A, "λ"},
+	{0x1D79B, "μ"},
+	{0x1D79C, "ν"},
+	{0x1D79D, "ξ"},
+	{0x1D79E, "ο"},
+	{0x1D79F, "π"},
+	{0x1D7A0, "ρ"},
+	{0x1D7A1, "θ"},
+	{0x1D7A2, "σ"},
+	{0x1D7A3, "τ"},
+	{0x1D7A4, "υ"},
+	{0x1D7A5, "φ"},
+	{0x1D7A6, "χ"},
+	{0x1D7A7, "ψ"},
+	{0x1D7A8, "ω"},
+	{0x1D7A9, "∇"},
+	{0x1D7AA, "α"},
+	{0x1D7AB, "β"},
+	{0x1D7AC, "γ"},
+	{0x1D7AD, "δ"},
+	{0x1D7AE, "ε"},
+	{0x1D7AF, "ζ"},
+	{0x1D7B0, "η"},
+	{0x1D7B1, "θ"},
+	{0x1D7B2, "ι"},
+	{0x1D7B3, "κ"},
+	{0x1D7B4, "λ"},
+	{0x1D7B5, "μ"},
+	{0x1D7B6, "ν"},
+	{0x1D7B7, "ξ"},
+	{0x1D7B8, "ο"},
+	{0x1D7B9, "π"},
+	{0x1D7BA, "ρ"},
+	{0x1D7BB, "σ"},
+	{0x1D7BC, "σ"},
+	{0x1D7BD, "τ"},
+	{0x1D7BE, "υ"},
+	{0x1D7BF, "φ"},
+	{0x1D7C0, "χ"},
+	{0x1D7C1, "ψ"},
+	{0x1D7C2, "ω"},
+	{0x1D7C3, "∂"},
+	{0x1D7C4, "ε"},
+	{0x1D7C5, "θ"},
+	{0x1D7C6, "κ"},
+	{0x1D7C7, "φ"},
+	{0x1D7C8, "ρ"},
+	{0x1D7C9, "π"},
+	{0x1D7CA, "ϝ"},
+	{0x1D7CB, "ϝ"},
+	{0x1D7CE, "0"},
+	{0x1D7CF, "1"},
+	{0x1D7D0, "2"},
+	{0x1D7D1, "3"},
+	{0x1D7D2, "4"},
+	{0x1D7D3, "5"},
+	{0x1D7D4, "6"},
+	{0x1D7D5, "7"},
+	{0x1D7D6, "8"},
+	{0x1D7D7, "9"},
+	{0x1D7D8, "0"},
+	{0x1D7D9, "1"},
+	{0x1D7DA, "2"},
+	{0x1D7DB, "3"},
+	{0x1D7DC, "4"},
+	{0x1D7DD, "5"},
+	{0x1D7DE, "6"},
+	{0x1D7DF, "7"},
+	{0x1D7E0, "8"},
+	{0x1D7E1, "9"},
+	{0x1D7E2, "0"},
+	{0x1D7E3, "1"},
+	{0x1D7E4, "2"},
+	{0x1D7E5, "3"},
+	{0x1D7E6, "4"},
+	{0x1D7E7, "5"},
+	{0x1D7E8, "6"},
+	{0x1D7E9, "7"},
+	{0x1D7EA, "8"},
+	{0x1D7EB, "9"},
+	{0x1D7EC, "0"},
+	{0x1D7ED, "1"},
+	{0x1D7EE, "2"},
+	{0x1D7EF, "3"},
+	{0x1D7F0, "4"},
+	{0x1D7F1, "5"},
+	{0x1D7F2, "6"},
+	{0x1D7F3, "7"},
+	{0x1D7F4, "8"},
+	{0x1D7F5, "9"},
+	{0x1D7F6, "0"},
+	{0x1D7F7, "1"},
+	{0x1D7F8, "2"},
+	{0x1D7F9, "3"},
+	{0x1D7FA, "4"},
+	{0x1D7FB, "5"},
+	{0x1D7FC, "6"},
+	{0x1D7FD, "7"},
+	{0x1D7FE, "8"},
+	{0x1D7FF, "9"},
+	{0x1E030, "а"},
+	{0x1E031, "б"},
+	{0x1E032, "в"},
+	{0x1E033, "г"},
+	{0x1E034, "д"},
+	{0x1E035, "е"},
+	{0x1E036, "ж"},
+	{0x1E037, "з"},
+	{0x1E038, "и"},
+	{0x1E039, "к"},
+	{0x1E03A, "л"},
+	{0x1E03B, "м"},
+	{0x1E03C, "о"},
+	{0x1E03D, "п"},
+	{0x1E03E, "р"},
+	{0x1E03F, "с"},
+	{0x1E040, "т"},
+	{0x1E041, "у"},
+	{0x1E042, "ф"},
+	{0x1E043, "х"},
+	{0x1E044, "ц"},
+	{0x1E045, "ч"},
+	{0x1E046, "ш"},
+	{0x1E047, "ы"},
+	{0x1E048, "э"},
+	{0x1E049, "ю"},
+	{0x1E04A, "ꚉ"},
+	{0x1E04B, "ә"},
+	{0x1E04C, "і"},
+	{0x1E04D, "ј"},
+	{0x1E04E, "ө"},
+	{0x1E04F, "ү"},
+	{0x1E050, "ӏ"},
+	{0x1E051, "а"},
+	{0x1E052, "б"},
+	{0x1E053, "в"},
+	{0x1E054, "г"},
+	{0x1E055, "д"},
+	{0x1E056, "е"},
+	{0x1E057, "ж"},
+	{0x1E058, "з"},
+	{0x1E059, "и"},
+	{0x1E05A, "к"},
+	{0x1E05B, "л"},
+	{0x1E05C, "о"},
+	{0x1E05D, "п"},
+	{0x1E05E, "с"},
+	{0x1E05F, "у"},
+	{0x1E060, "ф"},
+	{0x1E061, "х"},
+	{0x1E062, "ц"},
+	{0x1E063, "ч"},
+	{0x1E064, "ш"},
+	{0x1E065, "ъ"},
+	{0x1E066, "ы"},
+	{0x1E067, "ґ"},
+	{0x1E068, "і"},
+	{0x1E069, "ѕ"},
+	{0x1E06A, "џ"},
+	{0x1E06B, "ҫ"},
+	{0x1E06C, "ꙑ"},
+	{0x1E06D, "ұ"},
+	{0x1E900, "𞤢"},
+	{0x1E901, "𞤣"},
+	{0x1E902, "𞤤"},
+	{0x1E903, "𞤥"},
+	{0x1E904, "𞤦"},
+	{0x1E905, "𞤧"},
+	{0x1E906, "𞤨"},
+	{0x1E907, "𞤩"},
+	{0x1E908, "𞤪"},
+	{0x1E909, "𞤫"},
+	{0x1E90A, "𞤬"},
+	{0x1E90B, "𞤭"},
+	{0x1E90C, "𞤮"},
+	{0x1E90D, "𞤯"},
+	{0x1E90E, "𞤰"},
+	{0x1E90F, "𞤱"},
+	{0x1E910, "𞤲"},
+	{0x1E911, "𞤳"},
+	{0x1E912, "𞤴"},
+	{0x1E913, "𞤵"},
+	{0x1E914, "𞤶"},
+	{0x1E915, "𞤷"},
+	{0x1E916, "𞤸"},
+	{0x1E917, "𞤹"},
+	{0x1E918, "𞤺"},
+	{0x1E919, "𞤻"},
+	{0x1E91A, "𞤼"},
+	{0x1E91B, "𞤽"},
+	{0x1E91C, "𞤾"},
+	{0x1E91D, "𞤿"},
+	{0x1E91E, "𞥀"},
+	{0x1E91F, "𞥁"},
+	{0x1E920, "𞥂"},
+	{0x1E921, "𞥃"},
+	{0x1EE00, "ا"},
+	{0x1EE01, "ب"},
+	{0x1EE02, "ج"},
+	{0x1EE03, "د"},
+	{0x1EE05, "و"},
+	{0x1EE06, "ز"},
+	{0x1EE07, "ح"},
+	{0x1EE08, "ط"},
+	{0x1EE09, "ي"},
+	{0x1EE0A, "ك"},
+	{0x1EE0B, "ل"},
+	{0x1EE0C, "م"},
+	{0x1EE0D, "ن"},
+	{0x1EE0E, "س"},
+	{0x1EE0F, "ع"},
+	{0x1EE10, "ف"},
+	{0x1EE11, "ص"},
+	{0x1EE12, "ق"},
+	{0x1EE13, "ر"},
+	{0x1EE14, "ش"},
+	{0x1EE15, "ت"},
+	{0x1EE16, "ث"},
+	{0x1EE17, "خ"},
+	{0x1EE18, "ذ"},
+	{0x1EE19, "ض"},
+	{0x1EE1A, "ظ"},
+	{0x1EE1B, "غ"},
+	{0x1EE1C, "ٮ"},
+	{0x1EE1D, "ں"},
+	{0x1EE1E, "ڡ"},
+	{0x1EE1F, "ٯ"},
+	{0x1EE21, "ب"},
+	{0x1EE22, "ج"},
+	{0x1EE24, "ه"},
+	{0x1EE27, "ح"},
+	{0x1EE29, "ي"},
+	{0x1EE2A, "ك"},
+	{0x1EE2B, "ل"},
+	{0x1EE2C, "م"},
+	{0x1EE2D, "ن"},
+	{0x1EE2E, "س"},
+	{0x1EE2F, "ع"},
+	{0x1EE30, "ف"},
+	{0x1EE31, "ص"},
+	{0x1EE32, "ق"},
+	{0x1EE34, "ش"},
+	{0x1EE35, "ت"},
+	{0x1EE36, "ث"},
+	{0x1EE37, "خ"},
+	{0x1EE39, "ض"},
+	{0x1EE3B, "غ"},
+	{0x1EE42, "ج"},
+	{0x1EE47, "ح"},
+	{0x1EE49, "ي"},
+	{0x1EE4B, "ل"},
+	{0x1EE4D, "ن"},
+	{0x1EE4E, "س"},
+	{0x1EE4F, "ع"},
+	{0x1EE51, "ص"},
+	{0x1EE52, "ق"},
+	{0x1EE54, "ش"},
+	{0x1EE57, "خ"},
+	{0x1EE59, "ض"},
+	{0x1EE5B, "غ"},
+	{0x1EE5D, "ں"},
+	{0x1EE5F, "ٯ"},
+	{0x1EE61, "ب"},
+	{0x1EE62, "ج"},
+	{0x1EE64, "ه"},
+	{0x1EE67, "ح"},
+	{0x1EE68, "ط"},
+	{0x1EE69, "ي"},
+	{0x1EE6A, "ك"},
+	{0x1EE6C, "م"},
+	{0x1EE6D, "ن"},
+	{0x1EE6E, "س"},
+	{0x1EE6F, "ع"},
+	{0x1EE70, "ف"},
+	{0x1EE71, "ص"},
+	{0x1EE72, "ق"},
+	{0x1EE74, "ش"},
+	{0x1EE75, "ت"},
+	{0x1EE76, "ث"},
+	{0x1EE77, "خ"},
+	{0x1EE79, "ض"},
+	{0x1EE7A, "ظ"},
+	{0x1EE7B, "غ"},
+	{0x1EE7C, "ٮ"},
+	{0x1EE7E, "ڡ"},
+	{0x1EE80, "ا"},
+	{0x1EE81, "ب"},
+	{0x1EE82, "ج"},
+	{0x1EE83, "د"},
+	{0x1EE84, "ه"},
+	{0x1EE85, "و"},
+	{0x1EE86, "ز"},
+	{0x1EE87, "ح"},
+	{0x1EE88, "ط"},
+	{0x1EE89, "ي"},
+	{0x1EE8B, "ل"},
+	{0x1EE8C, "م"},
+	{0x1EE8D, "ن"},
+	{0x1EE8E, "س"},
+	{0x1EE8F, "ع"},
+	{0x1EE90, "ف"},
+	{0x1EE91, "ص"},
+	{0x1EE92, "ق"},
+	{0x1EE93, "ر"},
+	{0x1EE94, "ش"},
+	{0x1EE95, "ت"},
+	{0x1EE96, "ث"},
+	{0x1EE97, "خ"},
+	{0x1EE98, "ذ"},
+	{0x1EE99, "ض"},
+	{0x1EE9A, "ظ"},
+	{0x1EE9B, "غ"},
+	{0x1EEA1, "ب"},
+	{0x1EEA2, "ج"},
+	{0x1EEA3, "د"},
+	{0x1EEA5, "و"},
+	{0x1EEA6, "ز"},
+	{0x1EEA7, "ح"},
+	{0x1EEA8, "ط"},
+	{0x1EEA9, "ي"},
+	{0x1EEAB, "ل"},
+	{0x1EEAC, "م"},
+	{0x1EEAD, "ن"},
+	{0x1EEAE, "س"},
+	{0x1EEAF, "ع"},
+	{0x1EEB0, "ف"},
+	{0x1EEB1, "ص"},
+	{0x1EEB2, "ق"},
+	{0x1EEB3, "ر"},
+	{0x1EEB4, "ش"},
+	{0x1EEB5, "ت"},
+	{0x1EEB6, "ث"},
+	{0x1EEB7, "خ"},
+	{0x1EEB8, "ذ"},
+	{0x1EEB9, "ض"},
+	{0x1EEBA, "ظ"},
+	{0x1EEBB, "غ"},
+	{0x1F101, "0,"},
+	{0x1F102, "1,"},
+	{0x1F103, "2,"},
+	{0x1F104, "3,"},
+	{0x1F105, "4,"},
+	{0x1F106, "5,"},
+	{0x1F107, "6,"},
+	{0x1F108, "7,"},
+	{0x1F109, "8,"},
+	{0x1F10A, "9,"},
+	{0x1F110, "(a)"},
+	{0x1F111, "(b)"},
+	{0x1F112, "(c)"},
+	{0x1F113, "(d)"},
+	{0x1F114, "(e)"},
+	{0x1F115, "(f)"},
+	{0x1F116, "(g)"},
+	{0x1F117, "(h)"},
+	{0x1F118, "(i)"},
+	{0x1F119, "(j)"},
+	{0x1F11A, "(k)"},
+	{0x1F11B, "(l)"},
+	{0x1F11C, "(m)"},
+	{0x1F11D, "(n)"},
+	{0x1F11E, "(o)"},
+	{0x1F11F, "(p)"},
+	{0x1F120, "(q)"},
+	{0x1F121, "(r)"},
+	{0x1F122, "(s)"},
+	{0x1F123, "(t)"},
+	{0x1F124, "(u)"},
+	{0x1F125, "(v)"},
+	{0x1F126, "(w)"},
+	{0x1F127, "(x)"},
+	{0x1F128, "(y)"},
+	{0x1F129, "(z)"},
+	{0x1F12A, "〔s〕"},
+	{0x1F12B, "c"},
+	{0x1F12C, "r"},
+	{0x1F12D, "cd"},
+	{0x1F12E, "wz"},
+	{0x1F130, "a"},
+	{0x1F131, "b"},
+	{0x1F132, "c"},
+	{0x1F133, "d"},
+	{0x1F134, "e"},
+	{0x1F135, "f"},
+	{0x1F136, "g"},
+	{0x1F137, "h"},
+	{0x1F138, "i"},
+	{0x1F139, "j"},
+	{0x1F13A, "k"},
+	{0x1F13B, "l"},
+	{0x1F13C, "m"},
+	{0x1F13D, "n"},
+	{0x1F13E, "o"},
+	{0x1F13F, "p"},
+	{0x1F140, "q"},
+	{0x1F141, "r"},
+	{0x1F142, "s"},
+	{0x1F143, "t"},
+	{0x1F144, "u"},
+	{0x1F145, "v"},
+	{0x1F146, "w"},
+	{0x1F147, "x"},
+	{0x1F148, "y"},
+	{0x1F149, "z"},
+	{0x1F14A, "hv"},
+	{0x1F14B, "mv"},
+	{0x1F14C, "sd"},
+	{0x1F14D, "ss"},
+	{0x1F14E, "ppv"},
+	{0x1F14F, "wc"},
+	{0x1F16A, "mc"},
+	{0x1F16B, "md"},
+	{0x1F16C, "mr"},
+	{0x1F190, "dj"},
+	{0x1F200, "ほか"},
+	{0x1F201, "ココ"},
+	{0x1F202, "サ"},
+	{0x1F210, "手"},
+	{0x1F211, "字"},
+	{0x1F212, "双"},
+	{0x1F213, "デ"},
+	{0x1F214, "二"},
+	{0x1F215, "多"},
+	{0x1F216, "解"},
+	{0x1F217, "天"},
+	{0x1F218, "交"},
+	{0x1F219, "映"},
+	{0x1F21A, "無"},
+	{0x1F21B, "料"},
+	{0x1F21C, "前"},
+	{0x1F21D, "後"},
+	{0x1F21E, "再"},
+	{0x1F21F, "新"},
+	{0x1F220, "初"},
+	{0x1F221, "終"},
+	{0x1F222, "生"},
+	{0x1F223, "販"},
+	{0x1F224, "声"},
+	{0x1F225, "吹"},
+	{0x1F226, "演"},
+	{0x1F227, "投"},
+	{0x1F228, "捕"},
+	{0x1F229, "一"},
+	{0x1F22A, "三"},
+	{0x1F22B, "遊"},
+	{0x1F22C, "左"},
+	{0x1F22D, "中"},
+	{0x1F22E, "右"},
+	{0x1F22F, "指"},
+	{0x1F230, "走"},
+	{0x1F231, "打"},
+	{0x1F232, "禁"},
+	{0x1F233, "空"},
+	{0x1F234, "合"},
+	{0x1F235, "満"},
+	{0x1F236, "有"},
+	{0x1F237, "月"},
+	{0x1F238, "申"},
+	{0x1F239, "割"},
+	{0x1F23A, "営"},
+	{0x1F23B, "配"},
+	{0x1F240, "〔本〕"},
+	{0x1F241, "〔三〕"},
+	{0x1F242, "〔二〕"},
+	{0x1F243, "〔安〕"},
+	{0x1F244, "〔点〕"},
+	{0x1F245, "〔打〕"},
+	{0x1F246, "〔盗〕"},
+	{0x1F247, "〔勝〕"},
+	{0x1F248, "〔敗〕"},
+	{0x1F250, "得"},
+	{0x1F251, "可"},
+	{0x1FBF0, "0"},
+	{0x1FBF1, "1"},
+	{0x1FBF2, "2"},
+	{0x1FBF3, "3"},
+	{0x1FBF4, "4"},
+	{0x1FBF5, "5"},
+	{0x1FBF6, "6"},
+	{0x1FBF7, "7"},
+	{0x1FBF8, "8"},
+	{0x1FBF9, "9"},
+	{0x2F800, "丽"},
+	{0x2F801, "丸"},
+	{0x2F802, "乁"},
+	{0x2F803, "𠄢"},
+	{0x2F804, "你"},
+	{0x2F805, "侮"},
+	{0x2F806, "侻"},
+	{0x2F807, "倂"},
+	{0x2F808, "偺"},
+	{0x2F809, "備"},
+	{0x2F80A, "僧"},
+	{0x2F80B, "像"},
+	{0x2F80C, "㒞"},
+	{0x2F80D, "𠘺"},
+	{0x2F80E, "免"},
+	{0x2F80F, "兔"},
+	{0x2F810, "兤"},
+	{0x2F811, "具"},
+	{0x2F812, "𠔜"},
+	{0x2F813, "㒹"},
+	{0x2F814, "內"},
+	{0x2F815, "再"},
+	{0x2F816, "𠕋"},
+	{0x2F817, "冗"},
+	{0x2F818, "冤"},
+	{0x2F819, "仌"},
+	{0x2F81A, "冬"},
+	{0x2F81B, "况"},
+	{0x2F81C, "𩇟"},
+	{0x2F81D, "凵"},
+	{0x2F81E, "刃"},
+	{0x2F81F, "㓟"},
+	{0x2F820, "刻"},
+	{0x2F821, "剆"},
+	{0x2F822, "割"},
+	{0x2F823, "剷"},
+	{0x2F824, "㔕"},
+	{0x2F825, "勇"},
+	{0x2F826, "勉"},
+	{0x2F827, "勤"},
+	{0x2F828, "勺"},
+	{0x2F829, "包"},
+	{0x2F82A, "匆"},
+	{0x2F82B, "北"},
+	{0x2F82C, "卉"},
+	{0x2F82D, "卑"},
+	{0x2F82E, "博"},
+	{0x2F82F, "即"},
+	{0x2F830, "卽"},
+	{0x2F831, "卿"},
+	{0x2F832, "卿"},
+	{0x2F833, "卿"},
+	{0x2F834, "𠨬"},
+	{0x2F835, "灰"},
+	{0x2F836, "及"},
+	{0x2F837, "叟"},
+	{0x2F838, "𠭣"},
+	{0x2F839, "叫"},
+	{0x2F83A, "叱"},
+	{0x2F83B, "吆"},
+	{0x2F83C, "咞"},
+	{0x2F83D, "吸"},
+	{0x2F83E, "呈"},
+	{0x2F83F, "周"},
+	{0x2F840, "咢"},
+	{0x2F841, "哶"},
+	{0x2F842, "唐"},
+	{0x2F843, "啓"},
+	{0x2F844, "啣"},
+	{0x2F845, "善"},
+	{0x2F846, "善"},
+	{0x2F847, "喙"},
+	{0x2F848, "喫"},
+	{0x2F849, "喳"},
+	{0x2F84A, "嗂"},
+	{0x2F84B, "圖"},
+	{0x2F84C, "嘆"},
+	{0x2F84D, "圗"},
+	{0x2F84E, "噑"},
+	{0x2F84F, "噴"},
+	{0x2F850, "切"},
+	{0x2F851, "壮"},
+	{0x2F852, "城"},
+	{0x2F853, "埴"},
+	{0x2F854, "堍"},
+	{0x2F855, "型"},
+	{0x2F856, "堲"},
+	{0x2F857, "報"},
+	{0x2F858, "墬"},
+	{0x2F859, "𡓤"},
+	{0x2F85A, "売"},
+	{0x2F85B, "壷"},
+	{0x2F85C, "夆"},
+	{0x2F85D, "多"},
+	{0x2F85E, "夢"},
+	{0x2F85F, "奢"},
+	{0x2F860, "𡚨"},
+	{0x2F861, "𡛪"},
+	{0x2F862, "姬"},
+	{0x2F863, "娛"},
+	{0x2F864, "娧"},
+	{0x2F865, "姘"},
+	{0x2F866, "婦"},
+	{0x2F867, "㛮"},
+	{0x2F869, "嬈"},
+	{0x2F86A, "嬾"},
+	{0x2F86B, "嬾"},
+	{0x2F86C, "𡧈"},
+	{0x2F86D, "寃"},
+	{0x2F86E, "寘"},
+	{0x2F86F, "寧"},
+	{0x2F870, "寳"},
+	{0x2F871, "𡬘"},
+	{0x2F872, "寿"},
+	{0x2F873, "将"},
+	{0x2F875, "尢"},
+	{0x2F876, "㞁"},
+	{0x2F877, "屠"},
+	{0x2F878, "屮"},
+	{0x2F879, "峀"},
+	{0x2F87A, "岍"},
+	{0x2F87B, "𡷤"},
+	{0x2F87C, "嵃"},
+	{0x2F87D, "𡷦"},
+	{0x2F87E, "嵮"},
+	{0x2F87F, "嵫"},
+	{0x2F880, "嵼"},
+	{0x2F881, "巡"},
+	{0x2F882, "巢"},
+	{0x2F883, "㠯"},
+	{0x2F884, "巽"},
+	{0x2F885, "帨"},
+	{0x2F886, "帽"},
+	{0x2F887, "幩"},
+	{0x2F888, "㡢"},
+	{0x2F889, "𢆃"},
+	{0x2F88A, "㡼"},
+	{0x2F88B, "庰"},
+	{0x2F88C, "庳"},
+	{0x2F88D, "庶"},
+	{0x2F88E, "廊"},
+	{0x2F88F, "𪎒"},
+	{0x2F890, "廾"},
+	{0x2F891, "𢌱"},
+	{0x2F892, "𢌱"},
+	{0x2F893, "舁"},
+	{0x2F894, "弢"},
+	{0x2F895, "弢"},
+	{0x2F896, "㣇"},
+	{0x2F897, "𣊸"},
+	{0x2F898, "𦇚"},
+	{0x2F899, "形"},
+	{0x2F89A, "彫"},
+	{0x2F89B, "㣣"},
+	{0x2F89C, "徚"},
+	{0x2F89D, "忍"},
+	{0x2F89E, "志"},
+	{0x2F89F, "忹"},
+	{0x2F8A0, "悁"},
+	{0x2F8A1, "㤺"},
+	{0x2F8A2, "㤜"},
+	{0x2F8A3, "悔"},
+	{0x2F8A4, "𢛔"},
+	{0x2F8A5, "惇"},
+	{0x2F8A6, "慈"},
+	{0x2F8A7, "慌"},
+	{0x2F8A8, "慎"},
+	{0x2F8A9, "慌"},
+	{0x2F8AA, "慺"},
+	{0x2F8AB, "憎"},
+	{0x2F8AC, "憲"},
+	{0x2F8AD, "憤"},
+	{0x2F8AE, "憯"},
+	{0x2F8AF, "懞"},
+	{0x2F8B0, "懲"},
+	{0x2F8B1, "懶"},
+	{0x2F8B2, "成"},
+	{0x2F8B3, "戛"},
+	{0x2F8B4, "扝"},
+	{0x2F8B5, "抱"},
+	{0x2F8B6, "拔"},
+	{0x2F8B7, "捐"},
+	{0x2F8B8, "𢬌"},
+	{0x2F8B9, "挽"},
+	{0x2F8BA, "拼"},
+	{0x2F8BB, "捨"},
+	{0x2F8BC, "掃"},
+	{0x2F8BD, "揤"},
+	{0x2F8BE, "𢯱"},
+	{0x2F8BF, "搢"},
+	{0x2F8C0, "揅"},
+	{0x2F8C1, "掩"},
+	{0x2F8C2, "㨮"},
+	{0x2F8C3, "摩"},
+	{0x2F8C4, "摾"},
+	{0x2F8C5, "撝"},
+	{0x2F8C6, "摷"},
+	{0x2F8C7, "㩬"},
+	{0x2F8C8, "敏"},
+	{0x2F8C9, "敬"},
+	{0x2F8CA, "𣀊"},
+	{0x2F8CB, "旣"},
+	{0x2F8CC, "書"},
+	{0x2F8CD, "晉"},
+	{0x2F8CE, "㬙"},
+	{0x2F8CF, "暑"},
+	{0x2F8D0, "㬈"},
+	{0x2F8D1, "㫤"},
+	{0x2F8D2, "冒"},
+	{0x2F8D3, "冕"},
+	{0x2F8D4, "最"},
+	{0x2F8D5, "暜"},
+	{0x2F8D6, "肭"},
+	{0x2F8D7, "䏙"},
+	{0x2F8D8, "朗"},
+	{0x2F8D9, "望"},
+	{0x2F8DA, "朡"},
+	{0x2F8DB, "杞"},
+	{0x2F8DC, "杓"},
+	{0x2F8DD, "𣏃"},
+	{0x2F8DE, "㭉"},
+	{0x2F8DF, "柺"},
+	{0x2F8E0, "枅"},
+	{0x2F8E1, "桒"},
+	{0x2F8E2, "梅"},
+	{0x2F8E3, "𣑭"},
+	{0x2F8E4, "梎"},
+	{0x2F8E5, "栟"},
+	{0x2F8E6, "椔"},
+	{0x2F8E7, "㮝"},
+	{0x2F8E8, "楂"},
+	{0x2F8E9, "榣"},
+	{0x2F8EA, "槪"},
+	{0x2F8EB, "檨"},
+	{0x2F8EC, "𣚣"},
+	{0x2F8ED, "櫛"},
+	{0x2F8EE, "㰘"},
+	{0x2F8EF, "次"},
+	{0x2F8F0, "𣢧"},
+	{0x2F8F1, "歔"},
+	{0x2F8F2, "㱎"},
+	{0x2F8F3, "歲"},
+	{0x2F8F4, "殟"},
+	{0x2F8F5, "殺"},
+	{0x2F8F6, "殻"},
+	{0x2F8F7, "𣪍"},
+	{0x2F8F8, "𡴋"},
+	{0x2F8F9, "𣫺"},
+	{0x2F8FA, "汎"},
+	{0x2F8FB, "𣲼"},
+	{0x2F8FC, "沿"},
+	{0x2F8FD, "泍"},
+	{0x2F8FE, "汧"},
+	{0x2F8FF, "洖"},
+	{0x2F900, "派"},
+	{0x2F901, "海"},
+	{0x2F902, "流"},
+	{0x2F903, "浩"},
+	{0x2F904, "浸"},
+	{0x2F905, "涅"},
+	{0x2F906, "𣴞"},
+	{0x2F907, "洴"},
+	{0x2F908, "港"},
+	{0x2F909, "湮"},
+	{0x2F90A, "㴳"},
+	{0x2F90B, "滋"},
+	{0x2F90C, "滇"},
+	{0x2F90D, "𣻑"},
+	{0x2F90E, "淹"},
+	{0x2F90F, "潮"},
+	{0x2F910, "𣽞"},
+	{0x2F911, "𣾎"},
+	{0x2F912, "濆"},
+	{0x2F913, "瀹"},
+	{0x2F914, "瀞"},
+	{0x2F915, "瀛"},
+	{0x2F916, "㶖"},
+	{0x2F917, "灊"},
+	{0x2F918, "災"},
+	{0x2F919, "灷"},
+	{0x2F91A, "炭"},
+	{0x2F91B, "𠔥"},
+	{0x2F91C, "煅"},
+	{0x2F91D, "𤉣"},
+	{0x2F91E, "熜"},
+	{0x2F920, "爨"},
+	{0x2F921, "爵"},
+	{0x2F922, "牐"},
+	{0x2F923, "𤘈"},
+	{0x2F924, "犀"},
+	{0x2F925, "犕"},
+	{0x2F926, "𤜵"},
+	{0x2F927, "𤠔"},
+	{0x2F928, "獺"},
+	{0x2F929, "王"},
+	{0x2F92A, "㺬"},
+	{0x2F92B, "玥"},
+	{0x2F92C, "㺸"},
+	{0x2F92D, "㺸"},
+	{0x2F92E, "瑇"},
+	{0x2F92F, "瑜"},
+	{0x2F930, "瑱"},
+	{0x2F931, "璅"},
+	{0x2F932, "瓊"},
+	{0x2F933, "㼛"},
+	{0x2F934, "甤"},
+	{0x2F935, "𤰶"},
+	{0x2F936, "甾"},
+	{0x2F937, "𤲒"},
+	{0x2F938, "異"},
+	{0x2F939, "𢆟"},
+	{0x2F93A, "瘐"},
+	{0x2F93B, "𤾡"},
+	{0x2F93C, "𤾸"},
+	{0x2F93D, "𥁄"},
+	{0x2F93E, "㿼"},
+	{0x2F93F, "䀈"},
+	{0x2F940, "直"},
+	{0x2F941, "𥃳"},
+	{0x2F942, "𥃲"},
+	{0x2F943, "𥄙"},
+	{0x2F944, "𥄳"},
+	{0x2F945, "眞"},
+	{0x2F946, "真"},
+	{0x2F947, "真"},
+	{0x2F948, "睊"},
+	{0x2F949, "䀹"},
+	{0x2F94A, "瞋"},
+	{0x2F94B, "䁆"},
+	{0x2F94C, "䂖"},
+	{0x2F94D, "𥐝"},
+	{0x2F94E, "硎"},
+	{0x2F94F, "碌"},
+	{0x2F950, "磌"},
+	{0x2F951, "䃣"},
+	{0x2F952, "𥘦"},
+	{0x2F953, "祖"},
+	{0x2F954, "𥚚"},
+	{0x2F955, "𥛅"},
+	{0x2F956, "福"},
+	{0x2F957, "秫"},
+	{0x2F958, "䄯"},
+	{0x2F959, "穀"},
+	{0x2F95A, "穊"},
+	{0x2F95B, "穏"},
+	{0x2F95C, "𥥼"},
+	{0x2F95D, "𥪧"},
+	{0x2F95E, "𥪧"},
+	{0x2F960, "䈂"},
+	{0x2F961, "𥮫"},
+	{0x2F962, "篆"},
+	{0x2F963, "築"},
+	{0x2F964, "䈧"},
+	{0x2F965, "𥲀"},
+	{0x2F966, "糒"},
+	{0x2F967, "䊠"},
+	{0x2F968, "糨"},
+	{0x2F969, "糣"},
+	{0x2F96A, "紀"},
+	{0x2F96B, "𥾆"},
+	{0x2F96C, "絣"},
+	{0x2F96D, "䌁"},
+	{0x2F96E, "緇"},
+	{0x2F96F, "縂"},
+	{0x2F970, "繅"},
+	{0x2F971, "䌴"},
+	{0x2F972, "𦈨"},
+	{0x2F973, "𦉇"},
+	{0x2F974, "䍙"},
+	{0x2F975, "𦋙"},
+	{0x2F976, "罺"},
+	{0x2F977, "𦌾"},
+	{0x2F978, "羕"},
+	{0x2F979, "翺"},
+	{0x2F97A, "者"},
+	{0x2F97B, "𦓚"},
+	{0x2F97C, "𦔣"},
+	{0x2F97D, "聠"},
+	{0x2F97E, "𦖨"},
+	{0x2F97F, "聰"},
+	{0x2F980, "𣍟"},
+	{0x2F981, "䏕"},
+	{0x2F982, "育"},
+	{0x2F983, "脃"},
+	{0x2F984, "䐋"},
+	{0x2F985, "脾"},
+	{0x2F986, "媵"},
+	{0x2F987, "𦞧"},
+	{0x2F988, "𦞵"},
+	{0x2F989, "𣎓"},
+	{0x2F98A, "𣎜"},
+	{0x2F98B, "舁"},
+	{0x2F98C, "舄"},
+	{0x2F98D, "辞"},
+	{0x2F98E, "䑫"},
+	{0x2F98F, "芑"},
+	{0x2F990, "芋"},
+	{0x2F991, "芝"},
+	{0x2F992, "劳"},
+	{0x2F993, "花"},
+	{0x2F994, "芳"},
+	{0x2F995, "芽"},
+	{0x2F996, "苦"},
+	{0x2F997, "𦬼"},
+	{0x2F998, "若"},
+	{0x2F999, "茝"},
+	{0x2F99A, "荣"},
+	{0x2F99B, "莭"},
+	{0x2F99C, "茣"},
+	{0x2F99D, "莽"},
+	{0x2F99E, "菧"},
+	{0x2F99F, "著"},
+	{0x2F9A0, "荓"},
+	{0x2F9A1, "菊"},
+	{0x2F9A2, "菌"},
+	{0x2F9A3, "菜"},
+	{0x2F9A4, "𦰶"},
+	{0x2F9A5, "𦵫"},
+	{0x2F9A6, "𦳕"},
+	{0x2F9A7, "䔫"},
+	{0x2F9A8, "蓱"},
+	{0x2F9A9, "蓳"},
+	{0x2F9AA, "蔖"},
+	{0x2F9AB, "𧏊"},
+	{0x2F9AC, "蕤"},
+	{0x2F9AD, "𦼬"},
+	{0x2F9AE, "䕝"},
+	{0x2F9AF, "䕡"},
+	{0x2F9B0, "𦾱"},
+	{0x2F9B1, "𧃒"},
+	{0x2F9B2, "䕫"},
+	{0x2F9B3, "虐"},
+	{0x2F9B4, "虜"},
+	{0x2F9B5, "虧"},
+	{0x2F9B6, "虩"},
+	{0x2F9B7, "蚩"},
+	{0x2F9B8, "蚈"},
+	{0x2F9B9, "蜎"},
+	{0x2F9BA, "蛢"},
+	{0x2F9BB, "蝹"},
+	{0x2F9BC, "蜨"},
+	{0x2F9BD, "蝫"},
+	{0x2F9BE, "螆"},
+	{0x2F9C0, "蟡"},
+	{0x2F9C1, "蠁"},
+	{0x2F9C2, "䗹"},
+	{0x2F9C3, "衠"},
+	{0x2F9C4, "衣"},
+	{0x2F9C5, "𧙧"},
+	{0x2F9C6, "裗"},
+	{0x2F9C7, "裞"},
+	{0x2F9C8, "䘵"},
+	{0x2F9C9, "裺"},
+	{0x2F9CA, "㒻"},
+	{0x2F9CB, "𧢮"},
+	{0x2F9CC, "𧥦"},
+	{0x2F9CD, "䚾"},
+	{0x2F9CE, "䛇"},
+	{0x2F9CF, "誠"},
+	{0x2F9D0, "諭"},
+	{0x2F9D1, "變"},
+	{0x2F9D2, "豕"},
+	{0x2F9D3, "𧲨"},
+	{0x2F9D4, "貫"},
+	{0x2F9D5, "賁"},
+	{0x2F9D6, "贛"},
+	{0x2F9D7, "起"},
+	{0x2F9D8, "𧼯"},
+	{0x2F9D9, "𠠄"},
+	{0x2F9DA, "跋"},
+	{0x2F9DB, "趼"},
+	{0x2F9DC, "跰"},
+	{0x2F9DD, "𠣞"},
+	{0x2F9DE, "軔"},
+	{0x2F9DF, "輸"},
+	{0x2F9E0, "𨗒"},
+	{0x2F9E1, "𨗭"},
+	{0x2F9E2, "邔"},
+	{0x2F9E3, "郱"},
+	{0x2F9E4, "鄑"},
+	{0x2F9E5, "𨜮"},
+	{0x2F9E6, "鄛"},
+	{0x2F9E7, "鈸"},
+	{0x2F9E8, "鋗"},
+	{0x2F9E9, "鋘"},
+	{0x2F9EA, "鉼"},
+	{0x2F9EB, "鏹"},
+	{0x2F9EC, "鐕"},
+	{0x2F9ED, "𨯺"},
+	{0x2F9EE, "開"},
+	{0x2F9EF, "䦕"},
+	{0x2F9F0, "閷"},
+	{0x2F9F1, "𨵷"},
+	{0x2F9F2, "䧦"},
+	{0x2F9F3, "雃"},
+	{0x2F9F4, "嶲"},
+	{0x2F9F5, "霣"},
+	{0x2F9F6, "𩅅"},
+	{0x2F9F7, "𩈚"},
+	{0x2F9F8, "䩮"},
+	{0x2F9F9, "䩶"},
+	{0x2F9FA, "韠"},
+	{0x2F9FB, "𩐊"},
+	{0x2F9FC, "䪲"},
+	{0x2F9FD, "𩒖"},
+	{0x2F9FE, "頋"},
+	{0x2F9FF, "頋"},
+	{0x2FA00, "頩"},
+	{0x2FA01, "𩖶"},
+	{0x2FA02, "飢"},
+	{0x2FA03, "䬳"},
+	{0x2FA04, "餩"},
+	{0x2FA05, "馧"},
+	{0x2FA06, "駂"},
+	{0x2FA07, "駾"},
+	{0x2FA08, "䯎"},
+	{0x2FA09, "𩬰"},
+	{0x2FA0A, "鬒"},
+	{0x2FA0B, "鱀"},
+	{0x2FA0C, "鳽"},
+	{0x2FA0D, "䳎"},
+	{0x2FA0E, "䳭"},
+	{0x2FA0F, "鵧"},
+	{0x2FA10, "𪃎"},
+	{0x2FA11, "䳸"},
+	{0x2FA12, "𪄅"},
+	{0x2FA13, "𪈎"},
+	{0x2FA14, "𪊑"},
+	{0x2FA15, "麻"},
+	{0x2FA16, "䵖"},
+	{0x2FA17, "黹"},
+	{0x2FA18, "黾"},
+	{0x2FA19, "鼅"},
+	{0x2FA1A, "鼏"},
+	{0x2FA1B, "鼖"},
+	{0x2FA1C, "鼻"},
+	{0x2FA1D, "𪘀"},
+	{0xE0100, ""},
+	{0xE0101, ""},
+	{0xE0102, ""},
+	{0xE0103, ""},
+	{0xE0104, ""},
+	{0xE0105, ""},
+	{0xE0106, ""},
+	{0xE0107, ""},
+	{0xE0108, ""},
+	{0xE0109, ""},
+	{0xE010A, ""},
+	{0xE010B, ""},
+	{0xE010C, ""},
+	{0xE010D, ""},
+	{0xE010E, ""},
+	{0xE010F, ""},
+	{0xE0110, ""},
+	{0xE0111, ""},
+	{0xE0112, ""},
+	{0xE0113, ""},
+	{0xE0114, ""},
+	{0xE0115, ""},
+	{0xE0116, ""},
+	{0xE0117, ""},
+	{0xE0118, ""},
+	{0xE0119, ""},
+	{0xE011A, ""},
+	{0xE011B, ""},
+	{0xE011C, ""},
+	{0xE011D, ""},
+	{0xE011E, ""},
+	{0xE011F, ""},
+	{0xE0120, ""},
+	{0xE0121, ""},
+	{0xE0122, ""},
+	{0xE0123, ""},
+	{0xE0124, ""},
+	{0xE0125, ""},
+	{0xE0126, ""},
+	{0xE0127, ""},
+	{0xE0128, ""},
+	{0xE0129, ""},
+	{0xE012A, ""},
+	{0xE012B, ""},
+	{0xE012C, ""},
+	{0xE012D, ""},
+	{0xE012E, ""},
+	{0xE012F, ""},
+	{0xE0130, ""},
+	{0xE0131, ""},
+	{0xE0132, ""},
+	{0xE0133, ""},
+	{0xE0134, ""},
+	{0xE0135, ""},
+	{0xE0136, ""},
+	{0xE0137, ""},
+	{0xE0138, ""},
+	{0xE0139, ""},
+	{0xE013A, ""},
+	{0xE013B, ""},
+	{0xE013C, ""},
+	{0xE013D, ""},
+	{0xE013E, ""},
+	{0xE013F, ""},
+	{0xE0140, ""},
+	{0xE0141, ""},
+	{0xE0142, ""},
+	{0xE0143, ""},
+	{0xE0144, ""},
+	{0xE0145, ""},
+	{0xE0146, ""},
+	{0xE0147, ""},
+	{0xE0148, ""},
+	{0xE0149, ""},
+	{0xE014A, ""},
+	{0xE014B, ""},
+	{0xE014C, ""},
+	{0xE014D, ""},
+	{0xE014E, ""},
+	{0xE014F, ""},
+	{0xE0150, ""},
+	{0xE0151, ""},
+	{0xE0152, ""},
+	{0xE0153, ""},
+	{0xE0154, ""},
+	{0xE0155, ""},
+	{0xE0156, ""},
+	{0xE0157, ""},
+	{0xE0158, ""},
+	{0xE0159, ""},
+	{0xE015A, ""},
+	{0xE015B, ""},
+	{0xE015C, ""},
+	{0xE015D, ""},
+	{0xE015E, ""},
+	{0xE015F, ""},
+	{0xE0160, ""},
+	{0xE0161, ""},
+	{0xE0162, ""},
+	{0xE0163, ""},
+	{0xE0164, ""},
+	{0xE0165, ""},
+	{0xE0166, ""},
+	{0xE0167, ""},
+	{0xE0168, ""},
+	{0xE0169, ""},
+	{0xE016A, ""},
+	{0xE016B, ""},
+	{0xE016C, ""},
+	{0xE016D, ""},
+	{0xE016E, ""},
+	{0xE016F, ""},
+	{0xE0170, ""},
+	{0xE0171, ""},
+	{0xE0172, ""},
+	{0xE0173, ""},
+	{0xE0174, ""},
+	{0xE0175, ""},
+	{0xE0176, ""},
+	{0xE0177, ""},
+	{0xE0178, ""},
+	{0xE0179, ""},
+	{0xE017A, ""},
+	{0xE017B, ""},
+	{0xE017C, ""},
+	{0xE017D, ""},
+	{0xE017E, ""},
+	{0xE017F, ""},
+	{0xE0180, ""},
+	{0xE0181, ""},
+	{0xE0182, ""},
+	{0xE0183, ""},
+	{0xE0184, ""},
+	{0xE0185, ""},
+	{0xE0186, ""},
+	{0xE0187, ""},
+	{0xE0188, ""},
+	{0xE0189, ""},
+	{0xE018A, ""},
+	{0xE018B, ""},
+	{0xE018C, ""},
+	{0xE018D, ""},
+	{0xE018E, ""},
+	{0xE018F, ""},
+	{0xE0190, ""},
+	{0xE0191, ""},
+	{0xE0192, ""},
+	{0xE0193, ""},
+	{0xE0194, ""},
+	{0xE0195, ""},
+	{0xE0196, ""},
+	{0xE0197, ""},
+	{0xE0198, ""},
+	{0xE0199, ""},
+	{0xE019A, ""},
+	{0xE019B, ""},
+	{0xE019C, ""},
+	{0xE019D, ""},
+	{0xE019E, ""},
+	{0xE019F, ""},
+	{0xE01A0, ""},
+	{0xE01A1, ""},
+	{0xE01A2, ""},
+	{0xE01A3, ""},
+	{0xE01A4, ""},
+	{0xE01A5, ""},
+	{0xE01A6, ""},
+	{0xE01A7, ""},
+	{0xE01A8, ""},
+	{0xE01A9, ""},
+	{0xE01AA, ""},
+	{0xE01AB, ""},
+	{0xE01AC, ""},
+	{0xE01AD, ""},
+	{0xE01AE, ""},
+	{0xE01AF, ""},
+	{0xE01B0, ""},
+	{0xE01B1, ""},
+	{0xE01B2, ""},
+	{0xE01B3, ""},
+	{0xE01B4, ""},
+	{0xE01B5, ""},
+	{0xE01B6, ""},
+	{0xE01B7, ""},
+	{0xE01B8, ""},
+	{0xE01B9, ""},
+	{0xE01BA, ""},
+	{0xE01BB, ""},
+	{0xE01BC, ""},
+	{0xE01BD, ""},
+	{0xE01BE, ""},
+	{0xE01BF, ""},
+	{0xE01C0, ""},
+	{0xE01C1, ""},
+	{0xE01C2, ""},
+	{0xE01C3, ""},
+	{0xE01C4, ""},
+	{0xE01C5, ""},
+	{0xE01C6, ""},
+	{0xE01C7, ""},
+	{0xE01C8, ""},
+	{0xE01C9, ""},
+	{0xE01CA, ""},
+	{0xE01CB, ""},
+	{0xE01CC, ""},
+	{0xE01CD, ""},
+	{0xE01CE, ""},
+	{0xE01CF, ""},
+	{0xE01D0, ""},
+	{0xE01D1, ""},
+	{0xE01D2, ""},
+	{0xE01D3, ""},
+	{0xE01D4, ""},
+	{0xE01D5, ""},
+	{0xE01D6, ""},
+	{0xE01D7, ""},
+	{0xE01D8, ""},
+	{0xE01D9, ""},
+	{0xE01DA, ""},
+	{0xE01DB, ""},
+	{0xE01DC, ""},
+	{0xE01DD, ""},
+	{0xE01DE, ""},
+	{0xE01DF, ""},
+	{0xE01E0, ""},
+	{0xE01E1, ""},
+	{0xE01E2, ""},
+	{0xE01E3, ""},
+	{0xE01E4, ""},
+	{0xE01E5, ""},
+	{0xE01E6, ""},
+	{0xE01E7, ""},
+	{0xE01E8, ""},
+	{0xE01E9, ""},
+	{0xE01EA, ""},
+	{0xE01EB, ""},
+	{0xE01EC, ""},
+	{0xE01ED, ""},
+	{0xE01EE, ""},
+	{0xE01EF, ""},
+}
+
+// combiningClasses holds the ranges of non-zero canonical combining classes.
+var combiningClasses = []combiningClass{
+	{0x0300, 0x0314, 230},
+	{0x0315, 0x0315, 232},
+	{0x0316, 0x0319, 220},
+	{0x031A, 0x031A, 232},
+	{0x031B, 0x031B, 216},
+	{0x031C, 0x0320, 220},
+	{0x0321, 0x0322, 202},
+	{0x0323, 0x0326, 220},
+	{0x0327, 0x0328, 202},
+	{0x0329, 0x0333, 220},
+	{0x0334, 0x0338, 1},
+	{0x0339, 0x033C, 220},
+	{0x033D, 0x0344, 230},
+	{0x0345, 0x0345, 240},
+	{0x0346, 0x0346, 230},
+	{0x0347, 0x0349, 220},
+	{0x034A, 0x034C, 230},
+	{0x034D, 0x034E, 220},
+	{0x0350, 0x0352, 230},
+	{0x0353, 0x0356, 220},
+	{0x0357, 0x0357, 230},
+	{0x0358, 0x0358, 232},
+	{0x0359, 0x035A, 220},
+	{0x035B, 0x035B, 230},
+	{0x035C, 0x035C, 233},
+	{0x035D, 0x035E, 234},
+	{0x035F, 0x035F, 233},
+	{0x0360, 0x0361, 234},
+	{0x0362, 0x0362, 233},
+	{0x0363, 0x036F, 230},
+	{0x0483, 0x0487, 230},
+	{0x0591, 0x0591, 220},
+	{0x0592, 0x0595, 230},
+	{0x0596, 0x0596, 220},
+	{0x0597, 0x0599, 230},
+	{0x059A, 0x059A, 222},
+	{0x059B, 0x059B, 220},
+	{0x059C, 0x05A1, 230},
+	{0x05A2, 0x05A7, 220},
+	{0x05A8, 0x05A9, 230},
+	{0x05AA, 0x05AA, 220},
+	{0x05AB, 0x05AC, 230},
+	{0x05AD, 0x05AD, 222},
+	{0x05AE, 0x05AE, 228},
+	{0x05AF, 0x05AF, 230},
+	{0x05B0, 0x05B0, 10},
+	{0x05B1, 0x05B1, 11},
+	{0x05B2, 0x05B2, 12},
+	{0x05B3, 0x05B3, 13},
+	{0x05B4, 0x05B4, 14},
+	{0x05B5, 0x05B5, 15},
+	{0x05B6, 0x05B6, 16},
+	{0x05B7, 0x05B7, 17},
+	{0x05B8, 0x05B8, 18},
+	{0x05B9, 0x05BA, 19},
+	{0x05BB, 0x05BB, 20},
+	{0x05BC, 0x05BC, 21},
+	{0x05BD, 0x05BD, 22},
+	{0x05BF, 0x05BF, 23},
+	{0x05C1, 0x05C1, 24},
+	{0x05C2, 0x05C2, 25},
+	{0x05C4, 0x05C4, 230},
+	{0x05C5, 0x05C5, 220},
+	{0x05C7, 0x05C7, 18},
+	{0x0610, 0x0617, 230},
+	{0x0618, 0x0618, 30},
+	{0x0619, 0x0619, 31},
+	{0x061A, 0x061A, 32},
+	{0x064B, 0x064B, 27},
+	{0x064C, 0x064C, 28},
+	{0x064D, 0x064D, 29},
+	{0x064E, 0x064E, 30},
+	{0x064F, 0x064F, 31},
+	{0x0650, 0x0650, 32},
+	{0x0651, 0x0651, 33},
+	{0x0652, 0x0652, 34},
+	{0x0653, 0x0654, 230},
+	{0x0655, 0x0656, 220},
+	{0x0657, 0x065B, 230},
+	{0x065C, 0x065C, 220},
+	{0x065D, 0x065E, 230},
+	{0x065F, 0x065F, 220},
+	{0x0670, 0x0670, 35},
+	{0x06D6, 0x06DC, 230},
+	{0x06DF, 0x06E2, 230},
+	{0x06E3, 0x06E3, 220},
+	{0x06E4, 0x06E4, 230},
+	{0x06E7, 0x06E8, 230},
+	{0x06EA, 0x06EA, 220},
+	{0x06EB, 0x06EC, 230},
+	{0x06ED, 0x06ED, 220},
+	{0x0711, 0x0711, 36},
+	{0x0730, 0x0730, 230},
+	{0x0731, 0x0731, 220},
+	{0x0732, 0x0733, 230},
+	{0x0734, 0x0734, 220},
+	{0x0735, 0x0736, 230},
+	{0x0737, 0x0739, 220},
+	{0x073A, 0x073A, 230},
+	{0x073B, 0x073C, 220},
+	{0x073D, 0x073D, 230},
+	{0x073E, 0x073E, 220},
+	{0x073F, 0x0741, 230},
+	{0x0742, 0x0742, 220},
+	{0x0743, 0x0743, 230},
+	{0x0744, 0x0744, 220},
+	{0x0745, 0x0745, 230},
+	{0x0746, 0x0746, 220},
+	{0x0747, 0x0747, 230},
+	{0x0748, 0x0748, 220},
+	{0x0749, 0x074A, 230},
+	{0x07EB, 0x07F1, 230},
+	{0x07F2, 0x07F2, 220},
+	{0x07F3, 0x07F3, 230},
+	{0x07FD, 0x07FD, 220},
+	{0x0816, 0x0819, 230},
+	{0x081B, 0x0823, 230},
+	{0x0825, 0x0827, 230},
+	{0x0829, 0x082D, 230},
+	{0x0859, 0x085B, 220},
+	{0x0898, 0x0898, 230},
+	{0x0899, 0x089B, 220},
+	{0x089C, 0x089F, 230},
+	{0x08CA, 0x08CE, 230},
+	{0x08CF, 0x08D3, 220},
+	{0x08D4, 0x08E1, 230},
+	{0x08E3, 0x08E3, 220},
+	{0x08E4, 0x08E5, 230},
+	{0x08E6, 0x08E6, 220},
+	{0x08E7, 0x08E8, 230},
+	{0x08E9, 0x08E9, 220},
+	{0x08EA, 0x08EC, 230},
+	{0x08ED, 0x08EF, 220},
+	{0x08F0, 0x08F0, 27},
+	{0x08F1, 0x08F1, 28},
+	{0x08F2, 0x08F2, 29},
+	{0x08F3, 0x08F5, 230},
+	{0x08F6, 0x08F6, 220},
+	{0x08F7, 0x08F8, 230},
+	{0x08F9, 0x08FA, 220},
+	{0x08FB, 0x08FF, 230},
+	{0x093C, 0x093C, 7},
+	{0x094D, 0x094D, 9},
+	{0x0951, 0x0951, 230},
+	{0x0952, 0x0952, 220},
+	{0x0953, 0x0954, 230},
+	{0x09BC, 0x09BC, 7},
+	{0x09CD, 0x09CD, 9},
+	{0x09FE, 0x09FE, 230},
+	{0x0A3C, 0x0A3C, 7},
+	{0x0A4D, 0x0A4D, 9},
+	{0x0ABC, 0x0ABC, 7},
+	{0x0ACD, 0x0ACD, 9},
+	{0x0B3C, 0x0B3C, 7},
+	{0x0B4D, 0x0B4D, 9},
+	{0x0BCD, 0x0BCD, 9},
+	{0x0C3C, 0x0C3C, 7},
+	{0x0C4D, 0x0C4D, 9},
+	{0x0C55, 0x0C55, 84},
+	{0x0C56, 0x0C56, 91},
+	{0x0CBC, 0x0CBC, 7},
+	{0x0CCD, 0x0CCD, 9},
+	{0x0D3B, 0x0D3C, 9},
+	{0x0D4D, 0x0D4D, 9},
+	{0x0DCA, 0x0DCA, 9},
+	{0x0E38, 0x0E39, 103},
+	{0x0E3A, 0x0E3A, 9},
+	{0x0E48, 0x0E4B, 107},
+	{0x0EB8, 0x0EB9, 118},
+	{0x0EBA, 0x0EBA, 9},
+	{0x0EC8, 0x0ECB, 122},
+	{0x0F18, 0x0F19, 220},
+	{0x0F35, 0x0F35, 220},
+	{0x0F37, 0x0F37, 220},
+	{0x0F39, 0x0F39, 216},
+	{0x0F71, 0x0F71, 129},
+	{0x0F72, 0x0F72, 130},
+	{0x0F74, 0x0F74, 132},
+	{0x0F7A, 0x0F7D, 130},
+	{0x0F80, 0x0F80, 130},
+	{0x0F82, 0x0F83, 230},
+	{0x0F84, 0x0F84, 9},
+	{0x0F86, 0x0F87, 230},
+	{0x0FC6, 0x0FC6, 220},
+	{0x1037, 0x1037, 7},
+	{0x1039, 0x103A, 9},
+	{0x108D, 0x108D, 220},
+	{0x135D, 0x135F, 230},
+	{0x1714, 0x1715, 9},
+	{0x1734, 0x1734, 9},
+	{0x17D2, 0x17D2, 9},
+	{0x17DD, 0x17DD, 230},
+	{0x18A9, 0x18A9, 228},
+	{0x1939, 0x1939, 222},
+	{0x193A, 0x193A, 230},
+	{0x193B, 0x193B, 220},
+	{0x1A17, 0x1A17, 230},
+	{0x1A18, 0x1A18, 220},
+	{0x1A60, 0x1A60, 9},
+	{0x1A75, 0x1A7C, 230},
+	{0x1A7F, 0x1A7F, 220},
+	{0x1AB0, 0x1AB4, 230},
+	{0x1AB5, 0x1ABA, 220},
+	{0x1ABB, 0x1ABC, 230},
+	{0x1ABD, 0x1ABD, 220},
+	{0x1ABF, 0x1AC0, 220},
+	{0x1AC1, 0x1AC2, 230},
+	{0x1AC3, 0x1AC4, 220},
+	{0x1AC5, 0x1AC9, 230},
+	{0x1ACA, 0x1ACA, 220},
+	{0x1ACB, 0x1ACE, 230},
+	{0x1B34, 0x1B34, 7},
+	{0x1B44, 0x1B44, 9},
+	{0x1B6B, 0x1B6B, 230},
+	{0x1B6C, 0x1B6C, 220},
+	{0x1B6D, 0x1B73, 230},
+	{0x1BAA, 0x1BAB, 9},
+	{0x1BE6, 0x1BE6, 7},
+	{0x1BF2, 0x1BF3, 9},
+	{0x1C37, 0x1C37, 7},
+	{0x1CD0, 0x1CD2, 230},
+	{0x1CD4, 0x1CD4, 1},
+	{0x1CD5, 0x1CD9, 220},
+	{0x1CDA, 0x1CDB, 230},
+	{0x1CDC, 0x1CDF, 220},
+	{0x1CE0, 0x1CE0, 230},
+	{0x1CE2, 0x1CE8, 1},
+	{0x1CED, 0x1CED, 220},
+	{0x1CF4, 0x1CF4, 230},
+	{0x1CF8, 0x1CF9, 230},
+	{0x1DC0, 0x1DC1, 230},
+	{0x1DC2, 0x1DC2, 220},
+	{0x1DC3, 0x1DC9, 230},
+	{0x1DCA, 0x1DCA, 220},
+	{0x1DCB, 0x1DCC, 230},
+	{0x1DCD, 0x1DCD, 234},
+	{0x1DCE, 0x1DCE, 214},
+	{0x1DCF, 0x1DCF, 220},
+	{0x1DD0, 0x1DD0, 202},
+	{0x1DD1, 0x1DF5, 230},
+	{0x1DF6, 0x1DF6, 232},
+	{0x1DF7, 0x1DF8, 228},
+	{0x1DF9, 0x1DF9, 220},
+	{0x1DFA, 0x1DFA, 218},
+	{0x1DFB, 0x1DFB, 230},
+	{0x1DFC, 0x1DFC, 233},
+	{0x1DFD, 0x1DFD, 220},
+	{0x1DFE, 0x1DFE, 230},
+	{0x1DFF, 0x1DFF, 220},
+	{0x20D0, 0x20D1, 230},
+	{0x20D2, 0x20D3, 1},
+	{0x20D4, 0x20D7, 230},
+	{0x20D8, 0x20DA, 1},
+	{0x20DB, 0x20DC, 230},
+	{0x20E1, 0x20E1, 230},
+	{0x20E5, 0x20E6, 1},
+	{0x20E7, 0x20E7, 230},
+	{0x20E8, 0x20E8, 220},
+	{0x20E9, 0x20E9, 230},
+	{0x20EA, 0x20EB, 1},
+	{0x20EC, 0x20EF, 220},
+	{0x20F0, 0x20F0, 230},
+	{0x2CEF, 0x2CF1, 230},
+	{0x2D7F, 0x2D7F, 9},
+	{0x2DE0, 0x2DFF, 230},
+	{0x302A, 0x302A, 218},
+	{0x302B, 0x302B, 228},
+	{0x302C, 0x302C, 232},
+	{0x302D, 0x302D, 222},
+	{0x302E, 0x302F, 224},
+	{0x3099, 0x309A, 8},
+	{0xA66F, 0xA66F, 230},
+	{0xA674, 0xA67D, 230},
+	{0xA69E, 0xA69F, 230},
+	{0xA6F0, 0xA6F1, 230},
+	{0xA806, 0xA806, 9},
+	{0xA82C, 0xA82C, 9},
+	{0xA8C4, 0xA8C4, 9},
+	{0xA8E0, 0xA8F1, 230},
+	{0xA92B, 0xA92D, 220},
+	{0xA953, 0xA953, 9},
+	{0xA9B3, 0xA9B3, 7},
+	{0xA9C0, 0xA9C0, 9},
+	{0xAAB0, 0xAAB0, 230},
+	{0xAAB2, 0xAAB3, 230},
+	{0xAAB4, 0xAAB4, 220},
+	{0xAAB7, 0xAAB8, 230},
+	{0xAABE, 0xAABF, 230},
+	{0xAAC1, 0xAAC1, 230},
+	{0xAAF6, 0xAAF6, 9},
+	{0xABED, 0xABED, 9},
+	{0xFB1E, 0xFB1E, 26},
+	{0xFE20, 0xFE26, 230},
+	{0xFE27, 0xFE2D, 220},
+	{0xFE2E, 0xFE2F, 230},
+	{0x101FD, 0x101FD, 220},
+	{0x102E0, 0x102E0, 220},
+	{0x10376, 0x1037A, 230},
+	{0x10A0D, 0x10A0D, 220},
+	{0x10A0F, 0x10A0F, 230},
+	{0x10A38, 0x10A38, 230},
+	{0x10A39, 0x10A39, 1},
+	{0x10A3A, 0x10A3A, 220},
+	{0x10A3F, 0x10A3F, 9},
+	{0x10AE5, 0x10AE5, 230},
+	{0x10AE6, 0x10AE6, 220},
+	{0x10D24, 0x10D27, 230},
+	{0x10EAB, 0x10EAC, 230},
+	{0x10EFD, 0x10EFF, 220},
+	{0x10F46, 0x10F47, 220},
+	{0x10F48, 0x10F4A, 230},
+	{0x10F4B, 0x10F4B, 220},
+	{0x10F4C, 0x10F4C, 230},
+	{0x10F4D, 0x10F50, 220},
+	{0x10F82, 0x10F82, 230},
+	{0x10F83, 0x10F83, 220},
+	{0x10F84, 0x10F84, 230},
+	{0x10F85, 0x10F85, 220},
+	{0x11046, 0x11046, 9},
+	{0x11070, 0x11070, 9},
+	{0x1107F, 0x1107F, 9},
+	{0x110B9, 0x110B9, 9},
+	{0x110BA, 0x110BA, 7},
+	{0x11100, 0x11102, 230},
+	{0x11133, 0x11134, 9},
+	{0x11173, 0x11173, 7},
+	{0x111C0, 0x111C0, 9},
+	{0x111CA, 0x111CA, 7},
+	{0x11235, 0x11235, 9},
+	{0x11236, 0x11236, 7},
+	{0x112E9, 0x112E9, 7},
+	{0x112EA, 0x112EA, 9},
+	{0x1133B, 0x1133C, 7},
+	{0x1134D, 0x1134D, 9},
+	{0x11366, 0x1136C, 230},
+	{0x11370, 0x11374, 230},
+	{0x11442, 0x11442, 9},
+	{0x11446, 0x11446, 7},
+	{0x1145E, 0x1145E, 230},
+	{0x114C2, 0x114C2, 9},
+	{0x114C3, 0x114C3, 7},
+	{0x115BF, 0x115BF, 9},
+	{0x115C0, 0x115C0, 7},
+	{0x1163F, 0x1163F, 9},
+	{0x116B6, 0x116B6, 9},
+	{0x116B7, 0x116B7, 7},
+	{0x1172B, 0x1172B, 9},
+	{0x11839, 0x11839, 9},
+	{0x1183A, 0x1183A, 7},
+	{0x1193D, 0x1193E, 9},
+	{0x11943, 0x11943, 7},
+	{0x119E0, 0x119E0, 9},
+	{0x11A34, 0x11A34, 9},
+	{0x11A47, 0x11A47, 9},
+	{0x11A99, 0x11A99, 9},
+	{0x11C3F, 0x11C3F, 9},
+	{0x11D42, 0x11D42, 7},
+	{0x11D44, 0x11D45, 9},
+	{0x11D97, 0x11D97, 9},
+	{0x11F41, 0x11F42, 9},
+	{0x16AF0, 0x16AF4, 1},
+	{0x16B30, 0x16B36, 230},
+	{0x16FF0, 0x16FF1, 6},
+	{0x1BC9E, 0x1BC9E, 1},
+	{0x1D165, 0x1D166, 216},
+	{0x1D167, 0x1D169, 1},
+	{0x1D16D, 0x1D16D, 226},
+	{0x1D16E, 0x1D172, 216},
+	{0x1D17B, 0x1D182, 220},
+	{0x1D185, 0x1D189, 230},
+	{0x1D18A, 0x1D18B, 220},
+	{0x1D1AA, 0x1D1AD, 230},
+	{0x1D242, 0x1D244, 230},
+	{0x1E000, 0x1E006, 230},
+	{0x1E008, 0x1E018, 230},
+	{0x1E01B, 0x1E021, 230},
+	{0x1E023, 0x1E024, 230},
+	{0x1E026, 0x1E02A, 230},
+	{0x1E08F, 0x1E08F, 230},
+	{0x1E130, 0x1E136, 230},
+	{0x1E2AE, 0x1E2AE, 230},
+	{0x1E2EC, 0x1E2EF, 230},
+	{0x1E4EC, 0x1E4ED, 232},
+	{0x1E4EE, 0x1E4EE, 220},
+	{0x1E4EF, 0x1E4EF, 230},
+	{0x1E8D0, 0x1E8D6, 220},
+	{0x1E944, 0x1E949, 230},
+	{0x1E94A, 0x1E94A, 7},
+}
+
+// decompositions holds the full canonical decompositions.
+var decompositions = []runeMapping{
+	{0x00C0, "À"},
+	{0x00C1, "Á"},
+	{0x00C2, "Â"},
+	{0x00C3, "Ã"},
+	{0x00C4, "Ä"},
+	{0x00C5, "Å"},
+	{0x00C7, "Ç"},
+	{0x00C8, "È"},
+	{0x00C9, "É"},
+	{0x00CA, "Ê"},
+	{0x00CB, "Ë"},
+	{0x00CC, "Ì"},
+	{0x00CD, "Í"},
+	{0x00CE, "Î"},
+	{0x00CF, "Ï"},
+	{0x00D1, "Ñ"},
+	{0x00D2, "Ò"},
+	{0x00D3, "Ó"},
+	{0x00D4, "Ô"},
+	{0x00D5, "Õ"},
+	{0x00D6, "Ö"},
+	{0x00D9, "Ù"},
+	{0x00DA, "Ú"},
+	{0x00DB, "Û"},
+	{0x00DC, "Ü"},
+	{0x00DD, "Ý"},
+	{0x00E0, "à"},
+	{0x00E1, "á"},
+	{0x00E2, "â"},
+	{0x00E3, "ã"},
+	{0x00E4, "ä"},
+	{0x00E5, "å"},
+	{0x00E7, "ç"},
+	{0x00E8, "è"},
+	{0x00E9, "é"},
+	{0x00EA, "ê"},
+	{0x00EB, "ë"},
+	{0x00EC, "ì"},
+	{0x00ED, "í"},
+	{0x00EE, "î"},
+	{0x00EF, "ï"},
+	{0x00F1, "ñ"},
+	{0x00F2, "ò"},
+	{0x00F3, "ó"},
+	{0x00F4, "ô"},
+	{0x00F5, "õ"},
+	{0x00F6, "ö"},
+	{0x00F9, "ù"},
+	{0x00FA, "ú"},
+	{0x00FB, "û"},
+	{0x00FC, "ü"},
+	{0x00FD, "ý"},
+	{0x00FF, "ÿ"},
+	{0x0100, "Ā"},
+	{0x0101, "ā"},
+	{0x0102, "Ă"},
+	{0x0103, "ă"},
+	{0x0104, "Ą"},
+	{0x0105, "ą"},
+	{0x0106, "Ć"},
+	{0x0107, "ć"},
+	{0x0108, "Ĉ"},
+	{0x0109, "ĉ"},
+	{0x010A, "Ċ"},
+	{0x010B, "ċ"},
+	{0x010C, "Č"},
+	{0x010D, "č"},
+	{0x010E, "Ď"},
+	{0x010F, "ď"},
+	{0x0112, "Ē"},
+	{0x0113, "ē"},
+	{0x0114, "Ĕ"},
+	{0x0115, "ĕ"},
+	{0x0116, "Ė"},
+	{0x0117, "ė"},
+	{0x0118, "Ę"},
+	{0x0119, "ę"},
+	{0x011A, "Ě"},
+	{0x011B, "ě"},
+	{0x011C, "Ĝ"},
+	{0x011D, "ĝ"},
+	{0x011E, "Ğ"},
+	{0x011F, "ğ"},
+	{0x0120, "Ġ"},
+	{0x0121, "ġ"},
+	{0x0122, "Ģ"},
+	{0x0123, "ģ"},
+	{0x0124, "Ĥ"},
+	{0x0125, "ĥ"},
+	{0x0128, "Ĩ"},
+	{0x0129, "ĩ"},
+	{0x012A, "Ī"},
+	{0x012B, "ī"},
+	{0x012C, "Ĭ"},
+	{0x012D, "ĭ"},
+	{0x012E, "Į"},
+	{0x012F, "į"},
+	{0x0130, "İ"},
+	{0x0134, "Ĵ"},
+	{0x0135, "ĵ"},
+	{0x0136, "Ķ"},
+	{0x0137, "ķ"},
+	{0x0139, "Ĺ"},
+	{0x013A, "ĺ"},
+	{0x013B, "Ļ"},
+	{0x013C, "ļ"},
+	{0x013D, "Ľ"},
+	{0x013E, "ľ"},
+	{0x0143, "Ń"},
+	{0x0144, "ń"},
+	{0x0145, "Ņ"},
+	{0x0146, "ņ"},
+	{0x0147, "Ň"},
+	{0x0148, "ň"},
+	{0x014C, "Ō"},
+	{0x014D, "ō"},
+	{0x014E, "Ŏ"},
+	{0x014F, "ŏ"},
+	{0x0150, "Ő"},
+	{0x0151, "ő"},
+	{0x0154, "Ŕ"},
+	{0x0155, "ŕ"},
+	{0x0156, "Ŗ"},
+	{0x0157, "ŗ"},
+	{0x0158, "Ř"},
+	{0x0159, "ř"},
+	{0x015A, "Ś"},
+	{0x015B, "ś"},
+	{0x015C, "Ŝ"},
+	{0x015D, "ŝ"},
+	{0x015E, "Ş"},
+	{0x015F, "ş"},
+	{0x0160, "Š"},
+	{0x0161, "š"},
+	{0x0162, "Ţ"},
+	{0x0163, "ţ"},
+	{0x0164, "Ť"},
+	{0x0165, "ť"},
+	{0x0168, "Ũ"},
+	{0x0169, "ũ"},
+	{0x016A, "Ū"},
+	{0x016B, "ū"},
+	{0x016C, "Ŭ"},
+	{0x016D, "ŭ"},
+	{0x016E, "Ů"},
+	{0x016F, "ů"},
+	{0x0170, "Ű"},
+	{0x0171, "ű"},
+	{0x0172, "Ų"},
+	{0x0173, "ų"},
+	{0x0174, "Ŵ"},
+	{0x0175, "ŵ"},
+	{0x0176, "Ŷ"},
+	{0x0177, "ŷ"},
+	{0x0178, "Ÿ"},
+	{0x0179, "Ź"},
+	{0x017A, "ź"},
+	{0x017B, "Ż"},
+	{0x017C, "ż"},
+	{0x017D, "Ž"},
+	{0x017E, "ž"},
+	{0x01A0, "Ơ"},
+	{0x01A1, "ơ"},
+	{0x01AF, "Ư"},
+	{0x01B0, "ư"},
+	{0x01CD, "Ǎ"},
+	{0x01CE, "ǎ"},
+	{0x01CF, "Ǐ"},
+	{0x01D0, "ǐ"},
+	{0x01D1, "Ǒ"},
+	{0x01D2, "ǒ"},
+	{0x01D3, "Ǔ"},
+	{0x01D4, "ǔ"},
+	{0x01D5, "Ǖ"},
+	{0x01D6, "ǖ"},
+	{0x01D7, "Ǘ"},
+	{0x01D8, "ǘ"},
+	{0x01D9, "Ǚ"},
+	{0x01DA, "ǚ"},
+	{0x01DB, "Ǜ"},
+	{0x01DC, "ǜ"},
+	{0x01DE, "Ǟ"},
+	{0x01DF, "ǟ"},
+	{0x01E0, "Ǡ"},
+	{0x01E1, "ǡ"},
+	{0x01E2, "Ǣ"},
+	{0x01E3, "ǣ"},
+	{0x01E6, "Ǧ"},
+	{0x01E7, "ǧ"},
+	{0x01E8, "Ǩ"},
+	{0x01E9, "ǩ"},
+	{0x01EA, "Ǫ"},
+	{0x01EB, "ǫ"},
+	{0x01EC, "Ǭ"},
+	{0x01ED, "ǭ"},
+	{0x01EE, "Ǯ"},
+	{0x01EF, "ǯ"},
+	{0x01F0, "ǰ"},
+	{0x01F4, "Ǵ"},
+	{0x01F5, "ǵ"},
+	{0x01F8, "Ǹ"},
+	{0x01F9, "ǹ"},
+	{0x01FA, "Ǻ"},
+	{0x01FB, "ǻ"},
+	{0x01FC, "Ǽ"},
+	{0x01FD, "ǽ"},
+	{0x01FE, "Ǿ"},
+	{0x01FF, "ǿ"},
+	{0x0200, "Ȁ"},
+	{0x0201, "ȁ"},
+	{0x0202, "Ȃ"},
+	{0x0203, "ȃ"},
+	{0x0204, "Ȅ"},
+	{0x0205, "ȅ"},
+	{0x0206, "Ȇ"},
+	{0x0207, "ȇ"},
+	{0x0208, "Ȉ"},
+	{0x0209, "ȉ"},
+	{0x020A, "Ȋ"},
+	{0x020B, "ȋ"},
+	{0x020C, "Ȍ"},
+	{0x020D, "ȍ"},
+	{0x020E, "Ȏ"},
+	{0x020F, "ȏ"},
+	{0x0210, "Ȑ"},
+	{0x0211, "ȑ"},
+	{0x0212, "Ȓ"},
+	{0x0213, "ȓ"},
+	{0x0214, "Ȕ"},
+	{0x0215, "ȕ"},
+	{0x0216, "Ȗ"},
+	{0x0217, "ȗ"},
+	{0x0218, "Ș"},
+	{0x0219, "ș"},
+	{0x021A, "Ț"},
+	{0x021B, "ț"},
+	{0x021E, "Ȟ"},
+	{0x021F, "ȟ"},
+	{0x0226, "Ȧ"},
+	{0x0227, "ȧ"},
+	{0x0228, "Ȩ"},
+	{0x0229, "ȩ"},
+	{0x022A, "Ȫ"},
+	{0x022B, "ȫ"},
+	{0x022C, "Ȭ"},
+	{0x022D, "ȭ"},
+	{0x022E, "Ȯ"},
+	{0x022F, "ȯ"},
+	{0x0230, "Ȱ"},
+	{0x0231, "ȱ"},
+	{0x0232, "Ȳ"},
+	{0x0233, "ȳ"},
+	{0x0340, "̀"},
+	{0x0341, "́"},
+	{0x0343, "̓"},
+	{0x0344, "̈́"},
+	{0x0374, "ʹ"},
+	{0x037E, ";"},
+	{0x0385, "΅"},
+	{0x0386, "Ά"},
+	{0x0387, "·"},
+	{0x0388, "Έ"},
+	{0x0389, "Ή"},
+	{0x038A, "Ί"},
+	{0x038C, "Ό"},
+	{0x038E, "Ύ"},
+	{0x038F, "Ώ"},
+	{0x0390, "ΐ"},
+	{0x03AA, "Ϊ"},
+	{0x03AB, "Ϋ"},
+	{0x03AC, "ά"},
+	{0x03AD, "έ"},
+	{0x03AE, "ή"},
+	{0x03AF, "ί"},
+	{0x03B0, "ΰ"},
+	{0x03CA, "ϊ"},
+	{0x03CB, "ϋ"},
+	{0x03CC, "ό"},
+	{0x03CD, "ύ"},
+	{0x03CE, "ώ"},
+	{0x03D3, "ϓ"},
+	{0x03D4, "ϔ"},
+	{0x0400, "Ѐ"},
+	{0x0401, "Ё"},
+	{0x0403, "Ѓ"},
+	{0x0407, "Ї"},
+	{0x040C, "Ќ"},
+	{0x040D, "Ѝ"},
+	{0x040E, "Ў"},
+	{0x0419, "Й"},
+	{0x0439, "й"},
+	{0x0450, "ѐ"},
+	{0x0451, "ё"},
+	{0x0453, "ѓ"},
+	{0x0457, "ї"},
+	{0x045C, "ќ"},
+	{0x045D, "ѝ"},
+	{0x045E, "ў"},
+	{0x0476, "Ѷ"},
+	{0x0477, "ѷ"},
+	{0x04C1, "Ӂ"},
+	{0x04C2, "ӂ"},
+	{0x04D0, "Ӑ"},
+	{0x04D1, "ӑ"},
+	{0x04D2, "Ӓ"},
+	{0x04D3, "ӓ"},
+	{0x04D6, "Ӗ"},
+	{0x04D7, "ӗ"},
+	{0x04DA, "Ӛ"},
+	{0x04DB, "ӛ"},
+	{0x04DC, "Ӝ"},
+	{0x04DD, "ӝ"},
+	{0x04DE, "Ӟ"},
+	{0x04DF, "ӟ"},
+	{0x04E2, "Ӣ"},
+	{0x04E3, "ӣ"},
+	{0x04E4, "Ӥ"},
+	{0x04E5, "ӥ"},
+	{0x04E6, "Ӧ"},
+	{0x04E7, "ӧ"},
+	{0x04EA, "Ӫ"},
+	{0x04EB, "ӫ"},
+	{0x04EC, "Ӭ"},
+	{0x04ED, "ӭ"},
+	{0x04EE, "Ӯ"},
+	{0x04EF, "ӯ"},
+	{0x04F0, "Ӱ"},
+	{0x04F1, "ӱ"},
+	{0x04F2, "Ӳ"},
+	{0x04F3, "ӳ"},
+	{0x04F4, "Ӵ"},
+	{0x04F5, "ӵ"},
+	{0x04F8, "Ӹ"},
+	{0x04F9, "ӹ"},
+	{0x0622, "آ"},
+	{0x0623, "أ"},
+	{0x0624, "ؤ"},
+	{0x0625, "إ"},
+	{0x0626, "ئ"},
+	{0x06C0, "ۀ"},
+	{0x06C2, "ۂ"},
+	{0x06D3, "ۓ"},
+	{0x0929, "ऩ"},
+	{0x0931, "ऱ"},
+	{0x0934, "ऴ"},
+	{0x0958, "क़"},
+	{0x0959, "ख़"},
+	{0x095A, "ग़"},
+	{0x095B, "ज़"},
+	{0x095C, "ड़"},
+	{0x095D, "ढ़"},
+	{0x095E, "फ़"},
+	{0x095F, "य़"},
+	{0x09CB, "ো"},
+	{0x09CC, "ৌ"},
+	{0x09DC, "ড়"},
+	{0x09DD, "ঢ়"},
+	{0x09DF, "য়"},
+	{0x0A33, "ਲ਼"},
+	{0x0A36, "ਸ਼"},
+	{0x0A59, "ਖ਼"},
+	{0x0A5A, "ਗ਼"},
+	{0x0A5B, "ਜ਼"},
+	{0x0A5E, "ਫ਼"},
+	{0x0B48, "ୈ"},
+	{0x0B4B, "ୋ"},
+	{0x0B4C, "ୌ"},
+	{0x0B5C, "ଡ଼"},
+	{0x0B5D, "ଢ଼"},
+	{0x0B94, "ஔ"},
+	{0x0BCA, "ொ"},
+	{0x0BCB, "ோ"},
+	{0x0BCC, "ௌ"},
+	{0x0C48, "ై"},
+	{0x0CC0, "ೀ"},
+	{0x0CC7, "ೇ"},
+	{0x0CC8, "ೈ"},
+	{0x0CCA, "ೊ"},
+	{0x0CCB, "ೋ"},
+	{0x0D4A, "ൊ"},
+	{0x0D4B, "ോ"},
+	{0x0D4C, "ൌ"},
+	{0x0DDA, "ේ"},
+	{0x0DDC, "ො"},
+	{0x0DDD, "ෝ"},
+	{0x0DDE, "ෞ"},
+	{0x0F43, "གྷ"},
+	{0x0F4D, "ཌྷ"},
+	{0x0F52, "དྷ"},
+	{0x0F57, "བྷ"},
+	{0x0F5C, "ཛྷ"},
+	{0x0F69, "ཀྵ"},
+	{0x0F73, "ཱི"},
+	{0x0F75, "ཱུ"},
+	{0x0F76, "ྲྀ"},
+	{0x0F78, "ླྀ"},
+	{0x0F81, "ཱྀ"},
+	{0x0F93, "ྒྷ"},
+	{0x0F9D, "ྜྷ"},
+	{0x0FA2, "ྡྷ"},
+	{0x0FA7, "ྦྷ"},
+	{0x0FAC, "ྫྷ"},
+	{0x0FB9, "ྐྵ"},
+	{0x1026, "ဦ"},
+	{0x1B06, "ᬆ"},
+	{0x1B08, "ᬈ"},
+	{0x1B0A, "ᬊ"},
+	{0x1B0C, "ᬌ"},
+	{0x1B0E, "ᬎ"},
+	{0x1B12, "ᬒ"},
+	{0x1B3B, "ᬻ"},
+	{0x1B3D, "ᬽ"},
+	{0x1B40, "ᭀ"},
+	{0x1B41, "ᭁ"},
+	{0x1B43, "ᭃ"},
+	{0x1E00, "Ḁ"},
+	{0x1E01, "ḁ"},
+	{0x1E02, "Ḃ"},
+	{0x1E03, "ḃ"},
+	{0x1E04, "Ḅ"},
+	{0x1E05, "ḅ"},
+	{0x1E06, "Ḇ"},
+	{0x1E07, "ḇ"},
+	{0x1E08, "Ḉ"},
+	{0x1E09, "ḉ"},
+	{0x1E0A, "Ḋ"},
+	{0x1E0B, "ḋ"},
+	{0x1E0C, "Ḍ"},
+	{0x1E0D, "ḍ"},
+	{0x1E0E, "Ḏ"},
+	{0x1E0F, "ḏ"},
+	{0x1E10, "Ḑ"},
+	{0x1E11, "ḑ"},
+	{0x1E12, "Ḓ"},
+	{0x1E13, "ḓ"},
+	{0x1E14, "Ḕ"},
+	{0x1E15, "ḕ"},
+	{0x1E16, "Ḗ"},
+	{0x1E17, "ḗ"},
+	{0x1E18, "Ḙ"},
+	{0x1E19, "ḙ"},
+	{0x1E1A, "Ḛ"},
+	{0x1E1B, "ḛ"},
+	{0x1E1C, "Ḝ"},
+	{0x1E1D, "ḝ"},
+	{0x1E1E, "Ḟ"},
+	{0x1E1F, "ḟ"},
+	{0x1E20, "Ḡ"},
+	{0x1E21, "ḡ"},
+	{0x1E22, "Ḣ"},
+	{0x1E23, "ḣ"},
+	{0x1E24, "Ḥ"},
+	{0x1E25, "ḥ"},
+	{0x1E26, "Ḧ"},
+	{0x1E27, "ḧ"},
+	{0x1E28, "Ḩ"},
+	{0x1E29, "ḩ"},
+	{0x1E2A, "Ḫ"},
+	{0x1E2B, "ḫ"},
+	{0x1E2C, "Ḭ"},
+	{0x1E2D, "ḭ"},
+	{0x1E2E, "Ḯ"},
+	{0x1E2F, "ḯ"},
+	{0x1E30, "Ḱ"},
+	{0x1E31, "ḱ"},
+	{0x1E32, "Ḳ"},
+	{0x1E33, "ḳ"},
+	{0x1E34, "Ḵ"},
+	{0x1E35, "ḵ"},
+	{0x1E36, "Ḷ"},
+	{0x1E37, "ḷ"},
+	{0x1E38, "Ḹ"},
+	{0x1E39, "ḹ"},
+	{0x1E3A, "Ḻ"},
+	{0x1E3B, "ḻ"},
+	{0x1E3C, "Ḽ"},
+	{0x1E3D, "ḽ"},
+	{0x1E3E, "Ḿ"},
+	{0x1E3F, "ḿ"},
+	{0x1E40, "Ṁ"},
+	{0x1E41, "ṁ"},
+	{0x1E42, "Ṃ"},
+	{0x1E43, "ṃ"},
+	{0x1E44, "Ṅ"},
+	{0x1E45, "ṅ"},
+	{0x1E46, "Ṇ"},
+	{0x1E47, "ṇ"},
+	{0x1E48, "Ṉ"},
+	{0x1E49, "ṉ"},
+	{0x1E4A, "Ṋ"},
+	{0x1E4B, "ṋ"},
+	{0x1E4C, "Ṍ"},
+	{0x1E4D, "ṍ"},
+	{0x1E4E, "Ṏ"},
+	{0x1E4F, "ṏ"},
+	{0x1E50, "Ṑ"},
+	{0x1E51, "ṑ"},
+	{0x1E52, "Ṓ"},
+	{0x1E53, "ṓ"},
+	{0x1E54, "Ṕ"},
+	{0x1E55, "ṕ"},
+	{0x1E56, "Ṗ"},
+	{0x1E57, "ṗ"},
+	{0x1E58, "Ṙ"},
+	{0x1E59, "ṙ"},
+	{0x1E5A, "Ṛ"},
+	{0x1E5B, "ṛ"},
+	{0x1E5C, "Ṝ"},
+	{0x1E5D, "ṝ"},
+	{0x1E5E, "Ṟ"},
+	{0x1E5F, "ṟ"},
+	{0x1E60, "Ṡ"},
+	{0x1E61, "ṡ"},
+	{0x1E62, "Ṣ"},
+	{0x1E63, "ṣ"},
+	{0x1E64, "Ṥ"},
+	{0x1E65, "ṥ"},
+	{0x1E66, "Ṧ"},
+	{0x1E67, "ṧ"},
+	{0x1E68, "Ṩ"},
+	{0x1E69, "ṩ"},
+	{0x1E6A, "Ṫ"},
+	{0x1E6B, "ṫ"},
+	{0x1E6C, "Ṭ"},
+	{0x1E6D, "ṭ"},
+	{0x1E6E, "Ṯ"},
+	{0x1E6F, "ṯ"},
+	{0x1E70, "Ṱ"},
+	{0x1E71, "ṱ"},
+	{0x1E72, "Ṳ"},
+	{0x1E73, "ṳ"},
+	{0x1E74, "Ṵ"},
+	{0x1E75, "ṵ"},
+	{0x1E76, "Ṷ"},
+	{0x1E77, "ṷ"},
+	{0x1E78, "Ṹ"},
+	{0x1E79, "ṹ"},
+	{0x1E7A, "Ṻ"},
+	{0x1E7B, "ṻ"},
+	{0x1E7C, "Ṽ"},
+	{0x1E7D, "ṽ"},
+	{0x1E7E, "Ṿ"},
+	{0x1E7F, "ṿ"},
+	{0x1E80, "Ẁ"},
+	{0x1E81, "ẁ"},
+	{0x1E82, "Ẃ"},
+	{0x1E83, "ẃ"},
+	{0x1E84, "Ẅ"},
+	{0x1E85, "ẅ"},
+	{0x1E86, "Ẇ"},
+	{0x1E87, "ẇ"},
+	{0x1E88, "Ẉ"},
+	{0x1E89, "ẉ"},
+	{0x1E8A, "Ẋ"},
+	{0x1E8B, "ẋ"},
+	{0x1E8C, "Ẍ"},
+	{0x1E8D, "ẍ"},
+	{0x1E8E, "Ẏ"},
+	{0x1E8F, "ẏ"},
+	{0x1E90, "Ẑ"},
+	{0x1E91, "ẑ"},
+	{0x1E92, "Ẓ"},
+	{0x1E93, "ẓ"},
+	{0x1E94, "Ẕ"},
+	{0x1E95, "ẕ"},
+	{0x1E96, "ẖ"},
+	{0x1E97, "ẗ"},
+	{0x1E98, "ẘ"},
+	{0x1E99, "ẙ"},
+	{0x1E9B, "ẛ"},
+	{0x1EA0, "Ạ"},
+	{0x1EA1, "ạ"},
+	{0x1EA2, "Ả"},
+	{0x1EA3, "ả"},
+	{0x1EA4, "Ấ"},
+	{0x1EA5, "ấ"},
+	{0x1EA6, "Ầ"},
+	{0x1EA7, "ầ"},
+	{0x1EA8, "Ẩ"},
+	{0x1EA9, "ẩ"},
+	{0x1EAA, "Ẫ"},
+	{0x1EAB, "ẫ"},
+	{0x1EAC, "Ậ"},
+	{0x1EAD, "ậ"},
+	{0x1EAE, "Ắ"},
+	{0x1EAF, "ắ"},
+	{0x1EB0, "Ằ"},
+	{0x1EB1, "ằ"},
+	{0x1EB2, "Ẳ"},
+	{0x1EB3, "ẳ"},
+	{0x1EB4, "Ẵ"},
+	{0x1EB5, "ẵ"},
+	{0x1EB6, "Ặ"},
+	{0x1EB7, "ặ"},
+	{0x1EB8, "Ẹ"},
+	{0x1EB9, "ẹ"},
+	{0x1EBA, "Ẻ"},
+	{0x1EBB, "ẻ"},
+	{0x1EBC, "Ẽ"},
+	{0x1EBD, "ẽ"},
+	{0x1EBE, "Ế"},
+	{0x1EBF, "ế"},
+	{0x1EC0, "Ề"},
+	{0x1EC1, "ề"},
+	{0x1EC2, "Ể"},
+	{0x1EC3, "ể"},
+	{0x1EC4, "Ễ"},
+	{0x1EC5, "ễ"},
+	{0x1EC6, "Ệ"},
+	{0x1EC7, "ệ"},
+	{0x1EC8, "Ỉ"},
+	{0x1EC9, "ỉ"},
+	{0x1ECA, "Ị"},
+	{0x1ECB, "ị"},
+	{0x1ECC, "Ọ"},
+	{0x1ECD, "ọ"},
+	{0x1ECE, "Ỏ"},
+	{0x1ECF, "ỏ"},
+	{0x1ED0, "Ố"},
+	{0x1ED1, "ố"},
+	{0x1ED2, "Ồ"},
+	{0x1ED3, "ồ"},
+	{0x1ED4, "Ổ"},
+	{0x1ED5, "ổ"},
+	{0x1ED6, "Ỗ"},
+	{0x1ED7, "ỗ"},
+	{0x1ED8, "Ộ"},
+	{0x1ED9, "ộ"},
+	{0x1EDA, "Ớ"},
+	{0x1EDB, "ớ"},
+	{0x1EDC, "Ờ"},
+	{0x1EDD, "ờ"},
+	{0x1EDE, "Ở"},
+	{0x1EDF, "ở"},
+	{0x1EE0, "Ỡ"},
+	{0x1EE1, "ỡ"},
+	{0x1EE2, "Ợ"},
+	{0x1EE3, "ợ"},
+	{0x1EE4, "Ụ"},
+	{0x1EE5, "ụ"},
+	{0x1EE6, "Ủ"},
+	{0x1EE7, "ủ"},
+	{0x1EE8, "Ứ"},
+	{0x1EE9, "ứ"},
+	{0x1EEA, "Ừ"},
+	{0x1EEB, "ừ"},
+	{0x1EEC, "Ử"},
+	{0x1EED, "ử"},
+	{0x1EEE, "Ữ"},
+	{0x1EEF, "ữ"},
+	{0x1EF0, "Ự"},
+	{0x1EF1, "ự"},
+	{0x1EF2, "Ỳ"},
+	{0x1EF3, "ỳ"},
+	{0x1EF4, "Ỵ"},
+	{0x1EF5, "ỵ"},
+	{0x1EF6, "Ỷ"},
+	{0x1EF7, "ỷ"},
+	{0x1EF8, "Ỹ"},
+	{0x1EF9, "ỹ"},
+	{0x1F00, "ἀ"},
+	{0x1F01, "ἁ"},
+	{0x1F02, "ἂ"},
+	{0x1F03, "ἃ"},
+	{0x1F04, "ἄ"},
+	{0x1F05, "ἅ"},
+	{0x1F06, "ἆ"},
+	{0x1F07, "ἇ"},
+	{0x1F08, "Ἀ"},
+	{0x1F09, "Ἁ"},
+	{0x1F0A, "Ἂ"},
+	{0x1F0B, "Ἃ"},
+	{0x1F0C, "Ἄ"},
+	{0x1F0D, "Ἅ"},
+	{0x1F0E, "Ἆ"},
+	{0x1F0F, "Ἇ"},
+	{0x1F10, "ἐ"},
+	{0x1F11, "ἑ"},
+	{0x1F12, "ἒ"},
+	{0x1F13, "ἓ"},
+	{0x1F14, "ἔ"},
+	{0x1F15, "ἕ"},
+	{0x1F18, "Ἐ"},
+	{0x1F19, "Ἑ"},
+	{0x1F1A, "Ἒ"},
+	{0x1F1B, "Ἓ"},
+	{0x1F1C, "Ἔ"},
+	{0x1F1D, "Ἕ"},
+	{0x1F20, "ἠ"},
+	{0x1F21, "ἡ"},
+	{0x1F22, "ἢ"},
+	{0x1F23, "ἣ"},
+	{0x1F24, "ἤ"},
+	{0x1F25, "ἥ"},
+	{0x1F26, "ἦ"},
+	{0x1F27, "ἧ"},
+	{0x1F28, "Ἠ"},
+	{0x1F29, "Ἡ"},
+	{0x1F2A, "Ἢ"},
+	{0x1F2B, "Ἣ"},
+	{0x1F2C, "Ἤ"},
+	{0x1F2D, "Ἥ"},
+	{0x1F2E, "Ἦ"},
+	{0x1F2F, "Ἧ"},
+	{0x1F30, "ἰ"},
+	{0x1F31, "ἱ"},
+	{0x1F32, "ἲ"},
+	{0x1F33, "ἳ"},
+	{0x1F34, "ἴ"},
+	{0x1F35, "ἵ"},
+	{0x1F36, "ἶ"},
+	{0x1F37, "ἷ"},
+	{0x1F38, "Ἰ"},
+	{0x1F39, "Ἱ"},
+	{0x1F3A, "Ἲ"},
+	{0x1F3B, "Ἳ"},
+	{0x1F3C, "Ἴ"},
+	{0x1F3D, "Ἵ"},
+	{0x1F3E, "Ἶ"},
+	{0x1F3F, "Ἷ"},
+	{0x1F40, "ὀ"},
+	{0x1F41, "ὁ"},
+	{0x1F42, "ὂ"},
+	{0x1F43, "ὃ"},
+	{0x1F44, "ὄ"},
+	{0x1F45, "ὅ"},
+	{0x1F48, "Ὀ"},
+	{0x1F49, "Ὁ"},
+	{0x1F4A, "Ὂ"},
+	{0x1F4B, "Ὃ"},
+	{0x1F4C, "Ὄ"},
+	{0x1F4D, "Ὅ"},
+	{0x1F50, "ὐ"},
+	{0x1F51, "ὑ"},
+	{0x1F52, "ὒ"},
+	{0x1F53, "ὓ"},
+	{0x1F54, "ὔ"},
+	{0x1F55, "ὕ"},
+	{0x1F56, "ὖ"},
+	{0x1F57, "ὗ"},
+	{0x1F59, "Ὑ"},
+	{0x1F5B, "Ὓ"},
+	{0x1F5D, "Ὕ"},
+	{0x1F5F, "Ὗ"},
+	{0x1F60, "ὠ"},
+	{0x1F61, "ὡ"},
+	{0x1F62, "ὢ"},
+	{0x1F63, "ὣ"},
+	{0x1F64, "ὤ"},
+	{0x1F65, "ὥ"},
+	{0x1F66, "ὦ"},
+	{0x1F67, "ὧ"},
+	{0x1F68, "Ὠ"},
+	{0x1F69, "Ὡ"},
+	{0x1F6A, "Ὢ"},
+	{0x1F6B, "Ὣ"},
+	{0x1F6C, "Ὤ"},
+	{0x1F6D, "Ὥ"},
+	{0x1F6E, "Ὦ"},
+	{0x1F6F, "Ὧ"},
+	{0x1F70, "ὰ"},
+	{0x1F71, "ά"},
+	{0x1F72, "ὲ"},
+	{0x1F73, "έ"},
+	{0x1F74, "ὴ"},
+	{0x1F75, "ή"},
+	{0x1F76, "ὶ"},
+	{0x1F77, "ί"},
+	{0x1F78, "ὸ"},
+	{0x1F79, "ό"},
+	{0x1F7A, "ὺ"},
+	{0x1F7B, "ύ"},
+	{0x1F7C, "ὼ"},
+	{0x1F7D, "ώ"},
+	{0x1F80, "ᾀ"},
+	{0x1F81, "ᾁ"},
+	{0x1F82, "ᾂ"},
+	{0x1F83, "ᾃ"},
+	{0x1F84, "ᾄ"},
+	{0x1F85, "ᾅ"},
+	{0x1F86, "ᾆ"},
+	{0x1F87, "ᾇ"},
+	{0x1F88, "ᾈ"},
+	{0x1F89, "ᾉ"},
+	{0x1F8A, "ᾊ"},
+	{0x1F8B, "ᾋ"},
+	{0x1F8C, "ᾌ"},
+	{0x1F8D, "ᾍ"},
+	{0x1F8E, "ᾎ"},
+	{0x1F8F, "ᾏ"},
+	{0x1F90, "ᾐ"},
+	{0x1F91, "ᾑ"},
+	{0x1F92, "ᾒ"},
+	{0x1F93, "ᾓ"},
+	{0x1F94, "ᾔ"},
+	{0x1F95, "ᾕ"},
+	{0x1F96, "ᾖ"},
+	{0x1F97, "ᾗ"},
+	{0x1F98, "ᾘ"},
+	{0x1F99, "ᾙ"},
+	{0x1F9A, "ᾚ"},
+	{0x1F9B, "ᾛ"},
+	{0x1F9C, "ᾜ"},
+	{0x1F9D, "ᾝ"},
+	{0x1F9E, "ᾞ"},
+	{0x1F9F, "ᾟ"},
+	{0x1FA0, "ᾠ"},
+	{0x1FA1, "ᾡ"},
+	{0x1FA2, "ᾢ"},
+	{0x1FA3, "ᾣ"},
+	{0x1FA4, "ᾤ"},
+	{0x1FA5, "ᾥ"},
+	{0x1FA6, "ᾦ"},
+	{0x1FA7, "ᾧ"},
+	{0x1FA8, "ᾨ"},
+	{0x1FA9, "ᾩ"},
+	{0x1FAA, "ᾪ"},
+	{0x1FAB, "ᾫ"},
+	{0x1FAC, "ᾬ"},
+	{0x1FAD, "ᾭ"},
+	{0x1FAE, "ᾮ"},
+	{0x1FAF, "ᾯ"},
+	{0x1FB0, "ᾰ"},
+	{0x1FB1, "ᾱ"},
+	{0x1FB2, "ᾲ"},
+	{0x1FB3, "ᾳ"},
+	{0x1FB4, "ᾴ"},
+	{0x1FB6, "ᾶ"},
+	{0x1FB7, "ᾷ"},
+	{0x1FB8, "Ᾰ"},
+	{0x1FB9, "Ᾱ"},
+	{0x1FBA, "Ὰ"},
+	{0x1FBB, "Ά"},
+	{0x1FBC, "ᾼ"},
+	{0x1FBE, "ι"},
+	{0x1FC1, "῁"},
+	{0x1FC2, "ῂ"},
+	{0x1FC3, "ῃ"},
+	{0x1FC4, "ῄ"},
+	{0x1FC6, "ῆ"},
+	{0x1FC7, "ῇ"},
+	{0x1FC8, "Ὲ"},
+	{0x1FC9, "Έ"},
+	{0x1FCA, "Ὴ"},
+	{0x1FCB, "Ή"},
+	{0x1FCC, "ῌ"},
+	{0x1FCD, "῍"},
+	{0x1FCE, "῎"},
+	{0x1FCF, "῏"},
+	{0x1FD0, "ῐ"},
+	{0x1FD1, "ῑ"},
+	{0x1FD2, "ῒ"},
+	{0x1FD3, "ΐ"},
+	{0x1FD6, "ῖ"},
+	{0x1FD7, "ῗ"},
+	{0x1FD8, "Ῐ"},
+	{0x1FD9, "Ῑ"},
+	{0x1FDA, "Ὶ"},
+	{0x1FDB, "Ί"},
+	{0x1FDD, "῝"},
+	{0x1FDE, "῞"},
+	{0x1FDF, "῟"},
+	{0x1FE0, "ῠ"},
+	{0x1FE1, "ῡ"},
+	{0x1FE2, "ῢ"},
+	{0x1FE3, "ΰ"},
+	{0x1FE4, "ῤ"},
+	{0x1FE5, "ῥ"},
+	{0x1FE6, "ῦ"},
+	{0x1FE7, "ῧ"},
+	{0x1FE8, "Ῠ"},
+	{0x1FE9, "Ῡ"},
+	{0x1FEA, "Ὺ"},
+	{0x1FEB, "Ύ"},
+	{0x1FEC, "Ῥ"},
+	{0x1FED, "῭"},
+	{0x1FEE, "΅"},
+	{0x1FEF, "`"},
+	{0x1FF2, "ῲ"},
+	{0x1FF3, "ῳ"},
+	{0x1FF4, "ῴ"},
+	{0x1FF6, "ῶ"},
+	{0x1FF7, "ῷ"},
+	{0x1FF8, "Ὸ"},
+	{0x1FF9, "Ό"},
+	{0x1FFA, "Ὼ"},
+	{0x1FFB, "Ώ"},
+	{0x1FFC, "ῼ"},
+	{0x1FFD, "´"},
+	{0x2000, "\u2002"},
+	{0x2001, "\u2003"},
+	{0x2126, "Ω"},
+	{0x212A, "K"},
+	{0x212B, "Å"},
+	{0x219A, "↚"},
+	{0x219B, "↛"},
+	{0x21AE, "↮"},
+	{0x21CD, "⇍"},
+	{0x21CE, "⇎"},
+	{0x21CF, "⇏"},
+	{0x2204, "∄"},
+	{0x2209, "∉"},
+	{0x220C, "∌"},
+	{0x2224, "∤"},
+	{0x2226, "∦"},
+	{0x2241, "≁"},
+	{0x2244, "≄"},
+	{0x2247, "≇"},
+	{0x2249, "≉"},
+	{0x2260, "≠"},
+	{0x2262, "≢"},
+	{0x226D, "≭"},
+	{0x226E, "≮"},
+	{0x226F, "≯"},
+	{0x2270, "≰"},
+	{0x2271, "≱"},
+	{0x2274, "≴"},
+	{0x2275, "≵"},
+	{0x2278, "≸"},
+	{0x2279, "≹"},
+	{0x2280, "⊀"},
+	{0x2281, "⊁"},
+	{0x2284, "⊄"},
+	{0x2285, "⊅"},
+	{0x2288, "⊈"},
+	{0x2289, "⊉"},
+	{0x22AC, "⊬"},
+	{0x22AD, "⊭"},
+	{0x22AE, "⊮"},
+	{0x22AF, "⊯"},
+	{0x22E0, "⋠"},
+	{0x22E1, "⋡"},
+	{0x22E2, "⋢"},
+	{0x22E3, "⋣"},
+	{0x22EA, "⋪"},
+	{0x22EB, "⋫"},
+	{0x22EC, "⋬"},
+	{0x22ED, "⋭"},
+	{0x2329, "〈"},
+	{0x232A, "〉"},
+	{0x2ADC, "⫝̸"},
+	{0x304C, "が"},
+	{0x304E, "ぎ"},
+	{0x3050, "ぐ"},
+	{0x3052, "げ"},
+	{0x3054, "ご"},
+	{0x3056, "ざ"},
+	{0x3058, "じ"},
+	{0x305A, "ず"},
+	{0x305C, "ぜ"},
+	{0x305E, "ぞ"},
+	{0x3060, "だ"},
+	{0x3062, "ぢ"},
+	{0x3065, "づ"},
+	{0x3067, "で"},
+	{0x3069, "ど"},
+	{0x3070, "ば"},
+	{0x3071, "ぱ"},
+	{0x3073, "び"},
+	{0x3074, "ぴ"},
+	{0x3076, "ぶ"},
+	{0x3077, "ぷ"},
+	{0x3079, "べ"},
+	{0x307A, "ぺ"},
+	{0x307C, "ぼ"},
+	{0x307D, "ぽ"},
+	{0x3094, "ゔ"},
+	{0x309E, "ゞ"},
+	{0x30AC, "ガ"},
+	{0x30AE, "ギ"},
+	{0x30B0, "グ"},
+	{0x30B2, "ゲ"},
+	{0x30B4, "ゴ"},
+	{0x30B6, "ザ"},
+	{0x30B8, "ジ"},
+	{0x30BA, "ズ"},
+	{0x30BC, "ゼ"},
+	{0x30BE, "ゾ"},
+	{0x30C0, "ダ"},
+	{0x30C2, "ヂ"},
+	{0x30C5, "ヅ"},
+	{0x30C7, "デ"},
+	{0x30C9, "ド"},
+	{0x30D0, "バ"},
+	{0x30D1, "パ"},
+	{0x30D3, "ビ"},
+	{0x30D4, "ピ"},
+	{0x30D6, "ブ"},
+	{0x30D7, "プ"},
+	{0x30D9, "ベ"},
+	{0x30DA, "ペ"},
+	{0x30DC, "ボ"},
+	{0x30DD, "ポ"},
+	{0x30F4, "ヴ"},
+	{0x30F7, "ヷ"},
+	{0x30F8, "ヸ"},
+	{0x30F9, "ヹ"},
+	{0x30FA, "ヺ"},
+	{0x30FE, "ヾ"},
+	{0xF900, "豈"},
+	{0xF901, "更"},
+	{0xF902, "車"},
+	{0xF903, "賈"},
+	{0xF904, "滑"},
+	{0xF905, "串"},
+	{0xF906, "句"},
+	{0xF907, "龜"},
+	{0xF908, "龜"},
+	{0xF909, "契"},
+	{0xF90A, "金"},
+	{0xF90B, "喇"},
+	{0xF90C, "奈"},
+	{0xF90D, "懶"},
+	{0xF90E, "癩"},
+	{0xF90F, "羅"},
+	{0xF910, "蘿"},
+	{0xF911, "螺"},
+	{0xF912, "裸"},
+	{0xF913, "邏"},
+	{0xF914, "樂"},
+	{0xF915, "洛"},
+	{0xF916, "烙"},
+	{0xF917, "珞"},
+	{0xF918, "落"},
+	{0xF919, "酪"},
+	{0xF91A, "駱"},
+	{0xF91B, "亂"},
+	{0xF91C, "卵"},
+	{0xF91D, "欄"},
+	{0xF91E, "爛"},
+	{0xF91F, "蘭"},
+	{0xF920, "鸞"},
+	{0xF921, "嵐"},
+	{0xF922, "濫"},
+	{0xF923, "藍"},
+	{0xF924, "襤"},
+	{0xF925, "拉"},
+	{0xF926, "臘"},
+	{0xF927, "蠟"},
+	{0xF928, "廊"},
+	{0xF929, "朗"},
+	{0xF92A, "浪"},
+	{0xF92B, "狼"},
+	{0xF92C, "郎"},
+	{0xF92D, "來"},
+	{0xF92E, "冷"},
+	{0xF92F, "勞"},
+	{0xF930, "擄"},
+	{0xF931, "櫓"},
+	{0xF932, "爐"},
+	{0xF933, "盧"},
+	{0xF934, "老"},
+	{0xF935, "蘆"},
+	{0xF936, "虜"},
+	{0xF937, "路"},
+	{0xF938, "露"},
+	{0xF939, "魯"},
+	{0xF93A, "鷺"},
+	{0xF93B, "碌"},
+	{0xF93C, "祿"},
+	{0xF93D, "綠"},
+	{0xF93E, "菉"},
+	{0xF93F, "錄"},
+	{0xF940, "鹿"},
+	{0xF941, "論"},
+	{0xF942, "壟"},
+	{0xF943, "弄"},
+	{0xF944, "籠"},
+	{0xF945, "聾"},
+	{0xF946, "牢"},
+	{0xF947, "磊"},
+	{0xF948, "賂"},
+	{0xF949, "雷"},
+	{0xF94A, "壘"},
+	{0xF94B, "屢"},
+	{0xF94C, "樓"},
+	{0xF94D, "淚"},
+	{0xF94E, "漏"},
+	{0xF94F, "累"},
+	{0xF950, "縷"},
+	{0xF951, "陋"},
+	{0xF952, "勒"},
+	{0xF953, "肋"},
+	{0xF954, "凜"},
+	{0xF955, "凌"},
+	{0xF956, "稜"},
+	{0xF957, "綾"},
+	{0xF958, "菱"},
+	{0xF959, "陵"},
+	{0xF95A, "讀"},
+	{0xF95B, "拏"},
+	{0xF95C, "樂"},
+	{0xF95D, "諾"},
+	{0xF95E, "丹"},
+	{0xF95F, "寧"},
+	{0xF960, "怒"},
+	{0xF961, "率"},
+	{0xF962, "異"},
+	{0xF963, "北"},
+	{0xF964, "磻"},
+	{0xF965, "便"},
+	{0xF966, "復"},
+	{0xF967, "不"},
+	{0xF968, "泌"},
+	{0xF969, "數"},
+	{0xF96A, "索"},
+	{0xF96B, "參"},
+	{0xF96C, "塞"},
+	{0xF96D, "省"},
+	{0xF96E, "葉"},
+	{0xF96F, "說"},
+	{0xF970, "殺"},
+	{0xF971, "辰"},
+	{0xF972, "沈"},
+	{0xF973, "拾"},
+	{0xF974, "若"},
+	{0xF975, "掠"},
+	{0xF976, "略"},
+	{0xF977, "亮"},
+	{0xF978, "兩"},
+	{0xF979, "凉"},
+	{0xF97A, "梁"},
+	{0xF97B, "糧"},
+	{0xF97C, "良"},
+	{0xF97D, "諒"},
+	{0xF97E, "量"},
+	{0xF97F, "勵"},
+	{0xF980, "呂"},
+	{0xF981, "女"},
+	{0xF982, "廬"},
+	{0xF983, "旅"},
+	{0xF984, "濾"},
+	{0xF985, "礪"},
+	{0xF986, "閭"},
+	{0xF987, "驪"},
+	{0xF988, "麗"},
+	{0xF989, "黎"},
+	{0xF98A, "力"},
+	{0xF98B, "曆"},
+	{0xF98C, "歷"},
+	{0xF98D, "轢"},
+	{0xF98E, "年"},
+	{0xF98F, "憐"},
+	{0xF990, "戀"},
+	{0xF991, "撚"},
+	{0xF992, "漣"},
+	{0xF993, "煉"},
+	{0xF994, "璉"},
+	{0xF995, "秊"},
+	{0xF996, "練"},
+	{0xF997, "聯"},
+	{0xF998, "輦"},
+	{0xF999, "蓮"},
+	{0xF99A, "連"},
+	{0xF99B, "鍊"},
+	{0xF99C, "列"},
+	{0xF99D, "劣"},
+	{0xF99E, "咽"},
+	{0xF99F, "烈"},
+	{0xF9A0, "裂"},
+	{0xF9A1, "說"},
+	{0xF9A2, "廉"},
+	{0xF9A3, "念"},
+	{0xF9A4, "捻"},
+	{0xF9A5, "殮"},
+	{0xF9A6, "簾"},
+	{0xF9A7, "獵"},
+	{0xF9A8, "令"},
+	{0xF9A9, "囹"},
+	{0xF9AA, "寧"},
+	{0xF9AB, "嶺"},
+	{0xF9AC, "怜"},
+	{0xF9AD, "玲"},
+	{0xF9AE, "瑩"},
+	{0xF9AF, "羚"},
+	{0xF9B0, "聆"},
+	{0xF9B1, "鈴"},
+	{0xF9B2, "零"},
+	{0xF9B3, "靈"},
+	{0xF9B4, "領"},
+	{0xF9B5, "例"},
+	{0xF9B6, "禮"},
+	{0xF9B7, "醴"},
+	{0xF9B8, "隸"},
+	{0xF9B9, "惡"},
+	{0xF9BA, "了"},
+	{0xF9BB, "僚"},
+	{0xF9BC, "寮"},
+	{0xF9BD, "尿"},
+	{0xF9BE, "料"},
+	{0xF9BF, "樂"},
+	{0xF9C0, "燎"},
+	{0xF9C1, "療"},
+	{0xF9C2, "蓼"},
+	{0xF9C3, "遼"},
+	{0xF9C4, "龍"},
+	{0xF9C5, "暈"},
+	{0xF9C6, "阮"},
+	{0xF9C7, "劉"},
+	{0xF9C8, "杻"},
+	{0xF9C9, "柳"},
+	{0xF9CA, "流"},
+	{0xF9CB, "溜"},
+	{0xF9CC, "琉"},
+	{0xF9CD, "留"},
+	{0xF9CE, "硫"},
+	{0xF9CF, "紐"},
+	{0xF9D0, "類"},
+	{0xF9D1, "六"},
+	{0xF9D2, "戮"},
+	{0xF9D3, "陸"},
+	{0xF9D4, "倫"},
+	{0xF9D5, "崙"},
+	{0xF9D6, "淪"},
+	{0xF9D7, "輪"},
+	{0xF9D8, "律"},
+	{0xF9D9, "慄"},
+	{0xF9DA, "栗"},
+	{0xF9DB, "率"},
+	{0xF9DC, "隆"},
+	{0xF9DD, "利"},
+	{0xF9DE, "吏"},
+	{0xF9DF, "履"},
+	{0xF9E0, "易"},
+	{0xF9E1, "李"},
+	{0xF9E2, "梨"},
+	{0xF9E3, "泥"},
+	{0xF9E4, "理"},
+	{0xF9E5, "痢"},
+	{0xF9E6, "罹"},
+	{0xF9E7, "裏"},
+	{0xF9E8, "裡"},
+	{0xF9E9, "里"},
+	{0xF9EA, "離"},
+	{0xF9EB, "匿"},
+	{0xF9EC, "溺"},
+	{0xF9ED, "吝"},
+	{0xF9EE, "燐"},
+	{0xF9EF, "璘"},
+	{0xF9F0, "藺"},
+	{0xF9F1, "隣"},
+	{0xF9F2, "鱗"},
+	{0xF9F3, "麟"},
+	{0xF9F4, "林"},
+	{0xF9F5, "淋"},
+	{0xF9F6, "臨"},
+	{0xF9F7, "立"},
+	{0xF9F8, "笠"},
+	{0xF9F9, "粒"},
+	{0xF9FA, "狀"},
+	{0xF9FB, "炙"},
+	{0xF9FC, "識"},
+	{0xF9FD, "什"},
+	{0xF9FE, "茶"},
+	{0xF9FF, "刺"},
+	{0xFA00, "切"},
+	{0xFA01, "度"},
+	{0xFA02, "拓"},
+	{0xFA03, "糖"},
+	{0xFA04, "宅"},
+	{0xFA05, "洞"},
+	{0xFA06, "暴"},
+	{0xFA07, "輻"},
+	{0xFA08, "行"},
+	{0xFA09, "降"},
+	{0xFA0A, "見"},
+	{0xFA0B, "廓"},
+	{0xFA0C, "兀"},
+	{0xFA0D, "嗀"},
+	{0xFA10, "塚"},
+	{0xFA12, "晴"},
+	{0xFA15, "凞"},
+	{0xFA16, "猪"},
+	{0xFA17, "益"},
+	{0xFA18, "礼"},
+	{0xFA19, "神"},
+	{0xFA1A, "祥"},
+	{0xFA1B, "福"},
+	{0xFA1C, "靖"},
+	{0xFA1D, "精"},
+	{0xFA1E, "羽"},
+	{0xFA20, "蘒"},
+	{0xFA22, "諸"},
+	{0xFA25, "逸"},
+	{0xFA26, "都"},
+	{0xFA2A, "飯"},
+	{0xFA2B, "飼"},
+	{0xFA2C, "館"},
+	{0xFA2D, "鶴"},
+	{0xFA2E, "郞"},
+	{0xFA2F, "隷"},
+	{0xFA30, "侮"},
+	{0xFA31, "僧"},
+	{0xFA32, "免"},
+	{0xFA33, "勉"},
+	{0xFA34, "勤"},
+	{0xFA35, "卑"},
+	{0xFA36, "喝"},
+	{0xFA37, "嘆"},
+	{0xFA38, "器"},
+	{0xFA39, "塀"},
+	{0xFA3A, "墨"},
+	{0xFA3B, "層"},
+	{0xFA3C, "屮"},
+	{0xFA3D, "悔"},
+	{0xFA3E, "慨"},
+	{0xFA3F, "憎"},
+	{0xFA40, "懲"},
+	{0xFA41, "敏"},
+	{0xFA42, "既"},
+	{0xFA43, "暑"},
+	{0xFA44, "梅"},
+	{0xFA45, "海"},
+	{0xFA46, "渚"},
+	{0xFA47, "漢"},
+	{0xFA48, "煮"},
+	{0xFA49, "爫"},
+	{0xFA4A, "琢"},
+	{0xFA4B, "碑"},
+	{0xFA4C, "社"},
+	{0xFA4D, "祉"},
+	{0xFA4E, "祈"},
+	{0xFA4F, "祐"},
+	{0xFA50, "祖"},
+	{0xFA51, "祝"},
+	{0xFA52, "禍"},
+	{0xFA53, "禎"},
+	{0xFA54, "穀"},
+	{0xFA55, "突"},
+	{0xFA56, "節"},
+	{0xFA57, "練"},
+	{0xFA58, "縉"},
+	{0xFA59, "繁"},
+	{0xFA5A, "署"},
+	{0xFA5B, "者"},
+	{0xFA5C, "臭"},
+	{0xFA5D, "艹"},
+	{0xFA5E, "艹"},
+	{0xFA5F, "著"},
+	{0xFA60, "褐"},
+	{0xFA61, "視"},
+	{0xFA62, "謁"},
+	{0xFA63, "謹"},
+	{0xFA64, "賓"},
+	{0xFA65, "贈"},
+	{0xFA66, "辶"},
+	{0xFA67, "逸"},
+	{0xFA68, "難"},
+	{0xFA69, "響"},
+	{0xFA6A, "頻"},
+	{0xFA6B, "恵"},
+	{0xFA6C, "𤋮"},
+	{0xFA6D, "舘"},
+	{0xFA70, "並"},
+	{0xFA71, "况"},
+	{0xFA72, "全"},
+	{0xFA73, "侀"},
+	{0xFA74, "充"},
+	{0xFA75, "冀"},
+	{0xFA76, "勇"},
+	{0xFA77, "勺"},
+	{0xFA78, "喝"},
+	{0xFA79, "啕"},
+	{0xFA7A, "喙"},
+	{0xFA7B, "嗢"},
+	{0xFA7C, "塚"},
+	{0xFA7D, "墳"},
+	{0xFA7E, "奄"},
+	{0xFA7F, "奔"},
+	{0xFA80, "婢"},
+	{0xFA81, "嬨"},
+	{0xFA82, "廒"},
+	{0xFA83, "廙"},
+	{0xFA84, "彩"},
+	{0xFA85, "徭"},
+	{0xFA86, "惘"},
+	{0xFA87, "慎"},
+	{0xFA88, "愈"},
+	{0xFA89, "憎"},
+	{0xFA8A, "慠"},
+	{0xFA8B, "懲"},
+	{0xFA8C, "戴"},
+	{0xFA8D, "揄"},
+	{0xFA8E, "搜"},
+	{0xFA8F, "摒"},
+	{0xFA90, "敖"},
+	{0xFA91, "晴"},
+	{0xFA92, "朗"},
+	{0xFA93, "望"},
+	{0xFA94, "杖"},
+	{0xFA95, "歹"},
+	{0xFA96, "殺"},
+	{0xFA97, "流"},
+	{0xFA98, "滛"},
+	{0xFA99, "滋"},
+	{0xFA9A, "漢"},
+	{0xFA9B, "瀞"},
+	{0xFA9C, "煮"},
+	{0xFA9D, "瞧"},
+	{0xFA9E, "爵"},
+	{0xFA9F, "犯"},
+	{0xFAA0, "猪"},
+	{0xFAA1, "瑱"},
+	{0xFAA2, "甆"},
+	{0xFAA3, "画"},
+	{0xFAA4, "瘝"},
+	{0xFAA5, "瘟"},
+	{0xFAA6, "益"},
+	{0xFAA7, "盛"},
+	{0xFAA8, "直"},
+	{0xFAA9, "睊"},
+	{0xFAAA, "着"},
+	{0xFAAB, "磌"},
+	{0xFAAC, "窱"},
+	{0xFAAD, "節"},
+	{0xFAAE, "类"},
+	{0xFAAF, "絛"},
+	{0xFAB0, "練"},
+	{0xFAB1, "缾"},
+	{0xFAB2, "者"},
+	{0xFAB3, "荒"},
+	{0xFAB4, "華"},
+	{0xFAB5, "蝹"},
+	{0xFAB6, "襁"},
+	{0xFAB7, "覆"},
+	{0xFAB8, "視"},
+	{0xFAB9, "調"},
+	{0xFABA, "諸"},
+	{0xFABB, "請"},
+	{0xFABC, "謁"},
+	{0xFABD, "諾"},
+	{0xFABE, "諭"},
+	{0xFABF, "謹"},
+	{0xFAC0, "變"},
+	{0xFAC1, "贈"},
+	{0xFAC2, "輸"},
+	{0xFAC3, "遲"},
+	{0xFAC4, "醙"},
+	{0xFAC5, "鉶"},
+	{0xFAC6, "陼"},
+	{0xFAC7, "難"},
+	{0xFAC8, "靖"},
+	{0xFAC9, "韛"},
+	{0xFACA, "響"},
+	{0xFACB, "頋"},
+	{0xFACC, "頻"},
+	{0xFACD, "鬒"},
+	{0xFACE, "龜"},
+	{0xFACF, "𢡊"},
+	{0xFAD0, "𢡄"},
+	{0xFAD1, "𣏕"},
+	{0xFAD2, "㮝"},
+	{0xFAD3, "䀘"},
+	{0xFAD4, "䀹"},
+	{0xFAD5, "𥉉"},
+	{0xFAD6, "𥳐"},
+	{0xFAD7, "𧻓"},
+	{0xFAD8, "齃"},
+	{0xFAD9, "龎"},
+	{0xFB1D, "יִ"},
+	{0xFB1F, "ײַ"},
+	{0xFB2A, "שׁ"},
+	{0xFB2B, "שׂ"},
+	{0xFB2C, "שּׁ"},
+	{0xFB2D, "שּׂ"},
+	{0xFB2E, "אַ"},
+	{0xFB2F, "אָ"},
+	{0xFB30, "אּ"},
+	{0xFB31, "בּ"},
+	{0xFB32, "גּ"},
+	{0xFB33, "דּ"},
+	{0xFB34, "הּ"},
+	{0xFB35, "וּ"},
+	{0xFB36, "זּ"},
+	{0xFB38, "טּ"},
+	{0xFB39, "יּ"},
+	{0xFB3A, "ךּ"},
+	{0xFB3B, "כּ"},
+	{0xFB3C, "לּ"},
+	{0xFB3E, "מּ"},
+	{0xFB40, "נּ"},
+	{0xFB41, "סּ"},
+	{0xFB43, "ףּ"},
+	{0xFB44, "פּ"},
+	{0xFB46, "צּ"},
+	{0xFB47, "קּ"},
+	{0xFB48, "רּ"},
+	{0xFB49, "שּ"},
+	{0xFB4A, "תּ"},
+	{0xFB4B, "וֹ"},
+	{0xFB4C, "בֿ"},
+	{0xFB4D, "כֿ"},
+	{0xFB4E, "פֿ"},
+	{0x1109A, "𑂚"},
+	{0x1109C, "𑂜"},
+	{0x110AB, "𑂫"},
+	{0x1112E, "𑄮"},
+	{0x1112F, "𑄯"},
+	{0x1134B, "𑍋"},
+	{0x1134C, "𑍌"},
+	{0x114BB, "𑒻"},
+	{0x114BC, "𑒼"},
+	{0x114BE, "𑒾"},
+	{0x115BA, "𑖺"},
+	{0x115BB, "𑖻"},
+	{0x11938, "𑤸"},
+	{0x1D15E, "𝅗𝅥"},
+	{0x1D15F, "𝅘𝅥"},
+	{0x1D160, "𝅘𝅥𝅮"},
+	{0x1D161, "𝅘𝅥𝅯"},
+	{0x1D162, "𝅘𝅥𝅰"},
+	{0x1D163, "𝅘𝅥𝅱"},
+	{0x1D164, "𝅘𝅥𝅲"},
+	{0x1D1BB, "𝆹𝅥"},
+	{0x1D1BC, "𝆺𝅥"},
+	{0x1D1BD, "𝆹𝅥𝅮"},
+	{0x1D1BE, "𝆺𝅥𝅮"},
+	{0x1D1BF, "𝆹𝅥𝅯"},
+	{0x1D1C0, "𝆺𝅥𝅯"},
+	{0x2F800, "丽"},
+	{0x2F801, "丸"},
+	{0x2F802, "乁"},
+	{0x2F803, "𠄢"},
+	{0x2F804, "你"},
+	{0x2F805, "侮"},
+	{0x2F806, "侻"},
+	{0x2F807, "倂"},
+	{0x2F808, "偺"},
+	{0x2F809, "備"},
+	{0x2F80A, "僧"},
+	{0x2F80B, "像"},
+	{0x2F80C, "㒞"},
+	{0x2F80D, "𠘺"},
+	{0x2F80E, "免"},
+	{0x2F80F, "兔"},
+	{0x2F810, "兤"},
+	{0x2F811, "具"},
+	{0x2F812, "𠔜"},
+	{0x2F813, "㒹"},
+	{0x2F814, "內"},
+	{0x2F815, "再"},
+	{0x2F816, "𠕋"},
+	{0x2F817, "冗"},
+	{0x2F818, "冤"},
+	{0x2F819, "仌"},
+	{0x2F81A, "冬"},
+	{0x2F81B, "况"},
+	{0x2F81C, "𩇟"},
+	{0x2F81D, "凵"},
+	{0x2F81E, "刃"},
+	{0x2F81F, "㓟"},
+	{0x2F820, "刻"},
+	{0x2F821, "剆"},
+	{0x2F822, "割"},
+	{0x2F823, "剷"},
+	{0x2F824, "㔕"},
+	{0x2F825, "勇"},
+	{0x2F826, "勉"},
+	{0x2F827, "勤"},
+	{0x2F828, "勺"},
+	{0x2F829, "包"},
+	{0x2F82A, "匆"},
+	{0x2F82B, "北"},
+	{0x2F82C, "卉"},
+	{0x2F82D, "卑"},
+	{0x2F82E, "博"},
+	{0x2F82F, "即"},
+	{0x2F830, "卽"},
+	{0x2F831, "卿"},
+	{0x2F832, "卿"},
+	{0x2F833, "卿"},
+	{0x2F834, "𠨬"},
+	{0x2F835, "灰"},
+	{0x2F836, "及"},
+	{0x2F837, "叟"},
+	{0x2F838, "𠭣"},
+	{0x2F839, "叫"},
+	{0x2F83A, "叱"},
+	{0x2F83B, "吆"},
+	{0x2F83C, "咞"},
+	{0x2F83D, "吸"},
+	{0x2F83E, "呈"},
+	{0x2F83F, "周"},
+	{0x2F840, "咢"},
+	{0x2F841, "哶"},
+	{0x2F842, "唐"},
+	{0x2F843, "啓"},
+	{0x2F844, "啣"},
+	{0x2F845, "善"},
+	{0x2F846, "善"},
+	{0x2F847, "喙"},
+	{0x2F848, "喫"},
+	{0x2F849, "喳"},
+	{0x2F84A, "嗂"},
+	{0x2F84B, "圖"},
+	{0x2F84C, "嘆"},
+	{0x2F84D, "圗"},
+	{0x2F84E, "噑"},
+	{0x2F84F, "噴"},
+	{0x2F850, "切"},
+	{0x2F851, "壮"},
+	{0x2F852, "城"},
+	{0x2F853, "埴"},
+	{0x2F854, "堍"},
+	{0x2F855, "型"},
+	{0x2F856, "堲"},
+	{0x2F857, "報"},
+	{0x2F858, "墬"},
+	{0x2F859, "𡓤"},
+	{0x2F85A, "売"},
+	{0x2F85B, "壷"},
+	{0x2F85C, "夆"},
+	{0x2F85D, "多"},
+	{0x2F85E, "夢"},
+	{0x2F85F, "奢"},
+	{0x2F860, "𡚨"},
+	{0x2F861, "𡛪"},
+	{0x2F862, "姬"},
+	{0x2F863, "娛"},
+	{0x2F864, "娧"},
+	{0x2F865, "姘"},
+	{0x2F866, "婦"},
+	{0x2F867, "㛮"},
+	{0x2F868, "㛼"},
+	{0x2F869, "嬈"},
+	{0x2F86A, "嬾"},
+	{0x2F86B, "嬾"},
+	{0x2F86C, "𡧈"},
+	{0x2F86D, "寃"},
+	{0x2F86E, "寘"},
+	{0x2F86F, "寧"},
+	{0x2F870, "寳"},
+	{0x2F871, "𡬘"},
+	{0x2F872, "寿"},
+	{0x2F873, "将"},
+	{0x2F874, "当"},
+	{0x2F875, "尢"},
+	{0x2F876, "㞁"},
+	{0x2F877, "屠"},
+	{0x2F878, "屮"},
+	{0x2F879, "峀"},
+	{0x2F87A, "岍"},
+	{0x2F87B, "𡷤"},
+	{0x2F87C, "嵃"},
+	{0x2F87D, "𡷦"},
+	{0x2F87E, "嵮"},
+	{0x2F87F, "嵫"},
+	{0x2F880, "嵼"},
+	{0x2F881, "巡"},
+	{0x2F882, "巢"},
+	{0x2F883, "㠯"},
+	{0x2F884, "巽"},
+	{0x2F885, "帨"},
+	{0x2F886, "帽"},
+	{0x2F887, "幩"},
+	{0x2F888, "㡢"},
+	{0x2F889, "𢆃"},
+	{0x2F88A, "㡼"},
+	{0x2F88B, "庰"},
+	{0x2F88C, "庳"},
+	{0x2F88D, "庶"},
+	{0x2F88E, "廊"},
+	{0x2F88F, "𪎒"},
+	{0x2F890, "廾"},
+	{0x2F891, "𢌱"},
+	{0x2F892, "𢌱"},
+	{0x2F893, "舁"},
+	{0x2F894, "弢"},
+	{0x2F895, "弢"},
+	{0x2F896, "㣇"},
+	{0x2F897, "𣊸"},
+	{0x2F898, "𦇚"},
+	{0x2F899, "形"},
+	{0x2F89A, "彫"},
+	{0x2F89B, "㣣"},
+	{0x2F89C, "徚"},
+	{0x2F89D, "忍"},
+	{0x2F89E, "志"},
+	{0x2F89F, "忹"},
+	{0x2F8A0, "悁"},
+	{0x2F8A1, "㤺"},
+	{0x2F8A2, "㤜"},
+	{0x2F8A3, "悔"},
+	{0x2F8A4, "𢛔"},
+	{0x2F8A5, "惇"},
+	{0x2F8A6, "慈"},
+	{0x2F8A7, "慌"},
+	{0x2F8A8, "慎"},
+	{0x2F8A9, "慌"},
+	{0x2F8AA, "慺"},
+	{0x2F8AB, "憎"},
+	{0x2F8AC, "憲"},
+	{0x2F8AD, "憤"},
+	{0x2F8AE, "憯"},
+	{0x2F8AF, "懞"},
+	{0x2F8B0, "懲"},
+	{0x2F8B1, "懶"},
+	{0x2F8B2, "成"},
+	{0x2F8B3, "戛"},
+	{0x2F8B4, "扝"},
+	{0x2F8B5, "抱"},
+	{0x2F8B6, "拔"},
+	{0x2F8B7, "捐"},
+	{0x2F8B8, "𢬌"},
+	{0x2F8B9, "挽"},
+	{0x2F8BA, "拼"},
+	{0x2F8BB, "捨"},
+	{0x2F8BC, "掃"},
+	{0x2F8BD, "揤"},
+	{0x2F8BE, "𢯱"},
+	{0x2F8BF, "搢"},
+	{0x2F8C0, "揅"},
+	{0x2F8C1, "掩"},
+	{0x2F8C2, "㨮"},
+	{0x2F8C3, "摩"},
+	{0x2F8C4, "摾"},
+	{0x2F8C5, "撝"},
+	{0x2F8C6, "摷"},
+	{0x2F8C7, "㩬"},
+	{0x2F8C8, "敏"},
+	{0x2F8C9, "敬"},
+	{0x2F8CA, "𣀊"},
+	{0x2F8CB, "旣"},
+	{0x2F8CC, "書"},
+	{0x2F8CD, "晉"},
+	{0x2F8CE, "㬙"},
+	{0x2F8CF, "暑"},
+	{0x2F8D0, "㬈"},
+	{0x2F8D1, "㫤"},
+	{0x2F8D2, "冒"},
+	{0x2F8D3, "冕"},
+	{0x2F8D4, "最"},
+	{0x2F8D5, "暜"},
+	{0x2F8D6, "肭"},
+	{0x2F8D7, "䏙"},
+	{0x2F8D8, "朗"},
+	{0x2F8D9, "望"},
+	{0x2F8DA, "朡"},
+	{0x2F8DB, "杞"},
+	{0x2F8DC, "杓"},
+	{0x2F8DD, "𣏃"},
+	{0x2F8DE, "㭉"},
+	{0x2F8DF, "柺"},
+	{0x2F8E0, "枅"},
+	{0x2F8E1, "桒"},
+	{0x2F8E2, "梅"},
+	{0x2F8E3, "𣑭"},
+	{0x2F8E4, "梎"},
+	{0x2F8E5, "栟"},
+	{0x2F8E6, "椔"},
+	{0x2F8E7, "㮝"},
+	{0x2F8E8, "楂"},
+	{0x2F8E9, "榣"},
+	{0x2F8EA, "槪"},
+	{0x2F8EB, "檨"},
+	{0x2F8EC, "𣚣"},
+	{0x2F8ED, "櫛"},
+	{0x2F8EE, "㰘"},
+	{0x2F8EF, "次"},
+	{0x2F8F0, "𣢧"},
+	{0x2F8F1, "歔"},
+	{0x2F8F2, "㱎"},
+	{0x2F8F3, "歲"},
+	{0x2F8F4, "殟"},
+	{0x2F8F5, "殺"},
+	{0x2F8F6, "殻"},
+	{0x2F8F7, "𣪍"},
+	{0x2F8F8, "𡴋"},
+	{0x2F8F9, "𣫺"},
+	{0x2F8FA, "汎"},
+	{0x2F8FB, "𣲼"},
+	{0x2F8FC, "沿"},
+	{0x2F8FD, "泍"},
+	{0x2F8FE, "汧"},
+	{0x2F8FF, "洖"},
+	{0x2F900, "派"},
+	{0x2F901, "海"},
+	{0x2F902, "流"},
+	{0x2F903, "浩"},
+	{0x2F904, "浸"},
+	{0x2F905, "涅"},
+	{0x2F906, "𣴞"},
+	{0x2F907, "洴"},
+	{0x2F908, "港"},
+	{0x2F909, "湮"},
+	{0x2F90A, "㴳"},
+	{0x2F90B, "滋"},
+	{0x2F90C, "滇"},
+	{0x2F90D, "𣻑"},
+	{0x2F90E, "淹"},
+	{0x2F90F, "潮"},
+	{0x2F910, "𣽞"},
+	{0x2F911, "𣾎"},
+	{0x2F912, "濆"},
+	{0x2F913, "瀹"},
+	{0x2F914, "瀞"},
+	{0x2F915, "瀛"},
+	{0x2F916, "㶖"},
+	{0x2F917, "灊"},
+	{0x2F918, "災"},
+	{0x2F919, "灷"},
+	{0x2F91A, "炭"},
+	{0x2F91B, "𠔥"},
+	{0x2F91C, "煅"},
+	{0x2F91D, "𤉣"},
+	{0x2F91E, "熜"},
+	{0x2F91F, "𤎫"},
+	{0x2F920, "爨"},
+	{0x2F921, "爵"},
+	{0x2F922, "牐"},
+	{0x2F923, "𤘈"},
+	{0x2F924, "犀"},
+	{0x2F925, "犕"},
+	{0x2F926, "𤜵"},
+	{0x2F927, "𤠔"},
+	{0x2F928, "獺"},
+	{0x2F929, "王"},
+	{0x2F92A, "㺬"},
+	{0x2F92B, "玥"},
+	{0x2F92C, "㺸"},
+	{0x2F92D, "㺸"},
+	{0x2F92E, "瑇"},
+	{0x2F92F, "瑜"},
+	{0x2F930, "瑱"},
+	{0x2F931, "璅"},
+	{0x2F932, "瓊"},
+	{0x2F933, "㼛"},
+	{0x2F934, "甤"},
+	{0x2F935, "𤰶"},
+	{0x2F936, "甾"},
+	{0x2F937, "𤲒"},
+	{0x2F938, "異"},
+	{0x2F939, "𢆟"},
+	{0x2F93A, "瘐"},
+	{0x2F93B, "𤾡"},
+	{0x2F93C, "𤾸"},
+	{0x2F93D, "𥁄"},
+	{0x2F93E, "㿼"},
+	{0x2F93F, "䀈"},
+	{0x2F940, "直"},
+	{0x2F941, "𥃳"},
+	{0x2F942, "𥃲"},
+	{0x2F943, "𥄙"},
+	{0x2F944, "𥄳"},
+	{0x2F945, "眞"},
+	{0x2F946, "真"},
+	{0x2F947, "真"},
+	{0x2F948, "睊"},
+	{0x2F949, "䀹"},
+	{0x2F94A, "瞋"},
+	{0x2F94B, "䁆"},
+	{0x2F94C, "䂖"},
+	{0x2F94D, "𥐝"},
+	{0x2F94E, "硎"},
+	{0x2F94F, "碌"},
+	{0x2F950, "磌"},
+	{0x2F951, "䃣"},
+	{0x2F952, "𥘦"},
+	{0x2F953, "祖"},
+	{0x2F954, "𥚚"},
+	{0x2F955, "𥛅"},
+	{0x2F956, "福"},
+	{0x2F957, "秫"},
+	{0x2F958, "䄯"},
+	{0x2F959, "穀"},
+	{0x2F95A, "穊"},
+	{0x2F95B, "穏"},
+	{0x2F95C, "𥥼"},
+	{0x2F95D, "𥪧"},
+	{0x2F95E, "𥪧"},
+	{0x2F95F, "竮"},
+	{0x2F960, "䈂"},
+	{0x2F961, "𥮫"},
+	{0x2F962, "篆"},
+	{0x2F963, "築"},
+	{0x2F964, "䈧"},
+	{0x2F965, "𥲀"},
+	{0x2F966, "糒"},
+	{0x2F967, "䊠"},
+	{0x2F968, "糨"},
+	{0x2F969, "糣"},
+	{0x2F96A, "紀"},
+	{0x2F96B, "𥾆"},
+	{0x2F96C, "絣"},
+	{0x2F96D, "䌁"},
+	{0x2F96E, "緇"},
+	{0x2F96F, "縂"},
+	{0x2F970, "繅"},
+	{0x2F971, "䌴"},
+	{0x2F972, "𦈨"},
+	{0x2F973, "𦉇"},
+	{0x2F974, "䍙"},
+	{0x2F975, "𦋙"},
+	{0x2F976, "罺"},
+	{0x2F977, "𦌾"},
+	{0x2F978, "羕"},
+	{0x2F979, "翺"},
+	{0x2F97A, "者"},
+	{0x2F97B, "𦓚"},
+	{0x2F97C, "𦔣"},
+	{0x2F97D, "聠"},
+	{0x2F97E, "𦖨"},
+	{0x2F97F, "聰"},
+	{0x2F980, "𣍟"},
+	{0x2F981, "䏕"},
+	{0x2F982, "育"},
+	{0x2F983, "脃"},
+	{0x2F984, "䐋"},
+	{0x2F985, "脾"},
+	{0x2F986, "媵"},
+	{0x2F987, "𦞧"},
+	{0x2F988, "𦞵"},
+	{0x2F989, "𣎓"},
+	{0x2F98A, "𣎜"},
+	{0x2F98B, "舁"},
+	{0x2F98C, "舄"},
+	{0x2F98D, "辞"},
+	{0x2F98E, "䑫"},
+	{0x2F98F, "芑"},
+	{0x2F990, "芋"},
+	{0x2F991, "芝"},
+	{0x2F992, "劳"},
+	{0x2F993, "花"},
+	{0x2F994, "芳"},
+	{0x2F995, "芽"},
+	{0x2F996, "苦"},
+	{0x2F997, "𦬼"},
+	{0x2F998, "若"},
+	{0x2F999, "茝"},
+	{0x2F99A, "荣"},
+	{0x2F99B, "莭"},
+	{0x2F99C, "茣"},
+	{0x2F99D, "莽"},
+	{0x2F99E, "菧"},
+	{0x2F99F, "著"},
+	{0x2F9A0, "荓"},
+	{0x2F9A1, "菊"},
+	{0x2F9A2, "菌"},
+	{0x2F9A3, "菜"},
+	{0x2F9A4, "𦰶"},
+	{0x2F9A5, "𦵫"},
+	{0x2F9A6, "𦳕"},
+	{0x2F9A7, "䔫"},
+	{0x2F9A8, "蓱"},
+	{0x2F9A9, "蓳"},
+	{0x2F9AA, "蔖"},
+	{0x2F9AB, "𧏊"},
+	{0x2F9AC, "蕤"},
+	{0x2F9AD, "𦼬"},
+	{0x2F9AE, "䕝"},
+	{0x2F9AF, "䕡"},
+	{0x2F9B0, "𦾱"},
+	{0x2F9B1, "𧃒"},
+	{0x2F9B2, "䕫"},
+	{0x2F9B3, "虐"},
+	{0x2F9B4, "虜"},
+	{0x2F9B5, "虧"},
+	{0x2F9B6, "虩"},
+	{0x2F9B7, "蚩"},
+	{0x2F9B8, "蚈"},
+	{0x2F9B9, "蜎"},
+	{0x2F9BA, "蛢"},
+	{0x2F9BB, "蝹"},
+	{0x2F9BC, "蜨"},
+	{0x2F9BD, "蝫"},
+	{0x2F9BE, "螆"},
+	{0x2F9BF, "䗗"},
+	{0x2F9C0, "蟡"},
+	{0x2F9C1, "蠁"},
+	{0x2F9C2, "䗹"},
+	{0x2F9C3, "衠"},
+	{0x2F9C4, "衣"},
+	{0x2F9C5, "𧙧"},
+	{0x2F9C6, "裗"},
+	{0x2F9C7, "裞"},
+	{0x2F9C8, "䘵"},
+	{0x2F9C9, "裺"},
+	{0x2F9CA, "㒻"},
+	{0x2F9CB, "𧢮"},
+	{0x2F9CC, "𧥦"},
+	{0x2F9CD, "䚾"},
+	{0x2F9CE, "䛇"},
+	{0x2F9CF, "誠"},
+	{0x2F9D0, "諭"},
+	{0x2F9D1, "變"},
+	{0x2F9D2, "豕"},
+	{0x2F9D3, "𧲨"},
+	{0x2F9D4, "貫"},
+	{0x2F9D5, "賁"},
+	{0x2F9D6, "贛"},
+	{0x2F9D7, "起"},
+	{0x2F9D8, "𧼯"},
+	{0x2F9D9, "𠠄"},
+	{0x2F9DA, "跋"},
+	{0x2F9DB, "趼"},
+	{0x2F9DC, "跰"},
+	{0x2F9DD, "𠣞"},
+	{0x2F9DE, "軔"},
+	{0x2F9DF, "輸"},
+	{0x2F9E0, "𨗒"},
+	{0x2F9E1, "𨗭"},
+	{0x2F9E2, "邔"},
+	{0x2F9E3, "郱"},
+	{0x2F9E4, "鄑"},
+	{0x2F9E5, "𨜮"},
+	{0x2F9E6, "鄛"},
+	{0x2F9E7, "鈸"},
+	{0x2F9E8, "鋗"},
+	{0x2F9E9, "鋘"},
+	{0x2F9EA, "鉼"},
+	{0x2F9EB, "鏹"},
+	{0x2F9EC, "鐕"},
+	{0x2F9ED, "𨯺"},
+	{0x2F9EE, "開"},
+	{0x2F9EF, "䦕"},
+	{0x2F9F0, "閷"},
+	{0x2F9F1, "𨵷"},
+	{0x2F9F2, "䧦"},
+	{0x2F9F3, "雃"},
+	{0x2F9F4, "嶲"},
+	{0x2F9F5, "霣"},
+	{0x2F9F6, "𩅅"},
+	{0x2F9F7, "𩈚"},
+	{0x2F9F8, "䩮"},
+	{0x2F9F9, "䩶"},
+	{0x2F9FA, "韠"},
+	{0x2F9FB, "𩐊"},
+	{0x2F9FC, "䪲"},
+	{0x2F9FD, "𩒖"},
+	{0x2F9FE, "頋"},
+	{0x2F9FF, "頋"},
+	{0x2FA00, "頩"},
+	{0x2FA01, "𩖶"},
+	{0x2FA02, "飢"},
+	{0x2FA03, "䬳"},
+	{0x2FA04, "餩"},
+	{0x2FA05, "馧"},
+	{0x2FA06, "駂"},
+	{0x2FA07, "駾"},
+	{0x2FA08, "䯎"},
+	{0x2FA09, "𩬰"},
+	{0x2FA0A, "鬒"},
+	{0x2FA0B, "鱀"},
+	{0x2FA0C, "鳽"},
+	{0x2FA0D, "䳎"},
+	{0x2FA0E, "䳭"},
+	{0x2FA0F, "鵧"},
+	{0x2FA10, "𪃎"},
+	{0x2FA11, "䳸"},
+	{0x2FA12, "𪄅"},
+	{0x2FA13, "𪈎"},
+	{0x2FA14, "𪊑"},
+	{0x2FA15, "麻"},
+	{0x2FA16, "䵖"},
+	{0x2FA17, "黹"},
+	{0x2FA18, "黾"},
+	{0x2FA19, "鼅"},
+	{0x2FA1A, "鼏"},
+	{0x2FA1B, "鼖"},
+	{0x2FA1C, "鼻"},
+	{0x2FA1D, "𪘀"},
+}
+
+// compositions holds the primary composites by their canonical pairs.
+var compositions = []composition{
+	{0x003C, 0x0338, 0x226E},
+	{0x003D, 0x0338, 0x2260},
+	{0x003E, 0x0338, 0x226F},
+	{0x0041, 0x0300, 0x00C0},
+	{0x0041, 0x0301, 0x00C1},
+	{0x0041, 0x0302, 0x00C2},
+	{0x0041, 0x0303, 0x00C3},
+	{0x0041, 0x0304, 0x0100},
+	{0x0041, 0x0306, 0x0102},
+	{0x0041, 0x0307, 0x0226},
+	{0x0041, 0x0308, 0x00C4},
+	{0x0041, 0x0309, 0x1EA2},
+	{0x0041, 0x030A, 0x00C5},
+	{0x0041, 0x030C, 0x01CD},
+	{0x0041, 0x030F, 0x0200},
+	{0x0041, 0x0311, 0x0202},
+	{0x0041, 0x0323, 0x1EA0},
+	{0x0041, 0x0325, 0x1E00},
+	{0x0041, 0x0328, 0x0104},
+	{0x0042, 0x0307, 0x1E02},
+	{0x0042, 0x0323, 0x1E04},
+	{0x0042, 0x0331, 0x1E06},
+	{0x0043, 0x0301, 0x0106},
+	{0x0043, 0x0302, 0x0108},
+	{0x0043, 0x0307, 0x010A},
+	{0x0043, 0x030C, 0x010C},
+	{0x0043, 0x0327, 0x00C7},
+	{0x0044, 0x0307, 0x1E0A},
+	{0x0044, 0x030C, 0x010E},
+	{0x0044, 0x0323, 0x1E0C},
+	{0x0044, 0x0327, 0x1E10},
+	{0x0044, 0x032D, 0x1E12},
+	{0x0044, 0x0331, 0x1E0E},
+	{0x0045, 0x0300, 0x00C8},
+	{0x0045, 0x0301, 0x00C9},
+	{0x0045, 0x0302, 0x00CA},
+	{0x0045, 0x0303, 0x1EBC},
+	{0x0045, 0x0304, 0x0112},
+	{0x0045, 0x0306, 0x0114},
+	{0x0045, 0x0307, 0x0116},
+	{0x0045, 0x0308, 0x00CB},
+	{0x0045, 0x0309, 0x1EBA},
+	{0x0045, 0x030C, 0x011A},
+	{0x0045, 0x030F, 0x0204},
+	{0x0045, 0x0311, 0x0206},
+	{0x0045, 0x0323, 0x1EB8},
+	{0x0045, 0x0327, 0x0228},
+	{0x0045, 0x0328, 0x0118},
+	{0x0045, 0x032D, 0x1E18},
+	{0x0045, 0x0330, 0x1E1A},
+	{0x0046, 0x0307, 0x1E1E},
+	{0x0047, 0x0301, 0x01F4},
+	{0x0047, 0x0302, 0x011C},
+	{0x0047, 0x0304, 0x1E20},
+	{0x0047, 0x0306, 0x011E},
+	{0x0047, 0x0307, 0x0120},
+	{0x0047, 0x030C, 0x01E6},
+	{0x0047, 0x0327, 0x0122},
+	{0x0048, 0x0302, 0x0124},
+	{0x0048, 0x0307, 0x1E22},
+	{0x0048, 0x0308, 0x1E26},
+	{0x0048, 0x030C, 0x021E},
+	{0x0048, 0x0323, 0x1E24},
+	{0x0048, 0x0327, 0x1E28},
+	{0x0048, 0x032E, 0x1E2A},
+	{0x0049, 0x0300, 0x00CC},
+	{0x0049, 0x0301, 0x00CD},
+	{0x0049, 0x0302, 0x00CE},
+	{0x0049, 0x0303, 0x0128},
+	{0x0049, 0x0304, 0x012A},
+	{0x0049, 0x0306, 0x012C},
+	{0x0049, 0x0307, 0x0130},
+	{0x0049, 0x0308, 0x00CF},
+	{0x0049, 0x0309, 0x1EC8},
+	{0x0049, 0x030C, 0x01CF},
+	{0x0049, 0x030F, 0x0208},
+	{0x0049, 0x0311, 0x020A},
+	{0x0049, 0x0323, 0x1ECA},
+	{0x0049, 0x0328, 0x012E},
+	{0x0049, 0x0330, 0x1E2C},
+	{0x004A, 0x0302, 0x0134},
+	{0x004B, 0x0301, 0x1E30},
+	{0x004B, 0x030C, 0x01E8},
+	{0x004B, 0x0323, 0x1E32},
+	{0x004B, 0x0327, 0x0136},
+	{0x004B, 0x0331, 0x1E34},
+	{0x004C, 0x0301, 0x0139},
+	{0x004C, 0x030C, 0x013D},
+	{0x004C, 0x0323, 0x1E36},
+	{0x004C, 0x0327, 0x013B},
+	{0x004C, 0x032D, 0x1E3C},
+	{0x004C, 0x0331, 0x1E3A},
+	{0x004D, 0x0301, 0x1E3E},
+	{0x004D, 0x0307, 0x1E40},
+	{0x004D, 0x0323, 0x1E42},
+	{0x004E, 0x0300, 0x01F8},
+	{0x004E, 0x0301, 0x0143},
+	{0x004E, 0x0303, 0x00D1},
+	{0x004E, 0x0307, 0x1E44},
+	{0x004E, 0x030C, 0x0147},
+	{0x004E, 0x0323, 0x1E46},
+	{0x004E, 0x0327, 0x0145},
+	{0x004E, 0x032D, 0x1E4A},
+	{0x004E, 0x0331, 0x1E48},
+	{0x004F, 0x0300, 0x00D2},
+	{0x004F, 0x0301, 0x00D3},
+	{0x004F, 0x0302, 0x00D4},
+	{0x004F, 0x0303, 0x00D5},
+	{0x004F, 0x0304, 0x014C},
+	{0x004F, 0x0306, 0x014E},
+	{0x004F, 0x0307, 0x022E},
+	{0x004F, 0x0308, 0x00D6},
+	{0x004F, 0x0309, 0x1ECE},
+	{0x004F, 0x030B, 0x0150},
+	{0x004F, 0x030C, 0x01D1},
+	{0x004F, 0x030F, 0x020C},
+	{0x004F, 0x0311, 0x020E},
+	{0x004F, 0x031B, 0x01A0},
+	{0x004F, 0x0323, 0x1ECC},
+	{0x004F, 0x0328, 0x01EA},
+	{0x0050, 0x0301, 0x1E54},
+	{0x0050, 0x0307, 0x1E56},
+	{0x0052, 0x0301, 0x0154},
+	{0x0052, 0x0307, 0x1E58},
+	{0x0052, 0x030C, 0x0158},
+	{0x0052, 0x030F, 0x0210},
+	{0x0052, 0x0311, 0x0212},
+	{0x0052, 0x0323, 0x1E5A},
+	{0x0052, 0x0327, 0x0156},
+	{0x0052, 0x0331, 0x1E5E},
+	{0x0053, 0x0301, 0x015A},
+	{0x0053, 0x0302, 0x015C},
+	{0x0053, 0x0307, 0x1E60},
+	{0x0053, 0x030C, 0x0160},
+	{0x0053, 0x0323, 0x1E62},
+	{0x0053, 0x0326, 0x0218},
+	{0x0053, 0x0327, 0x015E},
+	{0x0054, 0x0307, 0x1E6A},
+	{0x0054, 0x030C, 0x0164},
+	{0x0054, 0x0323, 0x1E6C},
+	{0x0054, 0x0326, 0x021A},
+	{0x0054, 0x0327, 0x0162},
+	{0x0054, 0x032D, 0x1E70},
+	{0x0054, 0x0331, 0x1E6E},
+	{0x0055, 0x0300, 0x00D9},
+	{0x0055, 0x0301, 0x00DA},
+	{0x0055, 0x0302, 0x00DB},
+	{0x0055, 0x0303, 0x0168},
+	{0x0055, 0x0304, 0x016A},
+	{0x0055, 0x0306, 0x016C},
+	{0x0055, 0x0308, 0x00DC},
+	{0x0055, 0x0309, 0x1EE6},
+	{0x0055, 0x030A, 0x016E},
+	{0x0055, 0x030B, 0x0170},
+	{0x0055, 0x030C, 0x01D3},
+	{0x0055, 0x030F, 0x0214},
+	{0x0055, 0x0311, 0x0216},
+	{0x0055, 0x031B, 0x01AF},
+	{0x0055, 0x0323, 0x1EE4},
+	{0x0055, 0x0324, 0x1E72},
+	{0x0055, 0x0328, 0x0172},
+	{0x0055, 0x032D, 0x1E76},
+	{0x0055, 0x0330, 0x1E74},
+	{0x0056, 0x0303, 0x1E7C},
+	{0x0056, 0x0323, 0x1E7E},
+	{0x0057, 0x0300, 0x1E80},
+	{0x0057, 0x0301, 0x1E82},
+	{0x0057, 0x0302, 0x0174},
+	{0x0057, 0x0307, 0x1E86},
+	{0x0057, 0x0308, 0x1E84},
+	{0x0057, 0x0323, 0x1E88},
+	{0x0058, 0x0307, 0x1E8A},
+	{0x0058, 0x0308, 0x1E8C},
+	{0x0059, 0x0300, 0x1EF2},
+	{0x0059, 0x0301, 0x00DD},
+	{0x0059, 0x0302, 0x0176},
+	{0x0059, 0x0303, 0x1EF8},
+	{0x0059, 0x0304, 0x0232},
+	{0x0059, 0x0307, 0x1E8E},
+	{0x0059, 0x0308, 0x0178},
+	{0x0059, 0x0309, 0x1EF6},
+	{0x0059, 0x0323, 0x1EF4},
+	{0x005A, 0x0301, 0x0179},
+	{0x005A, 0x0302, 0x1E90},
+	{0x005A, 0x0307, 0x017B},
+	{0x005A, 0x030C, 0x017D},
+	{0x005A, 0x0323, 0x1E92},
+	{0x005A, 0x0331, 0x1E94},
+	{0x0061, 0x0300, 0x00E0},
+	{0x0061, 0x0301, 0x00E1},
+	{0x0061, 0x0302, 0x00E2},
+	{0x0061, 0x0303, 0x00E3},
+	{0x0061, 0x0304, 0x0101},
+	{0x0061, 0x0306, 0x0103},
+	{0x0061, 0x0307, 0x0227},
+	{0x0061, 0x0308, 0x00E4},
+	{0x0061, 0x0309, 0x1EA3},
+	{0x0061, 0x030A, 0x00E5},
+	{0x0061, 0x030C, 0x01CE},
+	{0x0061, 0x030F, 0x0201},
+	{0x0061, 0x0311, 0x0203},
+	{0x0061, 0x0323, 0x1EA1},
+	{0x0061, 0x0325, 0x1E01},
+	{0x0061, 0x0328, 0x0105},
+	{0x0062, 0x0307, 0x1E03},
+	{0x0062, 0x0323, 0x1E05},
+	{0x0062, 0x0331, 0x1E07},
+	{0x0063, 0x0301, 0x0107},
+	{0x0063, 0x0302, 0x0109},
+	{0x0063, 0x0307, 0x010B},
+	{0x0063, 0x030C, 0x010D},
+	{0x0063, 0x0327, 0x00E7},
+	{0x0064, 0x0307, 0x1E0B},
+	{0x0064, 0x030C, 0x010F},
+	{0x0064, 0x0323, 0x1E0D},
+	{0x0064, 0x0327, 0x1E11},
+	{0x0064, 0x032D, 0x1E13},
+	{0x0064, 0x0331, 0x1E0F},
+	{0x0065, 0x0300, 0x00E8},
+	{0x0065, 0x0301, 0x00E9},
+	{0x0065, 0x0302, 0x00EA},
+	{0x0065, 0x0303, 0x1EBD},
+	{0x0065, 0x0304, 0x0113},
+	{0x0065, 0x0306, 0x0115},
+	{0x0065, 0x0307, 0x0117},
+	{0x0065, 0x0308, 0x00EB},
+	{0x0065, 0x0309, 0x1EBB},
+	{0x0065, 0x030C, 0x011B},
+	{0x0065, 0x030F, 0x0205},
+	{0x0065, 0x0311, 0x0207},
+	{0x0065, 0x0323, 0x1EB9},
+	{0x0065, 0x0327, 0x0229},
+	{0x0065, 0x0328, 0x0119},
+	{0x0065, 0x032D, 0x1E19},
+	{0x0065, 0x0330, 0x1E1B},
+	{0x0066, 0x0307, 0x1E1F},
+	{0x0067, 0x0301, 0x01F5},
+	{0x0067, 0x0302, 0x011D},
+	{0x0067, 0x0304, 0x1E21},
+	{0x0067, 0x0306, 0x011F},
+	{0x0067, 0x0307, 0x0121},
+	{0x0067, 0x030C, 0x01E7},
+	{0x0067, 0x0327, 0x0123},
+	{0x0068, 0x0302, 0x0125},
+	{0x0068, 0x0307, 0x1E23},
+	{0x0068, 0x0308, 0x1E27},
+	{0x0068, 0x030C, 0x021F},
+	{0x0068, 0x0323, 0x1E25},
+	{0x0068, 0x0327, 0x1E29},
+	{0x0068, 0x032E, 0x1E2B},
+	{0x0068, 0x0331, 0x1E96},
+	{0x0069, 0x0300, 0x00EC},
+	{0x0069, 0x0301, 0x00ED},
+	{0x0069, 0x0302, 0x00EE},
+	{0x0069, 0x0303, 0x0129},
+	{0x0069, 0x0304, 0x012B},
+	{0x0069, 0x0306, 0x012D},
+	{0x0069, 0x0308, 0x00EF},
+	{0x0069, 0x0309, 0x1EC9},
+	{0x0069, 0x030C, 0x01D0},
+	{0x0069, 0x030F, 0x0209},
+	{0x0069, 0x0311, 0x020B},
+	{0x0069, 0x0323, 0x1ECB},
+	{0x0069, 0x0328, 0x012F},
+	{0x0069, 0x0330, 0x1E2D},
+	{0x006A, 0x0302, 0x0135},
+	{0x006A, 0x030C, 0x01F0},
+	{0x006B, 0x0301, 0x1E31},
+	{0x006B, 0x030C, 0x01E9},
+	{0x006B, 0x0323, 0x1E33},
+	{0x006B, 0x0327, 0x0137},
+	{0x006B, 0x0331, 0x1E35},
+	{0x006C, 0x0301, 0x013A},
+	{0x006C, 0x030C, 0x013E},
+	{0x006C, 0x0323, 0x1E37},
+	{0x006C, 0x0327, 0x013C},
+	{0x006C, 0x032D, 0x1E3D},
+	{0x006C, 0x0331, 0x1E3B},
+	{0x006D, 0x0301, 0x1E3F},
+	{0x006D, 0x0307, 0x1E41},
+	{0x006D, 0x0323, 0x1E43},
+	{0x006E, 0x0300, 0x01F9},
+	{0x006E, 0x0301, 0x0144},
+	{0x006E, 0x0303, 0x00F1},
+	{0x006E, 0x0307, 0x1E45},
+	{0x006E, 0x030C, 0x0148},
+	{0x006E, 0x0323, 0x1E47},
+	{0x006E, 0x0327, 0x0146},
+	{0x006E, 0x032D, 0x1E4B},
+	{0x006E, 0x0331, 0x1E49},
+	{0x006F, 0x0300, 0x00F2},
+	{0x006F, 0x0301, 0x00F3},
+	{0x006F, 0x0302, 0x00F4},
+	{0x006F, 0x0303, 0x00F5},
+	{0x006F, 0x0304, 0x014D},
+	{0x006F, 0x0306, 0x014F},
+	{0x006F, 0x0307, 0x022F},
+	{0x006F, 0x0308, 0x00F6},
+	{0x006F, 0x0309, 0x1ECF},
+	{0x006F, 0x030B, 0x0151},
+	{0x006F, 0x030C, 0x01D2},
+	{0x006F, 0x030F, 0x020D},
+	{0x006F, 0x0311, 0x020F},
+	{0x006F, 0x031B, 0x01A1},
+	{0x006F, 0x0323, 0x1ECD},
+	{0x006F, 0x0328, 0x01EB},
+	{0x0070, 0x0301, 0x1E55},
+	{0x0070, 0x0307, 0x1E57},
+	{0x0072, 0x0301, 0x0155},
+	{0x0072, 0x0307, 0x1E59},
+	{0x0072, 0x030C, 0x0159},
+	{0x0072, 0x030F, 0x0211},
+	{0x0072, 0x0311, 0x0213},
+	{0x0072, 0x0323, 0x1E5B},
+	{0x0072, 0x0327, 0x0157},
+	{0x0072, 0x0331, 0x1E5F},
+	{0x0073, 0x0301, 0x015B},
+	{0x0073, 0x0302, 0x015D},
+	{0x0073, 0x0307, 0x1E61},
+	{0x0073, 0x030C, 0x0161},
+	{0x0073, 0x0323, 0x1E63},
+	{0x0073, 0x0326, 0x0219},
+	{0x0073, 0x0327, 0x015F},
+	{0x0074, 0x0307, 0x1E6B},
+	{0x0074, 0x0308, 0x1E97},
+	{0x0074, 0x030C, 0x0165},
+	{0x0074, 0x0323, 0x1E6D},
+	{0x0074, 0x0326, 0x021B},
+	{0x0074, 0x0327, 0x0163},
+	{0x0074, 0x032D, 0x1E71},
+	{0x0074, 0x0331, 0x1E6F},
+	{0x0075, 0x0300, 0x00F9},
+	{0x0075, 0x0301, 0x00FA},
+	{0x0075, 0x0302, 0x00FB},
+	{0x0075, 0x0303, 0x0169},
+	{0x0075, 0x0304, 0x016B},
+	{0x0075, 0x0306, 0x016D},
+	{0x0075, 0x0308, 0x00FC},
+	{0x0075, 0x0309, 0x1EE7},
+	{0x0075, 0x030A, 0x016F},
+	{0x0075, 0x030B, 0x0171},
+	{0x0075, 0x030C, 0x01D4},
+	{0x0075, 0x030F, 0x0215},
+	{0x0075, 0x0311, 0x0217},
+	{0x0075, 0x031B, 0x01B0},
+	{0x0075, 0x0323, 0x1EE5},
+	{0x0075, 0x0324, 0x1E73},
+	{0x0075, 0x0328, 0x0173},
+	{0x0075, 0x032D, 0x1E77},
+	{0x0075, 0x0330, 0x1E75},
+	{0x0076, 0x0303, 0x1E7D},
+	{0x0076, 0x0323, 0x1E7F},
+	{0x0077, 0x0300, 0x1E81},
+	{0x0077, 0x0301, 0x1E83},
+	{0x0077, 0x0302, 0x0175},
+	{0x0077, 0x0307, 0x1E87},
+	{0x0077, 0x0308, 0x1E85},
+	{0x0077, 0x030A, 0x1E98},
+	{0x0077, 0x0323, 0x1E89},
+	{0x0078, 0x0307, 0x1E8B},
+	{0x0078, 0x0308, 0x1E8D},
+	{0x0079, 0x0300, 0x1EF3},
+	{0x0079, 0x0301, 0x00FD},
+	{0x0079, 0x0302, 0x0177},
+	{0x0079, 0x0303, 0x1EF9},
+	{0x0079, 0x0304, 0x0233},
+	{0x0079, 0x0307, 0x1E8F},
+	{0x0079, 0x0308, 0x00FF},
+	{0x0079, 0x0309, 0x1EF7},
+	{0x0079, 0x030A, 0x1E99},
+	{0x0079, 0x0323, 0x1EF5},
+	{0x007A, 0x0301, 0x017A},
+	{0x007A, 0x0302, 0x1E91},
+	{0x007A, 0x0307, 0x017C},
+	{0x007A, 0x030C, 0x017E},
+	{0x007A, 0x0323, 0x1E93},
+	{0x007A, 0x0331, 0x1E95},
+	{0x00A8, 0x0300, 0x1FED},
+	{0x00A8, 0x0301, 0x0385},
+	{0x00A8, 0x0342, 0x1FC1},
+	{0x00C2, 0x0300, 0x1EA6},
+	{0x00C2, 0x0301, 0x1EA4},
+	{0x00C2, 0x0303, 0x1EAA},
+	{0x00C2, 0x0309, 0x1EA8},
+	{0x00C4, 0x0304, 0x01DE},
+	{0x00C5, 0x0301, 0x01FA},
+	{0x00C6, 0x0301, 0x01FC},
+	{0x00C6, 0x0304, 0x01E2},
+	{0x00C7, 0x0301, 0x1E08},
+	{0x00CA, 0x0300, 0x1EC0},
+	{0x00CA, 0x0301, 0x1EBE},
+	{0x00CA, 0x0303, 0x1EC4},
+	{0x00CA, 0x0309, 0x1EC2},
+	{0x00CF, 0x0301, 0x1E2E},
+	{0x00D4, 0x0300, 0x1ED2},
+	{0x00D4, 0x0301, 0x1ED0},
+	{0x00D4, 0x0303, 0x1ED6},
+	{0x00D4, 0x0309, 0x1ED4},
+	{0x00D5, 0x0301, 0x1E4C},
+	{0x00D5, 0x0304, 0x022C},
+	{0x00D5, 0x0308, 0x1E4E},
+	{0x00D6, 0x0304, 0x022A},
+	{0x00D8, 0x0301, 0x01FE},
+	{0x00DC, 0x0300, 0x01DB},
+	{0x00DC, 0x0301, 0x01D7},
+	{0x00DC, 0x0304, 0x01D5},
+	{0x00DC, 0x030C, 0x01D9},
+	{0x00E2, 0x0300, 0x1EA7},
+	{0x00E2, 0x0301, 0x1EA5},
+	{0x00E2, 0x0303, 0x1EAB},
+	{0x00E2, 0x0309, 0x1EA9},
+	{0x00E4, 0x0304, 0x01DF},
+	{0x00E5, 0x0301, 0x01FB},
+	{0x00E6, 0x0301, 0x01FD},
+	{0x00E6, 0x0304, 0x01E3},
+	{0x00E7, 0x0301, 0x1E09},
+	{0x00EA, 0x0300, 0x1EC1},
+	{0x00EA, 0x0301, 0x1EBF},
+	{0x00EA, 0x0303, 0x1EC5},
+	{0x00EA, 0x0309, 0x1EC3},
+	{0x00EF, 0x0301, 0x1E2F},
+	{0x00F4, 0x0300, 0x1ED3},
+	{0x00F4, 0x0301, 0x1ED1},
+	{0x00F4, 0x0303, 0x1ED7},
+	{0x00F4, 0x0309, 0x1ED5},
+	{0x00F5, 0x0301, 0x1E4D},
+	{0x00F5, 0x0304, 0x022D},
+	{0x00F5, 0x0308, 0x1E4F},
+	{0x00F6, 0x0304, 0x022B},
+	{0x00F8, 0x0301, 0x01FF},
+	{0x00FC, 0x0300, 0x01DC},
+	{0x00FC, 0x0301, 0x01D8},
+	{0x00FC, 0x0304, 0x01D6},
+	{0x00FC, 0x030C, 0x01DA},
+	{0x0102, 0x0300, 0x1EB0},
+	{0x0102, 0x0301, 0x1EAE},
+	{0x0102, 0x0303, 0x1EB4},
+	{0x0102, 0x0309, 0x1EB2},
+	{0x0103, 0x0300, 0x1EB1},
+	{0x0103, 0x0301, 0x1EAF},
+	{0x0103, 0x0303, 0x1EB5},
+	{0x0103, 0x0309, 0x1EB3},
+	{0x0112, 0x0300, 0x1E14},
+	{0x0112, 0x0301, 0x1E16},
+	{0x0113, 0x0300, 0x1E15},
+	{0x0113, 0x0301, 0x1E17},
+	{0x014C, 0x0300, 0x1E50},
+	{0x014C, 0x0301, 0x1E52},
+	{0x014D, 0x0300, 0x1E51},
+	{0x014D, 0x0301, 0x1E53},
+	{0x015A, 0x0307, 0x1E64},
+	{0x015B, 0x0307, 0x1E65},
+	{0x0160, 0x0307, 0x1E66},
+	{0x0161, 0x0307, 0x1E67},
+	{0x0168, 0x0301, 0x1E78},
+	{0x0169, 0x0301, 0x1E79},
+	{0x016A, 0x0308, 0x1E7A},
+	{0x016B, 0x0308, 0x1E7B},
+	{0x017F, 0x0307, 0x1E9B},
+	{0x01A0, 0x0300, 0x1EDC},
+	{0x01A0, 0x0301, 0x1EDA},
+	{0x01A0, 0x0303, 0x1EE0},
+	{0x01A0, 0x0309, 0x1EDE},
+	{0x01A0, 0x0323, 0x1EE2},
+	{0x01A1, 0x0300, 0x1EDD},
+	{0x01A1, 0x0301, 0x1EDB},
+	{0x01A1, 0x0303, 0x1EE1},
+	{0x01A1, 0x0309, 0x1EDF},
+	{0x01A1, 0x0323, 0x1EE3},
+	{0x01AF, 0x0300, 0x1EEA},
+	{0x01AF, 0x0301, 0x1EE8},
+	{0x01AF, 0x0303, 0x1EEE},
+	{0x01AF, 0x0309, 0x1EEC},
+	{0x01AF, 0x0323, 0x1EF0},
+	{0x01B0, 0x0300, 0x1EEB},
+	{0x01B0, 0x0301, 0x1EE9},
+	{0x01B0, 0x0303, 0x1EEF},
+	{0x01B0, 0x0309, 0x1EED},
+	{0x01B0, 0x0323, 0x1EF1},
+	{0x01B7, 0x030C, 0x01EE},
+	{0x01EA, 0x0304, 0x01EC},
+	{0x01EB, 0x0304, 0x01ED},
+	{0x0226, 0x0304, 0x01E0},
+	{0x0227, 0x0304, 0x01E1},
+	{0x0228, 0x0306, 0x1E1C},
+	{0x0229, 0x0306, 0x1E1D},
+	{0x022E, 0x0304, 0x0230},
+	{0x022F, 0x0304, 0x0231},
+	{0x0292, 0x030C, 0x01EF},
+	{0x0391, 0x0300, 0x1FBA},
+	{0x0391, 0x0301, 0x0386},
+	{0x0391, 0x0304, 0x1FB9},
+	{0x0391, 0x0306, 0x1FB8},
+	{0x0391, 0x0313, 0x1F08},
+	{0x0391, 0x0314, 0x1F09},
+	{0x0391, 0x0345, 0x1FBC},
+	{0x0395, 0x0300, 0x1FC8},
+	{0x0395, 0x0301, 0x0388},
+	{0x0395, 0x0313, 0x1F18},
+	{0x0395, 0x0314, 0x1F19},
+	{0x0397, 0x0300, 0x1FCA},
+	{0x0397, 0x0301, 0x0389},
+	{0x0397, 0x0313, 0x1F28},
+	{0x0397, 0x0314, 0x1F29},
+	{0x0397, 0x0345, 0x1FCC},
+	{0x0399, 0x0300, 0x1FDA},
+	{0x0399, 0x0301, 0x038A},
+	{0x0399, 0x0304, 0x1FD9},
+	{0x0399, 0x0306, 0x1FD8},
+	{0x0399, 0x0308, 0x03AA},
+	{0x0399, 0x0313, 0x1F38},
+	{0x0399, 0x0314, 0x1F39},
+	{0x039F, 0x0300, 0x1FF8},
+	{0x039F, 0x0301, 0x038C},
+	{0x039F, 0x0313, 0x1F48},
+	{0x039F, 0x0314, 0x1F49},
+	{0x03A1, 0x0314, 0x1FEC},
+	{0x03A5, 0x0300, 0x1FEA},
+	{0x03A5, 0x0301, 0x038E},
+	{0x03A5, 0x0304, 0x1FE9},
+	{0x03A5, 0x0306, 0x1FE8},
+	{0x03A5, 0x0308, 0x03AB},
+	{0x03A5, 0x0314, 0x1F59},
+	{0x03A9, 0x0300, 0x1FFA},
+	{0x03A9, 0x0301, 0x038F},
+	{0x03A9, 0x0313, 0x1F68},
+	{0x03A9, 0x0314, 0x1F69},
+	{0x03A9, 0x0345, 0x1FFC},
+	{0x03AC, 0x0345, 0x1FB4},
+	{0x03AE, 0x0345, 0x1FC4},
+	{0x03B1, 0x0300, 0x1F70},
+	{0x03B1, 0x0301, 0x03AC},
+	{0x03B1, 0x0304, 0x1FB1},
+	{0x03B1, 0x0306, 0x1FB0},
+	{0x03B1, 0x0313, 0x1F00},
+	{0x03B1, 0x0314, 0x1F01},
+	{0x03B1, 0x0342, 0x1FB6},
+	{0x03B1, 0x0345, 0x1FB3},
+	{0x03B5, 0x0300, 0x1F72},
+	{0x03B5, 0x0301, 0x03AD},
+	{0x03B5, 0x0313, 0x1F10},
+	{0x03B5, 0x0314, 0x1F11},
+	{0x03B7, 0x0300, 0x1F74},
+	{0x03B7, 0x0301, 0x03AE},
+	{0x03B7, 0x0313, 0x1F20},
+	{0x03B7, 0x0314, 0x1F21},
+	{0x03B7, 0x0342, 0x1FC6},
+	{0x03B7, 0x0345, 0x1FC3},
+	{0x03B9, 0x0300, 0x1F76},
+	{0x03B9, 0x0301, 0x03AF},
+	{0x03B9, 0x0304, 0x1FD1},
+	{0x03B9, 0x0306, 0x1FD0},
+	{0x03B9, 0x0308, 0x03CA},
+	{0x03B9, 0x0313, 0x1F30},
+	{0x03B9, 0x0314, 0x1F31},
+	{0x03B9, 0x0342, 0x1FD6},
+	{0x03BF, 0x0300, 0x1F78},
+	{0x03BF, 0x0301, 0x03CC},
+	{0x03BF, 0x0313, 0x1F40},
+	{0x03BF, 0x0314, 0x1F41},
+	{0x03C1, 0x0313, 0x1FE4},
+	{0x03C1, 0x0314, 0x1FE5},
+	{0x03C5, 0x0300, 0x1F7A},
+	{0x03C5, 0x0301, 0x03CD},
+	{0x03C5, 0x0304, 0x1FE1},
+	{0x03C5, 0x0306, 0x1FE0},
+	{0x03C5, 0x0308, 0x03CB},
+	{0x03C5, 0x0313, 0x1F50},
+	{0x03C5, 0x0314, 0x1F51},
+	{0x03C5, 0x0342, 0x1FE6},
+	{0x03C9, 0x0300, 0x1F7C},
+	{0x03C9, 0x0301, 0x03CE},
+	{0x03C9, 0x0313, 0x1F60},
+	{0x03C9, 0x0314, 0x1F61},
+	{0x03C9, 0x0342, 0x1FF6},
+	{0x03C9, 0x0345, 0x1FF3},
+	{0x03CA, 0x0300, 0x1FD2},
+	{0x03CA, 0x0301, 0x0390},
+	{0x03CA, 0x0342, 0x1FD7},
+	{0x03CB, 0x0300, 0x1FE2},
+	{0x03CB, 0x0301, 0x03B0},
+	{0x03CB, 0x0342, 0x1FE7},
+	{0x03CE, 0x0345, 0x1FF4},
+	{0x03D2, 0x0301, 0x03D3},
+	{0x03D2, 0x0308, 0x03D4},
+	{0x0406, 0x0308, 0x0407},
+	{0x0410, 0x0306, 0x04D0},
+	{0x0410, 0x0308, 0x04D2},
+	{0x0413, 0x0301, 0x0403},
+	{0x0415, 0x0300, 0x0400},
+	{0x0415, 0x0306, 0x04D6},
+	{0x0415, 0x0308, 0x0401},
+	{0x0416, 0x0306, 0x04C1},
+	{0x0416, 0x0308, 0x04DC},
+	{0x0417, 0x0308, 0x04DE},
+	{0x0418, 0x0300, 0x040D},
+	{0x0418, 0x0304, 0x04E2},
+	{0x0418, 0x0306, 0x0419},
+	{0x0418, 0x0308, 0x04E4},
+	{0x041A, 0x0301, 0x040C},
+	{0x041E, 0x0308, 0x04E6},
+	{0x0423, 0x0304, 0x04EE},
+	{0x0423, 0x0306, 0x040E},
+	{0x0423, 0x0308, 0x04F0},
+	{0x0423, 0x030B, 0x04F2},
+	{0x0427, 0x0308, 0x04F4},
+	{0x042B, 0x0308, 0x04F8},
+	{0x042D, 0x0308, 0x04EC},
+	{0x0430, 0x0306, 0x04D1},
+	{0x0430, 0x0308, 0x04D3},
+	{0x0433, 0x0301, 0x0453},
+	{0x0435, 0x0300, 0x0450},
+	{0x0435, 0x0306, 0x04D7},
+	{0x0435, 0x0308, 0x0451},
+	{0x0436, 0x0306, 0x04C2},
+	{0x0436, 0x0308, 0x04DD},
+	{0x0437, 0x0308, 0x04DF},
+	{0x0438, 0x0300, 0x045D},
+	{0x0438, 0x0304, 0x04E3},
+	{0x0438, 0x0306, 0x0439},
+	{0x0438, 0x0308, 0x04E5},
+	{0x043A, 0x0301, 0x045C},
+	{0x043E, 0x0308, 0x04E7},
+	{0x0443, 0x0304, 0x04EF},
+	{0x0443, 0x0306, 0x045E},
+	{0x0443, 0x0308, 0x04F1},
+	{0x0443, 0x030B, 0x04F3},
+	{0x0447, 0x0308, 0x04F5},
+	{0x044B, 0x0308, 0x04F9},
+	{0x044D, 0x0308, 0x04ED},
+	{0x0456, 0x0308, 0x0457},
+	{0x0474, 0x030F, 0x0476},
+	{0x0475, 0x030F, 0x0477},
+	{0x04D8, 0x0308, 0x04DA},
+	{0x04D9, 0x0308, 0x04DB},
+	{0x04E8, 0x0308, 0x04EA},
+	{0x04E9, 0x0308, 0x04EB},
+	{0x0627, 0x0653, 0x0622},
+	{0x0627, 0x0654, 0x0623},
+	{0x0627, 0x0655, 0x0625},
+	{0x0648, 0x0654, 0x0624},
+	{0x064A, 0x0654, 0x0626},
+	{0x06C1, 0x0654, 0x06C2},
+	{0x06D2, 0x0654, 0x06D3},
+	{0x06D5, 0x0654, 0x06C0},
+	{0x0928, 0x093C, 0x0929},
+	{0x0930, 0x093C, 0x0931},
+	{0x0933, 0x093C, 0x0934},
+	{0x09C7, 0x09BE, 0x09CB},
+	{0x09C7, 0x09D7, 0x09CC},
+	{0x0B47, 0x0B3E, 0x0B4B},
+	{0x0B47, 0x0B56, 0x0B48},
+	{0x0B47, 0x0B57, 0x0B4C},
+	{0x0B92, 0x0BD7, 0x0B94},
+	{0x0BC6, 0x0BBE, 0x0BCA},
+	{0x0BC6, 0x0BD7, 0x0BCC},
+	{0x0BC7, 0x0BBE, 0x0BCB},
+	{0x0C46, 0x0C56, 0x0C48},
+	{0x0CBF, 0x0CD5, 0x0CC0},
+	{0x0CC6, 0x0CC2, 0x0CCA},
+	{0x0CC6, 0x0CD5, 0x0CC7},
+	{0x0CC6, 0x0CD6, 0x0CC8},
+	{0x0CCA, 0x0CD5, 0x0CCB},
+	{0x0D46, 0x0D3E, 0x0D4A},
+	{0x0D46, 0x0D57, 0x0D4C},
+	{0x0D47, 0x0D3E, 0x0D4B},
+	{0x0DD9, 0x0DCA, 0x0DDA},
+	{0x0DD9, 0x0DCF, 0x0DDC},
+	{0x0DD9, 0x0DDF, 0x0DDE},
+	{0x0DDC, 0x0DCA, 0x0DDD},
+	{0x1025, 0x102E, 0x1026},
+	{0x1B05, 0x1B35, 0x1B06},
+	{0x1B07, 0x1B35, 0x1B08},
+	{0x1B09, 0x1B35, 0x1B0A},
+	{0x1B0B, 0x1B35, 0x1B0C},
+	{0x1B0D, 0x1B35, 0x1B0E},
+	{0x1B11, 0x1B35, 0x1B12},
+	{0x1B3A, 0x1B35, 0x1B3B},
+	{0x1B3C, 0x1B35, 0x1B3D},
+	{0x1B3E, 0x1B35, 0x1B40},
+	{0x1B3F, 0x1B35, 0x1B41},
+	{0x1B42, 0x1B35, 0x1B43},
+	{0x1E36, 0x0304, 0x1E38},
+	{0x1E37, 0x0304, 0x1E39},
+	{0x1E5A, 0x0304, 0x1E5C},
+	{0x1E5B, 0x0304, 0x1E5D},
+	{0x1E62, 0x0307, 0x1E68},
+	{0x1E63, 0x0307, 0x1E69},
+	{0x1EA0, 0x0302, 0x1EAC},
+	{0x1EA0, 0x0306, 0x1EB6},
+	{0x1EA1, 0x0302, 0x1EAD},
+	{0x1EA1, 0x0306, 0x1EB7},
+	{0x1EB8, 0x0302, 0x1EC6},
+	{0x1EB9, 0x0302, 0x1EC7},
+	{0x1ECC, 0x0302, 0x1ED8},
+	{0x1ECD, 0x0302, 0x1ED9},
+	{0x1F00, 0x0300, 0x1F02},
+	{0x1F00, 0x0301, 0x1F04},
+	{0x1F00, 0x0342, 0x1F06},
+	{0x1F00, 0x0345, 0x1F80},
+	{0x1F01, 0x0300, 0x1F03},
+	{0x1F01, 0x0301, 0x1F05},
+	{0x1F01, 0x0342, 0x1F07},
+	{0x1F01, 0x0345, 0x1F81},
+	{0x1F02, 0x0345, 0x1F82},
+	{0x1F03, 0x0345, 0x1F83},
+	{0x1F04, 0x0345, 0x1F84},
+	{0x1F05, 0x0345, 0x1F85},
+	{0x1F06, 0x0345, 0x1F86},
+	{0x1F07, 0x0345, 0x1F87},
+	{0x1F08, 0x0300, 0x1F0A},
+	{0x1F08, 0x0301, 0x1F0C},
+	{0x1F08, 0x0342, 0x1F0E},
+	{0x1F08, 0x0345, 0x1F88},
+	{0x1F09, 0x0300, 0x1F0B},
+	{0x1F09, 0x0301, 0x1F0D},
+	{0x1F09, 0x0342, 0x1F0F},
+	{0x1F09, 0x0345, 0x1F89},
+	{0x1F0A, 0x0345, 0x1F8A},
+	{0x1F0B, 0x0345, 0x1F8B},
+	{0x1F0C, 0x0345, 0x1F8C},
+	{0x1F0D, 0x0345, 0x1F8D},
+	{0x1F0E, 0x0345, 0x1F8E},
+	{0x1F0F, 0x0345, 0x1F8F},
+	{0x1F10, 0x0300, 0x1F12},
+	{0x1F10, 0x0301, 0x1F14},
+	{0x1F11, 0x0300, 0x1F13},
+	{0x1F11, 0x0301, 0x1F15},
+	{0x1F18, 0x0300, 0x1F1A},
+	{0x1F18, 0x0301, 0x1F1C},
+	{0x1F19, 0x0300, 0x1F1B},
+	{0x1F19, 0x0301, 0x1F1D},
+	{0x1F20, 0x0300, 0x1F22},
+	{0x1F20, 0x0301, 0x1F24},
+	{0x1F20, 0x0342, 0x1F26},
+	{0x1F20, 0x0345, 0x1F90},
+	{0x1F21, 0x0300, 0x1F23},
+	{0x1F21, 0x0301, 0x1F25},
+	{0x1F21, 0x0342, 0x1F27},
+	{0x1F21, 0x0345, 0x1F91},
+	{0x1F22, 0x0345, 0x1F92},
+	{0x1F23, 0x0345, 0x1F93},
+	{0x1F24, 0x0345, 0x1F94},
+	{0x1F25, 0x0345, 0x1F95},
+	{0x1F26, 0x0345, 0x1F96},
+	{0x1F27, 0x0345, 0x1F97},
+	{0x1F28, 0x0300, 0x1F2A},
+	{0x1F28, 0x0301, 0x1F2C},
+	{0x1F28, 0x0342, 0x1F2E},
+	{0x1F28, 0x0345, 0x1F98},
+	{0x1F29, 0x0300, 0x1F2B},
+	{0x1F29, 0x0301, 0x1F2D},
+	{0x1F29, 0x0342, 0x1F2F},
+	{0x1F29, 0x0345, 0x1F99},
+	{0x1F2A, 0x0345, 0x1F9A},
+	{0x1F2B, 0x0345, 0x1F9B},
+	{0x1F2C, 0x0345, 0x1F9C},
+	{0x1F2D, 0x0345, 0x1F9D},
+	{0x1F2E, 0x0345, 0x1F9E},
+	{0x1F2F, 0x0345, 0x1F9F},
+	{0x1F30, 0x0300, 0x1F32},
+	{0x1F30, 0x0301, 0x1F34},
+	{0x1F30, 0x0342, 0x1F36},
+	{0x1F31, 0x0300, 0x1F33},
+	{0x1F31, 0x0301, 0x1F35},
+	{0x1F31, 0x0342, 0x1F37},
+	{0x1F38, 0x0300, 0x1F3A},
+	{0x1F38, 0x0301, 0x1F3C},
+	{0x1F38, 0x0342, 0x1F3E},
+	{0x1F39, 0x0300, 0x1F3B},
+	{0x1F39, 0x0301, 0x1F3D},
+	{0x1F39, 0x0342, 0x1F3F},
+	{0x1F40, 0x0300, 0x1F42},
+	{0x1F40, 0x0301, 0x1F44},
+	{0x1F41, 0x0300, 0x1F43},
+	{0x1F41, 0x0301, 0x1F45},
+	{0x1F48, 0x0300, 0x1F4A},
+	{0x1F48, 0x0301, 0x1F4C},
+	{0x1F49, 0x0300, 0x1F4B},
+	{0x1F49, 0x0301, 0x1F4D},
+	{0x1F50, 0x0300, 0x1F52},
+	{0x1F50, 0x0301, 0x1F54},
+	{0x1F50, 0x0342, 0x1F56},
+	{0x1F51, 0x0300, 0x1F53},
+	{0x1F51, 0x0301, 0x1F55},
+	{0x1F51, 0x0342, 0x1F57},
+	{0x1F59, 0x0300, 0x1F5B},
+	{0x1F59, 0x0301, 0x1F5D},
+	{0x1F59, 0x0342, 0x1F5F},
+	{0x1F60, 0x0300, 0x1F62},
+	{0x1F60, 0x0301, 0x1F64},
+	{0x1F60, 0x0342, 0x1F66},
+	{0x1F60, 0x0345, 0x1FA0},
+	{0x1F61, 0x0300, 0x1F63},
+	{0x1F61, 0x0301, 0x1F65},
+	{0x1F61, 0x0342, 0x1F67},
+	{0x1F61, 0x0345, 0x1FA1},
+	{0x1F62, 0x0345, 0x1FA2},
+	{0x1F63, 0x0345, 0x1FA3},
+	{0x1F64, 0x0345, 0x1FA4},
+	{0x1F65, 0x0345, 0x1FA5},
+	{0x1F66, 0x0345, 0x1FA6},
+	{0x1F67, 0x0345, 0x1FA7},
+	{0x1F68, 0x0300, 0x1F6A},
+	{0x1F68, 0x0301, 0x1F6C},
+	{0x1F68, 0x0342, 0x1F6E},
+	{0x1F68, 0x0345, 0x1FA8},
+	{0x1F69, 0x0300, 0x1F6B},
+	{0x1F69, 0x0301, 0x1F6D},
+	{0x1F69, 0x0342, 0x1F6F},
+	{0x1F69, 0x0345, 0x1FA9},
+	{0x1F6A, 0x0345, 0x1FAA},
+	{0x1F6B, 0x0345, 0x1FAB},
+	{0x1F6C, 0x0345, 0x1FAC},
+	{0x1F6D, 0x0345, 0x1FAD},
+	{0x1F6E, 0x0345, 0x1FAE},
+	{0x1F6F, 0x0345, 0x1FAF},
+	{0x1F70, 0x0345, 0x1FB2},
+	{0x1F74, 0x0345, 0x1FC2},
+	{0x1F7C, 0x0345, 0x1FF2},
+	{0x1FB6, 0x0345, 0x1FB7},
+	{0x1FBF, 0x0300, 0x1FCD},
+	{0x1FBF, 0x0301, 0x1FCE},
+	{0x1FBF, 0x0342, 0x1FCF},
+	{0x1FC6, 0x0345, 0x1FC7},
+	{0x1FF6, 0x0345, 0x1FF7},
+	{0x1FFE, 0x0300, 0x1FDD},
+	{0x1FFE, 0x0301, 0x1FDE},
+	{0x1FFE, 0x0342, 0x1FDF},
+	{0x2190, 0x0338, 0x219A},
+	{0x2192, 0x0338, 0x219B},
+	{0x2194, 0x0338, 0x21AE},
+	{0x21D0, 0x0338, 0x21CD},
+	{0x21D2, 0x0338, 0x21CF},
+	{0x21D4, 0x0338, 0x21CE},
+	{0x2203, 0x0338, 0x2204},
+	{0x2208, 0x0338, 0x2209},
+	{0x220B, 0x0338, 0x220C},
+	{0x2223, 0x0338, 0x2224},
+	{0x2225, 0x0338, 0x2226},
+	{0x223C, 0x0338, 0x2241},
+	{0x2243, 0x0338, 0x2244},
+	{0x2245, 0x0338, 0x2247},
+	{0x2248, 0x0338, 0x2249},
+	{0x224D, 0x0338, 0x226D},
+	{0x2261, 0x0338, 0x2262},
+	{0x2264, 0x0338, 0x2270},
+	{0x2265, 0x0338, 0x2271},
+	{0x2272, 0x0338, 0x2274},
+	{0x2273, 0x0338, 0x2275},
+	{0x2276, 0x0338, 0x2278},
+	{0x2277, 0x0338, 0x2279},
+	{0x227A, 0x0338, 0x2280},
+	{0x227B, 0x0338, 0x2281},
+	{0x227C, 0x0338, 0x22E0},
+	{0x227D, 0x0338, 0x22E1},
+	{0x2282, 0x0338, 0x2284},
+	{0x2283, 0x0338, 0x2285},
+	{0x2286, 0x0338, 0x2288},
+	{0x2287, 0x0338, 0x2289},
+	{0x2291, 0x0338, 0x22E2},
+	{0x2292, 0x0338, 0x22E3},
+	{0x22A2, 0x0338, 0x22AC},
+	{0x22A8, 0x0338, 0x22AD},
+	{0x22A9, 0x0338, 0x22AE},
+	{0x22AB, 0x0338, 0x22AF},
+	{0x22B2, 0x0338, 0x22EA},
+	{0x22B3, 0x0338, 0x22EB},
+	{0x22B4, 0x0338, 0x22EC},
+	{0x22B5, 0x0338, 0x22ED},
+	{0x3046, 0x3099, 0x3094},
+	{0x304B, 0x3099, 0x304C},
+	{0x304D, 0x3099, 0x304E},
+	{0x304F, 0x3099, 0x3050},
+	{0x3051, 0x3099, 0x3052},
+	{0x3053, 0x3099, 0x3054},
+	{0x3055, 0x3099, 0x3056},
+	{0x3057, 0x3099, 0x3058},
+	{0x3059, 0x3099, 0x305A},
+	{0x305B, 0x3099, 0x305C},
+	{0x305D, 0x3099, 0x305E},
+	{0x305F, 0x3099, 0x3060},
+	{0x3061, 0x3099, 0x3062},
+	{0x3064, 0x3099, 0x3065},
+	{0x3066, 0x3099, 0x3067},
+	{0x3068, 0x3099, 0x3069},
+	{0x306F, 0x3099, 0x3070},
+	{0x306F, 0x309A, 0x3071},
+	{0x3072, 0x3099, 0x3073},
+	{0x3072, 0x309A, 0x3074},
+	{0x3075, 0x3099, 0x3076},
+	{0x3075, 0x309A, 0x3077},
+	{0x3078, 0x3099, 0x3079},
+	{0x3078, 0x309A, 0x307A},
+	{0x307B, 0x3099, 0x307C},
+	{0x307B, 0x309A, 0x307D},
+	{0x309D, 0x3099, 0x309E},
+	{0x30A6, 0x3099, 0x30F4},
+	{0x30AB, 0x3099, 0x30AC},
+	{0x30AD, 0x3099, 0x30AE},
+	{0x30AF, 0x3099, 0x30B0},
+	{0x30B1, 0x3099, 0x30B2},
+	{0x30B3, 0x3099, 0x30B4},
+	{0x30B5, 0x3099, 0x30B6},
+	{0x30B7, 0x3099, 0x30B8},
+	{0x30B9, 0x3099, 0x30BA},
+	{0x30BB, 0x3099, 0x30BC},
+	{0x30BD, 0x3099, 0x30BE},
+	{0x30BF, 0x3099, 0x30C0},
+	{0x30C1, 0x3099, 0x30C2},
+	{0x30C4, 0x3099, 0x30C5},
+	{0x30C6, 0x3099, 0x30C7},
+	{0x30C8, 0x3099, 0x30C9},
+	{0x30CF, 0x3099, 0x30D0},
+	{0x30CF, 0x309A, 0x30D1},
+	{0x30D2, 0x3099, 0x30D3},
+	{0x30D2, 0x309A, 0x30D4},
+	{0x30D5, 0x3099, 0x30D6},
+	{0x30D5, 0x309A, 0x30D7},
+	{0x30D8, 0x3099, 0x30D9},
+	{0x30D8, 0x309A, 0x30DA},
+	{0x30DB, 0x3099, 0x30DC},
+	{0x30DB, 0x309A, 0x30DD},
+	{0x30EF, 0x3099, 0x30F7},
+	{0x30F0, 0x3099, 0x30F8},
+	{0x30F1, 0x3099, 0x30F9},
+	{0x30F2, 0x3099, 0x30FA},
+	{0x30FD, 0x3099, 0x30FE},
+	{0x11099, 0x110BA, 0x1109A},
+	{0x1109B, 0x110BA, 0x1109C},
+	{0x110A5, 0x110BA, 0x110AB},
+	{0x11131, 0x11127, 0x1112E},
+	{0x11132, 0x11127, 0x1112F},
+	{0x11347, 0x1133E, 0x1134B},
+	{0x11347, 0x11357, 0x1134C},
+	{0x114B9, 0x114B0, 0x114BC},
+	{0x114B9, 0x114BA, 0x114BB},
+	{0x114B9, 0x114BD, 0x114BE},
+	{0x115B8, 0x115AF, 0x115BA},
+	{0x115B9, 0x115AF, 0x115BB},
+	{0x11935, 0x11930, 0x11938},
+}
@@ -0,0 +1,146 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+)
+
+func TestPunycode(t *testing.T) {
+	r := assert.New(t)
+
+	// Sample strings from RFC 3492, section 7.1.
+	tests := []struct {
+		unicode  string
+		punycode string
+	}{
+		{"bücher", "bcher-kva"},
+		{"münchen", "mnchen-3ya"},
+		{"他们为什么不说中文", "ihqwcrb4cv8a8dqg056pqjye"},
+		{"ليهمابتكلموشعربي؟", "egbpdaj6bu4bxfgehfvwxn"},
+		{"3年B組金八先生", "3B-ww4c5e180e575a65lsy2b"},
+		{"Pročprostěnemluvíčesky", "Proprostnemluvesky-uyb24dma41a"},
+		{"abc", "abc-"},
+	}
+	for _, tt := range tests {
+		enc, err := EncodePunycode(tt.unicode)
+		r.NoError(err, tt.unicode)
+		r.Equal(tt.punycode, enc)
+
+		dec, err := DecodePunycode(tt.punycode)
+		r.NoError(err, tt.punycode)
+		r.Equal(tt.unicode, dec)
+	}
+
+	for _, bad := range []string{"ü-abc", "abc-!", "99999999999999999"} {
+		_, err := DecodePunycode(bad)
+		r.ErrorIs(err, ErrPunycode, bad)
+	}
+}
+
+func TestToASCII(t *testing.T) {
+	r := assert.New(t)
+
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"example.com", "example.com"},
+		{"Bücher.Example.", "xn--bcher-kva.example."},
+		{"ＥＸＡＭＰＬＥ．com", "example.com"},
+		{"中文。测试", "xn--fiq228c.xn--0zwm56d"},
+		{"xn--bcher-kva.example", "xn--bcher-kva.example"},
+		{"soft\u00adhyphen.example", "softhyphen.example"},
+		{"日本語テキストabc.jp", "xn--abc-rj4b9bzdq6889hdocu19k.jp"},
+		{"ﬁ.com", "fi.com"},
+		{"İ.example", "xn--i-9bb.example"},            // i + U+0307, not i
+		{"Cafe\u0301.example", "xn--caf-dma.example"}, // NFC
+		{"*.Bücher.example", "*.xn--bcher-kva.example"},
+		{"r3---sn-abc.googlevideo.com.", "r3---sn-abc.googlevideo.com."},
+		{"-Leading.ab--cd.example", "-leading.ab--cd.example"},
+		{"_sip._tcp.example", "_sip._tcp.example"},
+		{".", "."},
+	}
+	for _, tt := range tests {
+		out, err := ToASCII(tt.in)
+		r.NoError(err, tt.in)
+		r.Equal(tt.out, out)
+	}
+
+	// Conjoining jamo compose to the precomposed syllable.
+	jamo, err := ToASCII("\u1100\u1161.kr")
+	r.NoError(err)
+	syllable, err := ToASCII("\uAC00.kr")
+	r.NoError(err)
+	r.Equal(syllable, jamo)
+
+	bad := []struct {
+		in  string
+		err error
+	}{
+		{"-bücher.example", ErrInvalidLabel},
+		{"bücher-.example", ErrInvalidLabel},
+		{"bü--cher.example", ErrInvalidLabel},
+		{"sp ace.example", ErrInvalidLabel},
+		{"pаypal.com", ErrMixedScript}, // Cyrillic а
+		{"αβγabc.example", ErrMixedScript},
+		{"xn--ü.example", ErrPunycode},
+		{"a.*.example", ErrInvalidLabel},
+		{"\u2488x.com", ErrInvalidLabel}, // disallowed
+		// A-labels must decode to non-ASCII U-labels that encode back to them.
+		{"xn--abc-.example", ErrInvalidLabel},
+		{"xn---tda.example", ErrInvalidLabel},
+		{"\u0301accent.example", ErrInvalidLabel},
+	}
+	for _, tt := range bad {
+		_, err := ToASCII(tt.in)
+		r.ErrorIs(err, tt.err, tt.in)
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	r := assert.New(t)
+
+	out, err := ToUnicode("xn--bcher-kva.example.")
+	r.NoError(err)
+	r.Equal("bücher.example.", out)
+
+	out, err = ToUnicode("WWW.xn--fiq228c.xn--0zwm56d")
+	r.NoError(err)
+	r.Equal("www.中文.测试", out)
+
+	out, err = ToUnicode("*.xn--bcher-kva.example")
+	r.NoError(err)
+	r.Equal("*.bücher.example", out)
+
+	_, err = ToUnicode("xn--80ak6aa92e.com") // Cyrillic "аррӏе"
+	r.NoError(err)
+
+	_, err = ToUnicode("xn--pypal-4ve.com") // Latin/Cyrillic "pаypal"
+	r.ErrorIs(err, ErrMixedScript)
+
+	out, err = ToUnicode("r3---sn-abc.googlevideo.com.")
+	r.NoError(err)
+	r.Equal("r3---sn-abc.googlevideo.com.", out)
+
+	_, err = ToUnicode("xn--abc-.example")
+	r.ErrorIs(err, ErrInvalidLabel)
+	_, err = ToUnicode("xn---tda.example")
+	r.ErrorIs(err, ErrInvalidLabel)
+
+	ascii, err := ToASCII("münchen.de")
+	r.NoError(err)
+	wire := EncodeDomain(nil, ascii)
+	r.Equal("\x0exn--mnchen-3ya\x02de\x00", string(wire))
+}
+
+func TestCheckScripts(t *testing.T) {
+	r := assert.New(t)
+	r.NoError(CheckScripts("example"))
+	r.NoError(CheckScripts("пример"))
+	r.NoError(CheckScripts("東京tokyo"))
+	r.NoError(CheckScripts("서울seoul漢"))
+	r.NoError(CheckScripts("web2-0"))
+	r.ErrorIs(CheckScripts("ελλάδαgr"), ErrMixedScript)
+	r.ErrorIs(CheckScripts("東京서울ひら"), ErrMixedScript)
+}
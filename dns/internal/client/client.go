@@ -0,0 +1,43 @@
+// Package client sends dns requests to a server for the packages of this
+// module, e.g. upstream and recursive, and their tests. It is the only
+// client: package dns, which cannot import it, sends the queries of its
+// Resolver with dns/internal/transport directly.
+package client
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/dns/internal/transport"
+)
+
+// Client sends requests to a server and reads the responses.
+type Client struct {
+	// Net is "udp" or "tcp". Over "udp", the default, truncated responses
+	// are retried over TCP.
+	Net string
+	// Timeout bounds an exchange when the context has no deadline; it
+	// defaults to transport.DefaultTimeout.
+	Timeout time.Duration
+	Dialer  net.Dialer
+	// Observer, if set, is told about every exchange.
+	Observer dns.Observer
+}
+
+// Exchange sends req, as packed by SetQuestion, to the server at addr
+// ("host:port") and returns its response.
+func (c *Client) Exchange(ctx context.Context, req *dns.Request, addr string) (*dns.Response, error) {
+	start := dns.QueryStart()
+	raw, network, err := transport.Exchange(ctx, &c.Dialer, c.Net, addr, req.Raw, max(int(req.OPT.Hdr.Class), 512), c.Timeout)
+	var resp *dns.Response
+	if raw != nil {
+		resp = new(dns.Response)
+		if uerr := resp.Unpack(raw); uerr != nil {
+			resp, err = nil, uerr
+		}
+	}
+	dns.Observe(c.Observer, network, req.Question.Type, start, resp, len(raw), err)
+	return resp, err
+}
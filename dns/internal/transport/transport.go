@@ -0,0 +1,201 @@
+// Package transport sends DNS messages over UDP and TCP for the packages of
+// dns that query servers. It works on packed messages so that package dns
+// can use it too: its Resolver sends queries with it directly, the other
+// packages with dns/internal/client.
+package transport
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"time"
+)
+
+const headerSize = 12
+
+// DefaultTimeout bounds an exchange whose context has no deadline.
+const DefaultTimeout = 2 * time.Second
+
+var (
+	// ErrNoQuestion is returned when exchanging a message without a
+	// question, e.g. a request that was not built with SetQuestion.
+	ErrNoQuestion = errors.New("dns: request has no question")
+	// ErrTruncated is returned when a truncated reply cannot be retried
+	// over TCP.
+	ErrTruncated = errors.New("dns: response truncated")
+)
+
+// Exchange sends msg to the server at addr ("host:port") over network,
+// "udp" or "tcp", and returns its reply and the network that carried it.
+// Over UDP, replies with another ID or question are ignored, which protects
+// against simple spoofing, and truncated replies are retried over TCP. The
+// UDP receive buffer is udpSize octets long; timeout bounds the exchange
+// when ctx has no deadline, DefaultTimeout when zero.
+func Exchange(ctx context.Context, d *net.Dialer, network, addr string, msg []byte, udpSize int, timeout time.Duration) ([]byte, string, error) {
+	if len(msg) <= headerSize {
+		return nil, network, ErrNoQuestion
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if network != "tcp" {
+		reply, err := exchangeUDP(ctx, d, addr, msg, udpSize)
+		if err != nil || !truncated(reply) {
+			return reply, "udp", err
+		}
+	}
+	reply, err := exchangeTCP(ctx, d, addr, msg)
+	return reply, "tcp", err
+}
+
+func exchangeUDP(ctx context.Context, d *net.Dialer, addr string, msg []byte, size int) ([]byte, error) {
+	conn, err := d.DialContext(ctx, "udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	stop := closeOnDone(ctx, conn)
+	defer stop()
+
+	if _, err := conn.Write(msg); err != nil {
+		return nil, ctxErr(ctx, err)
+	}
+	buf := make([]byte, size)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, ctxErr(ctx, err)
+		}
+		reply := buf[:n]
+		// A truncated datagram may not even hold the question; the header
+		// alone tells whether to retry over TCP.
+		if isReply(msg, reply) || sameID(msg, reply) && truncated(reply) {
+			return reply, nil
+		}
+	}
+}
+
+func exchangeTCP(ctx context.Context, d *net.Dialer, addr string, msg []byte) ([]byte, error) {
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	stop := closeOnDone(ctx, conn)
+	defer stop()
+
+	if err := WriteTCP(conn, msg); err != nil {
+		return nil, ctxErr(ctx, err)
+	}
+	for {
+		reply, err := ReadTCP(conn)
+		if err != nil {
+			return nil, ctxErr(ctx, err)
+		}
+		if isReply(msg, reply) {
+			if truncated(reply) {
+				return reply, ErrTruncated
+			}
+			return reply, nil
+		}
+	}
+}
+
+// sameID reports whether reply is a response with the ID of msg.
+func sameID(msg, reply []byte) bool {
+	return len(reply) >= headerSize && reply[0] == msg[0] && reply[1] == msg[1] && reply[2]&0x80 != 0
+}
+
+func truncated(reply []byte) bool {
+	return reply[2]&0x02 != 0
+}
+
+// isReply reports whether reply answers msg: same ID and question, the names
+// compared without case.
+func isReply(msg, reply []byte) bool {
+	if !sameID(msg, reply) {
+		return false
+	}
+	q, rq := question(msg), question(reply)
+	if q == nil || len(q) != len(rq) {
+		return false
+	}
+	for i := range q {
+		if lower(q[i]) != lower(rq[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// question returns the only question of msg, nil if there is not exactly
+// one or its name is compressed.
+func question(msg []byte) []byte {
+	if len(msg) < headerSize || binary.BigEndian.Uint16(msg[4:]) != 1 {
+		return nil
+	}
+	off := headerSize
+	for off < len(msg) && msg[off] != 0 {
+		if msg[off]&0xC0 != 0 {
+			return nil
+		}
+		off += int(msg[off]) + 1
+	}
+	// The root label, type and class.
+	off += 5
+	if off > len(msg) {
+		return nil
+	}
+	return msg[headerSize:off]
+}
+
+func lower(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+// WriteTCP writes msg to w with the two octet length prefix of DNS over TCP.
+// msg must be at most 65535 octets long.
+func WriteTCP(w io.Writer, msg []byte) error {
+	b := make([]byte, 2+len(msg))
+	binary.BigEndian.PutUint16(b, uint16(len(msg)))
+	copy(b[2:], msg)
+	_, err := w.Write(b)
+	return err
+}
+
+// ReadTCP reads one length-prefixed DNS over TCP message from r.
+func ReadTCP(r io.Reader) ([]byte, error) {
+	var l [2]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return nil, err
+	}
+	msg := make([]byte, binary.BigEndian.Uint16(l[:]))
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// closeOnDone closes conn when ctx is done, unblocking reads and writes.
+func closeOnDone(ctx context.Context, conn net.Conn) (stop func() bool) {
+	return context.AfterFunc(ctx, func() { conn.Close() })
+}
+
+// ctxErr prefers the context error over the error of a connection closed by
+// closeOnDone.
+func ctxErr(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
@@ -0,0 +1,216 @@
+package mdns
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/dns/dnstest"
+)
+
+var (
+	responderAddr = netip.MustParseAddrPort("192.0.2.1:5353")
+	peerAddr      = netip.MustParseAddrPort("192.0.2.2:5353")
+	otherAddr     = netip.MustParseAddrPort("192.0.2.3:5353")
+)
+
+func TestMessage(t *testing.T) {
+	r := assert.New(t)
+	m := &Message{
+		Questions: []Question{
+			{Name: "host.local.", Type: dns.TypeA, Class: dns.ClassINET, Unicast: true},
+			{Name: "host.local.", Type: dns.TypeAAAA, Class: dns.ClassINET},
+		},
+		Answer: dnstest.RRs(t, "host.local. 120 IN A 192.0.2.1"),
+	}
+	m.Answer[0].Header().Class |= CacheFlush
+	b, err := m.Pack()
+	r.NoError(err)
+
+	var got Message
+	r.NoError(got.Unpack(b))
+	r.DeepEqual(m.Questions, got.Questions)
+	r.Len(got.Answer, 1)
+	class, flush := ClassOf(got.Answer[0])
+	r.Equal(dns.ClassINET, class)
+	r.True(flush)
+
+	r.NotNil(got.Unpack(b[:len(b)-5]))
+	b[7] = 2 // a second answer that is not there
+	r.ErrorIs(got.Unpack(b), ErrTruncatedMessage)
+}
+
+// receive returns the next message on t, or nil after a short wait.
+func receive(t *testing.T, tr Transport) (*Message, netip.AddrPort) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	b, src, err := tr.Receive(ctx)
+	if err != nil {
+		return nil, src
+	}
+	m := new(Message)
+	assert.NoError(t, m.Unpack(b))
+	return m, src
+}
+
+func send(t *testing.T, tr Transport, m *Message, dst netip.AddrPort) {
+	t.Helper()
+	b, err := m.Pack()
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Send(b, dst))
+}
+
+// newResponder serves host.local. and a shared PTR on a new link, and
+// returns a transport on the same link.
+func newResponder(t *testing.T) (*Loopback, *Responder, Transport) {
+	link := NewLoopback()
+	r := NewResponder(link.Join(responderAddr))
+	r.Add(dnstest.RR(t, "host.local. 120 IN A 192.0.2.1"), true)
+	r.Add(dnstest.RR(t, "_http._tcp.local. 4500 IN PTR web._http._tcp.local."), false)
+	r.Add(dnstest.RR(t, "web._http._tcp.local. 120 IN SRV 0 0 80 host.local."), true)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.Serve(ctx)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return link, r, link.Join(peerAddr)
+}
+
+func TestResponder(t *testing.T) {
+	r := assert.New(t)
+	link, _, peer := newResponder(t)
+	other := link.Join(otherAddr)
+
+	send(t, peer, &Message{Questions: []Question{{Name: "HOST.local.", Type: dns.TypeA, Class: dns.ClassINET}}}, netip.AddrPort{})
+	receive(t, peer) // the query itself
+	receive(t, other)
+	resp, src := receive(t, peer)
+	r.NotNil(resp)
+	r.Equal(responderAddr, src)
+	r.True(resp.Header.Response())
+	r.True(resp.Header.Authoritative())
+	r.Equal(uint16(0), resp.Header.ID)
+	r.Len(resp.Questions, 0)
+	r.Len(resp.Answer, 1)
+	r.Equal(dns.ClassINET|CacheFlush, resp.Answer[0].Header().Class)
+	// Multicast: the rest of the link hears it.
+	resp, _ = receive(t, other)
+	r.NotNil(resp)
+
+	// A PTR query gets the SRV and address records as additionals; the
+	// shared PTR has no CacheFlush bit.
+	send(t, peer, &Message{Questions: []Question{{Name: "_http._tcp.local.", Type: dns.TypePTR, Class: dns.ClassINET, Unicast: true}}}, netip.AddrPort{})
+	receive(t, peer)
+	resp, _ = receive(t, peer)
+	r.NotNil(resp)
+	r.Len(resp.Answer, 1)
+	r.Equal(dns.ClassINET, resp.Answer[0].Header().Class)
+	r.Len(resp.Extra, 2)
+	r.Equal(dns.TypeSRV, resp.Extra[0].Header().Rrtype)
+	r.Equal(dns.TypeA, resp.Extra[1].Header().Rrtype)
+	// Unicast: only the sender gets it.
+	receive(t, other)
+	resp, _ = receive(t, other)
+	r.True(resp == nil)
+
+	// Nothing is known for other names.
+	send(t, peer, &Message{Questions: []Question{{Name: "nope.local.", Type: dns.TypeA, Class: dns.ClassINET}}}, netip.AddrPort{})
+	receive(t, peer)
+	resp, _ = receive(t, peer)
+	r.True(resp == nil)
+}
+
+func TestKnownAnswerSuppression(t *testing.T) {
+	r := assert.New(t)
+	_, _, peer := newResponder(t)
+	question := []Question{{Name: "host.local.", Type: dns.TypeA, Class: dns.ClassINET}}
+
+	send(t, peer, &Message{Questions: question, Answer: dnstest.RRs(t, "host.local. 60 IN A 192.0.2.1")}, netip.AddrPort{})
+	receive(t, peer)
+	resp, _ := receive(t, peer)
+	r.True(resp == nil)
+
+	// Less than half the TTL left: the record is refreshed.
+	send(t, peer, &Message{Questions: question, Answer: dnstest.RRs(t, "host.local. 59 IN A 192.0.2.1")}, netip.AddrPort{})
+	receive(t, peer)
+	resp, _ = receive(t, peer)
+	r.NotNil(resp)
+	r.Len(resp.Answer, 1)
+}
+
+func TestLegacyUnicast(t *testing.T) {
+	r := assert.New(t)
+	link, _, _ := newResponder(t)
+	legacy := link.Join(netip.MustParseAddrPort("192.0.2.9:40000"))
+
+	q := &Message{Questions: []Question{{Name: "host.local.", Type: dns.TypeA, Class: dns.ClassINET}}}
+	q.Header.ID = 0x1234
+	send(t, legacy, q, responderAddr)
+	resp, _ := receive(t, legacy)
+	r.NotNil(resp)
+	r.Equal(uint16(0x1234), resp.Header.ID)
+	r.DeepEqual(q.Questions, resp.Questions)
+	r.Len(resp.Answer, 1)
+	r.Equal(dns.ClassINET, resp.Answer[0].Header().Class)
+	r.Equal(uint32(legacyTTL), resp.Answer[0].Header().Ttl)
+}
+
+func TestQuerierCache(t *testing.T) {
+	r := assert.New(t)
+	link, resp, _ := newResponder(t)
+	q := NewQuerier(link.Join(otherAddr))
+	now := time.Unix(1e9, 0)
+	q.now = func() time.Time { return now }
+
+	rrs := query(t, q, Question{Name: "host.local.", Type: dns.TypeA, Class: dns.ClassINET})
+	r.Len(rrs, 1)
+	r.Equal(dns.ClassINET, rrs[0].Header().Class)
+
+	// A goodbye removes the record.
+	r.NoError(resp.Remove(dnstest.RR(t, "host.local. 120 IN A 192.0.2.1")))
+	r.Len(query(t, q, Question{Name: "nope.local.", Type: dns.TypeA, Class: dns.ClassINET}), 0)
+	r.Len(q.Lookup("host.local.", dns.TypeA), 0)
+
+	// A record with the CacheFlush bit replaces those received more than a
+	// second before, but not those of the same burst.
+	flushed := func(s string) dns.RR {
+		rr := dnstest.RR(t, s)
+		rr.Header().Class |= CacheFlush
+		return rr
+	}
+	q.add(dnstest.RRs(t, "host.local. 120 IN A 192.0.2.1"))
+	now = now.Add(2 * time.Second)
+	q.add([]dns.RR{flushed("host.local. 120 IN A 192.0.2.7")})
+	q.add([]dns.RR{flushed("host.local. 120 IN A 192.0.2.8")})
+	rrs = q.Lookup("HOST.local", dns.TypeA)
+	r.Len(rrs, 2)
+	dnstest.AssertAnswerContains(t, &dns.Response{Answer: rrs}, "host.local. 120 IN A 192.0.2.7")
+
+	// TTLs count down and records expire.
+	now = now.Add(100 * time.Second)
+	rrs = q.Lookup("host.local.", dns.TypeA)
+	r.Len(rrs, 2)
+	r.Equal(uint32(20), rrs[0].Header().Ttl)
+	// With less than half the TTL left the record is no known answer.
+	r.Len(q.known(Question{Name: "host.local.", Type: dns.TypeA}), 0)
+	now = now.Add(20 * time.Second)
+	r.Len(q.Lookup("host.local.", dns.TypeA), 0)
+}
+
+func query(t *testing.T, q *Querier, questions ...Question) []dns.RR {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	rrs, err := q.Query(ctx, questions...)
+	assert.NoError(t, err)
+	return rrs
+}
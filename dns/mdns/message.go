@@ -0,0 +1,182 @@
+package mdns
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/dnsoa/go/dns"
+)
+
+const (
+	// CacheFlush is the top bit of the class of a resource record. It marks
+	// the record as the whole of its unique RRset, so caches drop the other
+	// records of the set, see RFC 6762, section 10.2.
+	CacheFlush dns.Class = 1 << 15
+	// UnicastResponse is the top bit of the class of a question. It asks for
+	// a unicast response, see RFC 6762, section 5.4.
+	UnicastResponse dns.Class = 1 << 15
+)
+
+// maxMessageSize is the largest mDNS message, see RFC 6762, section 17.
+const maxMessageSize = 9000
+
+// ErrTruncatedMessage is returned by Unpack when the counts of the header
+// claim more than the message holds.
+var ErrTruncatedMessage = errors.New("mdns: truncated message")
+
+// Question is a question of a Message. Unlike those of package dns, mDNS
+// queries carry any number of questions.
+type Question struct {
+	Name  string // fully qualified, in presentation format
+	Type  dns.Type
+	Class dns.Class // without the UnicastResponse bit
+	// Unicast asks for a unicast response.
+	Unicast bool
+}
+
+// Message is an mDNS message. The classes of resource records are kept as
+// they are on the wire, so records of a response may have the CacheFlush bit
+// set; see ClassOf.
+type Message struct {
+	Questions []Question
+	Answer    []dns.RR
+	Ns        []dns.RR
+	Extra     []dns.RR
+	Header    dns.Header
+}
+
+// ClassOf returns the class of rr without the CacheFlush bit and whether the
+// bit is set.
+func ClassOf(rr dns.RR) (class dns.Class, flush bool) {
+	c := rr.Header().Class
+	return c &^ CacheFlush, c&CacheFlush != 0
+}
+
+// Pack returns the wire format of the message. The counts of the header are
+// set from the sections.
+func (m *Message) Pack() ([]byte, error) {
+	m.Header.Qdcount = uint16(len(m.Questions))
+	m.Header.Ancount = uint16(len(m.Answer))
+	m.Header.Nscount = uint16(len(m.Ns))
+	m.Header.Arcount = uint16(len(m.Extra))
+
+	buf := make([]byte, maxMessageSize)
+	hdr := m.Header.Pack()
+	off := copy(buf, hdr[:])
+	compression := make(map[string]int)
+	var err error
+	for _, q := range m.Questions {
+		if off, err = dns.PackDomainName(q.Name, buf, off, compression); err != nil {
+			return nil, err
+		}
+		if off+4 > len(buf) {
+			return nil, dns.ErrBuf
+		}
+		class := q.Class
+		if q.Unicast {
+			class |= UnicastResponse
+		}
+		buf[off], buf[off+1] = byte(q.Type>>8), byte(q.Type)
+		buf[off+2], buf[off+3] = byte(class>>8), byte(class)
+		off += 4
+	}
+	for _, rrs := range [][]dns.RR{m.Answer, m.Ns, m.Extra} {
+		for _, rr := range rrs {
+			if off, err = dns.PackRR(rr, buf, off, compression); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return buf[:off], nil
+}
+
+// Unpack decodes msg into m.
+func (m *Message) Unpack(msg []byte) error {
+	if err := m.Header.Unpack(msg); err != nil {
+		return err
+	}
+	off := 12
+	m.Questions = m.Questions[:0]
+	for range m.Header.Qdcount {
+		name, off1, err := dns.UnpackDomainName(msg, off)
+		if err != nil {
+			return err
+		}
+		if off1+4 > len(msg) {
+			return ErrTruncatedMessage
+		}
+		class := dns.Class(msg[off1+2])<<8 | dns.Class(msg[off1+3])
+		m.Questions = append(m.Questions, Question{
+			Name:    string(name),
+			Type:    dns.Type(msg[off1])<<8 | dns.Type(msg[off1+1]),
+			Class:   class &^ UnicastResponse,
+			Unicast: class&UnicastResponse != 0,
+		})
+		off = off1 + 4
+	}
+	var err error
+	if m.Answer, off, err = unpackRRs(msg, off, m.Header.Ancount, m.Answer); err != nil {
+		return err
+	}
+	if m.Ns, off, err = unpackRRs(msg, off, m.Header.Nscount, m.Ns); err != nil {
+		return err
+	}
+	m.Extra, _, err = unpackRRs(msg, off, m.Header.Arcount, m.Extra)
+	return err
+}
+
+func unpackRRs(msg []byte, off int, n uint16, dst []dns.RR) ([]dns.RR, int, error) {
+	dst = dst[:0]
+	for range n {
+		if off >= len(msg) {
+			return nil, off, ErrTruncatedMessage
+		}
+		rr, off1, err := dns.UnpackRR(msg, off)
+		if err != nil {
+			return nil, off1, err
+		}
+		dst = append(dst, rr)
+		off = off1
+	}
+	return dst, off, nil
+}
+
+// clone returns a copy of rr with the given class and TTL.
+func clone(rr dns.RR, class dns.Class, ttl uint32) (dns.RR, error) {
+	buf := make([]byte, maxMessageSize)
+	off, err := dns.PackRR(rr, buf, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	c, _, err := dns.UnpackRR(buf[:off], 0)
+	if err != nil {
+		return nil, err
+	}
+	c.Header().Class = class
+	c.Header().Ttl = ttl
+	return c, nil
+}
+
+// rdata returns the wire format of the rdata of rr, for comparing records.
+func rdata(rr dns.RR) string {
+	buf := make([]byte, maxMessageSize)
+	start, err := dns.PackDomainName(rr.Header().Name, buf, 0, nil)
+	if err != nil {
+		return rr.String()
+	}
+	end, err := dns.PackRR(rr, buf, 0, nil)
+	if err != nil {
+		return rr.String()
+	}
+	return string(buf[start+10 : end])
+}
+
+// sameRecord reports whether a and b hold the same data for the same name and
+// type, whatever their TTLs and CacheFlush bits.
+func sameRecord(a, b dns.RR) bool {
+	ha, hb := a.Header(), b.Header()
+	if ha.Rrtype != hb.Rrtype || ha.Class&^CacheFlush != hb.Class&^CacheFlush || !strings.EqualFold(ha.Name, hb.Name) {
+		return false
+	}
+	return rdata(a) == rdata(b)
+}
@@ -0,0 +1,174 @@
+package mdns
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dnsoa/go/dns"
+)
+
+type cacheKey struct {
+	name string // lowercased
+	typ  dns.Type
+}
+
+type cached struct {
+	rr       dns.RR // without the CacheFlush bit
+	received time.Time
+}
+
+func (c *cached) expires() time.Time {
+	return c.received.Add(time.Duration(c.rr.Header().Ttl) * time.Second)
+}
+
+// Querier sends mDNS queries and caches the records of the responses it
+// receives.
+type Querier struct {
+	t     Transport
+	query sync.Mutex // serializes Query
+	mu    sync.Mutex // guards cache
+	cache map[cacheKey][]cached
+	now   func() time.Time
+}
+
+// NewQuerier returns a querier with an empty cache on t.
+func NewQuerier(t Transport) *Querier {
+	return &Querier{t: t, cache: make(map[cacheKey][]cached)}
+}
+
+func (q *Querier) time() time.Time {
+	if q.now != nil {
+		return q.now()
+	}
+	return time.Now()
+}
+
+// Query multicasts questions, together with the cached records that answer
+// them as known answers, and caches the responses received until ctx is
+// done. It then returns the cached records that answer the questions.
+// Queries are sent one at a time.
+func (q *Querier) Query(ctx context.Context, questions ...Question) ([]dns.RR, error) {
+	q.query.Lock()
+	defer q.query.Unlock()
+
+	m := new(Message)
+	m.Questions = questions
+	for _, question := range questions {
+		m.Answer = append(m.Answer, q.known(question)...)
+	}
+	b, err := m.Pack()
+	if err != nil {
+		return nil, err
+	}
+	if err := q.t.Send(b, netip.AddrPort{}); err != nil {
+		return nil, err
+	}
+	for {
+		msg, _, err := q.t.Receive(ctx)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+				break
+			}
+			return nil, err
+		}
+		var resp Message
+		if resp.Unpack(msg) != nil || !resp.Header.Response() {
+			continue
+		}
+		q.add(resp.Answer, resp.Ns, resp.Extra)
+	}
+	var rrs []dns.RR
+	for _, question := range questions {
+		rrs = append(rrs, q.Lookup(question.Name, question.Type)...)
+	}
+	return rrs, nil
+}
+
+// known returns the cached answers to question that have at least half of
+// their TTL left, which responders then leave out, see RFC 6762, section
+// 7.1.
+func (q *Querier) known(question Question) []dns.RR {
+	now := q.time()
+	name := strings.ToLower(dns.Fqdn(question.Name))
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var rrs []dns.RR
+	for k, entries := range q.cache {
+		if k.name != name || (question.Type != dns.TypeANY && question.Type != k.typ) {
+			continue
+		}
+		for _, c := range entries {
+			left := c.expires().Sub(now)
+			if left*2 >= time.Duration(c.rr.Header().Ttl)*time.Second {
+				if rr, err := clone(c.rr, c.rr.Header().Class, uint32(left/time.Second)); err == nil {
+					rrs = append(rrs, rr)
+				}
+			}
+		}
+	}
+	return rrs
+}
+
+// Lookup returns the cached records of name and qtype, all types for
+// TypeANY, with their remaining TTLs.
+func (q *Querier) Lookup(name string, qtype dns.Type) []dns.RR {
+	now := q.time()
+	name = strings.ToLower(dns.Fqdn(name))
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var rrs []dns.RR
+	for k, entries := range q.cache {
+		if k.name != name || (qtype != dns.TypeANY && qtype != k.typ) {
+			continue
+		}
+		for _, c := range entries {
+			left := c.expires().Sub(now)
+			if left <= 0 {
+				continue
+			}
+			if rr, err := clone(c.rr, c.rr.Header().Class, uint32((left+time.Second-1)/time.Second)); err == nil {
+				rrs = append(rrs, rr)
+			}
+		}
+	}
+	return rrs
+}
+
+// add caches the records of a response. A record with the CacheFlush bit
+// replaces the records of its RRset received more than a second before, see
+// RFC 6762, section 10.2, and a record with TTL zero is a goodbye that
+// removes the record.
+func (q *Querier) add(sections ...[]dns.RR) {
+	now := q.time()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, rrs := range sections {
+		for _, rr := range rrs {
+			h := rr.Header()
+			if h.Rrtype == dns.TypeOPT {
+				continue
+			}
+			class, flush := ClassOf(rr)
+			h.Class = class
+			k := cacheKey{name: strings.ToLower(h.Name), typ: h.Rrtype}
+			entries := slices.DeleteFunc(q.cache[k], func(c cached) bool {
+				return !now.Before(c.expires()) ||
+					(flush && now.Sub(c.received) > time.Second) ||
+					sameRecord(c.rr, rr)
+			})
+			if h.Ttl > 0 {
+				entries = append(entries, cached{rr: rr, received: now})
+			}
+			if len(entries) == 0 {
+				delete(q.cache, k)
+				continue
+			}
+			q.cache[k] = entries
+		}
+	}
+}
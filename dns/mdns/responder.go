@@ -0,0 +1,270 @@
+// Package mdns implements Multicast DNS (RFC 6762) and DNS-Based Service
+// Discovery (RFC 6763) on top of the message codec of package dns.
+//
+// A Responder answers the queries of the link for the records it holds, with
+// the cache-flush bit on unique records, known-answer suppression and
+// unicast or legacy unicast responses as asked. A Querier multicasts
+// questions, with the answers it already knows, and caches the responses.
+// Register and Browse add the DNS-SD layer of PTR, SRV and TXT records.
+//
+//	t, err := mdns.Listen("udp4", nil)
+//	r := mdns.NewResponder(t)
+//	r.Register(mdns.Service{Instance: "Printer", Service: "_ipp._tcp", Host: "printer.local.", Port: 631})
+//	go r.Serve(ctx)
+//
+// Both work over a Transport, so tests can use a Loopback link instead of
+// multicast sockets. Probing and conflict resolution for unique names
+// (RFC 6762, sections 8.1 and 9) are left to the caller.
+package mdns
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/dnsoa/go/dns"
+)
+
+// legacyTTL caps the TTLs of responses to legacy unicast queries, see RFC
+// 6762, section 6.7.
+const legacyTTL = 10
+
+type record struct {
+	rr     dns.RR
+	unique bool
+}
+
+// Responder answers mDNS queries for its records.
+type Responder struct {
+	t       Transport
+	mu      sync.Mutex
+	records []record
+}
+
+// NewResponder returns a responder without records on t.
+func NewResponder(t Transport) *Responder {
+	return &Responder{t: t}
+}
+
+// Add adds rr to the records the responder answers with. Unique records,
+// such as the SRV, TXT and address records of a host, make up the whole of
+// their RRset and are sent with the CacheFlush bit. Shared records, such as
+// the PTR records of DNS-SD, are not. A record equal to one already held
+// replaces it.
+func (r *Responder) Add(rr dns.RR, unique bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.records {
+		if sameRecord(r.records[i].rr, rr) {
+			r.records[i] = record{rr: rr, unique: unique}
+			return
+		}
+	}
+	r.records = append(r.records, record{rr: rr, unique: unique})
+}
+
+// Remove removes the records equal to rrs and multicasts a goodbye for them:
+// the records with TTL zero, see RFC 6762, section 10.1.
+func (r *Responder) Remove(rrs ...dns.RR) error {
+	r.mu.Lock()
+	var gone []record
+	r.records = slices.DeleteFunc(r.records, func(rec record) bool {
+		if slices.ContainsFunc(rrs, func(rr dns.RR) bool { return sameRecord(rec.rr, rr) }) {
+			gone = append(gone, rec)
+			return true
+		}
+		return false
+	})
+	r.mu.Unlock()
+	if len(gone) == 0 {
+		return nil
+	}
+	m := new(Message)
+	setResponse(&m.Header)
+	var err error
+	if m.Answer, err = wire(gone, false, 0); err != nil {
+		return err
+	}
+	return r.send(m, netip.AddrPort{})
+}
+
+// Announce multicasts all records of the responder in an unsolicited
+// response, see RFC 6762, section 8.3. Announcements should be sent at least
+// twice, one second apart.
+func (r *Responder) Announce() error {
+	r.mu.Lock()
+	recs := slices.Clone(r.records)
+	r.mu.Unlock()
+	if len(recs) == 0 {
+		return nil
+	}
+	m := new(Message)
+	setResponse(&m.Header)
+	var err error
+	if m.Answer, err = wire(recs, false, -1); err != nil {
+		return err
+	}
+	return r.send(m, netip.AddrPort{})
+}
+
+// Serve answers the queries received on the transport until ctx is done or
+// the transport fails, and returns the error. Malformed messages are
+// ignored.
+func (r *Responder) Serve(ctx context.Context) error {
+	for {
+		msg, src, err := r.t.Receive(ctx)
+		if err != nil {
+			return err
+		}
+		r.handle(msg, src)
+	}
+}
+
+// handle answers the query msg from src.
+func (r *Responder) handle(msg []byte, src netip.AddrPort) error {
+	var q Message
+	if err := q.Unpack(msg); err != nil {
+		return err
+	}
+	if q.Header.Response() || q.Header.OpCode() != dns.OpcodeQuery || q.Header.Rcode() != dns.RcodeSuccess {
+		return nil
+	}
+	// Queries from another port come from a plain resolver, see RFC 6762,
+	// section 6.7.
+	legacy := src.Port() != Port
+	unicast := true
+	var answers []record
+	r.mu.Lock()
+	for _, question := range q.Questions {
+		n := len(answers)
+		answers = r.answer(answers, question, q.Answer)
+		if len(answers) > n && !question.Unicast {
+			unicast = false
+		}
+	}
+	extra := r.additional(answers)
+	r.mu.Unlock()
+	if len(answers) == 0 {
+		return nil
+	}
+
+	resp := new(Message)
+	setResponse(&resp.Header)
+	var dst netip.AddrPort
+	ttl := -1
+	switch {
+	case legacy:
+		resp.Header.ID = q.Header.ID
+		for _, question := range q.Questions {
+			question.Unicast = false
+			resp.Questions = append(resp.Questions, question)
+		}
+		dst, ttl = src, legacyTTL
+	case unicast:
+		dst = src
+	}
+	var err error
+	if resp.Answer, err = wire(answers, legacy, ttl); err != nil {
+		return err
+	}
+	if resp.Extra, err = wire(extra, legacy, ttl); err != nil {
+		return err
+	}
+	return r.send(resp, dst)
+}
+
+// answer appends to dst the records that answer question and are not among
+// the known answers with at least half their TTL, see RFC 6762, section
+// 7.1.
+func (r *Responder) answer(dst []record, question Question, known []dns.RR) []record {
+	for _, rec := range r.records {
+		h := rec.rr.Header()
+		if !strings.EqualFold(h.Name, question.Name) ||
+			(question.Type != dns.TypeANY && question.Type != h.Rrtype) ||
+			(question.Class != dns.ClassANY && question.Class != h.Class&^CacheFlush) {
+			continue
+		}
+		if slices.ContainsFunc(known, func(k dns.RR) bool {
+			return k.Header().Ttl >= h.Ttl/2 && sameRecord(k, rec.rr)
+		}) {
+			continue
+		}
+		if !slices.ContainsFunc(dst, func(d record) bool { return d.rr == rec.rr }) {
+			dst = append(dst, rec)
+		}
+	}
+	return dst
+}
+
+// additional returns the records that the answers make useful: the SRV and
+// TXT records of the instances named by PTR records and the addresses of
+// SRV targets, see RFC 6763, section 12.
+func (r *Responder) additional(answers []record) []record {
+	var extra []record
+	add := func(name string, types ...dns.Type) {
+		for _, rec := range r.records {
+			h := rec.rr.Header()
+			if !strings.EqualFold(h.Name, name) || !slices.Contains(types, h.Rrtype) {
+				continue
+			}
+			in := func(d record) bool { return d.rr == rec.rr }
+			if !slices.ContainsFunc(answers, in) && !slices.ContainsFunc(extra, in) {
+				extra = append(extra, rec)
+			}
+		}
+	}
+	for _, rec := range answers {
+		switch rr := rec.rr.(type) {
+		case *dns.PTR:
+			add(rr.Ptr, dns.TypeSRV, dns.TypeTXT)
+		case *dns.SRV:
+			add(rr.Target, dns.TypeA, dns.TypeAAAA)
+		}
+	}
+	// The SRV records just added name hosts too.
+	for i := 0; i < len(extra); i++ {
+		if srv, ok := extra[i].rr.(*dns.SRV); ok {
+			add(srv.Target, dns.TypeA, dns.TypeAAAA)
+		}
+	}
+	return extra
+}
+
+func (r *Responder) send(m *Message, dst netip.AddrPort) error {
+	b, err := m.Pack()
+	if err != nil {
+		return err
+	}
+	return r.t.Send(b, dst)
+}
+
+func setResponse(h *dns.Header) {
+	h.SetResponse()
+	h.SetAuthoritative()
+}
+
+// wire returns copies of recs as sent: unique records get the CacheFlush bit
+// unless legacy is set, and the TTLs are capped at ttl when it is not
+// negative.
+func wire(recs []record, legacy bool, ttl int) ([]dns.RR, error) {
+	rrs := make([]dns.RR, 0, len(recs))
+	for _, rec := range recs {
+		h := rec.rr.Header()
+		class := h.Class &^ CacheFlush
+		if rec.unique && !legacy {
+			class |= CacheFlush
+		}
+		t := h.Ttl
+		if ttl >= 0 {
+			t = min(t, uint32(ttl))
+		}
+		rr, err := clone(rec.rr, class, t)
+		if err != nil {
+			return nil, err
+		}
+		rrs = append(rrs, rr)
+	}
+	return rrs, nil
+}
@@ -0,0 +1,220 @@
+package mdns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/dnsoa/go/dns"
+)
+
+// TTLs of RFC 6762, section 10: records that name a host, and the others.
+const (
+	HostTTL  = 120
+	OtherTTL = 4500
+)
+
+// servicesName lists the service types of a domain, see RFC 6763, section 9.
+const servicesName = "_services._dns-sd._udp."
+
+// ErrBadService is returned by Register for a service without an instance
+// name or host, or whose type is not of the form "_name._tcp" or
+// "_name._udp".
+var ErrBadService = errors.New("mdns: bad service")
+
+// Service is a DNS-SD service instance.
+type Service struct {
+	// Instance is the user-visible name of the instance, such as
+	// "Printer on the 2nd floor". It may hold any characters, dots included.
+	Instance string
+	// Service is the service type, such as "_ipp._tcp".
+	Service string
+	// Domain is the domain of the service; "local." when empty.
+	Domain string
+	// Host is the fully qualified name of the host, such as "printer.local.".
+	Host string
+	Port uint16
+	// Text holds the key=value pairs of the TXT record.
+	Text []string
+	// Addrs holds the addresses of the host, published as A and AAAA
+	// records when registering.
+	Addrs []netip.Addr
+}
+
+func (s *Service) domain() string {
+	if s.Domain == "" {
+		return "local."
+	}
+	return dns.Fqdn(s.Domain)
+}
+
+// Type returns the fully qualified service type, such as
+// "_ipp._tcp.local.".
+func (s *Service) Type() string {
+	return s.Service + "." + s.domain()
+}
+
+// Name returns the fully qualified name of the instance, with dots and
+// backslashes of the instance escaped, such as
+// "Printer\.2._ipp._tcp.local.".
+func (s *Service) Name() string {
+	return escapeLabel(s.Instance) + "." + s.Type()
+}
+
+func (s *Service) valid() bool {
+	proto, ok := strings.CutPrefix(s.Service, "_")
+	if !ok {
+		return false
+	}
+	name, proto, ok := strings.Cut(proto, "._")
+	return ok && name != "" && (proto == "tcp" || proto == "udp") && s.Instance != "" && s.Host != ""
+}
+
+// records returns the records of the service and whether each is unique.
+func (s *Service) records() ([]dns.RR, []bool) {
+	name, typ := s.Name(), s.Type()
+	hdr := func(owner string, rrtype dns.Type, ttl uint32) dns.RR_Header {
+		return dns.RR_Header{Name: owner, Rrtype: rrtype, Class: dns.ClassINET, Ttl: ttl}
+	}
+	text := s.Text
+	if len(text) == 0 {
+		// A TXT record holds at least one string, see RFC 6763, section 6.1.
+		text = []string{""}
+	}
+	host := dns.Fqdn(s.Host)
+	rrs := []dns.RR{
+		&dns.PTR{Hdr: hdr(servicesName+s.domain(), dns.TypePTR, OtherTTL), Ptr: typ},
+		&dns.PTR{Hdr: hdr(typ, dns.TypePTR, OtherTTL), Ptr: name},
+		&dns.SRV{Hdr: hdr(name, dns.TypeSRV, HostTTL), Port: s.Port, Target: host},
+		&dns.TXT{Hdr: hdr(name, dns.TypeTXT, OtherTTL), TXT: text},
+	}
+	unique := []bool{false, false, true, true}
+	for _, addr := range s.Addrs {
+		addr = addr.Unmap()
+		if addr.Is4() {
+			rrs = append(rrs, &dns.A{Hdr: hdr(host, dns.TypeA, HostTTL), A: addr.As4()})
+		} else {
+			rrs = append(rrs, &dns.AAAA{Hdr: hdr(host, dns.TypeAAAA, HostTTL), AAAA: net.IP(addr.AsSlice())})
+		}
+		unique = append(unique, true)
+	}
+	return rrs, unique
+}
+
+// Register adds the PTR, SRV and TXT records of s, and the address records
+// of its host, to the responder and announces them.
+func (r *Responder) Register(s Service) error {
+	if !s.valid() {
+		return ErrBadService
+	}
+	rrs, unique := s.records()
+	for i, rr := range rrs {
+		r.Add(rr, unique[i])
+	}
+	return r.Announce()
+}
+
+// Deregister removes the records of s added by Register and sends a goodbye
+// for them. The PTR record listing the service type stays, since other
+// instances may share it.
+func (r *Responder) Deregister(s Service) error {
+	if !s.valid() {
+		return ErrBadService
+	}
+	rrs, _ := s.records()
+	return r.Remove(rrs[1:]...)
+}
+
+// Browse looks for instances of the service type service, such as
+// "_ipp._tcp", in domain, "local." when empty, until ctx is done. The SRV,
+// TXT and address records of the instances come from the additional records
+// of the responses; instances whose SRV record is missing are left out.
+func Browse(ctx context.Context, q *Querier, service, domain string) ([]Service, error) {
+	base := Service{Service: service, Domain: domain}
+	ptrs, err := q.Query(ctx, Question{Name: base.Type(), Type: dns.TypePTR, Class: dns.ClassINET})
+	if err != nil {
+		return nil, err
+	}
+	var services []Service
+	for _, rr := range ptrs {
+		ptr, ok := rr.(*dns.PTR)
+		if !ok {
+			continue
+		}
+		if s, ok := q.resolve(ptr.Ptr, base); ok {
+			services = append(services, s)
+		}
+	}
+	return services, nil
+}
+
+// resolve builds the instance called name from the cache.
+func (q *Querier) resolve(name string, base Service) (Service, bool) {
+	label, rest, ok := cutLabel(name)
+	if !ok || !strings.EqualFold(rest, base.Type()) {
+		return Service{}, false
+	}
+	s := base
+	s.Domain = base.domain()
+	s.Instance = label
+	var srv *dns.SRV
+	for _, rr := range q.Lookup(name, dns.TypeSRV) {
+		srv = rr.(*dns.SRV)
+	}
+	if srv == nil {
+		return Service{}, false
+	}
+	s.Host, s.Port = srv.Target, srv.Port
+	for _, rr := range q.Lookup(name, dns.TypeTXT) {
+		s.Text = rr.(*dns.TXT).TXT
+	}
+	if len(s.Text) == 1 && s.Text[0] == "" {
+		s.Text = nil
+	}
+	for _, rr := range q.Lookup(s.Host, dns.TypeANY) {
+		switch rr := rr.(type) {
+		case *dns.A:
+			s.Addrs = append(s.Addrs, netip.AddrFrom4(rr.A))
+		case *dns.AAAA:
+			if addr, ok := netip.AddrFromSlice(rr.AAAA); ok {
+				s.Addrs = append(s.Addrs, addr)
+			}
+		}
+	}
+	return s, true
+}
+
+// escapeLabel escapes the dots and backslashes of an instance name.
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `.`, `\.`).Replace(s)
+}
+
+// cutLabel returns the unescaped first label of name and the rest of name.
+func cutLabel(name string) (label, rest string, ok bool) {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		switch c := name[i]; c {
+		case '.':
+			return b.String(), name[i+1:], true
+		case '\\':
+			if i+3 < len(name) && isDigit(name[i+1]) && isDigit(name[i+2]) && isDigit(name[i+3]) {
+				b.WriteByte((name[i+1]-'0')*100 + (name[i+2]-'0')*10 + name[i+3] - '0')
+				i += 3
+				continue
+			}
+			if i+1 < len(name) {
+				i++
+				b.WriteByte(name[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", false
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,87 @@
+package mdns
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+)
+
+func TestRegisterBrowse(t *testing.T) {
+	r := assert.New(t)
+	link := NewLoopback()
+	q := NewQuerier(link.Join(peerAddr))
+	resp := NewResponder(link.Join(responderAddr))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go resp.Serve(ctx)
+
+	printer := Service{
+		Instance: "Printer 2.1",
+		Service:  "_ipp._tcp",
+		Host:     "printer.local",
+		Port:     631,
+		Text:     []string{"rp=ipp/print"},
+		Addrs:    []netip.Addr{netip.MustParseAddr("192.0.2.10"), netip.MustParseAddr("2001:db8::10")},
+	}
+	r.Equal(`Printer 2\.1._ipp._tcp.local.`, printer.Name())
+	r.NoError(resp.Register(printer))
+	r.NoError(resp.Register(Service{Instance: "Scanner", Service: "_ipp._tcp", Host: "scanner.local.", Port: 8631}))
+
+	services := browse(t, q, "_ipp._tcp")
+	r.Len(services, 2)
+	var got Service
+	for _, s := range services {
+		if s.Instance == printer.Instance {
+			got = s
+		}
+	}
+	r.Equal("local.", got.Domain)
+	r.Equal("printer.local.", got.Host)
+	r.Equal(uint16(631), got.Port)
+	r.DeepEqual(printer.Text, got.Text)
+	r.Len(got.Addrs, 2)
+
+	types, err := q.Query(timeout(t), Question{Name: "_services._dns-sd._udp.local.", Type: dns.TypePTR, Class: dns.ClassINET})
+	r.NoError(err)
+	r.Len(types, 1)
+	r.Equal("_ipp._tcp.local.", types[0].(*dns.PTR).Ptr)
+
+	// The goodbye removes the instance from the cache of the querier.
+	r.NoError(resp.Deregister(printer))
+	services = browse(t, q, "_ipp._tcp")
+	r.Len(services, 1)
+	r.Equal("Scanner", services[0].Instance)
+	r.True(services[0].Text == nil)
+
+	r.Len(browse(t, q, "_http._tcp"), 0)
+}
+
+func TestBadService(t *testing.T) {
+	r := assert.New(t)
+	resp := NewResponder(NewLoopback().Join(responderAddr))
+	for _, s := range []Service{
+		{Service: "_ipp._tcp", Host: "h.local."},
+		{Instance: "x", Service: "ipp._tcp", Host: "h.local."},
+		{Instance: "x", Service: "_ipp._sctp", Host: "h.local."},
+		{Instance: "x", Service: "_ipp._tcp"},
+	} {
+		r.ErrorIs(resp.Register(s), ErrBadService, s)
+	}
+}
+
+func timeout(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func browse(t *testing.T, q *Querier, service string) []Service {
+	t.Helper()
+	services, err := Browse(timeout(t), q, service, "")
+	assert.NoError(t, err)
+	return services
+}
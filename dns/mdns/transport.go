@@ -0,0 +1,170 @@
+package mdns
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// Port is the mDNS port.
+const Port = 5353
+
+var (
+	// IPv4Group is the IPv4 mDNS multicast group.
+	IPv4Group = netip.AddrPortFrom(netip.MustParseAddr("224.0.0.251"), Port)
+	// IPv6Group is the link-local IPv6 mDNS multicast group.
+	IPv6Group = netip.AddrPortFrom(netip.MustParseAddr("ff02::fb"), Port)
+)
+
+// Transport carries mDNS messages on a link.
+type Transport interface {
+	// Send sends msg to dst, or to the multicast group when dst is the zero
+	// AddrPort.
+	Send(msg []byte, dst netip.AddrPort) error
+	// Receive returns the next message and its source. It blocks until one
+	// arrives, ctx is done or the transport is closed.
+	Receive(ctx context.Context) ([]byte, netip.AddrPort, error)
+	// Close closes the transport and unblocks Receive.
+	Close() error
+}
+
+// udpTransport is a Transport on a multicast UDP socket.
+type udpTransport struct {
+	conn  *net.UDPConn
+	group netip.AddrPort
+	buf   []byte
+	mu    sync.Mutex // guards buf
+}
+
+// Listen joins the mDNS group of network, "udp4" or "udp6", on ifi, or on
+// the system default interface when ifi is nil.
+func Listen(network string, ifi *net.Interface) (Transport, error) {
+	group := IPv4Group
+	if network == "udp6" {
+		group = IPv6Group
+	}
+	conn, err := net.ListenMulticastUDP(network, ifi, net.UDPAddrFromAddrPort(group))
+	if err != nil {
+		return nil, err
+	}
+	return &udpTransport{conn: conn, group: group, buf: make([]byte, maxMessageSize)}, nil
+}
+
+func (t *udpTransport) Send(msg []byte, dst netip.AddrPort) error {
+	if !dst.IsValid() {
+		dst = t.group
+	}
+	_, err := t.conn.WriteToUDPAddrPort(msg, dst)
+	return err
+}
+
+func (t *udpTransport) Receive(ctx context.Context) ([]byte, netip.AddrPort, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	deadline, _ := ctx.Deadline()
+	t.conn.SetReadDeadline(deadline)
+	stop := context.AfterFunc(ctx, func() { t.conn.SetReadDeadline(time.Now()) })
+	defer stop()
+	n, src, err := t.conn.ReadFromUDPAddrPort(t.buf)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, src, ctx.Err()
+		}
+		return nil, src, err
+	}
+	return append([]byte(nil), t.buf[:n]...), src, nil
+}
+
+func (t *udpTransport) Close() error {
+	return t.conn.Close()
+}
+
+// Loopback is an in-memory link for tests: the transports that joined it
+// exchange messages without sockets or multicast. Like a multicast socket
+// with loopback enabled, a transport receives its own multicast messages.
+type Loopback struct {
+	mu      sync.Mutex
+	members map[netip.AddrPort]*loopbackTransport
+}
+
+// NewLoopback returns an empty link.
+func NewLoopback() *Loopback {
+	return &Loopback{members: make(map[netip.AddrPort]*loopbackTransport)}
+}
+
+// Join returns a transport on the link with source address addr. Joining
+// twice with the same address replaces the first transport.
+func (l *Loopback) Join(addr netip.AddrPort) Transport {
+	t := &loopbackTransport{link: l, addr: addr, in: make(chan packet, 64), done: make(chan struct{})}
+	l.mu.Lock()
+	l.members[addr] = t
+	l.mu.Unlock()
+	return t
+}
+
+type packet struct {
+	msg []byte
+	src netip.AddrPort
+}
+
+type loopbackTransport struct {
+	link      *Loopback
+	addr      netip.AddrPort
+	in        chan packet
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (t *loopbackTransport) Send(msg []byte, dst netip.AddrPort) error {
+	select {
+	case <-t.done:
+		return net.ErrClosed
+	default:
+	}
+	p := packet{msg: append([]byte(nil), msg...), src: t.addr}
+	t.link.mu.Lock()
+	defer t.link.mu.Unlock()
+	if dst.IsValid() {
+		if m := t.link.members[dst]; m != nil {
+			m.deliver(p)
+		}
+		return nil
+	}
+	for _, m := range t.link.members {
+		m.deliver(p)
+	}
+	return nil
+}
+
+// deliver queues p, dropping it when the queue is full as a socket would.
+func (t *loopbackTransport) deliver(p packet) {
+	select {
+	case t.in <- p:
+	default:
+	}
+}
+
+func (t *loopbackTransport) Receive(ctx context.Context) ([]byte, netip.AddrPort, error) {
+	select {
+	case p := <-t.in:
+		return p.msg, p.src, nil
+	case <-t.done:
+		return nil, netip.AddrPort{}, net.ErrClosed
+	case <-ctx.Done():
+		return nil, netip.AddrPort{}, ctx.Err()
+	}
+}
+
+func (t *loopbackTransport) Close() error {
+	t.closeOnce.Do(func() {
+		close(t.done)
+		t.link.mu.Lock()
+		if t.link.members[t.addr] == t {
+			delete(t.link.members, t.addr)
+		}
+		t.link.mu.Unlock()
+	})
+	return nil
+}
@@ -0,0 +1,59 @@
+package dns
+
+import (
+	"time"
+
+	"github.com/dnsoa/go/fasttime"
+)
+
+// QueryInfo describes one query handled by a server or sent by a Client.
+type QueryInfo struct {
+	// Transport is "udp" or "tcp": the one that carried the response, after
+	// a client retried a truncated response over TCP.
+	Transport string
+	Qtype     Type
+	// Rcode is the response code, extended bits included.
+	Rcode Rcode
+	// Latency is measured with fasttime, so it has the resolution of its
+	// update interval: 200ms, or 10ms with FASTTIME_HIGH_PRECISION=true.
+	// Queries answered within one tick may have a zero latency.
+	Latency time.Duration
+	// Size is the size of the response message in octets.
+	Size      int
+	Truncated bool
+	// Err is set when a client exchange failed; the other fields describe
+	// the response, if there was one.
+	Err error
+}
+
+// Observer is told about every query, e.g. to export metrics. It is called
+// on the hot path, from many goroutines at once, and must be cheap.
+type Observer interface {
+	ObserveQuery(q QueryInfo)
+}
+
+// QueryStart returns the start time of a query for Observe.
+func QueryStart() int64 {
+	return fasttime.UnixNano()
+}
+
+// Observe tells o, which may be nil, about the query qtype answered with
+// resp, size octets long, over transport since start, as returned by
+// QueryStart. err is the error of a failed exchange, where resp may be nil.
+func Observe(o Observer, transport string, qtype Type, start int64, resp *Response, size int, err error) {
+	if o == nil {
+		return
+	}
+	q := QueryInfo{
+		Transport: transport,
+		Qtype:     qtype,
+		Latency:   time.Duration(fasttime.UnixNano() - start),
+		Err:       err,
+	}
+	if resp != nil {
+		q.Rcode = resp.Rcode()
+		q.Size = size
+		q.Truncated = resp.Header.Truncated()
+	}
+	o.ObserveQuery(q)
+}
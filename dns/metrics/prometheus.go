@@ -0,0 +1,321 @@
+// Package metrics exports the queries seen by a dns.Observer in the
+// Prometheus text exposition format, without depending on the Prometheus
+// client library.
+//
+//	m := metrics.NewPrometheus("dns_client")
+//	g := upstream.NewGroup(&upstream.RoundRobin{}, "192.0.2.53:53")
+//	g.Observer = m
+//	http.Handle("/metrics", m)
+package metrics
+
+import (
+	"cmp"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dnsoa/go/dns"
+)
+
+// ContentType is the media type of the text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var (
+	// LatencyBuckets are the default upper bounds, in seconds, of the
+	// latency histogram. They start at 10ms, the finest resolution of the
+	// latency, see dns.QueryInfo.
+	LatencyBuckets = []float64{0.01, 0.025, 0.05, 0.1, 0.2, 0.4, 0.6, 1, 2.5, 5}
+	// SizeBuckets are the default upper bounds, in octets, of the response
+	// size histogram.
+	SizeBuckets = []float64{0, 100, 200, 300, 400, 511, 1023, 2047, 4095, 8291, 16000, 32000, 48000, 64000}
+)
+
+type queryKey struct {
+	transport string
+	qtype     dns.Type
+	rcode     dns.Rcode
+}
+
+// transportStats holds the metrics labelled with the transport only.
+type transportStats struct {
+	latency   histogram
+	size      histogram
+	truncated atomic.Uint64
+	errors    atomic.Uint64
+}
+
+// Prometheus is a dns.Observer that counts queries by transport, type and
+// rcode, and keeps histograms of latency and response size by transport.
+// It is safe for concurrent use; observing a query is lock-free once its
+// labels have been seen.
+type Prometheus struct {
+	namespace      string
+	latencyBuckets []float64
+	sizeBuckets    []float64
+
+	mu         sync.RWMutex
+	queries    map[queryKey]*atomic.Uint64
+	transports map[string]*transportStats
+}
+
+// NewPrometheus returns an exporter whose metric names start with
+// namespace, e.g. "dns_server" for dns_server_queries_total.
+//
+// Latencies are only as precise as fasttime: they are multiples of 200ms, or
+// of 10ms with FASTTIME_HIGH_PRECISION=true, so buckets below that only
+// separate the queries answered within the same tick.
+func NewPrometheus(namespace string) *Prometheus {
+	return &Prometheus{
+		namespace:      namespace,
+		latencyBuckets: LatencyBuckets,
+		sizeBuckets:    SizeBuckets,
+		queries:        make(map[queryKey]*atomic.Uint64),
+		transports:     make(map[string]*transportStats),
+	}
+}
+
+// SetBuckets replaces the upper bounds of the latency, in seconds, and size
+// histograms; nil keeps the current ones. It must be called before the
+// first query is observed.
+func (p *Prometheus) SetBuckets(latency, size []float64) {
+	if latency != nil {
+		p.latencyBuckets = slices.Sorted(slices.Values(latency))
+	}
+	if size != nil {
+		p.sizeBuckets = slices.Sorted(slices.Values(size))
+	}
+}
+
+// ObserveQuery implements dns.Observer. Failed client exchanges are only
+// counted as errors.
+func (p *Prometheus) ObserveQuery(q dns.QueryInfo) {
+	t := p.transport(q.Transport)
+	if q.Err != nil {
+		t.errors.Add(1)
+		return
+	}
+	p.counter(queryKey{transport: q.Transport, qtype: q.Qtype, rcode: q.Rcode}).Add(1)
+	t.latency.observe(q.Latency.Seconds(), int64(q.Latency))
+	t.size.observe(float64(q.Size), int64(q.Size))
+	if q.Truncated {
+		t.truncated.Add(1)
+	}
+}
+
+func (p *Prometheus) counter(k queryKey) *atomic.Uint64 {
+	p.mu.RLock()
+	c := p.queries[k]
+	p.mu.RUnlock()
+	if c != nil {
+		return c
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if c = p.queries[k]; c == nil {
+		c = new(atomic.Uint64)
+		p.queries[k] = c
+	}
+	return c
+}
+
+func (p *Prometheus) transport(name string) *transportStats {
+	p.mu.RLock()
+	t := p.transports[name]
+	p.mu.RUnlock()
+	if t != nil {
+		return t
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t = p.transports[name]; t == nil {
+		t = &transportStats{
+			latency: newHistogram(p.latencyBuckets),
+			size:    newHistogram(p.sizeBuckets),
+		}
+		p.transports[name] = t
+	}
+	return t
+}
+
+// WriteTo writes all metrics to w in the text exposition format, sorted by
+// labels.
+func (p *Prometheus) WriteTo(w io.Writer) (int64, error) {
+	p.mu.RLock()
+	keys := make([]queryKey, 0, len(p.queries))
+	for k := range p.queries {
+		keys = append(keys, k)
+	}
+	names := make([]string, 0, len(p.transports))
+	for name := range p.transports {
+		names = append(names, name)
+	}
+	p.mu.RUnlock()
+	slices.SortFunc(keys, func(a, b queryKey) int {
+		return cmp.Or(
+			strings.Compare(a.transport, b.transport),
+			cmp.Compare(a.qtype, b.qtype),
+			cmp.Compare(a.rcode, b.rcode),
+		)
+	})
+	slices.Sort(names)
+
+	b := make([]byte, 0, 4096)
+	b = p.header(b, "queries_total", "counter", "Queries by transport, type and response code.")
+	for _, k := range keys {
+		b = p.name(b, "queries_total")
+		b = append(b, `{transport="`...)
+		b = appendLabel(b, k.transport)
+		b = append(b, `",qtype="`...)
+		b = append(b, typeString(k.qtype)...)
+		b = append(b, `",rcode="`...)
+		b = append(b, rcodeString(k.rcode)...)
+		b = append(b, `"} `...)
+		b = strconv.AppendUint(b, p.counter(k).Load(), 10)
+		b = append(b, '\n')
+	}
+	stats := make([]*transportStats, len(names))
+	for i, name := range names {
+		stats[i] = p.transport(name)
+	}
+	b = p.header(b, "truncated_total", "counter", "Responses with the TC bit set.")
+	for i, name := range names {
+		b = p.sample(b, "truncated_total", name, stats[i].truncated.Load())
+	}
+	b = p.header(b, "errors_total", "counter", "Exchanges that failed without a usable response.")
+	for i, name := range names {
+		b = p.sample(b, "errors_total", name, stats[i].errors.Load())
+	}
+	b = p.header(b, "request_duration_seconds", "histogram", "Time to answer a query.")
+	for i, name := range names {
+		b = p.histogram(b, "request_duration_seconds", name, &stats[i].latency, float64(time.Second))
+	}
+	b = p.header(b, "response_size_bytes", "histogram", "Size of the response message.")
+	for i, name := range names {
+		b = p.histogram(b, "response_size_bytes", name, &stats[i].size, 1)
+	}
+	n, err := w.Write(b)
+	return int64(n), err
+}
+
+// ServeHTTP writes the metrics as the response, so the exporter can be
+// mounted at /metrics.
+func (p *Prometheus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+	p.WriteTo(w)
+}
+
+func (p *Prometheus) name(b []byte, metric string) []byte {
+	if p.namespace != "" {
+		b = append(b, p.namespace...)
+		b = append(b, '_')
+	}
+	return append(b, metric...)
+}
+
+func (p *Prometheus) header(b []byte, metric, typ, help string) []byte {
+	b = append(b, "# HELP "...)
+	b = p.name(b, metric)
+	b = append(b, ' ')
+	b = append(b, help...)
+	b = append(b, "\n# TYPE "...)
+	b = p.name(b, metric)
+	b = append(b, ' ')
+	b = append(b, typ...)
+	return append(b, '\n')
+}
+
+func (p *Prometheus) sample(b []byte, metric, transport string, v uint64) []byte {
+	b = p.name(b, metric)
+	b = append(b, `{transport="`...)
+	b = appendLabel(b, transport)
+	b = append(b, `"} `...)
+	b = strconv.AppendUint(b, v, 10)
+	return append(b, '\n')
+}
+
+// histogram writes h; its sum is divided by unit.
+func (p *Prometheus) histogram(b []byte, metric, transport string, h *histogram, unit float64) []byte {
+	var total uint64
+	for i := range h.counts {
+		total += h.counts[i].Load()
+		b = p.name(b, metric)
+		b = append(b, `_bucket{transport="`...)
+		b = appendLabel(b, transport)
+		b = append(b, `",le="`...)
+		if i < len(h.bounds) {
+			b = strconv.AppendFloat(b, h.bounds[i], 'g', -1, 64)
+		} else {
+			b = append(b, "+Inf"...)
+		}
+		b = append(b, `"} `...)
+		b = strconv.AppendUint(b, total, 10)
+		b = append(b, '\n')
+	}
+	b = p.name(b, metric)
+	b = append(b, `_sum{transport="`...)
+	b = appendLabel(b, transport)
+	b = append(b, `"} `...)
+	b = strconv.AppendFloat(b, float64(h.sum.Load())/unit, 'g', -1, 64)
+	b = append(b, '\n')
+	b = p.name(b, metric)
+	b = append(b, `_count{transport="`...)
+	b = appendLabel(b, transport)
+	b = append(b, `"} `...)
+	b = strconv.AppendUint(b, total, 10)
+	return append(b, '\n')
+}
+
+// histogram counts observations per bucket; the last count is for values
+// above all bounds. The sum is kept as an integer in the observed unit, so
+// it is exact and lock-free.
+type histogram struct {
+	bounds []float64
+	counts []atomic.Uint64
+	sum    atomic.Int64
+}
+
+func newHistogram(bounds []float64) histogram {
+	return histogram{bounds: bounds, counts: make([]atomic.Uint64, len(bounds)+1)}
+}
+
+func (h *histogram) observe(v float64, raw int64) {
+	i, _ := slices.BinarySearch(h.bounds, v)
+	h.counts[i].Add(1)
+	h.sum.Add(raw)
+}
+
+func typeString(t dns.Type) string {
+	if s := t.String(); s != "" {
+		return s
+	}
+	return "TYPE" + strconv.Itoa(int(t))
+}
+
+func rcodeString(rcode dns.Rcode) string {
+	if s, ok := dns.RcodeToString[rcode]; ok {
+		return s
+	}
+	return "RCODE" + strconv.Itoa(int(rcode))
+}
+
+// appendLabel appends a label value escaped as the text format requires.
+func appendLabel(b []byte, v string) []byte {
+	for i := 0; i < len(v); i++ {
+		switch c := v[i]; c {
+		case '\\':
+			b = append(b, `\\`...)
+		case '"':
+			b = append(b, `\"`...)
+		case '\n':
+			b = append(b, `\n`...)
+		default:
+			b = append(b, c)
+		}
+	}
+	return b
+}
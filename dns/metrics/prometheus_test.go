@@ -0,0 +1,141 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/dns/dnstest"
+	"github.com/dnsoa/go/dns/internal/client"
+)
+
+func TestPrometheus(t *testing.T) {
+	r := assert.New(t)
+	p := NewPrometheus("dns")
+	p.SetBuckets([]float64{0.1, 0.01}, []float64{512})
+
+	p.ObserveQuery(dns.QueryInfo{Transport: "udp", Qtype: dns.TypeA, Rcode: dns.RcodeSuccess, Latency: 5 * time.Millisecond, Size: 100})
+	p.ObserveQuery(dns.QueryInfo{Transport: "udp", Qtype: dns.TypeA, Rcode: dns.RcodeSuccess, Latency: 50 * time.Millisecond, Size: 512, Truncated: true})
+	p.ObserveQuery(dns.QueryInfo{Transport: "tcp", Qtype: dns.Type(65280), Rcode: dns.RcodeNameError, Latency: time.Second, Size: 1400})
+	p.ObserveQuery(dns.QueryInfo{Transport: "udp", Qtype: dns.TypeAAAA, Err: errors.New("timeout")})
+
+	var b strings.Builder
+	n, err := p.WriteTo(&b)
+	r.NoError(err)
+	r.Equal(int64(b.Len()), n)
+	r.Equal(`# HELP dns_queries_total Queries by transport, type and response code.
+# TYPE dns_queries_total counter
+dns_queries_total{transport="tcp",qtype="TYPE65280",rcode="NXDOMAIN"} 1
+dns_queries_total{transport="udp",qtype="A",rcode="NOERROR"} 2
+# HELP dns_truncated_total Responses with the TC bit set.
+# TYPE dns_truncated_total counter
+dns_truncated_total{transport="tcp"} 0
+dns_truncated_total{transport="udp"} 1
+# HELP dns_errors_total Exchanges that failed without a usable response.
+# TYPE dns_errors_total counter
+dns_errors_total{transport="tcp"} 0
+dns_errors_total{transport="udp"} 1
+# HELP dns_request_duration_seconds Time to answer a query.
+# TYPE dns_request_duration_seconds histogram
+dns_request_duration_seconds_bucket{transport="tcp",le="0.01"} 0
+dns_request_duration_seconds_bucket{transport="tcp",le="0.1"} 0
+dns_request_duration_seconds_bucket{transport="tcp",le="+Inf"} 1
+dns_request_duration_seconds_sum{transport="tcp"} 1
+dns_request_duration_seconds_count{transport="tcp"} 1
+dns_request_duration_seconds_bucket{transport="udp",le="0.01"} 1
+dns_request_duration_seconds_bucket{transport="udp",le="0.1"} 2
+dns_request_duration_seconds_bucket{transport="udp",le="+Inf"} 2
+dns_request_duration_seconds_sum{transport="udp"} 0.055
+dns_request_duration_seconds_count{transport="udp"} 2
+# HELP dns_response_size_bytes Size of the response message.
+# TYPE dns_response_size_bytes histogram
+dns_response_size_bytes_bucket{transport="tcp",le="512"} 0
+dns_response_size_bytes_bucket{transport="tcp",le="+Inf"} 1
+dns_response_size_bytes_sum{transport="tcp"} 1400
+dns_response_size_bytes_count{transport="tcp"} 1
+dns_response_size_bytes_bucket{transport="udp",le="512"} 2
+dns_response_size_bytes_bucket{transport="udp",le="+Inf"} 2
+dns_response_size_bytes_sum{transport="udp"} 612
+dns_response_size_bytes_count{transport="udp"} 2
+`, b.String())
+}
+
+func TestPrometheusHandler(t *testing.T) {
+	r := assert.New(t)
+	p := NewPrometheus("")
+	p.ObserveQuery(dns.QueryInfo{Transport: `a"b\c`, Qtype: dns.TypeMX, Rcode: dns.Rcode(3841)})
+
+	w := httptest.NewRecorder()
+	p.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	r.Equal(ContentType, w.Header().Get("Content-Type"))
+	r.Contains(w.Body.String(), `queries_total{transport="a\"b\\c",qtype="MX",rcode="RCODE3841"} 1`)
+}
+
+func TestPrometheusConcurrent(t *testing.T) {
+	r := assert.New(t)
+	p := NewPrometheus("dns")
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Go(func() {
+			for range 1000 {
+				p.ObserveQuery(dns.QueryInfo{Transport: "udp", Qtype: dns.Type(i % 2), Size: 100})
+			}
+		})
+	}
+	var b strings.Builder
+	p.WriteTo(&b)
+	wg.Wait()
+	b.Reset()
+	p.WriteTo(&b)
+	r.Contains(b.String(), `dns_response_size_bytes_count{transport="udp"} 8000`)
+}
+
+func TestObserveClientAndServer(t *testing.T) {
+	r := assert.New(t)
+	srv := dnstest.NewServer(t)
+	server := NewPrometheus("dns_server")
+	srv.Observer = server
+	srv.Handle("example.com.", dns.TypeA, dnstest.Reply{Truncate: true, Answer: dnstest.RRs(t, "example.com. 60 IN A 192.0.2.1")})
+
+	observer := NewPrometheus("dns_client")
+	c := &client.Client{Observer: observer}
+	req := new(dns.Request)
+	req.SetQuestion("example.com.", dns.TypeA, dns.ClassINET)
+	_, err := c.Exchange(context.Background(), req, srv.Addr())
+	r.NoError(err)
+
+	var b strings.Builder
+	server.WriteTo(&b)
+	r.Contains(b.String(), `dns_server_queries_total{transport="tcp",qtype="A",rcode="NOERROR"} 1`)
+	r.Contains(b.String(), `dns_server_queries_total{transport="udp",qtype="A",rcode="NOERROR"} 1`)
+	r.Contains(b.String(), `dns_server_truncated_total{transport="udp"} 1`)
+
+	b.Reset()
+	observer.WriteTo(&b)
+	r.Contains(b.String(), `dns_client_queries_total{transport="tcp",qtype="A",rcode="NOERROR"} 1`)
+	r.Contains(b.String(), `dns_client_response_size_bytes_count{transport="tcp"} 1`)
+
+	// Nothing listens on the port once the server is closed.
+	srv.Close()
+	c.Timeout = 100 * time.Millisecond
+	_, err = c.Exchange(context.Background(), req, srv.Addr())
+	r.NotNil(err)
+	b.Reset()
+	observer.WriteTo(&b)
+	r.Contains(b.String(), `dns_client_errors_total{transport="udp"} 1`)
+}
+
+func BenchmarkPrometheusObserve(b *testing.B) {
+	p := NewPrometheus("dns")
+	q := dns.QueryInfo{Transport: "udp", Qtype: dns.TypeA, Latency: 3 * time.Millisecond, Size: 120}
+	b.ReportAllocs()
+	for b.Loop() {
+		p.ObserveQuery(q)
+	}
+}
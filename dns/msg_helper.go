@@ -15,7 +15,7 @@ func packDataA(a net.IP, msg []byte, off int) (int, error) {
 	case net.IPv4len, net.IPv6len:
 		// It must be a slice of 4, even if it is 16, we encode only the first 4
 		if off+net.IPv4len > len(msg) {
-			return len(msg), &Error{err: "overflow packing a"}
+			return len(msg), ErrBuf
 		}
 
 		copy(msg[off:], a.To4())
@@ -23,7 +23,7 @@ func packDataA(a net.IP, msg []byte, off int) (int, error) {
 	case 0:
 		// Allowed, for dynamic updates.
 	default:
-		return len(msg), &Error{err: "overflow packing a"}
+		return len(msg), ErrBuf
 	}
 	return off, nil
 }
@@ -79,7 +79,7 @@ func unpackUint8(msg []byte, off int) (i uint8, off1 int, err error) {
 
 func packUint8(i uint8, msg []byte, off int) (off1 int, err error) {
 	if off+1 > len(msg) {
-		return len(msg), &Error{err: "overflow packing uint8"}
+		return len(msg), ErrBuf
 	}
 	msg[off] = i
 	return off + 1, nil
@@ -94,7 +94,7 @@ func unpackUint16(msg []byte, off int) (i uint16, off1 int, err error) {
 
 func packUint16(i uint16, msg []byte, off int) (off1 int, err error) {
 	if off+2 > len(msg) {
-		return len(msg), &Error{err: "overflow packing uint16"}
+		return len(msg), ErrBuf
 	}
 	binary.BigEndian.PutUint16(msg[off:], i)
 	return off + 2, nil
@@ -109,7 +109,7 @@ func unpackUint32(msg []byte, off int) (i uint32, off1 int, err error) {
 
 func packUint32(i uint32, msg []byte, off int) (off1 int, err error) {
 	if off+4 > len(msg) {
-		return len(msg), &Error{err: "overflow packing uint32"}
+		return len(msg), ErrBuf
 	}
 	binary.BigEndian.PutUint32(msg[off:], i)
 	return off + 4, nil
@@ -128,7 +128,7 @@ func unpackUint48(msg []byte, off int) (i uint64, off1 int, err error) {
 
 func packUint48(i uint64, msg []byte, off int) (off1 int, err error) {
 	if off+6 > len(msg) {
-		return len(msg), &Error{err: "overflow packing uint64 as uint48"}
+		return len(msg), ErrBuf
 	}
 	msg[off] = byte(i >> 40)
 	msg[off+1] = byte(i >> 32)
@@ -149,7 +149,7 @@ func unpackUint64(msg []byte, off int) (i uint64, off1 int, err error) {
 
 func packUint64(i uint64, msg []byte, off int) (off1 int, err error) {
 	if off+8 > len(msg) {
-		return len(msg), &Error{err: "overflow packing uint64"}
+		return len(msg), ErrBuf
 	}
 	binary.BigEndian.PutUint64(msg[off:], i)
 	off += 8
@@ -215,7 +215,7 @@ func packStringBase32(s string, msg []byte, off int) (int, error) {
 		return len(msg), err
 	}
 	if off+len(b32) > len(msg) {
-		return len(msg), &Error{err: "overflow packing base32"}
+		return len(msg), ErrBuf
 	}
 	copy(msg[off:off+len(b32)], b32)
 	off += len(b32)
@@ -239,7 +239,7 @@ func packStringBase64(s string, msg []byte, off int) (int, error) {
 		return len(msg), err
 	}
 	if off+len(b64) > len(msg) {
-		return len(msg), &Error{err: "overflow packing base64"}
+		return len(msg), ErrBuf
 	}
 	copy(msg[off:off+len(b64)], b64)
 	off += len(b64)
@@ -264,7 +264,7 @@ func packStringHex(s string, msg []byte, off int) (int, error) {
 		return len(msg), err
 	}
 	if off+len(h) > len(msg) {
-		return len(msg), &Error{err: "overflow packing hex"}
+		return len(msg), ErrBuf
 	}
 	copy(msg[off:off+len(h)], h)
 	off += len(h)
@@ -280,7 +280,7 @@ func unpackStringAny(msg []byte, off, end int) (string, int, error) {
 
 func packStringAny(s string, msg []byte, off int) (int, error) {
 	if off+len(s) > len(msg) {
-		return len(msg), &Error{err: "overflow packing anything"}
+		return len(msg), ErrBuf
 	}
 	copy(msg[off:off+len(s)], s)
 	off += len(s)
@@ -408,6 +408,12 @@ func dddToByte[T ~[]byte | ~string](s T) byte {
 	return byte((s[0]-'0')*100 + (s[1]-'0')*10 + (s[2] - '0'))
 }
 
+// PackDomainName packs name into msg at off and returns the offset after it,
+// compressed against compression as with PackRR.
+func PackDomainName(name string, msg []byte, off int, compression map[string]int) (int, error) {
+	return packDomainNameWithCompression(name, msg, off, compression)
+}
+
 // packDomainName packs a domain name into msg at off.
 // Zero-allocation implementation - iterates directly over the string.
 func packDomainName(domain string, msg []byte, off int) (int, error) {
@@ -415,100 +421,70 @@ func packDomainName(domain string, msg []byte, off int) (int, error) {
 }
 
 // packDomainNameWithCompression packs a domain name with compression pointer support.
-// compression is a map of domain names to their offsets in msg.
-// If compression is nil, no compression is applied.
+// compression maps name suffixes in presentation format to their offsets in msg.
+// If compression is nil, no compression is applied. Escapes (\X and \DDD) in
+// domain are decoded.
 func packDomainNameWithCompression(domain string, msg []byte, off int, compression map[string]int) (int, error) {
-	// Handle root domain
-	if domain == "." || domain == "" {
-		if off >= len(msg) {
-			return off, &Error{err: "overflow packing domain name"}
-		}
-		msg[off] = 0
-		return off + 1, nil
-	}
-
 	// Remove trailing dot if present
-	originalDomain := domain
-	if len(domain) > 0 && domain[len(domain)-1] == '.' {
+	if len(domain) > 0 && domain[len(domain)-1] == '.' && !isEscapedDot(domain) {
 		domain = domain[:len(domain)-1]
 	}
 
-	// Check compression map for existing entry
-	if compression != nil {
-		if ptr, ok := compression[domain]; ok && ptr < off {
-			// Compression pointer must fit within 14 bits (0x3FFF)
-			if ptr > maxCompressionOffset {
-				// Can't use compression, fall through to normal packing
-			} else {
+	wireLen := 1 // root label
+	for start := 0; start < len(domain); {
+		suffix := domain[start:]
+		if compression != nil {
+			if ptr, ok := compression[suffix]; ok && ptr < maxCompressionOffset {
 				// Write compression pointer: 0xC0 | (ptr >> 8), ptr & 0xFF
 				if off+2 > len(msg) {
-					return off, &Error{err: "overflow packing compression pointer"}
+					return off, ErrBuf
 				}
 				msg[off] = 0xC0 | byte(ptr>>8)
 				msg[off+1] = byte(ptr)
 				return off + 2, nil
 			}
+			if off < maxCompressionOffset {
+				compression[suffix] = off
+			}
 		}
-	}
-
-	start := 0
-	startOff := off
-	originalOff := off
 
-	for i := 0; i < len(domain); i++ {
-		if domain[i] == '.' {
-			labelLen := i - start
-			if labelLen > 63 {
-				return off, &Error{err: "label too long"}
+		lenOff := off
+		off++
+		labelLen := 0
+		i := start
+		for i < len(domain) && domain[i] != '.' {
+			b, n := nextByte(domain, i)
+			if n == 0 {
+				return lenOff, &Error{err: "bad escape in domain name"}
 			}
-			if off+1+labelLen > len(msg) {
-				return off, &Error{err: "overflow packing domain name"}
+			if off >= len(msg) {
+				return lenOff, ErrBuf
 			}
-			msg[off] = byte(labelLen)
+			msg[off] = b
 			off++
-			// Copy label bytes directly
-			copy(msg[off:], domain[start:i])
-			off += labelLen
-			start = i + 1
-
-			// Add intermediate labels to compression map
-			if compression != nil {
-				partialDomain := domain[:i]
-				if _, exists := compression[partialDomain]; !exists {
-					compression[partialDomain] = originalOff
-				}
-			}
+			labelLen++
+			i += n
 		}
+		if labelLen == 0 {
+			return lenOff, &Error{err: "empty label in domain name"}
+		}
+		if labelLen > 63 {
+			return lenOff, &Error{err: "label too long"}
+		}
+		msg[lenOff] = byte(labelLen)
+		wireLen += labelLen + 1
+		if wireLen > maxDomainNameWireOctets {
+			return lenOff, ErrLongDomain
+		}
+		start = i + 1
 	}
 
-	// Last label
-	labelLen := len(domain) - start
-	if labelLen > 63 {
-		return off, &Error{err: "label too long"}
-	}
-	if off+1+labelLen > len(msg) {
-		return off, &Error{err: "overflow packing domain name"}
-	}
-	msg[off] = byte(labelLen)
-	off++
-	copy(msg[off:], domain[start:])
-	off += labelLen
-
 	// Root label
 	if off >= len(msg) {
-		return off, &Error{err: "overflow packing domain name"}
+		return off, ErrBuf
 	}
 	msg[off] = 0
-	off++
-
-	// Add full domain to compression map
-	if compression != nil {
-		if _, exists := compression[originalDomain]; !exists {
-			compression[originalDomain] = startOff
-		}
-	}
-
-	return off, nil
+	return off + 1, nil
 }
 
 // splitDomainName splits a domain name into labels.
@@ -0,0 +1,162 @@
+package dns
+
+// maxLabels is the maximum number of labels in a wire-format name, root excluded.
+const maxLabels = (maxDomainNameWireOctets - 1) / 2
+
+// NameKey is a comparable, case-insensitive key for a domain name. It holds the
+// canonical (lowercased, uncompressed) wire format of the name in a Name, so it
+// can be used as a key in Go maps, maps.HashMap or lru caches without building a
+// string. Bytes past Length are always zero.
+type NameKey Name
+
+// MakeNameKey returns the key of an uncompressed wire-format name. Names longer
+// than 255 octets are truncated and should be validated by the caller.
+func MakeNameKey(wire []byte) NameKey {
+	var k NameKey
+	n := copy(k.Data[:], wire)
+	k.Length = uint8(n)
+	toLowerASCII(k.Data[:n])
+	return k
+}
+
+// NameKeyFromString returns the key of a presentation-format name such as
+// "www.Example.com.".
+func NameKeyFromString(name string) (NameKey, error) {
+	var k NameKey
+	n, err := packDomainName(name, k.Data[:], 0)
+	if err != nil {
+		return NameKey{}, err
+	}
+	k.Length = uint8(n)
+	toLowerASCII(k.Data[:n])
+	return k, nil
+}
+
+// Wire returns the canonical wire format of the name. The slice aliases k.
+func (k *NameKey) Wire() []byte {
+	return k.Data[:k.Length]
+}
+
+// String returns the name in presentation format with a trailing dot.
+func (k NameKey) String() string {
+	if k.Length <= 1 {
+		return "."
+	}
+	name, _, err := UnpackDomainName(k.Data[:k.Length], 0)
+	if err != nil {
+		return ""
+	}
+	return string(name)
+}
+
+// Compare orders k and o canonically, see CompareName.
+func (k *NameKey) Compare(o *NameKey) int {
+	return CompareName(k.Wire(), o.Wire())
+}
+
+// CompareName compares two uncompressed wire-format names in the canonical DNS
+// name order of RFC 4034, section 6.1: labels are compared right to left as
+// lowercased octet strings, and a name sorts before the names below it. It
+// returns -1, 0 or +1.
+func CompareName(a, b []byte) int {
+	var la, lb [maxLabels]uint8
+	na := labelOffsets(a, &la)
+	nb := labelOffsets(b, &lb)
+	for na > 0 && nb > 0 {
+		na--
+		nb--
+		if c := compareLabel(a[la[na]:], b[lb[nb]:]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case na > 0:
+		return 1
+	case nb > 0:
+		return -1
+	}
+	return 0
+}
+
+// EqualName reports whether two uncompressed wire-format names are equal,
+// ignoring ASCII case.
+func EqualName(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if lowerASCII(a[i]) != lowerASCII(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// CanonicalName appends the canonical form of the wire-format name to dst and
+// returns the result: uppercase US-ASCII letters are replaced by lowercase ones
+// (RFC 4034, section 6.2).
+func CanonicalName(dst, name []byte) []byte {
+	n := len(dst)
+	dst = append(dst, name...)
+	toLowerASCII(dst[n:])
+	return dst
+}
+
+// CanonicalFqdn returns the canonical form of the presentation-format name,
+// see CanonicalName, with a trailing dot.
+func CanonicalFqdn(name string) string {
+	return string(CanonicalName(nil, s2b(Fqdn(name))))
+}
+
+// labelOffsets records the offset of each length octet in name and returns the
+// number of labels. Malformed names are cut at the first bad label.
+func labelOffsets(name []byte, offs *[maxLabels]uint8) int {
+	n := 0
+	for off := 0; off < len(name) && n < maxLabels; {
+		l := int(name[off])
+		if l == 0 || l > 63 || off+1+l > len(name) {
+			break
+		}
+		offs[n] = uint8(off)
+		n++
+		off += 1 + l
+	}
+	return n
+}
+
+// compareLabel compares the labels starting at the length octets of a and b.
+func compareLabel(a, b []byte) int {
+	la, lb := int(a[0]), int(b[0])
+	a, b = a[1:1+la], b[1:1+lb]
+	for i := 0; i < la && i < lb; i++ {
+		ca, cb := lowerASCII(a[i]), lowerASCII(b[i])
+		if ca != cb {
+			if ca < cb {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case la < lb:
+		return -1
+	case la > lb:
+		return 1
+	}
+	return 0
+}
+
+func lowerASCII(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+// toLowerASCII lowercases b in place. Length octets are never in 'A'..'Z'
+// range since labels are at most 63 octets long.
+func toLowerASCII(b []byte) {
+	for i, c := range b {
+		b[i] = lowerASCII(c)
+	}
+}
@@ -0,0 +1,80 @@
+package dns
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+)
+
+func TestCompareName(t *testing.T) {
+	r := assert.New(t)
+
+	// The example from RFC 4034, section 6.1, in canonical order.
+	ordered := [][]byte{
+		EncodeDomain(nil, "example"),
+		EncodeDomain(nil, "a.example"),
+		EncodeDomain(nil, "yljkjljk.a.example"),
+		EncodeDomain(nil, "Z.a.example"),
+		EncodeDomain(nil, "zABC.a.EXAMPLE"),
+		EncodeDomain(nil, "z.example"),
+		append([]byte{1, 1}, EncodeDomain(nil, "z.example")...),
+		EncodeDomain(nil, "*.z.example"),
+		append([]byte{1, 200}, EncodeDomain(nil, "z.example")...),
+	}
+	for i := range ordered {
+		for j := range ordered {
+			want := 0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = 1
+			}
+			r.Equal(want, CompareName(ordered[i], ordered[j]), i, j)
+		}
+	}
+
+	shuffled := slices.Clone(ordered)
+	slices.Reverse(shuffled)
+	slices.SortFunc(shuffled, CompareName)
+	r.DeepEqual(ordered, shuffled)
+
+	r.Equal(0, CompareName([]byte{0}, []byte{0}))
+	r.Equal(-1, CompareName([]byte{0}, EncodeDomain(nil, "com")))
+}
+
+func TestEqualName(t *testing.T) {
+	r := assert.New(t)
+	r.True(EqualName(EncodeDomain(nil, "WWW.Example.COM"), EncodeDomain(nil, "www.example.com")))
+	r.False(EqualName(EncodeDomain(nil, "www.example.com"), EncodeDomain(nil, "www.example.net")))
+	r.False(EqualName(EncodeDomain(nil, "www.example.com"), EncodeDomain(nil, "example.com")))
+
+	canon := CanonicalName(nil, EncodeDomain(nil, "WWW.Example.COM"))
+	r.Equal("\x03www\x07example\x03com\x00", string(canon))
+
+	r.Equal("www.example.com.", CanonicalFqdn("WWW.Example.COM"))
+	r.Equal(".", CanonicalFqdn(""))
+}
+
+func TestNameKey(t *testing.T) {
+	r := assert.New(t)
+
+	k1 := MakeNameKey(EncodeDomain(nil, "WWW.Example.com"))
+	k2, err := NameKeyFromString("www.example.COM.")
+	r.NoError(err)
+	r.True(k1 == k2)
+	r.Equal("www.example.com.", k1.String())
+	r.Equal("\x03www\x07example\x03com\x00", string(k1.Wire()))
+
+	root, err := NameKeyFromString(".")
+	r.NoError(err)
+	r.Equal(".", root.String())
+	r.Equal(-1, root.Compare(&k1))
+
+	m := map[NameKey]int{k1: 1}
+	r.Equal(1, m[MakeNameKey(EncodeDomain(nil, "www.EXAMPLE.com"))])
+
+	_, err = NameKeyFromString("this-label-is-way-too-long-to-fit-in-a-single-dns-label-because-it-is-long.com")
+	r.Error(err)
+}
@@ -0,0 +1,354 @@
+package dns
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// NSEC3 hash algorithms and flags, see RFC 5155, section 11.
+const (
+	SHA1 uint8 = 1
+
+	// NSEC3OptOut marks an NSEC3 record that may cover unsigned delegations.
+	NSEC3OptOut uint8 = 1
+)
+
+// NSEC record (Next Secure)
+// RFC 4034, section 4
+type NSEC struct {
+	Hdr        RR_Header
+	NextDomain string
+	TypeBitMap []Type
+}
+
+func (rr *NSEC) Header() *RR_Header { return &rr.Hdr }
+
+func (rr *NSEC) pack(msg []byte, off int) (off1 int, err error) {
+	// The next domain name is never compressed.
+	off, err = packDomainName(rr.NextDomain, msg, off)
+	if err != nil {
+		return off, err
+	}
+	return packTypeBitMap(rr.TypeBitMap, msg, off)
+}
+
+func (rr *NSEC) unpack(msg []byte, off int) (off1 int, err error) {
+	end := off + int(rr.Hdr.Rdlength)
+	name, off, err := UnpackDomainName(msg, off)
+	if err != nil {
+		return off, err
+	}
+	rr.NextDomain = string(name)
+	rr.TypeBitMap, off, err = unpackTypeBitMap(msg, off, end)
+	return off, err
+}
+
+func (rr *NSEC) String() string {
+	return rr.Hdr.String() + rr.NextDomain + typeBitMapString(rr.TypeBitMap)
+}
+
+// Match reports whether the NSEC record is owned by name.
+func (rr *NSEC) Match(name string) bool {
+	return strings.EqualFold(Fqdn(rr.Hdr.Name), Fqdn(name))
+}
+
+// Cover reports whether name falls strictly between the owner and the next
+// domain name in canonical order, proving that it does not exist. The last
+// NSEC of a zone, whose next name is the apex, covers everything after it.
+func (rr *NSEC) Cover(name string) bool {
+	owner, err1 := NameKeyFromString(rr.Hdr.Name)
+	next, err2 := NameKeyFromString(rr.NextDomain)
+	key, err3 := NameKeyFromString(name)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return false
+	}
+	return between(owner.Compare(&key), key.Compare(&next), owner.Compare(&next))
+}
+
+// between tells whether x lies strictly between owner and next, given
+// cmp(owner, x), cmp(x, next) and cmp(owner, next), where next <= owner
+// means the interval wraps around the end of the zone.
+func between(ownerX, xNext, ownerNext int) bool {
+	if ownerNext < 0 {
+		return ownerX < 0 && xNext < 0
+	}
+	return ownerX < 0 || xNext < 0
+}
+
+// NSEC3 record (Hashed Next Secure)
+// RFC 5155, section 3
+type NSEC3 struct {
+	Hdr        RR_Header
+	Hash       uint8
+	Flags      uint8
+	Iterations uint16
+	SaltLength uint8
+	Salt       string // hex, "-" for no salt in presentation format
+	HashLength uint8
+	NextDomain string // base32hex, lowercase
+	TypeBitMap []Type
+}
+
+func (rr *NSEC3) Header() *RR_Header { return &rr.Hdr }
+
+func (rr *NSEC3) pack(msg []byte, off int) (off1 int, err error) {
+	off, err = packNSEC3Params(rr.Hash, rr.Flags, rr.Iterations, rr.Salt, msg, off)
+	if err != nil {
+		return off, err
+	}
+	next, err := fromBase32([]byte(rr.NextDomain))
+	if err != nil {
+		return len(msg), &Error{err: "bad NSEC3 next hashed owner"}
+	}
+	if off, err = packUint8(uint8(len(next)), msg, off); err != nil {
+		return off, err
+	}
+	if off+len(next) > len(msg) {
+		return len(msg), ErrBuf
+	}
+	off += copy(msg[off:], next)
+	return packTypeBitMap(rr.TypeBitMap, msg, off)
+}
+
+func (rr *NSEC3) unpack(msg []byte, off int) (off1 int, err error) {
+	end := off + int(rr.Hdr.Rdlength)
+	rr.Hash, rr.Flags, rr.Iterations, rr.SaltLength, rr.Salt, off, err = unpackNSEC3Params(msg, off)
+	if err != nil {
+		return off, err
+	}
+	if rr.HashLength, off, err = unpackUint8(msg, off); err != nil {
+		return off, err
+	}
+	if off+int(rr.HashLength) > end {
+		return len(msg), &Error{err: "overflow unpacking nsec3"}
+	}
+	rr.NextDomain = strings.ToLower(toBase32(msg[off : off+int(rr.HashLength)]))
+	off += int(rr.HashLength)
+	rr.TypeBitMap, off, err = unpackTypeBitMap(msg, off, end)
+	return off, err
+}
+
+func (rr *NSEC3) String() string {
+	return rr.Hdr.String() +
+		strconv.Itoa(int(rr.Hash)) + " " +
+		strconv.Itoa(int(rr.Flags)) + " " +
+		strconv.Itoa(int(rr.Iterations)) + " " +
+		saltString(rr.Salt) + " " +
+		rr.NextDomain + typeBitMapString(rr.TypeBitMap)
+}
+
+// OptOut reports whether the opt-out flag is set.
+func (rr *NSEC3) OptOut() bool {
+	return rr.Flags&NSEC3OptOut != 0
+}
+
+// Match reports whether name hashes to the owner of the record.
+func (rr *NSEC3) Match(name string) bool {
+	label, zone, ok := strings.Cut(Fqdn(rr.Hdr.Name), ".")
+	if !ok || !IsSubDomain(zone, name) {
+		return false
+	}
+	return strings.EqualFold(label, HashName(name, rr.Hash, rr.Iterations, rr.Salt))
+}
+
+// Cover reports whether the hash of name falls strictly between the owner
+// hash and the next hashed owner, proving that name does not exist.
+func (rr *NSEC3) Cover(name string) bool {
+	label, zone, ok := strings.Cut(Fqdn(rr.Hdr.Name), ".")
+	if !ok || !IsSubDomain(zone, name) {
+		return false
+	}
+	h := HashName(name, rr.Hash, rr.Iterations, rr.Salt)
+	if h == "" {
+		return false
+	}
+	// base32hex keeps the order of the hashes.
+	owner, next := strings.ToLower(label), strings.ToLower(rr.NextDomain)
+	return between(strings.Compare(owner, h), strings.Compare(h, next), strings.Compare(owner, next))
+}
+
+// NSEC3PARAM record
+// RFC 5155, section 4
+type NSEC3PARAM struct {
+	Hdr        RR_Header
+	Hash       uint8
+	Flags      uint8
+	Iterations uint16
+	SaltLength uint8
+	Salt       string // hex
+}
+
+func (rr *NSEC3PARAM) Header() *RR_Header { return &rr.Hdr }
+
+func (rr *NSEC3PARAM) pack(msg []byte, off int) (off1 int, err error) {
+	return packNSEC3Params(rr.Hash, rr.Flags, rr.Iterations, rr.Salt, msg, off)
+}
+
+func (rr *NSEC3PARAM) unpack(msg []byte, off int) (off1 int, err error) {
+	rr.Hash, rr.Flags, rr.Iterations, rr.SaltLength, rr.Salt, off, err = unpackNSEC3Params(msg, off)
+	return off, err
+}
+
+func (rr *NSEC3PARAM) String() string {
+	return rr.Hdr.String() +
+		strconv.Itoa(int(rr.Hash)) + " " +
+		strconv.Itoa(int(rr.Flags)) + " " +
+		strconv.Itoa(int(rr.Iterations)) + " " +
+		saltString(rr.Salt)
+}
+
+func packNSEC3Params(hash, flags uint8, iterations uint16, salt string, msg []byte, off int) (int, error) {
+	var err error
+	if off, err = packUint8(hash, msg, off); err != nil {
+		return off, err
+	}
+	if off, err = packUint8(flags, msg, off); err != nil {
+		return off, err
+	}
+	if off, err = packUint16(iterations, msg, off); err != nil {
+		return off, err
+	}
+	if salt == "-" {
+		salt = ""
+	}
+	if len(salt)/2 > 255 {
+		return len(msg), &Error{err: "nsec3 salt too long"}
+	}
+	if off, err = packUint8(uint8(len(salt)/2), msg, off); err != nil {
+		return off, err
+	}
+	return packStringHex(salt, msg, off)
+}
+
+func unpackNSEC3Params(msg []byte, off int) (hash, flags uint8, iterations uint16, saltLength uint8, salt string, off1 int, err error) {
+	if hash, off, err = unpackUint8(msg, off); err != nil {
+		return
+	}
+	if flags, off, err = unpackUint8(msg, off); err != nil {
+		return
+	}
+	if iterations, off, err = unpackUint16(msg, off); err != nil {
+		return
+	}
+	if saltLength, off, err = unpackUint8(msg, off); err != nil {
+		return
+	}
+	salt, off, err = unpackStringHex(msg, off, off+int(saltLength))
+	return hash, flags, iterations, saltLength, salt, off, err
+}
+
+func saltString(salt string) string {
+	if salt == "" {
+		return "-"
+	}
+	return strings.ToUpper(salt)
+}
+
+// HashName returns the NSEC3 hash of name, in lowercase base32hex as used
+// for NSEC3 owner labels, see RFC 5155, section 5. salt is hex encoded. It
+// returns "" for an unknown hash algorithm or a bad name or salt.
+func HashName(name string, hash uint8, iterations uint16, salt string) string {
+	if hash != SHA1 {
+		return ""
+	}
+	if salt == "-" {
+		salt = ""
+	}
+	s, err := hex.DecodeString(salt)
+	if err != nil {
+		return ""
+	}
+	key, err := NameKeyFromString(name)
+	if err != nil {
+		return ""
+	}
+	h := sha1.New()
+	h.Write(key.Wire())
+	h.Write(s)
+	sum := h.Sum(nil)
+	for range iterations {
+		h.Reset()
+		h.Write(sum)
+		h.Write(s)
+		sum = h.Sum(sum[:0])
+	}
+	return strings.ToLower(toBase32(sum))
+}
+
+// IsSubDomain reports whether child is parent or below it, ignoring case.
+func IsSubDomain(parent, child string) bool {
+	parent, child = strings.ToLower(Fqdn(parent)), strings.ToLower(Fqdn(child))
+	if parent == "." || parent == child {
+		return true
+	}
+	return strings.HasSuffix(child, "."+parent)
+}
+
+// packTypeBitMap packs types as window blocks, see RFC 4034, section 4.1.2.
+func packTypeBitMap(types []Type, msg []byte, off int) (int, error) {
+	if len(types) == 0 {
+		return off, nil
+	}
+	types = slices.Clone(types)
+	slices.Sort(types)
+	types = slices.Compact(types)
+	for i := 0; i < len(types); {
+		window := types[i] >> 8
+		var bitmap [32]byte
+		length := 0
+		for ; i < len(types) && types[i]>>8 == window; i++ {
+			b := types[i] & 0xff
+			bitmap[b/8] |= 0x80 >> (b % 8)
+			length = int(b/8) + 1
+		}
+		if off+2+length > len(msg) {
+			return len(msg), ErrBuf
+		}
+		msg[off] = byte(window)
+		msg[off+1] = byte(length)
+		off += 2
+		off += copy(msg[off:], bitmap[:length])
+	}
+	return off, nil
+}
+
+func unpackTypeBitMap(msg []byte, off, end int) ([]Type, int, error) {
+	var types []Type
+	last := -1
+	for off < end {
+		if off+2 > end {
+			return nil, len(msg), &Error{err: "overflow unpacking type bitmap"}
+		}
+		window, length := int(msg[off]), int(msg[off+1])
+		off += 2
+		if window <= last || length == 0 || length > 32 || off+length > end {
+			return nil, len(msg), &Error{err: "bad type bitmap"}
+		}
+		last = window
+		for i, b := range msg[off : off+length] {
+			for bit := range 8 {
+				if b&(0x80>>bit) != 0 {
+					types = append(types, Type(window<<8|i*8+bit))
+				}
+			}
+		}
+		off += length
+	}
+	return types, off, nil
+}
+
+func typeBitMapString(types []Type) string {
+	var b strings.Builder
+	for _, t := range types {
+		b.WriteByte(' ')
+		if s := t.String(); s != "" {
+			b.WriteString(s)
+		} else {
+			b.WriteString("TYPE" + strconv.Itoa(int(t)))
+		}
+	}
+	return b.String()
+}
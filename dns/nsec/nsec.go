@@ -0,0 +1,154 @@
+// Package nsec builds the NSEC and NSEC3 chains of a signed zone and picks
+// the records that prove a name or type does not exist.
+//
+//	chain, err := nsec.NewChain3("example.", rrs, 3600, nsec.Params{OptOut: true})
+//	kind, proof := chain.Deny("nope.example.", dns.TypeA)
+//	if kind == nsec.NXDomain {
+//		resp.Ns = append(resp.Ns, proof...)
+//	}
+//
+// The records are not signed; sign them with the rest of the zone.
+package nsec
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/dnsoa/go/dns"
+)
+
+// Kind is the kind of denial of existence a query needs.
+type Kind uint8
+
+const (
+	// None means no denial is needed: the name has the type, a CNAME, or the
+	// query is answered with a referral.
+	None Kind = iota
+	// NXDomain proves that the name and the wildcard that could have
+	// matched it do not exist.
+	NXDomain
+	// NoData proves that the name exists without the type.
+	NoData
+	// Wildcard proves that the name does not exist, so a wildcard answer
+	// was synthesized for it.
+	Wildcard
+	// WildcardNoData proves that the name does not exist and that the
+	// matching wildcard has no record of the type.
+	WildcardNoData
+)
+
+var kindStrings = [...]string{"None", "NXDomain", "NoData", "Wildcard", "WildcardNoData"}
+
+func (k Kind) String() string {
+	if int(k) < len(kindStrings) {
+		return kindStrings[k]
+	}
+	return "Kind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Chain is the NSEC chain of a zone, RFC 4034, section 4.
+type Chain struct {
+	zone    *zone
+	records []*dns.NSEC
+	keys    []dns.NameKey
+}
+
+// NewChain builds the NSEC chain of the zone origin holding rrs. Names
+// below a delegation are not authoritative and get no NSEC; delegation
+// points list only NS and DS. ttl is the TTL of the NSEC records, usually the
+// minimum TTL of the SOA record. NSEC, NSEC3 and NSEC3PARAM records in rrs
+// are ignored.
+func NewChain(origin string, rrs []dns.RR, ttl uint32) (*Chain, error) {
+	z, err := newZone(origin, rrs)
+	if err != nil {
+		return nil, err
+	}
+	c := &Chain{zone: z}
+	for _, name := range sortedNames(z.nodes) {
+		n := z.nodes[name]
+		types := n.types
+		if n.deleg {
+			types = nil
+			for _, t := range []dns.Type{dns.TypeNS, dns.TypeDS} {
+				if n.has(t) {
+					types = append(types, t)
+				}
+			}
+		}
+		key, err := dns.NameKeyFromString(name)
+		if err != nil {
+			return nil, err
+		}
+		c.keys = append(c.keys, key)
+		c.records = append(c.records, &dns.NSEC{
+			Hdr:        dns.RR_Header{Name: name, Rrtype: dns.TypeNSEC, Class: dns.ClassINET, Ttl: ttl},
+			TypeBitMap: bitmap(types, dns.TypeRRSIG, dns.TypeNSEC),
+		})
+	}
+	for i, rr := range c.records {
+		rr.NextDomain = c.records[(i+1)%len(c.records)].Hdr.Name
+	}
+	return c, nil
+}
+
+// Records returns the NSEC records in canonical order, starting at the apex.
+func (c *Chain) Records() []dns.RR {
+	out := make([]dns.RR, len(c.records))
+	for i, rr := range c.records {
+		out[i] = rr
+	}
+	return out
+}
+
+// Deny returns the kind of denial for qname and qtype and the NSEC records
+// that prove it, see RFC 4035, section 3.1.3.
+func (c *Chain) Deny(qname string, qtype dns.Type) (Kind, []dns.RR) {
+	kind, name, ce := c.zone.lookup(qname, qtype)
+	var proof []dns.RR
+	switch kind {
+	case NoData:
+		if rr := c.match(name); rr != nil {
+			proof = appendUnique(proof, rr)
+		} else {
+			// An empty non-terminal has no NSEC; the one before it
+			// proves that it holds no type.
+			proof = appendUnique(proof, c.cover(name))
+		}
+	case NXDomain:
+		proof = appendUnique(proof, c.cover(name), c.cover(wildcard(ce)))
+	case Wildcard:
+		proof = appendUnique(proof, c.cover(name))
+	case WildcardNoData:
+		proof = appendUnique(proof, c.cover(name), c.match(wildcard(ce)))
+	}
+	return kind, proof
+}
+
+// search returns the index of the last record at or before name, wrapping
+// around to the last record, and whether it is owned by name.
+func (c *Chain) search(name string) (int, bool) {
+	key, err := dns.NameKeyFromString(name)
+	if err != nil {
+		return 0, false
+	}
+	i, found := slices.BinarySearchFunc(c.keys, key, func(a, b dns.NameKey) int { return a.Compare(&b) })
+	if found {
+		return i, true
+	}
+	if i == 0 {
+		return len(c.records) - 1, false
+	}
+	return i - 1, false
+}
+
+func (c *Chain) match(name string) dns.RR {
+	if i, ok := c.search(name); ok {
+		return c.records[i]
+	}
+	return nil
+}
+
+func (c *Chain) cover(name string) dns.RR {
+	i, _ := c.search(name)
+	return c.records[i]
+}
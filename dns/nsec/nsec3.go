@@ -0,0 +1,195 @@
+package nsec
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dnsoa/go/dns"
+)
+
+// Params are the hash parameters of an NSEC3 chain. RFC 9276 recommends no
+// extra iterations and no salt.
+type Params struct {
+	Iterations uint16
+	// Salt is hex encoded; "" or "-" for none.
+	Salt string
+	// OptOut leaves insecure delegations, those without DS, out of the
+	// chain, see RFC 5155, section 6.
+	OptOut bool
+}
+
+// Chain3 is the NSEC3 chain of a zone, RFC 5155.
+type Chain3 struct {
+	zone    *zone
+	params  Params
+	records []*dns.NSEC3 // by hash
+	hashes  []string
+}
+
+// NewChain3 builds the NSEC3 chain of the zone origin holding rrs, with the
+// hash parameters p. Empty non-terminals get an NSEC3 record with an empty
+// type bitmap, unless p.OptOut is set and only insecure delegations are below
+// them. ttl and the records ignored work as for NewChain.
+func NewChain3(origin string, rrs []dns.RR, ttl uint32, p Params) (*Chain3, error) {
+	if p.Salt == "-" {
+		p.Salt = ""
+	}
+	p.Salt = strings.ToLower(p.Salt)
+	if dns.HashName(".", dns.SHA1, 0, p.Salt) == "" || len(p.Salt) > 2*255 {
+		return nil, fmt.Errorf("nsec: bad NSEC3 salt %q", p.Salt)
+	}
+	z, err := newZone(origin, rrs)
+	if err != nil {
+		return nil, err
+	}
+	c := &Chain3{zone: z, params: p}
+
+	// The owner names of the chain, with the types of their bitmaps.
+	names := make(map[string][]dns.Type)
+	for name, n := range z.nodes {
+		secure := !n.deleg || n.has(dns.TypeDS)
+		switch {
+		case !secure && p.OptOut:
+			continue
+		case n.deleg:
+			names[name] = bitmap(nil, dns.TypeNS)
+			if secure {
+				names[name] = bitmap(nil, dns.TypeNS, dns.TypeDS, dns.TypeRRSIG)
+			}
+		case name == z.origin:
+			names[name] = bitmap(n.types, dns.TypeRRSIG, dns.TypeNSEC3PARAM)
+		default:
+			names[name] = bitmap(n.types, dns.TypeRRSIG)
+		}
+		for a := range z.ancestors(name) {
+			if _, ok := names[a]; !ok && z.ents[a] {
+				names[a] = nil
+			}
+		}
+	}
+
+	var flags uint8
+	if p.OptOut {
+		flags = dns.NSEC3OptOut
+	}
+	byHash := make(map[string]*dns.NSEC3, len(names))
+	for name, types := range names {
+		h := dns.HashName(name, dns.SHA1, p.Iterations, p.Salt)
+		if _, dup := byHash[h]; dup {
+			return nil, fmt.Errorf("%w: %s", ErrHashCollision, name)
+		}
+		byHash[h] = &dns.NSEC3{
+			Hdr:        dns.RR_Header{Name: h + "." + z.origin, Rrtype: dns.TypeNSEC3, Class: dns.ClassINET, Ttl: ttl},
+			Hash:       dns.SHA1,
+			Flags:      flags,
+			Iterations: p.Iterations,
+			SaltLength: uint8(len(p.Salt) / 2),
+			Salt:       p.Salt,
+			HashLength: 20,
+			TypeBitMap: types,
+		}
+		c.hashes = append(c.hashes, h)
+	}
+	slices.Sort(c.hashes)
+	for i, h := range c.hashes {
+		rr := byHash[h]
+		rr.NextDomain = c.hashes[(i+1)%len(c.hashes)]
+		c.records = append(c.records, rr)
+	}
+	return c, nil
+}
+
+// Param returns the NSEC3PARAM record of the apex. Its flags are zero, as
+// RFC 5155, section 4.1.2 requires.
+func (c *Chain3) Param(ttl uint32) *dns.NSEC3PARAM {
+	return &dns.NSEC3PARAM{
+		Hdr:        dns.RR_Header{Name: c.zone.origin, Rrtype: dns.TypeNSEC3PARAM, Class: dns.ClassINET, Ttl: ttl},
+		Hash:       dns.SHA1,
+		Iterations: c.params.Iterations,
+		SaltLength: uint8(len(c.params.Salt) / 2),
+		Salt:       c.params.Salt,
+	}
+}
+
+// Records returns the NSEC3 records in hash order.
+func (c *Chain3) Records() []dns.RR {
+	out := make([]dns.RR, len(c.records))
+	for i, rr := range c.records {
+		out[i] = rr
+	}
+	return out
+}
+
+// Deny returns the kind of denial for qname and qtype and the NSEC3 records
+// that prove it, see RFC 5155, section 7.2. Names left out of the chain by
+// opt-out, such as an insecure delegation asked for DS, are proven with the
+// closest provable encloser and an opt-out record covering the next closer
+// name.
+func (c *Chain3) Deny(qname string, qtype dns.Type) (Kind, []dns.RR) {
+	kind, name, ce := c.zone.lookup(qname, qtype)
+	var proof []dns.RR
+	switch kind {
+	case NoData:
+		if rr := c.match(name); rr != nil {
+			proof = appendUnique(proof, rr)
+		} else {
+			proof = c.encloserProof(proof, name)
+		}
+	case NXDomain:
+		proof = c.encloserProof(proof, name)
+		proof = appendUnique(proof, c.cover(wildcard(c.provable(name))))
+	case Wildcard:
+		// The signature of the answer tells the closest encloser; only
+		// the next closer name must be proven absent.
+		proof = appendUnique(proof, c.cover(nextCloser(name, ce)))
+	case WildcardNoData:
+		proof = c.encloserProof(proof, name)
+		proof = appendUnique(proof, c.match(wildcard(ce)))
+	}
+	return kind, proof
+}
+
+// provable returns the closest ancestor of name, name excluded, that is in
+// the chain.
+func (c *Chain3) provable(name string) string {
+	ce := parent(name)
+	for ce != c.zone.origin && c.match(ce) == nil {
+		ce = parent(ce)
+	}
+	return ce
+}
+
+// encloserProof appends the closest encloser proof of name, RFC 5155,
+// section 7.2.1: the record matching the closest provable encloser and the
+// one covering the next closer name.
+func (c *Chain3) encloserProof(proof []dns.RR, name string) []dns.RR {
+	ce := c.provable(name)
+	return appendUnique(proof, c.match(ce), c.cover(nextCloser(name, ce)))
+}
+
+// search returns the index of the last record whose hash is at or before the
+// hash of name, wrapping around to the last record, and whether it matches.
+func (c *Chain3) search(name string) (int, bool) {
+	h := dns.HashName(name, dns.SHA1, c.params.Iterations, c.params.Salt)
+	i, found := slices.BinarySearch(c.hashes, h)
+	if found {
+		return i, true
+	}
+	if i == 0 {
+		return len(c.records) - 1, false
+	}
+	return i - 1, false
+}
+
+func (c *Chain3) match(name string) dns.RR {
+	if i, ok := c.search(name); ok {
+		return c.records[i]
+	}
+	return nil
+}
+
+func (c *Chain3) cover(name string) dns.RR {
+	i, _ := c.search(name)
+	return c.records[i]
+}
@@ -0,0 +1,209 @@
+package nsec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+)
+
+// The example zone of RFC 5155, Appendix A, without DNSSEC keys and
+// signatures.
+const rfc5155Zone = `
+$ORIGIN example.
+@ 3600 IN SOA ns1 bugs.x.w 1 3600 300 3600000 3600
+  NS ns1
+  NS ns2
+  MX 1 xx
+2t7b4g4vsa5smi47k61mv5bv1a22bojr A 192.0.2.127
+a NS ns1.a
+  NS ns2.a
+  DS \# 4 e4660501
+ns1.a A 192.0.2.5
+ns2.a A 192.0.2.6
+ai A 192.0.2.9
+   AAAA 2001:db8::f00:baa9
+c NS ns1.c
+  NS ns2.c
+ns1.c A 192.0.2.7
+ns2.c A 192.0.2.8
+ns1 A 192.0.2.1
+ns2 A 192.0.2.2
+*.w MX 1 ai
+x.w MX 1 xx
+x.y.w MX 1 xx
+xx A 192.0.2.10
+   AAAA 2001:db8::f00:baaa
+`
+
+func parseZone(t *testing.T, s string) []dns.RR {
+	t.Helper()
+	rrs, err := dns.ParseZone(strings.NewReader(s), ".")
+	assert.NoError(t, err)
+	return rrs
+}
+
+func owners(rrs []dns.RR) []string {
+	out := make([]string, len(rrs))
+	for i, rr := range rrs {
+		out[i], _, _ = strings.Cut(rr.Header().Name, ".")
+	}
+	return out
+}
+
+func TestChain3(t *testing.T) {
+	r := assert.New(t)
+	chain, err := NewChain3("example.", parseZone(t, rfc5155Zone), 3600, Params{Iterations: 12, Salt: "AABBCCDD", OptOut: true})
+	r.NoError(err)
+
+	// The opt-out chain of RFC 5155, Appendix A: c.example is left out.
+	rrs := chain.Records()
+	r.DeepEqual([]string{
+		"0p9mhaveqvm6t7vbl5lop2u3t2rp3tom", // example
+		"2t7b4g4vsa5smi47k61mv5bv1a22bojr", // ns1.example
+		"2vptu5timamqttgl4luu9kg21e0aor3s", // x.y.w.example
+		"35mthgpgcu1qg68fab165klnsnk3dpvl", // a.example
+		"b4um86eghhds6nea196smvmlo4ors995", // x.w.example
+		"gjeqe526plbf1g8mklp59enfd789njgi", // ai.example
+		"ji6neoaepv8b5o6k4ev33abha8ht9fgc", // y.w.example
+		"k8udemvp1j2f7eg6jebps17vp3n8i58h", // w.example
+		"kohar7mbb8dc2ce8a9qvl8hon4k53uhi", // 2t7b4g4vsa5smi47k61mv5bv1a22bojr.example
+		"q04jkcevqvmu85r014c7dkba38o0ji5r", // ns2.example
+		"r53bq7cc2uvmubfu5ocmm6pers9tk9en", // *.w.example
+		"t644ebqk9bibcna874givr6joj62mlhv", // xx.example
+	}, owners(rrs))
+	r.Equal("0p9mhaveqvm6t7vbl5lop2u3t2rp3tom.example.\t3600\tIN\tNSEC3\t1 1 12 AABBCCDD 2t7b4g4vsa5smi47k61mv5bv1a22bojr NS SOA MX RRSIG NSEC3PARAM", rrs[0].String())
+	r.Equal("35mthgpgcu1qg68fab165klnsnk3dpvl.example.\t3600\tIN\tNSEC3\t1 1 12 AABBCCDD b4um86eghhds6nea196smvmlo4ors995 NS DS RRSIG", rrs[3].String())
+	r.Equal("ji6neoaepv8b5o6k4ev33abha8ht9fgc.example.\t3600\tIN\tNSEC3\t1 1 12 AABBCCDD k8udemvp1j2f7eg6jebps17vp3n8i58h", rrs[6].String())
+	r.Equal("t644ebqk9bibcna874givr6joj62mlhv.example.\t3600\tIN\tNSEC3\t1 1 12 AABBCCDD 0p9mhaveqvm6t7vbl5lop2u3t2rp3tom A AAAA RRSIG", rrs[11].String())
+	r.Equal("example.\t3600\tIN\tNSEC3PARAM\t1 0 12 AABBCCDD", chain.Param(3600).String())
+
+	// The responses of RFC 5155, Appendix B.
+	tests := []struct {
+		name   string
+		qname  string
+		qtype  dns.Type
+		kind   Kind
+		proofs []string
+	}{
+		{"B.1 name error", "a.c.x.w.example.", dns.TypeA, NXDomain,
+			[]string{"b4um86eghhds6nea196smvmlo4ors995", "0p9mhaveqvm6t7vbl5lop2u3t2rp3tom", "35mthgpgcu1qg68fab165klnsnk3dpvl"}},
+		{"B.2 no data", "ns1.example.", dns.TypeMX, NoData,
+			[]string{"2t7b4g4vsa5smi47k61mv5bv1a22bojr"}},
+		{"B.2.1 empty non-terminal", "y.w.example.", dns.TypeA, NoData,
+			[]string{"ji6neoaepv8b5o6k4ev33abha8ht9fgc"}},
+		{"B.3 opt-out DS", "c.example.", dns.TypeDS, NoData,
+			[]string{"0p9mhaveqvm6t7vbl5lop2u3t2rp3tom", "35mthgpgcu1qg68fab165klnsnk3dpvl"}},
+		{"B.4 wildcard", "a.z.w.example.", dns.TypeMX, Wildcard,
+			[]string{"q04jkcevqvmu85r014c7dkba38o0ji5r"}},
+		{"B.5 wildcard no data", "a.z.w.example.", dns.TypeAAAA, WildcardNoData,
+			[]string{"k8udemvp1j2f7eg6jebps17vp3n8i58h", "q04jkcevqvmu85r014c7dkba38o0ji5r", "r53bq7cc2uvmubfu5ocmm6pers9tk9en"}},
+		{"B.6 DS child zone", "a.example.", dns.TypeDS, None, nil},
+		{"existing", "x.w.example.", dns.TypeMX, None, nil},
+		{"referral", "ns1.c.example.", dns.TypeA, None, nil},
+		{"out of zone", "example.org.", dns.TypeA, None, nil},
+	}
+	for _, tt := range tests {
+		kind, proof := chain.Deny(tt.qname, tt.qtype)
+		r.Equal(tt.kind, kind, tt.name)
+		if tt.proofs == nil {
+			r.Len(proof, 0, tt.name)
+			continue
+		}
+		r.DeepEqual(tt.proofs, owners(proof), tt.name)
+	}
+}
+
+func TestChain3NoOptOut(t *testing.T) {
+	r := assert.New(t)
+	chain, err := NewChain3("example.", parseZone(t, rfc5155Zone), 3600, Params{})
+	r.NoError(err)
+	// The insecure delegation c.example is in the chain, with NS only.
+	r.Len(chain.Records(), 13)
+	c := dns.HashName("c.example.", dns.SHA1, 0, "")
+	for _, rr := range chain.Records() {
+		nsec3 := rr.(*dns.NSEC3)
+		r.False(nsec3.OptOut())
+		r.Equal("", nsec3.Salt)
+		if strings.HasPrefix(nsec3.Hdr.Name, c) {
+			r.DeepEqual([]dns.Type{dns.TypeNS}, nsec3.TypeBitMap)
+		}
+	}
+	kind, proof := chain.Deny("c.example.", dns.TypeDS)
+	r.Equal(NoData, kind)
+	r.Len(proof, 1)
+	r.True(proof[0].(*dns.NSEC3).Match("c.example."))
+
+	_, err = NewChain3("example.", nil, 3600, Params{Salt: "xyz"})
+	r.NotNil(err)
+}
+
+func TestChain(t *testing.T) {
+	r := assert.New(t)
+	chain, err := NewChain("example.", parseZone(t, rfc5155Zone), 3600)
+	r.NoError(err)
+
+	// Canonical order; names below the delegations are occluded and the
+	// empty non-terminals w and y.w have no NSEC.
+	rrs := chain.Records()
+	var names []string
+	for _, rr := range rrs {
+		names = append(names, rr.Header().Name)
+	}
+	r.DeepEqual([]string{
+		"example.",
+		"2t7b4g4vsa5smi47k61mv5bv1a22bojr.example.",
+		"a.example.",
+		"ai.example.",
+		"c.example.",
+		"ns1.example.",
+		"ns2.example.",
+		"*.w.example.",
+		"x.w.example.",
+		"x.y.w.example.",
+		"xx.example.",
+	}, names)
+	r.Equal("example.\t3600\tIN\tNSEC\t2t7b4g4vsa5smi47k61mv5bv1a22bojr.example. NS SOA MX RRSIG NSEC", rrs[0].String())
+	r.Equal("c.example.\t3600\tIN\tNSEC\tns1.example. NS RRSIG NSEC", rrs[4].String())
+	r.Equal("xx.example.\t3600\tIN\tNSEC\texample. A AAAA RRSIG NSEC", rrs[10].String())
+
+	tests := []struct {
+		qname  string
+		qtype  dns.Type
+		kind   Kind
+		proofs []string
+	}{
+		{"ab.example.", dns.TypeA, NXDomain, []string{"a.example.", "example."}},
+		{"zz.example.", dns.TypeA, NXDomain, []string{"xx.example.", "example."}},
+		{"ns1.example.", dns.TypeMX, NoData, []string{"ns1.example."}},
+		{"y.w.example.", dns.TypeA, NoData, []string{"x.w.example."}},
+		{"c.example.", dns.TypeDS, NoData, []string{"c.example."}},
+		{"a.z.w.example.", dns.TypeMX, Wildcard, []string{"x.y.w.example."}},
+		{"a.z.w.example.", dns.TypeA, WildcardNoData, []string{"x.y.w.example.", "*.w.example."}},
+		{"a.c.x.w.example.", dns.TypeA, NXDomain, []string{"x.w.example."}},
+		{"xx.example.", dns.TypeAAAA, None, nil},
+		{"ns1.a.example.", dns.TypeA, None, nil},
+	}
+	for _, tt := range tests {
+		kind, proof := chain.Deny(tt.qname, tt.qtype)
+		r.Equal(tt.kind, kind, tt.qname)
+		var got []string
+		for _, rr := range proof {
+			got = append(got, rr.Header().Name)
+		}
+		if kind == NXDomain || kind == Wildcard {
+			r.True(proof[0].(*dns.NSEC).Cover(tt.qname), tt.qname)
+		}
+		r.DeepEqual(tt.proofs, got, tt.qname)
+	}
+
+	_, err = NewChain("example.", parseZone(t, "example.org. IN A 192.0.2.1"), 3600)
+	r.ErrorIs(err, ErrOutOfZone)
+}
+
+func TestKindString(t *testing.T) {
+	r := assert.New(t)
+	r.Equal("NXDomain", NXDomain.String())
+	r.Equal("Kind(9)", Kind(9).String())
+}
@@ -0,0 +1,206 @@
+package nsec
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/dnsoa/go/dns"
+)
+
+var (
+	// ErrOutOfZone is returned for a record whose owner is not in the zone.
+	ErrOutOfZone = errors.New("nsec: record outside of zone")
+	// ErrHashCollision is returned when two names of a zone have the same
+	// NSEC3 hash; another salt avoids it.
+	ErrHashCollision = errors.New("nsec: NSEC3 hash collision")
+)
+
+// node is an owner name of the zone and the types it holds.
+type node struct {
+	name  string // fully qualified, lowercase
+	types []dns.Type
+	deleg bool // delegation point, holding NS below the apex
+}
+
+func (n *node) has(t dns.Type) bool {
+	return slices.Contains(n.types, t)
+}
+
+// zone holds the authoritative names of a zone; names occluded by a
+// delegation are left out.
+type zone struct {
+	origin string
+	nodes  map[string]*node
+	ents   map[string]bool // empty non-terminals
+}
+
+func newZone(origin string, rrs []dns.RR) (*zone, error) {
+	z := &zone{
+		origin: strings.ToLower(dns.Fqdn(origin)),
+		nodes:  make(map[string]*node),
+		ents:   make(map[string]bool),
+	}
+	for _, rr := range rrs {
+		h := rr.Header()
+		switch h.Rrtype {
+		case dns.TypeNSEC, dns.TypeNSEC3, dns.TypeNSEC3PARAM:
+			// Replaced by the chain.
+			continue
+		}
+		if !dns.IsSubDomain(z.origin, h.Name) {
+			return nil, fmt.Errorf("%w: %s", ErrOutOfZone, h.Name)
+		}
+		name := strings.ToLower(dns.Fqdn(h.Name))
+		n := z.nodes[name]
+		if n == nil {
+			n = &node{name: name}
+			z.nodes[name] = n
+		}
+		if !n.has(h.Rrtype) {
+			n.types = append(n.types, h.Rrtype)
+		}
+	}
+	if z.nodes[z.origin] == nil {
+		z.nodes[z.origin] = &node{name: z.origin}
+	}
+	for name, n := range z.nodes {
+		n.deleg = name != z.origin && n.has(dns.TypeNS)
+	}
+	for name := range z.nodes {
+		if z.delegation(parent(name)) != "" {
+			delete(z.nodes, name)
+		}
+	}
+	for name := range z.nodes {
+		for a := range z.ancestors(name) {
+			if z.nodes[a] == nil {
+				z.ents[a] = true
+			}
+		}
+	}
+	return z, nil
+}
+
+// ancestors yields the names between name and the apex, both excluded.
+func (z *zone) ancestors(name string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if name == z.origin {
+			return
+		}
+		for a := parent(name); a != z.origin && a != ""; a = parent(a) {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// delegation returns the delegation point at or above name, or "".
+func (z *zone) delegation(name string) string {
+	for a := name; a != z.origin && a != ""; a = parent(a) {
+		if n := z.nodes[a]; n != nil && n.deleg {
+			return a
+		}
+	}
+	return ""
+}
+
+func (z *zone) exists(name string) bool {
+	return z.nodes[name] != nil || z.ents[name]
+}
+
+// lookup tells which denial answers qname and qtype, and returns the closest
+// encloser of qname for the kinds that need one.
+func (z *zone) lookup(qname string, qtype dns.Type) (kind Kind, name, ce string) {
+	name = strings.ToLower(dns.Fqdn(qname))
+	if !dns.IsSubDomain(z.origin, name) {
+		return None, name, ""
+	}
+	if d := z.delegation(name); d != "" && (d != name || qtype != dns.TypeDS) {
+		// A referral.
+		return None, name, ""
+	}
+	if z.exists(name) {
+		if n := z.nodes[name]; n != nil && (n.has(qtype) || n.has(dns.TypeCNAME)) {
+			return None, name, ""
+		}
+		return NoData, name, ""
+	}
+	ce = parent(name)
+	for ce != z.origin && !z.exists(ce) {
+		ce = parent(ce)
+	}
+	if w := z.nodes[wildcard(ce)]; w != nil {
+		if w.has(qtype) || w.has(dns.TypeCNAME) {
+			return Wildcard, name, ce
+		}
+		return WildcardNoData, name, ce
+	}
+	return NXDomain, name, ce
+}
+
+// parent strips the first label of a fully qualified name; the parent of the
+// root is "".
+func parent(name string) string {
+	if name == "." || name == "" {
+		return ""
+	}
+	i := strings.IndexByte(name, '.')
+	if i == len(name)-1 {
+		return "."
+	}
+	return name[i+1:]
+}
+
+// wildcard returns the wildcard name directly below ce.
+func wildcard(ce string) string {
+	if ce == "." {
+		return "*."
+	}
+	return "*." + ce
+}
+
+// nextCloser returns the name one label longer than ce on the way to name.
+func nextCloser(name, ce string) string {
+	for p := parent(name); p != ce && p != ""; p = parent(p) {
+		name = p
+	}
+	return name
+}
+
+// sortedNames returns the keys of nodes in canonical order; names that do
+// not pack sort first.
+func sortedNames(nodes map[string]*node) []string {
+	keys := make(map[string]dns.NameKey, len(nodes))
+	for name := range nodes {
+		keys[name], _ = dns.NameKeyFromString(name)
+	}
+	names := slices.Collect(maps.Keys(nodes))
+	slices.SortFunc(names, func(a, b string) int {
+		ka, kb := keys[a], keys[b]
+		return ka.Compare(&kb)
+	})
+	return names
+}
+
+// bitmap returns types sorted, with extra added.
+func bitmap(types []dns.Type, extra ...dns.Type) []dns.Type {
+	out := append(slices.Clone(types), extra...)
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// appendUnique appends the records of rrs to dst that dst does not hold yet,
+// skipping nil.
+func appendUnique(dst []dns.RR, rrs ...dns.RR) []dns.RR {
+	for _, rr := range rrs {
+		if rr != nil && !slices.Contains(dst, rr) {
+			dst = append(dst, rr)
+		}
+	}
+	return dst
+}
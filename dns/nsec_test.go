@@ -0,0 +1,159 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+)
+
+func TestHashName(t *testing.T) {
+	r := assert.New(t)
+
+	// RFC 5155, Appendix A: salt aabbccdd, 12 iterations.
+	for name, want := range map[string]string{
+		"example.":       "0p9mhaveqvm6t7vbl5lop2u3t2rp3tom",
+		"a.example.":     "35mthgpgcu1qg68fab165klnsnk3dpvl",
+		"ns1.example.":   "2t7b4g4vsa5smi47k61mv5bv1a22bojr",
+		"*.w.example.":   "r53bq7cc2uvmubfu5ocmm6pers9tk9en",
+		"x.y.w.example.": "2vptu5timamqttgl4luu9kg21e0aor3s",
+		"X.Y.W.Example":  "2vptu5timamqttgl4luu9kg21e0aor3s",
+	} {
+		r.Equal(want, HashName(name, SHA1, 12, "aabbccdd"), name)
+	}
+	r.Equal("", HashName("example.", 2, 12, "aabbccdd"))
+	r.Equal("", HashName("example.", SHA1, 12, "xyz"))
+	r.Equal(HashName("example.", SHA1, 0, ""), HashName("example.", SHA1, 0, "-"))
+}
+
+func TestNSECPackUnpack(t *testing.T) {
+	r := assert.New(t)
+
+	rr := &NSEC{
+		Hdr:        RR_Header{Name: "alfa.example.com.", Rrtype: TypeNSEC, Class: ClassINET, Ttl: 86400},
+		NextDomain: "host.example.com.",
+		TypeBitMap: []Type{TypeA, TypeMX, TypeRRSIG, TypeNSEC, Type(1234)},
+	}
+	msg := make([]byte, 512)
+	off, err := packRR(rr, msg, 0, nil)
+	r.NoError(err)
+
+	got, off2, err := UnpackRR(msg[:off], 0)
+	r.NoError(err)
+	r.Equal(off, off2)
+	nsec, ok := got.(*NSEC)
+	r.True(ok)
+	r.Equal("host.example.com.", nsec.NextDomain)
+	r.DeepEqual([]Type{TypeA, TypeMX, TypeRRSIG, TypeNSEC, Type(1234)}, nsec.TypeBitMap)
+	r.Equal("alfa.example.com.\t86400\tIN\tNSEC\thost.example.com. A MX RRSIG NSEC TYPE1234", nsec.String())
+
+	// RFC 4034, section 4.3: the bitmap of A MX RRSIG NSEC TYPE1234.
+	bitmap := []byte{
+		0x00, 0x06, 0x40, 0x01, 0x00, 0x00, 0x00, 0x03,
+		0x04, 0x1b, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x20,
+	}
+	r.DeepEqual(bitmap, msg[off-len(bitmap):off])
+}
+
+func TestNSECBadBitmap(t *testing.T) {
+	r := assert.New(t)
+
+	for _, b := range [][]byte{
+		{0x00},                               // truncated window
+		{0x00, 0x00},                         // empty window
+		{0x00, 0x21},                         // window too long
+		{0x00, 0x01, 0x40, 0x00, 0x01},       // truncated bitmap
+		{0x01, 0x01, 0x40, 0x00, 0x01, 0x40}, // windows out of order
+	} {
+		_, _, err := unpackTypeBitMap(b, 0, len(b))
+		r.NotNil(err, b)
+	}
+}
+
+func TestNSEC3PackUnpack(t *testing.T) {
+	r := assert.New(t)
+
+	rr, err := NewRR("0p9mhaveqvm6t7vbl5lop2u3t2rp3tom.example. 3600 IN NSEC3 1 1 12 aabbccdd 2t7b4g4vsa5smi47k61mv5bv1a22bojr MX DNSKEY NS SOA NSEC3PARAM RRSIG")
+	r.NoError(err)
+	nsec3, ok := rr.(*NSEC3)
+	r.True(ok)
+	r.True(nsec3.OptOut())
+	r.Equal(uint8(4), nsec3.SaltLength)
+	r.Equal(uint8(20), nsec3.HashLength)
+	r.Equal("0p9mhaveqvm6t7vbl5lop2u3t2rp3tom.example.\t3600\tIN\tNSEC3\t1 1 12 AABBCCDD 2t7b4g4vsa5smi47k61mv5bv1a22bojr NS SOA MX RRSIG DNSKEY NSEC3PARAM", nsec3.String())
+
+	msg := make([]byte, 512)
+	off, err := packRR(nsec3, msg, 0, nil)
+	r.NoError(err)
+	got, _, err := UnpackRR(msg[:off], 0)
+	r.NoError(err)
+	r.Equal(nsec3.String(), got.String())
+
+	param, err := NewRR("example. 0 IN NSEC3PARAM 1 0 0 -")
+	r.NoError(err)
+	r.Equal("example.\t0\tIN\tNSEC3PARAM\t1 0 0 -", param.String())
+	off, err = packRR(param, msg, 0, nil)
+	r.NoError(err)
+	got, _, err = UnpackRR(msg[:off], 0)
+	r.NoError(err)
+	r.Equal(param.String(), got.String())
+
+	for _, s := range []string{
+		"example. IN NSEC3 1 0 0 -",
+		"example. IN NSEC3 1 0 0 zz 2t7b4g4vsa5smi47k61mv5bv1a22bojr",
+		"example. IN NSEC3 1 0 0 - !!",
+		"example. IN NSEC3PARAM 1 0 70000 -",
+	} {
+		_, err := NewRR(s)
+		r.NotNil(err, s)
+	}
+}
+
+func TestNSECCover(t *testing.T) {
+	r := assert.New(t)
+
+	rr, err := NewRR("b.example. IN NSEC d.example. A RRSIG NSEC")
+	r.NoError(err)
+	nsec := rr.(*NSEC)
+	r.True(nsec.Match("B.example"))
+	r.True(nsec.Cover("c.example."))
+	r.True(nsec.Cover("a.b.example."))
+	r.False(nsec.Cover("b.example."))
+	r.False(nsec.Cover("d.example."))
+	r.False(nsec.Cover("e.example."))
+
+	// The last NSEC of the zone points back to the apex.
+	rr, err = NewRR("z.example. IN NSEC example. A RRSIG NSEC")
+	r.NoError(err)
+	last := rr.(*NSEC)
+	r.True(last.Cover("zz.example."))
+	r.True(last.Cover("a.z.example."))
+	r.False(last.Cover("y.example."))
+}
+
+func TestNSEC3Cover(t *testing.T) {
+	r := assert.New(t)
+
+	// RFC 5155, Appendix B.1: the next closer name c.x.w.example and the
+	// wildcard *.x.w.example of a.c.x.w.example do not exist.
+	rr, err := NewRR("0p9mhaveqvm6t7vbl5lop2u3t2rp3tom.example. IN NSEC3 1 1 12 aabbccdd 2t7b4g4vsa5smi47k61mv5bv1a22bojr MX DNSKEY NS SOA NSEC3PARAM RRSIG")
+	r.NoError(err)
+	nsec3 := rr.(*NSEC3)
+	r.True(nsec3.Match("example."))
+	r.False(nsec3.Match("a.example."))
+	r.True(nsec3.Cover("c.x.w.example."))
+	r.False(nsec3.Cover("a.c.x.w.example."))
+	r.False(nsec3.Cover("example."))
+	r.False(nsec3.Cover("a.c.x.w.other."))
+
+	rr, err = NewRR("35mthgpgcu1qg68fab165klnsnk3dpvl.example. IN NSEC3 1 1 12 aabbccdd b4um86eghhds6nea196smvmlo4ors995 NS DS RRSIG")
+	r.NoError(err)
+	r.True(rr.(*NSEC3).Cover("*.x.w.example."))
+
+	// The last NSEC3 wraps around to the first hash.
+	rr, err = NewRR("t644ebqk9bibcna874givr6joj62mlhv.example. IN NSEC3 1 1 12 aabbccdd 0p9mhaveqvm6t7vbl5lop2u3t2rp3tom A HINFO AAAA RRSIG")
+	r.NoError(err)
+	r.True(rr.(*NSEC3).Cover("a.c.x.w.example."))
+}
@@ -87,6 +87,18 @@ type OPT struct {
 }
 
 func (rr *OPT) Header() *RR_Header { return &rr.Hdr }
+
+// Do reports whether the DNSSEC OK flag is set (RFC 3225).
+func (rr *OPT) Do() bool { return rr.Hdr.Ttl&_DO != 0 }
+
+// SetDo sets or clears the DNSSEC OK flag.
+func (rr *OPT) SetDo(do bool) {
+	if do {
+		rr.Hdr.Ttl |= _DO
+	} else {
+		rr.Hdr.Ttl &^= _DO
+	}
+}
 func (r *OPT) String() string {
 	s := r.Hdr.String() + "\n"
 	for _, o := range r.Options {
@@ -95,7 +107,15 @@ func (r *OPT) String() string {
 	return s
 }
 func (r *OPT) pack(msg []byte, off int) (off1 int, err error) {
-	// OPT has no RDATA to pack.
+	for _, o := range r.Options {
+		if off+4+len(o.Data) > len(msg) {
+			return len(msg), ErrBuf
+		}
+		binary.BigEndian.PutUint16(msg[off:], uint16(o.Code))
+		binary.BigEndian.PutUint16(msg[off+2:], uint16(len(o.Data)))
+		off += 4
+		off += copy(msg[off:], o.Data)
+	}
 	return off, nil
 }
 
@@ -168,6 +188,18 @@ func (r *OPT) AddOption(code OptionCode, data []byte) {
 	r.Hdr.Rdlength += 4 + uint16(len(data))
 }
 
+// SetOption sets an option, replacing any existing option with the same code.
+func (r *OPT) SetOption(code OptionCode, data []byte) {
+	for i, o := range r.Options {
+		if o.Code == code {
+			r.Hdr.Rdlength += uint16(len(data)) - o.Length
+			r.Options[i] = Option{Code: code, Length: uint16(len(data)), Data: data}
+			return
+		}
+	}
+	r.AddOption(code, data)
+}
+
 type Option struct {
 	Data   []byte
 	Code   OptionCode
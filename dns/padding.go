@@ -0,0 +1,48 @@
+package dns
+
+// Block lengths recommended by RFC 8467, section 4.1, for padding messages
+// sent over encrypted transports such as DoT and DoH.
+const (
+	QueryPaddingBlock    = 128
+	ResponsePaddingBlock = 468
+)
+
+// zeroPadding backs the data of padding options, which is never modified.
+var zeroPadding [ResponsePaddingBlock]byte
+
+// paddingLen returns how many padding octets bring a message of n octets,
+// padding option header included, to a multiple of block.
+func paddingLen(n, block int) int {
+	if block <= 0 {
+		return 0
+	}
+	return (block - n%block) % block
+}
+
+// setPadding replaces any padding option of the OPT record with one of n
+// zero octets.
+func (r *OPT) setPadding(n int) {
+	opts := r.Options[:0]
+	for _, o := range r.Options {
+		if o.Code == OptionCodePadding {
+			r.Hdr.Rdlength -= 4 + o.Length
+			continue
+		}
+		opts = append(opts, o)
+	}
+	r.Options = opts
+	data := zeroPadding[:min(n, len(zeroPadding)):min(n, len(zeroPadding))]
+	if n > len(zeroPadding) {
+		data = make([]byte, n)
+	}
+	r.AddOption(OptionCodePadding, data)
+}
+
+// rdlen returns the length of the options of the OPT record on the wire.
+func (r *OPT) rdlen() int {
+	n := 0
+	for _, o := range r.Options {
+		n += 4 + len(o.Data)
+	}
+	return n
+}
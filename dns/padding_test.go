@@ -0,0 +1,77 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+)
+
+func TestRequestPadding(t *testing.T) {
+	r := assert.New(t)
+	for _, name := range []string{"a.example", "www.example.com", "a-rather-long-label-for-padding.example.org"} {
+		req := new(Request)
+		req.SetEDNS0(1232, false)
+		req.SetEDNS0Cookie(make([]byte, 8))
+		req.PaddingBlock = QueryPaddingBlock
+		req.SetQuestion(name, TypeAAAA, ClassINET)
+		r.Equal(0, len(req.Raw)%QueryPaddingBlock, name)
+
+		// Packing again replaces the padding instead of adding to it.
+		req.SetQuestion(name, TypeA, ClassINET)
+		r.Equal(QueryPaddingBlock, len(req.Raw), name)
+
+		parsed := new(Request)
+		r.NoError(parsed.Unpack(req.Raw))
+		padding := 0
+		for _, o := range parsed.OPT.Options {
+			if o.Code == OptionCodePadding {
+				padding++
+				r.DeepEqual(make([]byte, o.Length), o.Data)
+			}
+		}
+		r.Equal(1, padding)
+	}
+
+	// Without EDNS0 there is nothing to pad.
+	req := new(Request)
+	req.PaddingBlock = QueryPaddingBlock
+	req.SetQuestion("example.com", TypeA, ClassINET)
+	r.Equal(29, len(req.Raw))
+}
+
+func TestResponsePadding(t *testing.T) {
+	r := assert.New(t)
+	resp := newPrintResponse()
+	resp.PaddingBlock = ResponsePaddingBlock
+	b, err := resp.PackTo(nil)
+	r.NoError(err)
+	r.Equal(ResponsePaddingBlock, len(b))
+
+	// Still one block-aligned padding option after changes and repacking.
+	for range 20 {
+		resp.Answer = append(resp.Answer, &TXT{
+			Hdr: RR_Header{Name: "example.com.", Rrtype: TypeTXT, Class: ClassINET, Ttl: 60},
+			TXT: []string{"some text to grow the message"},
+		})
+	}
+	resp.Header.Ancount = uint16(len(resp.Answer))
+	b, err = resp.PackTo(nil)
+	r.NoError(err)
+	r.Equal(0, len(b)%ResponsePaddingBlock)
+	parsed := new(Response)
+	r.NoError(parsed.Unpack(b))
+	opt := parsed.opt()
+	r.NotNil(opt)
+	r.Equal(2, len(opt.Options))
+	r.Equal(OptionCodeNSID, opt.Options[0].Code)
+	r.Equal(OptionCodePadding, opt.Options[1].Code)
+
+	// Responses without OPT are not padded.
+	resp = newPrintResponse()
+	resp.Extra = resp.Extra[:0]
+	resp.Header.Arcount = 0
+	resp.PaddingBlock = ResponsePaddingBlock
+	b, err = resp.PackTo(nil)
+	r.NoError(err)
+	r.True(len(b) < ResponsePaddingBlock)
+}
@@ -0,0 +1,339 @@
+package dns
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// String returns the response in the format printed by dig.
+func (r *Response) String() string {
+	if r == nil {
+		return "<nil> Response"
+	}
+	var b strings.Builder
+	opt := r.opt()
+	writeDigHeader(&b, &r.Header, opt)
+	if opt != nil {
+		writeDigOPT(&b, opt)
+	}
+	if r.Header.Qdcount > 0 || len(r.Question.Name) > 0 {
+		b.WriteString(";; QUESTION SECTION:\n")
+		writeDigQuestion(&b, Fqdn(sprintName(string(r.Question.Name))), r.Question)
+	}
+	writeDigSection(&b, "ANSWER", r.Answer)
+	writeDigSection(&b, "AUTHORITY", r.Ns)
+	writeDigSection(&b, "ADDITIONAL", r.Extra)
+	return b.String()
+}
+
+// String returns the request in the format printed by dig +qr.
+func (r *Request) String() string {
+	if r == nil {
+		return "<nil> Request"
+	}
+	var b strings.Builder
+	var opt *OPT
+	if r.OPT.Hdr.Rrtype == TypeOPT {
+		opt = &r.OPT
+	}
+	writeDigHeader(&b, &r.Header, opt)
+	if opt != nil {
+		writeDigOPT(&b, opt)
+	}
+	b.WriteString(";; QUESTION SECTION:\n")
+	writeDigQuestion(&b, Fqdn(sprintName(string(r.Domain))), r.Question)
+	return b.String()
+}
+
+// opt returns the OPT pseudo record of the additional section, if any.
+func (r *Response) opt() *OPT {
+	for _, rr := range r.Extra {
+		if opt, ok := rr.(*OPT); ok {
+			return opt
+		}
+	}
+	return nil
+}
+
+// extendedRcode combines the header RCODE with the upper bits carried in OPT.
+func extendedRcode(h *Header, opt *OPT) Rcode {
+	rcode := h.Rcode()
+	if opt != nil {
+		rcode |= Rcode(opt.Hdr.Ttl>>24) << 4
+	}
+	return rcode
+}
+
+func writeDigHeader(b *strings.Builder, h *Header, opt *OPT) {
+	opcode, ok := OpcodeToString[h.OpCode()]
+	if !ok {
+		opcode = "OPCODE" + strconv.Itoa(int(h.OpCode()))
+	}
+	rcode := extendedRcode(h, opt)
+	status, ok := RcodeToString[rcode]
+	if !ok {
+		status = "RCODE" + strconv.Itoa(int(rcode))
+	}
+	b.WriteString(";; ->>HEADER<<- opcode: " + opcode + ", status: " + status + ", id: " + strconv.Itoa(int(h.ID)) + "\n")
+	b.WriteString(";; flags:")
+	for _, f := range []struct {
+		set  bool
+		name string
+	}{
+		{h.Response(), " qr"},
+		{h.Authoritative(), " aa"},
+		{h.Truncated(), " tc"},
+		{h.RecursionDesired(), " rd"},
+		{h.RecursionAvailable(), " ra"},
+		{h.Zero(), " z"},
+		{h.AuthenticatedData(), " ad"},
+		{h.CheckingDisabled(), " cd"},
+	} {
+		if f.set {
+			b.WriteString(f.name)
+		}
+	}
+	b.WriteString("; QUERY: " + strconv.Itoa(int(h.Qdcount)))
+	b.WriteString(", ANSWER: " + strconv.Itoa(int(h.Ancount)))
+	b.WriteString(", AUTHORITY: " + strconv.Itoa(int(h.Nscount)))
+	b.WriteString(", ADDITIONAL: " + strconv.Itoa(int(h.Arcount)) + "\n\n")
+}
+
+func writeDigOPT(b *strings.Builder, opt *OPT) {
+	b.WriteString(";; OPT PSEUDOSECTION:\n")
+	b.WriteString("; EDNS: version: " + strconv.Itoa(int(opt.Hdr.Ttl>>16&0xFF)) + ", flags:")
+	if opt.Hdr.Ttl&_DO != 0 {
+		b.WriteString(" do")
+	}
+	b.WriteString("; udp: " + strconv.Itoa(int(opt.Hdr.Class)) + "\n")
+	for _, o := range opt.Options {
+		b.WriteString("; " + digOption(o) + "\n")
+	}
+}
+
+// digOption renders an EDNS0 option the way dig does.
+func digOption(o Option) string {
+	switch o.Code {
+	case OptionCodeNSID:
+		return "NSID: " + hex.EncodeToString(o.Data) + ` ("` + printableASCII(o.Data) + `")`
+	case OptionCodeCookie:
+		return "COOKIE: " + hex.EncodeToString(o.Data)
+	case OptionCodeEDNSClientSubnet:
+		if s, ok := clientSubnetString(o.Data); ok {
+			return "CLIENT-SUBNET: " + s
+		}
+	case OptionCodeEDNSExpire:
+		if len(o.Data) == 4 {
+			return "EXPIRE: " + strconv.FormatUint(uint64(binary.BigEndian.Uint32(o.Data)), 10)
+		}
+	case OptionCodeEDNSKeepAlive:
+		if len(o.Data) == 2 {
+			v := binary.BigEndian.Uint16(o.Data)
+			return "TCP-KEEPALIVE: " + strconv.FormatFloat(float64(v)/10, 'f', 1, 64) + " secs"
+		}
+	case OptionCodePadding:
+		return "PADDING: " + strconv.Itoa(len(o.Data)) + " bytes"
+	}
+	return "OPT=" + strconv.Itoa(int(o.Code)) + ": " + hex.EncodeToString(o.Data)
+}
+
+// clientSubnetString formats an EDNS Client Subnet option as address/source/scope.
+func clientSubnetString(data []byte) (string, bool) {
+	if len(data) < 4 {
+		return "", false
+	}
+	family := binary.BigEndian.Uint16(data)
+	source, scope := data[2], data[3]
+	var addr netip.Addr
+	switch family {
+	case 1:
+		var ip [4]byte
+		if len(data)-4 > len(ip) {
+			return "", false
+		}
+		copy(ip[:], data[4:])
+		addr = netip.AddrFrom4(ip)
+	case 2:
+		var ip [16]byte
+		if len(data)-4 > len(ip) {
+			return "", false
+		}
+		copy(ip[:], data[4:])
+		addr = netip.AddrFrom16(ip)
+	default:
+		return "", false
+	}
+	return addr.String() + "/" + strconv.Itoa(int(source)) + "/" + strconv.Itoa(int(scope)), true
+}
+
+func printableASCII(b []byte) string {
+	s := make([]byte, len(b))
+	for i, c := range b {
+		if c < ' ' || c > '~' {
+			c = '.'
+		}
+		s[i] = c
+	}
+	return string(s)
+}
+
+func writeDigQuestion(b *strings.Builder, name string, q Question) {
+	b.WriteString(";" + name + "\t\t\t" + q.Class.String() + "\t" + q.Type.String() + "\n")
+}
+
+func writeDigSection(b *strings.Builder, name string, rrs []RR) {
+	n := 0
+	for _, rr := range rrs {
+		if rr != nil && rr.Header().Rrtype != TypeOPT {
+			n++
+		}
+	}
+	if n == 0 {
+		return
+	}
+	b.WriteString("\n;; " + name + " SECTION:\n")
+	for _, rr := range rrs {
+		if rr == nil || rr.Header().Rrtype == TypeOPT {
+			continue
+		}
+		b.WriteString(rr.String() + "\n")
+	}
+}
+
+// jsonMessage is the RFC 8427 representation of a DNS message.
+type jsonMessage struct {
+	ID            uint16   `json:"ID"`
+	QR            int      `json:"QR"`
+	Opcode        int      `json:"Opcode"`
+	AA            int      `json:"AA"`
+	TC            int      `json:"TC"`
+	RD            int      `json:"RD"`
+	RA            int      `json:"RA"`
+	AD            int      `json:"AD"`
+	CD            int      `json:"CD"`
+	RCODE         int      `json:"RCODE"`
+	QDCOUNT       uint16   `json:"QDCOUNT"`
+	ANCOUNT       uint16   `json:"ANCOUNT"`
+	NSCOUNT       uint16   `json:"NSCOUNT"`
+	ARCOUNT       uint16   `json:"ARCOUNT"`
+	QNAME         string   `json:"QNAME,omitempty"`
+	QTYPE         uint16   `json:"QTYPE,omitempty"`
+	QTYPEname     string   `json:"QTYPEname,omitempty"`
+	QCLASS        uint16   `json:"QCLASS,omitempty"`
+	QCLASSname    string   `json:"QCLASSname,omitempty"`
+	AnswerRRs     []jsonRR `json:"answerRRs,omitempty"`
+	AuthorityRRs  []jsonRR `json:"authorityRRs,omitempty"`
+	AdditionalRRs []jsonRR `json:"additionalRRs,omitempty"`
+}
+
+// jsonRR is the RFC 8427 representation of a resource record.
+type jsonRR struct {
+	rr RR
+}
+
+func newJSONMessage(h *Header, name string, q Question) jsonMessage {
+	bit := func(b bool) int {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	m := jsonMessage{
+		ID:      h.ID,
+		QR:      bit(h.Response()),
+		Opcode:  int(h.OpCode()),
+		AA:      bit(h.Authoritative()),
+		TC:      bit(h.Truncated()),
+		RD:      bit(h.RecursionDesired()),
+		RA:      bit(h.RecursionAvailable()),
+		AD:      bit(h.AuthenticatedData()),
+		CD:      bit(h.CheckingDisabled()),
+		RCODE:   int(h.Rcode()),
+		QDCOUNT: h.Qdcount,
+		ANCOUNT: h.Ancount,
+		NSCOUNT: h.Nscount,
+		ARCOUNT: h.Arcount,
+	}
+	if name != "" {
+		m.QNAME = Fqdn(name)
+		m.QTYPE = uint16(q.Type)
+		m.QTYPEname = q.Type.String()
+		m.QCLASS = uint16(q.Class)
+		m.QCLASSname = q.Class.String()
+	}
+	return m
+}
+
+func jsonRRs(rrs []RR) []jsonRR {
+	out := make([]jsonRR, 0, len(rrs))
+	for _, rr := range rrs {
+		if rr != nil {
+			out = append(out, jsonRR{rr: rr})
+		}
+	}
+	return out
+}
+
+// MarshalJSON encodes the response as described in RFC 8427.
+func (r *Response) MarshalJSON() ([]byte, error) {
+	m := newJSONMessage(&r.Header, sprintName(string(r.Question.Name)), r.Question)
+	m.RCODE = int(extendedRcode(&r.Header, r.opt()))
+	m.AnswerRRs = jsonRRs(r.Answer)
+	m.AuthorityRRs = jsonRRs(r.Ns)
+	m.AdditionalRRs = jsonRRs(r.Extra)
+	return json.Marshal(m)
+}
+
+// MarshalJSON encodes the request as described in RFC 8427.
+func (r *Request) MarshalJSON() ([]byte, error) {
+	m := newJSONMessage(&r.Header, sprintName(string(r.Domain)), r.Question)
+	if r.OPT.Hdr.Rrtype == TypeOPT {
+		m.AdditionalRRs = []jsonRR{{rr: &r.OPT}}
+	}
+	return json.Marshal(m)
+}
+
+func (j jsonRR) MarshalJSON() ([]byte, error) {
+	h := j.rr.Header()
+	b := []byte(`{"NAME":`)
+	b = appendJSONString(b, Fqdn(sprintName(h.Name)))
+	b = append(b, `,"TYPE":`...)
+	b = strconv.AppendUint(b, uint64(h.Rrtype), 10)
+	if name := h.Rrtype.String(); name != "" {
+		b = append(b, `,"TYPEname":`...)
+		b = appendJSONString(b, name)
+	}
+	b = append(b, `,"CLASS":`...)
+	b = strconv.AppendUint(b, uint64(h.Class), 10)
+	if name, ok := ClassToString[h.Class]; ok && h.Rrtype != TypeOPT {
+		b = append(b, `,"CLASSname":`...)
+		b = appendJSONString(b, name)
+	}
+	b = append(b, `,"TTL":`...)
+	b = strconv.AppendUint(b, uint64(h.Ttl), 10)
+
+	switch rr := j.rr.(type) {
+	case *OPT:
+		b = append(b, `,"RDATAHEX":`...)
+		b = appendJSONString(b, strings.ToUpper(hex.EncodeToString(rr.Pack())))
+	case *RFC3597:
+		b = append(b, `,"RDATAHEX":`...)
+		b = appendJSONString(b, strings.ToUpper(rr.Rdata))
+	default:
+		rdata := strings.TrimPrefix(rr.String(), h.String())
+		b = append(b, `,"rdata`...)
+		b = append(b, h.Rrtype.String()...)
+		b = append(b, `":`...)
+		b = appendJSONString(b, rdata)
+	}
+	return append(b, '}'), nil
+}
+
+func appendJSONString(b []byte, s string) []byte {
+	enc, _ := json.Marshal(s) // marshaling a string cannot fail
+	return append(b, enc...)
+}
@@ -0,0 +1,130 @@
+package dns
+
+import (
+	"encoding/json"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+)
+
+func newPrintResponse() *Response {
+	resp := new(Response)
+	resp.Header.ID = 20000
+	resp.Header.SetResponse()
+	resp.Header.SetRecursionDesired()
+	resp.Header.SetRecursionAvailable()
+	resp.Header.Qdcount = 1
+	resp.Header.Ancount = 2
+	resp.Header.Nscount = 1
+	resp.Header.Arcount = 1
+	resp.SetQuestion("example.com.", TypeA, ClassINET)
+	resp.Answer = append(resp.Answer,
+		&CNAME{Hdr: RR_Header{Name: "example.com.", Rrtype: TypeCNAME, Class: ClassINET, Ttl: 300}, CNAME: "www.example.com."},
+		&A{Hdr: RR_Header{Name: "www.example.com.", Rrtype: TypeA, Class: ClassINET, Ttl: 300}, A: [4]byte{192, 0, 2, 1}},
+	)
+	resp.Ns = append(resp.Ns,
+		&NS{Hdr: RR_Header{Name: "example.com.", Rrtype: TypeNS, Class: ClassINET, Ttl: 3600}, NS: "ns.example.com."},
+	)
+	opt := &OPT{Hdr: RR_Header{Name: ".", Rrtype: TypeOPT, Class: 1232, Ttl: _DO}}
+	opt.AddOption(OptionCodeNSID, []byte("ns1"))
+	resp.Extra = append(resp.Extra, opt)
+	return resp
+}
+
+func TestResponseString(t *testing.T) {
+	r := assert.New(t)
+	resp := newPrintResponse()
+
+	want := `;; ->>HEADER<<- opcode: QUERY, status: NOERROR, id: 20000
+;; flags: qr rd ra; QUERY: 1, ANSWER: 2, AUTHORITY: 1, ADDITIONAL: 1
+
+;; OPT PSEUDOSECTION:
+; EDNS: version: 0, flags: do; udp: 1232
+; NSID: 6e7331 ("ns1")
+;; QUESTION SECTION:
+;example.com.			IN	A
+
+;; ANSWER SECTION:
+example.com.	300	IN	CNAME	www.example.com.
+www.example.com.	300	IN	A	192.0.2.1
+
+;; AUTHORITY SECTION:
+example.com.	3600	IN	NS	ns.example.com.
+`
+	r.Equal(want, resp.String())
+
+	// Extended RCODE bits in OPT are folded into the status.
+	resp.Extra[0].Header().Ttl |= 1 << 24
+	resp.Header.SetRcode(RcodeSuccess)
+	r.Contains(resp.String(), "status: BADSIG")
+}
+
+func TestRequestString(t *testing.T) {
+	r := assert.New(t)
+	req := new(Request)
+	req.SetEDNS0(4096, false)
+	r.NoError(req.SetEDNS0ClientSubnet(netip.MustParsePrefix("192.0.2.0/24")))
+	req.SetQuestion("example.com", TypeAAAA, ClassINET)
+	req.Header.ID = 1
+
+	want := `;; ->>HEADER<<- opcode: QUERY, status: NOERROR, id: 1
+;; flags: rd ad; QUERY: 1, ANSWER: 0, AUTHORITY: 0, ADDITIONAL: 1
+
+;; OPT PSEUDOSECTION:
+; EDNS: version: 0, flags:; udp: 4096
+; CLIENT-SUBNET: 192.0.2.0/24/0
+;; QUESTION SECTION:
+;example.com.			IN	AAAA
+`
+	r.Equal(want, req.String())
+}
+
+func TestMessageJSON(t *testing.T) {
+	r := assert.New(t)
+	resp := newPrintResponse()
+	resp.Extra = append(resp.Extra, &AAAA{
+		Hdr:  RR_Header{Name: "ns.example.com.", Rrtype: TypeAAAA, Class: ClassINET, Ttl: 60},
+		AAAA: net.ParseIP("2001:db8::53"),
+	})
+
+	b, err := json.Marshal(resp)
+	r.NoError(err)
+
+	var m map[string]any
+	r.NoError(json.Unmarshal(b, &m))
+	r.Equal(float64(20000), m["ID"])
+	r.Equal(float64(1), m["QR"])
+	r.Equal(float64(1), m["RD"])
+	r.Equal(float64(0), m["AA"])
+	r.Equal("example.com.", m["QNAME"])
+	r.Equal("A", m["QTYPEname"])
+	r.Equal("IN", m["QCLASSname"])
+
+	answers := m["answerRRs"].([]any)
+	r.Equal(2, len(answers))
+	cname := answers[0].(map[string]any)
+	r.Equal("example.com.", cname["NAME"])
+	r.Equal(float64(5), cname["TYPE"])
+	r.Equal("www.example.com.", cname["rdataCNAME"])
+	a := answers[1].(map[string]any)
+	r.Equal("192.0.2.1", a["rdataA"])
+
+	extra := m["additionalRRs"].([]any)
+	r.Equal(2, len(extra))
+	opt := extra[0].(map[string]any)
+	r.Equal("OPT", opt["TYPEname"])
+	r.Equal("000300036E7331", opt["RDATAHEX"])
+	r.Equal("2001:db8::53", extra[1].(map[string]any)["rdataAAAA"])
+
+	req := new(Request)
+	req.SetQuestion("example.org", TypeMX, ClassINET)
+	b, err = json.Marshal(req)
+	r.NoError(err)
+	m = nil
+	r.NoError(json.Unmarshal(b, &m))
+	r.Equal("example.org.", m["QNAME"])
+	r.Equal(float64(15), m["QTYPE"])
+	r.Nil(m["additionalRRs"])
+}
@@ -0,0 +1,362 @@
+// Package recursive resolves names iteratively, following referrals from the
+// root servers down to the authoritative servers of a name.
+//
+// By default it minimises the query names it sends, see RFC 9156: a server
+// is asked only for the name one label, or a few labels, below its zone, to
+// find the next zone cut, and only the servers of the zone of the full name
+// see it and the query type.
+//
+//	r := &recursive.Resolver{Roots: roots}
+//	resp, err := r.Resolve(ctx, "www.example.com.", dns.TypeA)
+package recursive
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/dns/internal/client"
+)
+
+// Limits of query name minimisation, see RFC 9156, section 2.3. Every
+// resolution sends at most MaxMinimiseCount minimised queries; the first
+// MinimiseOneLab add one label each, later ones several at once.
+const (
+	MaxMinimiseCount = 10
+	MinimiseOneLab   = 4
+)
+
+// DefaultTimeout bounds a query to one server.
+const DefaultTimeout = 2 * time.Second
+
+const (
+	maxReferrals = 30
+	maxCNAMEs    = 8
+	// maxDepth bounds nested resolutions of name server addresses without
+	// glue.
+	maxDepth = 3
+)
+
+var (
+	// ErrNoServers is returned when no server of a zone answered.
+	ErrNoServers = errors.New("recursive: no server answered")
+	// ErrLoop is returned after too many referrals or CNAMEs.
+	ErrLoop = errors.New("recursive: too many referrals or CNAMEs")
+)
+
+// Minimisation selects how query names are minimised.
+type Minimisation uint8
+
+const (
+	// MinimiseRelaxed minimises query names, but sends the full name for
+	// the rest of a resolution once a minimised query gets NXDOMAIN or an
+	// error, as broken servers answer for empty non-terminals.
+	MinimiseRelaxed Minimisation = iota
+	// MinimiseStrict minimises query names and takes NXDOMAIN for a
+	// minimised name as NXDOMAIN for the full name, see RFC 8020.
+	MinimiseStrict
+	// MinimiseOff sends the full query name to every server.
+	MinimiseOff
+)
+
+func (m Minimisation) String() string {
+	switch m {
+	case MinimiseRelaxed:
+		return "relaxed"
+	case MinimiseStrict:
+		return "strict"
+	case MinimiseOff:
+		return "off"
+	}
+	return "unknown"
+}
+
+// Resolver is an iterative resolver. It keeps no cache between
+// resolutions.
+type Resolver struct {
+	// Roots holds the addresses of the root servers.
+	Roots []netip.Addr
+	// Minimisation selects how query names are minimised; relaxed by
+	// default.
+	Minimisation Minimisation
+	// MinimiseType is the type of minimised queries; TypeA when zero, see
+	// RFC 9156, section 2.1.
+	MinimiseType dns.Type
+	// Observer, if set, is told about every query sent.
+	Observer dns.Observer
+	// Timeout bounds a query to one server; DefaultTimeout when zero.
+	Timeout time.Duration
+
+	exchange func(ctx context.Context, req *dns.Request, addr string) (*dns.Response, error)
+}
+
+// zone is a zone cut found during a resolution and its servers.
+type zone struct {
+	name    string
+	servers []netip.Addr
+}
+
+// Resolve resolves name and qtype. It returns the response of the
+// authoritative servers, with the CNAMEs followed to get there in front of
+// the answer section.
+func (r *Resolver) Resolve(ctx context.Context, name string, qtype dns.Type) (*dns.Response, error) {
+	return r.resolve(ctx, strings.ToLower(dns.Fqdn(name)), qtype, 0)
+}
+
+func (r *Resolver) resolve(ctx context.Context, qname string, qtype dns.Type, depth int) (*dns.Response, error) {
+	var chain []dns.RR
+	for range maxCNAMEs {
+		resp, err := r.iterate(ctx, qname, qtype, depth)
+		if err != nil {
+			return nil, err
+		}
+		target, done := follow(resp.Answer, qname, qtype)
+		if done || resp.Rcode() != dns.RcodeSuccess {
+			resp.Answer = append(chain, resp.Answer...)
+			return resp, nil
+		}
+		chain = append(chain, resp.Answer...)
+		qname = target
+	}
+	return nil, ErrLoop
+}
+
+// follow follows the CNAMEs in answer from qname and reports whether the
+// answer is complete: it has qtype records at the end of the chain, or no
+// CNAME for qname at all. Otherwise it returns the name to resolve next.
+func follow(answer []dns.RR, qname string, qtype dns.Type) (string, bool) {
+	name := qname
+	for range maxCNAMEs {
+		next := ""
+		for _, rr := range answer {
+			h := rr.Header()
+			if !strings.EqualFold(h.Name, name) {
+				continue
+			}
+			if h.Rrtype == qtype || qtype == dns.TypeANY {
+				return "", true
+			}
+			if cname, ok := rr.(*dns.CNAME); ok {
+				next = strings.ToLower(cname.CNAME)
+			}
+		}
+		if next == "" {
+			return name, name == qname || qtype == dns.TypeCNAME
+		}
+		name = next
+	}
+	return name, true
+}
+
+// iterate follows the referrals from the root to the zone of qname and
+// returns the answer of its servers, see RFC 9156, section 3.
+func (r *Resolver) iterate(ctx context.Context, qname string, qtype dns.Type, depth int) (*dns.Response, error) {
+	z := zone{name: ".", servers: r.Roots}
+	// known is the longest ancestor of qname known to be in z, i.e. with
+	// no zone cut between them.
+	known := z.name
+	minimise := r.Minimisation != MinimiseOff
+	count := 0
+	for range maxReferrals {
+		name, typ := qname, qtype
+		if minimise {
+			if child := r.child(known, qname, count); child != qname {
+				name, typ = child, r.minimiseType()
+				count++
+			}
+		}
+		minimised := name != qname
+
+		resp, err := r.query(ctx, z.servers, name, typ)
+		if err != nil {
+			if minimised && r.Minimisation == MinimiseRelaxed {
+				minimise = false
+				continue
+			}
+			return nil, err
+		}
+		if cut, ok := referral(resp, z.name, name); ok {
+			if z, err = r.delegate(ctx, cut, z.name, resp, depth); err != nil {
+				return nil, err
+			}
+			known = z.name
+			continue
+		}
+		if !minimised {
+			return resp, nil
+		}
+		switch resp.Rcode() {
+		case dns.RcodeSuccess:
+			// No zone cut at name: go on below it with the same servers.
+			known = name
+		case dns.RcodeNameError:
+			if r.Minimisation == MinimiseStrict {
+				resp.SetQuestion(qname, qtype, dns.ClassINET)
+				return resp, nil
+			}
+			minimise = false
+		default:
+			if r.Minimisation == MinimiseStrict {
+				resp.SetQuestion(qname, qtype, dns.ClassINET)
+				return resp, nil
+			}
+			minimise = false
+		}
+	}
+	return nil, ErrLoop
+}
+
+func (r *Resolver) minimiseType() dns.Type {
+	if r.MinimiseType == 0 {
+		return dns.TypeA
+	}
+	return r.MinimiseType
+}
+
+// child returns the name to ask for below known on the way to qname after
+// count minimised queries: one label more while count is below
+// MinimiseOneLab, then as many as spread the rest over the remaining
+// queries, and qname itself when only one label is left to add.
+func (r *Resolver) child(known, qname string, count int) string {
+	have, want := labels(known), labels(qname)
+	if want-have <= 1 || count >= MaxMinimiseCount {
+		return qname
+	}
+	add := 1
+	if count >= MinimiseOneLab {
+		// The last label goes with the full query name.
+		rest, left := want-have-1, MaxMinimiseCount-count
+		add = (rest + left - 1) / left
+	}
+	if have+add >= want {
+		return qname
+	}
+	return ancestor(qname, have+add)
+}
+
+// labels returns the number of labels of a fully qualified name.
+func labels(name string) int {
+	if name == "." {
+		return 0
+	}
+	return strings.Count(name, ".")
+}
+
+// ancestor returns the ancestor of name with n labels.
+func ancestor(name string, n int) string {
+	i := len(name) - 1 // the trailing dot
+	for range n {
+		i = strings.LastIndexByte(name[:i], '.')
+		if i < 0 {
+			return name
+		}
+	}
+	return name[i+1:]
+}
+
+// referral returns the zone cut of a referral from the servers of parent
+// for name: a non-authoritative response without answers whose NS records
+// are for a zone below parent that contains name.
+func referral(resp *dns.Response, parent, name string) (string, bool) {
+	if resp.Rcode() != dns.RcodeSuccess || resp.Header.Authoritative() || len(resp.Answer) > 0 {
+		return "", false
+	}
+	for _, rr := range resp.Ns {
+		h := rr.Header()
+		cut := strings.ToLower(h.Name)
+		if h.Rrtype == dns.TypeNS && cut != parent && dns.IsSubDomain(parent, cut) && dns.IsSubDomain(cut, name) {
+			return cut, true
+		}
+	}
+	return "", false
+}
+
+// delegate returns the zone at cut with the servers named by the NS records
+// of a referral from parent. It uses the glue in the referral that parent is
+// authoritative for, and resolves the other server names.
+func (r *Resolver) delegate(ctx context.Context, cut, parent string, resp *dns.Response, depth int) (zone, error) {
+	z := zone{name: cut}
+	var names []string
+	for _, rr := range resp.Ns {
+		if ns, ok := rr.(*dns.NS); ok && strings.EqualFold(ns.Hdr.Name, cut) {
+			names = append(names, strings.ToLower(dns.Fqdn(ns.NS)))
+		}
+	}
+	for _, rr := range resp.Extra {
+		name := strings.ToLower(rr.Header().Name)
+		if !dns.IsSubDomain(parent, name) || !slices.Contains(names, name) {
+			continue
+		}
+		switch rr := rr.(type) {
+		case *dns.A:
+			z.servers = append(z.servers, netip.AddrFrom4(rr.A))
+		case *dns.AAAA:
+			if addr, ok := netip.AddrFromSlice(rr.AAAA); ok {
+				z.servers = append(z.servers, addr)
+			}
+		}
+	}
+	if len(z.servers) > 0 {
+		return z, nil
+	}
+	if depth >= maxDepth {
+		return z, ErrNoServers
+	}
+	for _, name := range names {
+		if dns.IsSubDomain(cut, name) {
+			// Needs glue, which was not there.
+			continue
+		}
+		resp, err := r.resolve(ctx, name, dns.TypeA, depth+1)
+		if err != nil {
+			continue
+		}
+		for _, rr := range resp.Answer {
+			if a, ok := rr.(*dns.A); ok {
+				z.servers = append(z.servers, netip.AddrFrom4(a.A))
+			}
+		}
+		if len(z.servers) > 0 {
+			return z, nil
+		}
+	}
+	return z, ErrNoServers
+}
+
+// query asks servers in turn for name and qtype until one answers other
+// than with SERVFAIL or REFUSED.
+func (r *Resolver) query(ctx context.Context, servers []netip.Addr, name string, qtype dns.Type) (*dns.Response, error) {
+	exchange := r.exchange
+	if exchange == nil {
+		c := &client.Client{Observer: r.Observer}
+		exchange = c.Exchange
+	}
+	timeout := r.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	for _, server := range servers {
+		req := new(dns.Request)
+		req.SetQuestion(name, qtype, dns.ClassINET)
+		req.SetRecursionDesired(false)
+		qctx, cancel := context.WithTimeout(ctx, timeout)
+		resp, err := exchange(qctx, req, netip.AddrPortFrom(server, 53).String())
+		cancel()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err != nil {
+			continue
+		}
+		switch resp.Rcode() {
+		case dns.RcodeServerFailure, dns.RcodeRefused:
+			continue
+		}
+		return resp, nil
+	}
+	return nil, ErrNoServers
+}
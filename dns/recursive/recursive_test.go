@@ -0,0 +1,190 @@
+package recursive
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/dns/dnstest"
+	"github.com/dnsoa/go/dns/internal/client"
+)
+
+const qname = "x.y.z.www.example.com."
+
+// hierarchy is a root, a com. and an example.com. server on the scripted
+// servers of dnstest, which listen on loopback ports; the resolver reaches
+// them at the addresses in their glue.
+type hierarchy struct {
+	root, com, example *dnstest.Server
+}
+
+func newHierarchy(t *testing.T) *hierarchy {
+	h := &hierarchy{root: dnstest.NewServer(t), com: dnstest.NewServer(t), example: dnstest.NewServer(t)}
+	toCom := dnstest.Reply{
+		Ns:    dnstest.RRs(t, "com. 172800 IN NS a.nic.com."),
+		Extra: dnstest.RRs(t, "a.nic.com. 172800 IN A 192.0.2.2"),
+	}
+	toExample := dnstest.Reply{
+		Ns:    dnstest.RRs(t, "example.com. 172800 IN NS ns1.example.com."),
+		Extra: dnstest.RRs(t, "ns1.example.com. 172800 IN A 192.0.2.3"),
+	}
+	for _, name := range []string{"com.", "example.com.", "www.example.com.", "alias.example.com.", qname} {
+		for _, qtype := range []dns.Type{dns.TypeA, dns.TypeAAAA} {
+			h.root.Handle(name, qtype, toCom)
+			if name != "com." {
+				h.com.Handle(name, qtype, toExample)
+			}
+		}
+	}
+	// The names between example.com. and qname are empty non-terminals.
+	nodata := dnstest.Reply{Authoritative: true, Ns: dnstest.RRs(t, "example.com. 3600 IN SOA ns1.example.com. host.example.com. 1 7200 3600 1209600 300")}
+	h.example.Handle("www.example.com.", dns.TypeA, nodata)
+	h.example.Handle("z.www.example.com.", dns.TypeA, nodata)
+	h.example.Handle("y.z.www.example.com.", dns.TypeA, nodata)
+	h.example.Handle(qname, dns.TypeAAAA, dnstest.Reply{Authoritative: true, Answer: dnstest.RRs(t, qname+" 300 IN AAAA 2001:db8::1")})
+	return h
+}
+
+func (h *hierarchy) resolver(m Minimisation) *Resolver {
+	addrs := map[string]string{
+		"192.0.2.1:53": h.root.Addr(),
+		"192.0.2.2:53": h.com.Addr(),
+		"192.0.2.3:53": h.example.Addr(),
+	}
+	c := &client.Client{Timeout: time.Second}
+	return &Resolver{
+		Roots:        []netip.Addr{netip.MustParseAddr("192.0.2.1")},
+		Minimisation: m,
+		exchange: func(ctx context.Context, req *dns.Request, addr string) (*dns.Response, error) {
+			return c.Exchange(ctx, req, addrs[addr])
+		},
+	}
+}
+
+func qnames(srv *dnstest.Server) []string {
+	var s []string
+	for _, q := range srv.Queries() {
+		s = append(s, q.Name+" "+q.Type.String())
+	}
+	return s
+}
+
+func TestMinimise(t *testing.T) {
+	r := assert.New(t)
+	h := newHierarchy(t)
+	resp, err := h.resolver(MinimiseRelaxed).Resolve(context.Background(), "X.y.z.www.example.com", dns.TypeAAAA)
+	r.NoError(err)
+	dnstest.AssertRcode(t, resp, dns.RcodeSuccess)
+	dnstest.AssertAnswerContains(t, resp, qname+" 300 IN AAAA 2001:db8::1")
+
+	// Every server sees one label below its zone until the last label, and
+	// only the servers of example.com. the full name and type.
+	r.DeepEqual([]string{"com. A"}, qnames(h.root))
+	r.DeepEqual([]string{"example.com. A"}, qnames(h.com))
+	r.DeepEqual([]string{
+		"www.example.com. A",
+		"z.www.example.com. A",
+		"y.z.www.example.com. A",
+		qname + " AAAA",
+	}, qnames(h.example))
+}
+
+func TestMinimiseOff(t *testing.T) {
+	r := assert.New(t)
+	h := newHierarchy(t)
+	resp, err := h.resolver(MinimiseOff).Resolve(context.Background(), qname, dns.TypeAAAA)
+	r.NoError(err)
+	dnstest.AssertAnswerLen(t, resp, 1)
+	r.DeepEqual([]string{qname + " AAAA"}, qnames(h.root))
+	r.DeepEqual([]string{qname + " AAAA"}, qnames(h.com))
+	r.DeepEqual([]string{qname + " AAAA"}, qnames(h.example))
+}
+
+func TestMinimiseNXDOMAIN(t *testing.T) {
+	r := assert.New(t)
+	h := newHierarchy(t)
+	// A broken server denies the empty non-terminal.
+	h.example.Handle("www.example.com.", dns.TypeA, dnstest.Reply{Authoritative: true, Rcode: dns.RcodeNameError})
+
+	// Relaxed: the full name is asked for and found.
+	resp, err := h.resolver(MinimiseRelaxed).Resolve(context.Background(), qname, dns.TypeAAAA)
+	r.NoError(err)
+	dnstest.AssertRcode(t, resp, dns.RcodeSuccess)
+	dnstest.AssertAnswerLen(t, resp, 1)
+	r.DeepEqual([]string{"www.example.com. A", qname + " AAAA"}, qnames(h.example))
+
+	// Strict: NXDOMAIN means nothing exists below, see RFC 8020.
+	h = newHierarchy(t)
+	h.example.Handle("www.example.com.", dns.TypeA, dnstest.Reply{Authoritative: true, Rcode: dns.RcodeNameError})
+	resp, err = h.resolver(MinimiseStrict).Resolve(context.Background(), qname, dns.TypeAAAA)
+	r.NoError(err)
+	dnstest.AssertRcode(t, resp, dns.RcodeNameError)
+	r.Equal(qname, string(resp.Question.Name))
+	r.DeepEqual([]string{"www.example.com. A"}, qnames(h.example))
+}
+
+func TestMinimiseRefused(t *testing.T) {
+	r := assert.New(t)
+	h := newHierarchy(t)
+	// Servers refusing a minimised query get the full name in relaxed mode.
+	h.example.Handle("www.example.com.", dns.TypeA, dnstest.Reply{Rcode: dns.RcodeRefused})
+	resp, err := h.resolver(MinimiseRelaxed).Resolve(context.Background(), qname, dns.TypeAAAA)
+	r.NoError(err)
+	dnstest.AssertAnswerLen(t, resp, 1)
+	r.DeepEqual([]string{"www.example.com. A", qname + " AAAA"}, qnames(h.example))
+}
+
+func TestCNAME(t *testing.T) {
+	r := assert.New(t)
+	h := newHierarchy(t)
+	h.example.Handle("alias.example.com.", dns.TypeAAAA, dnstest.Reply{
+		Authoritative: true,
+		Answer:        dnstest.RRs(t, "alias.example.com. 300 IN CNAME "+qname),
+	})
+	resp, err := h.resolver(MinimiseOff).Resolve(context.Background(), "alias.example.com.", dns.TypeAAAA)
+	r.NoError(err)
+	dnstest.AssertAnswerLen(t, resp, 2)
+	r.Equal(dns.TypeCNAME, resp.Answer[0].Header().Rrtype)
+	dnstest.AssertAnswerContains(t, resp, qname+" 300 IN AAAA 2001:db8::1")
+}
+
+func TestChild(t *testing.T) {
+	r := assert.New(t)
+	res := new(Resolver)
+	long := "a.b.c.d.e.f.g.h.i.j.k.l.m.n.o.p.q.r.s.t.u.v.w.x.y.z.example."
+	known, count := ".", 0
+	var steps []int
+	for {
+		child := res.child(known, long, count)
+		if child == long {
+			break
+		}
+		steps = append(steps, labels(child)-labels(known))
+		known = child
+		count++
+	}
+	r.Equal(MaxMinimiseCount, count)
+	// One label at a time first, then several.
+	r.DeepEqual([]int{1, 1, 1, 1}, steps[:MinimiseOneLab])
+	r.True(steps[MinimiseOneLab] > 1)
+	r.Equal(labels(long)-1, labels(known))
+
+	r.Equal("example.", res.child(".", "www.example.", 0))
+	r.Equal("www.example.", res.child("example.", "www.example.", 1))
+	r.Equal("www.example.", res.child(".", "www.example.", MaxMinimiseCount))
+	r.Equal("com.", ancestor("www.example.com.", 1))
+	r.Equal("www.example.com.", ancestor("www.example.com.", 3))
+}
+
+func TestNoServers(t *testing.T) {
+	r := assert.New(t)
+	h := newHierarchy(t)
+	h.root.Default = dnstest.ServFail
+	h.root.Handle("com.", dns.TypeA, dnstest.ServFail)
+	h.root.Handle(qname, dns.TypeAAAA, dnstest.ServFail)
+	_, err := h.resolver(MinimiseRelaxed).Resolve(context.Background(), qname, dns.TypeAAAA)
+	r.ErrorIs(err, ErrNoServers)
+}
@@ -23,6 +23,10 @@ type Request struct {
 	Domain   []byte
 	Question Question
 	Header   Header
+	// PaddingBlock, when positive and EDNS0 is set, makes SetQuestion pad the
+	// query with an EDNS0 padding option to a multiple of this many octets,
+	// see RFC 8467. Use QueryPaddingBlock on encrypted transports.
+	PaddingBlock int
 }
 
 var requestPool = sync.NewPool(func() *Request {
@@ -122,11 +126,13 @@ func (r *Request) SetEDNS0(maxSize uint16, do bool) {
 			Class:  Class(maxSize),
 		},
 	}
-	if do {
-		r.OPT.Hdr.Ttl |= _DO
-	} else {
-		r.OPT.Hdr.Ttl &^= _DO
-	}
+	r.OPT.SetDo(do)
+}
+
+// DNSSECOK reports whether the request has the DNSSEC OK flag of its OPT
+// record set, i.e. asks for DNSSEC records (RFC 3225).
+func (r *Request) DNSSECOK() bool {
+	return r.OPT.Do()
 }
 
 func (r *Request) SetQuestion(domain string, typ Type, class Class) {
@@ -154,6 +160,13 @@ func (r *Request) SetQuestion(domain string, typ Type, class Class) {
 	if r.OPT.Hdr.Class == 0 {
 		return
 	}
+	if r.PaddingBlock > 0 {
+		// The OPT RR takes 11 octets before its options, which include the
+		// header of the empty padding option.
+		r.OPT.setPadding(0)
+		n := len(r.Raw) + 11 + r.OPT.rdlen()
+		r.OPT.setPadding(paddingLen(n, r.PaddingBlock))
+	}
 	// OPT RR - Domain name (root = 0x00)
 	r.Raw = append(r.Raw, 0)
 	// Type (OPT = 41)
@@ -177,6 +190,20 @@ func (r *Request) SetQuestion(domain string, typ Type, class Class) {
 	r.Raw[rdlengthOffset+1] = byte(rdlength)
 }
 
+// SetRecursionDesired sets or clears the RD bit, also in Raw when the
+// question is set already. SetQuestion sets the bit; iterative queries to
+// authoritative servers clear it afterwards.
+func (r *Request) SetRecursionDesired(rd bool) {
+	if rd {
+		r.Header.Bits |= _RD
+	} else {
+		r.Header.Bits &^= _RD
+	}
+	if len(r.Raw) >= headerSize {
+		binary.BigEndian.PutUint16(r.Raw[2:], r.Header.Bits)
+	}
+}
+
 func (r *Request) Unpack(payload []byte) error {
 	if err := r.Header.Unpack(payload); err != nil {
 		return err
@@ -186,33 +213,48 @@ func (r *Request) Unpack(payload []byte) error {
 		return ErrInvalidHeader
 	}
 	// QNAME
-	payload = payload[12:]
-	var i int
-	var b byte
-	for i, b = range payload {
-		if b == 0 {
+	payload = payload[headerSize:]
+	off := 0
+	for {
+		if off >= len(payload) {
+			return ErrInvalidQuestion
+		}
+		l := int(payload[off])
+		if l == 0 {
 			break
 		}
+		// Questions in queries are never compressed.
+		if l > 63 {
+			return ErrInvalidQuestion
+		}
+		off += l + 1
+		if off >= maxDomainNameWireOctets {
+			return ErrLongDomain
+		}
 	}
 	//each question size should be atleast 4 bytes long (2 byte QType + 2 byte QClass)
-	if i == 0 || i+5 > len(payload) {
+	if off+5 > len(payload) {
 		return ErrInvalidQuestion
 	}
-	r.Question.Name = payload[:i+1]
-	payload = payload[i:]
+	r.Question.Name = payload[:off+1]
+	payload = payload[off:]
 	// QTYPE
 	r.Question.Type = Type(binary.BigEndian.Uint16(payload[1:3]))
 	// QCLASS
 	r.Question.Class = Class(binary.BigEndian.Uint16(payload[3:5]))
 	// Domain
-	i = int(r.Question.Name[0])
-	domain := append(r.Domain[:0], r.Question.Name[1:]...)
-	for domain[i] != 0 {
-		j := int(domain[i])
-		domain[i] = '.'
-		i += j + 1
+	if off == 0 {
+		r.Domain = append(r.Domain[:0], '.')
+	} else {
+		i := int(r.Question.Name[0])
+		domain := append(r.Domain[:0], r.Question.Name[1:]...)
+		for domain[i] != 0 {
+			j := int(domain[i])
+			domain[i] = '.'
+			i += j + 1
+		}
+		r.Domain = domain[:len(domain)-1]
 	}
-	r.Domain = domain[:len(domain)-1]
 	payload = payload[5:]
 	if len(payload) == 0 {
 		return nil
@@ -229,4 +271,5 @@ func (r *Request) Reset() {
 	r.Domain = r.Domain[:0]
 	r.Question = Question{}
 	r.Header = Header{}
+	r.PaddingBlock = 0
 }
@@ -18,6 +18,39 @@ func TestRequest(t *testing.T) {
 	t.Logf("%x", req.Raw)
 }
 
+func TestRequestDNSSECOK(t *testing.T) {
+	r := assert.New(t)
+	req := new(Request)
+	req.SetQuestion("example.com.", TypeA, ClassINET)
+	r.False(req.DNSSECOK())
+	req.SetEDNS0(1232, true)
+	req.SetQuestion("example.com.", TypeA, ClassINET)
+	r.True(req.DNSSECOK())
+
+	back := new(Request)
+	r.NoError(back.Unpack(append([]byte(nil), req.Raw...)))
+	r.True(back.DNSSECOK())
+	back.OPT.SetDo(false)
+	r.False(back.DNSSECOK())
+}
+
+func TestRequestSetRecursionDesired(t *testing.T) {
+	r := assert.New(t)
+	req := new(Request)
+	req.SetQuestion("example.com.", TypeA, ClassINET)
+	req.SetRecursionDesired(false)
+	r.False(req.Header.RecursionDesired())
+
+	back := new(Request)
+	r.NoError(back.Unpack(append([]byte(nil), req.Raw...)))
+	r.False(back.Header.RecursionDesired())
+	r.True(back.Header.AuthenticatedData())
+
+	req.SetRecursionDesired(true)
+	r.NoError(back.Unpack(append([]byte(nil), req.Raw...)))
+	r.True(back.Header.RecursionDesired())
+}
+
 func TestRequestUnpack(t *testing.T) {
 	r := assert.New(t)
 	msg, _ := hex.DecodeString("4ffd0120000100000000000105617874717303636f6d0000010001000029100000000000000c000a000874b82f2641563c8e")
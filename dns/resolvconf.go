@@ -0,0 +1,165 @@
+package dns
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ResolvConf holds the settings of a resolv.conf file, see resolv.conf(5).
+type ResolvConf struct {
+	// Nameservers holds the servers as host:port.
+	Nameservers []string
+	// Search holds the fully qualified domains tried for names with fewer
+	// than Ndots dots.
+	Search []string
+	// Ndots is the number of dots from which a name is tried as is before
+	// the search domains; 1 by default.
+	Ndots int
+	// Timeout bounds a query to one server; 5s by default.
+	Timeout time.Duration
+	// Attempts is the number of times every server is tried; 2 by default.
+	Attempts int
+	// Rotate spreads the queries over the servers instead of always
+	// starting with the first.
+	Rotate bool
+	// EDNS0 sends queries with an EDNS0 OPT record.
+	EDNS0 bool
+}
+
+// Limits and defaults of resolv.conf(5).
+const (
+	maxNameservers = 3
+	maxNdots       = 15
+	maxTimeout     = 30
+	maxAttempts    = 5
+)
+
+// DefaultResolvConf returns the settings used without a resolv.conf file:
+// the name server on the local host and the defaults of resolv.conf(5).
+func DefaultResolvConf() *ResolvConf {
+	return &ResolvConf{
+		Nameservers: []string{"127.0.0.1:53", "[::1]:53"},
+		Ndots:       1,
+		Timeout:     5 * time.Second,
+		Attempts:    2,
+	}
+}
+
+// ReadResolvConf parses the resolv.conf file at path.
+func ReadResolvConf(path string) (*ResolvConf, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseResolvConf(f)
+}
+
+// ParseResolvConf parses resolv.conf settings from r. It understands the
+// nameserver, domain, search and options lines; the ndots, timeout,
+// attempts, rotate and edns0 options; and comments starting with '#' or ';'.
+// Unknown lines and options and bad addresses are skipped, as the C library
+// does. Without a valid nameserver line, those of DefaultResolvConf are
+// used.
+func ParseResolvConf(r io.Reader) (*ResolvConf, error) {
+	conf := DefaultResolvConf()
+	conf.Nameservers = nil
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexAny(line, "#;"); i >= 0 {
+			line = line[:i]
+		}
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		switch f[0] {
+		case "nameserver":
+			if len(f) < 2 || len(conf.Nameservers) == maxNameservers {
+				continue
+			}
+			// Zones of link-local addresses are kept.
+			host, zone, _ := strings.Cut(f[1], "%")
+			if addr, err := netip.ParseAddr(host); err == nil {
+				if zone != "" {
+					addr = addr.WithZone(zone)
+				}
+				conf.Nameservers = append(conf.Nameservers, net.JoinHostPort(addr.String(), "53"))
+			}
+		case "domain":
+			if len(f) > 1 {
+				conf.Search = []string{Fqdn(f[1])}
+			}
+		case "search":
+			conf.Search = conf.Search[:0]
+			for _, d := range f[1:] {
+				if d != "." {
+					conf.Search = append(conf.Search, Fqdn(d))
+				}
+			}
+		case "options":
+			for _, opt := range f[1:] {
+				conf.option(opt)
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(conf.Nameservers) == 0 {
+		conf.Nameservers = DefaultResolvConf().Nameservers
+	}
+	return conf, nil
+}
+
+func (conf *ResolvConf) option(opt string) {
+	name, value, _ := strings.Cut(opt, ":")
+	n, err := strconv.Atoi(value)
+	switch name {
+	case "ndots":
+		if err == nil && n >= 0 {
+			conf.Ndots = min(n, maxNdots)
+		}
+	case "timeout":
+		if err == nil && n >= 1 {
+			conf.Timeout = time.Duration(min(n, maxTimeout)) * time.Second
+		}
+	case "attempts":
+		if err == nil && n >= 1 {
+			conf.Attempts = min(n, maxAttempts)
+		}
+	case "rotate":
+		conf.Rotate = true
+	case "edns0":
+		conf.EDNS0 = true
+	}
+}
+
+// names returns the fully qualified names to try for name, in order: a
+// name with a trailing dot as is only; a name with at least Ndots dots as
+// is, then within the search domains; any other name within the search
+// domains first.
+func (conf *ResolvConf) names(name string) []string {
+	if strings.HasSuffix(name, ".") {
+		return []string{name}
+	}
+	names := make([]string, 0, len(conf.Search)+1)
+	asIs := strings.Count(name, ".") >= conf.Ndots
+	if asIs {
+		names = append(names, name+".")
+	}
+	for _, d := range conf.Search {
+		names = append(names, name+"."+d)
+	}
+	if !asIs {
+		names = append(names, name+".")
+	}
+	return names
+}
@@ -0,0 +1,298 @@
+package dns
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/dnsoa/go/dns/internal/transport"
+)
+
+// Default paths of the files read by a Resolver.
+const (
+	DefaultResolvConfPath = "/etc/resolv.conf"
+	DefaultHostsPath      = "/etc/hosts"
+)
+
+// Errors of a Resolver, in the Err field of a *net.DNSError as the standard
+// library reports them.
+var (
+	errNoSuchHost        = errors.New("no such host")
+	errServerMisbehaving = errors.New("server misbehaving")
+)
+
+// Resolver is a stub resolver configured like the C library: it answers
+// from the hosts file first and then asks the name servers of resolv.conf,
+// trying the search domains as ndots says. Its methods mirror those of
+// net.Resolver and report errors as *net.DNSError.
+//
+// The files are read on first use; Reload makes the next lookup read them
+// again. A missing or unreadable resolv.conf gives DefaultResolvConf, and a
+// missing hosts file no entries.
+type Resolver struct {
+	// ResolvConfPath is the path of resolv.conf; DefaultResolvConfPath when
+	// empty.
+	ResolvConfPath string
+	// HostsPath is the path of the hosts file; DefaultHostsPath when empty.
+	HostsPath string
+	// Observer, if set, is told about every query sent.
+	Observer Observer
+
+	mu    sync.Mutex
+	conf  *ResolvConf
+	hosts *Hosts
+	next  atomic.Uint32 // next server with the rotate option
+
+	exchange func(ctx context.Context, req *Request, addr string) (*Response, error)
+}
+
+// Reload makes the next lookup read resolv.conf and the hosts file again.
+func (r *Resolver) Reload() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.conf, r.hosts = nil, nil
+}
+
+func (r *Resolver) load() (*ResolvConf, *Hosts) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.conf == nil {
+		path := cmp.Or(r.ResolvConfPath, DefaultResolvConfPath)
+		conf, err := ReadResolvConf(path)
+		if err != nil {
+			conf = DefaultResolvConf()
+		}
+		hosts, _ := ReadHosts(cmp.Or(r.HostsPath, DefaultHostsPath))
+		r.conf, r.hosts = conf, hosts
+	}
+	return r.conf, r.hosts
+}
+
+// LookupHost returns the addresses of host, IPv4 first.
+func (r *Resolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	addrs, err := r.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil, err
+	}
+	s := make([]string, len(addrs))
+	for i, addr := range addrs {
+		s[i] = addr.String()
+	}
+	return s, nil
+}
+
+// LookupNetIP returns the addresses of host for network: "ip" for IPv4 and
+// IPv6 addresses, IPv4 first, "ip4" or "ip6" for one family. An address
+// literal is returned as is, and names in the hosts file are not looked up
+// in the DNS.
+func (r *Resolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	var types []Type
+	switch network {
+	case "ip":
+		types = []Type{TypeA, TypeAAAA}
+	case "ip4":
+		types = []Type{TypeA}
+	case "ip6":
+		types = []Type{TypeAAAA}
+	default:
+		return nil, net.UnknownNetworkError(network)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []netip.Addr{addr}, nil
+	}
+	_, hosts := r.load()
+	var addrs []netip.Addr
+	if known := hosts.LookupHost(host); len(known) > 0 {
+		for _, addr := range known {
+			if network == "ip" || addr.Unmap().Is4() == (network == "ip4") {
+				addrs = append(addrs, addr)
+			}
+		}
+		if len(addrs) == 0 {
+			return nil, &net.DNSError{Err: errNoSuchHost.Error(), Name: host, IsNotFound: true}
+		}
+		return addrs, nil
+	}
+
+	var firstErr error
+	for _, qtype := range types {
+		_, rrs, err := r.lookup(ctx, host, qtype)
+		if err != nil {
+			var dnsErr *net.DNSError
+			if firstErr == nil || (errors.As(firstErr, &dnsErr) && dnsErr.IsNotFound) {
+				firstErr = err
+			}
+			continue
+		}
+		for _, rr := range rrs {
+			switch rr := rr.(type) {
+			case *A:
+				addrs = append(addrs, netip.AddrFrom4(rr.A))
+			case *AAAA:
+				if addr, ok := netip.AddrFromSlice(rr.AAAA); ok {
+					addrs = append(addrs, addr)
+				}
+			}
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, firstErr
+	}
+	return addrs, nil
+}
+
+// LookupSRV looks up the SRV records of _service._proto.name, or of name
+// when service and proto are empty, see RFC 2782. It returns the name the
+// records were found at and the records sorted by priority and randomized
+// by weight.
+func (r *Resolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	target := name
+	if service != "" || proto != "" {
+		target = "_" + service + "._" + proto + "." + name
+	}
+	cname, rrs, err := r.lookup(ctx, target, TypeSRV)
+	if err != nil {
+		return "", nil, err
+	}
+	srvs := make([]*net.SRV, 0, len(rrs))
+	for _, rr := range rrs {
+		if rr, ok := rr.(*SRV); ok {
+			srvs = append(srvs, &net.SRV{Target: rr.Target, Port: rr.Port, Priority: rr.Priority, Weight: rr.Weight})
+		}
+	}
+	sortSRV(srvs)
+	return cname, srvs, nil
+}
+
+// sortSRV sorts srvs by priority, and within a priority in a random order
+// where heavier records tend to come first, see RFC 2782.
+func sortSRV(srvs []*net.SRV) {
+	slices.SortStableFunc(srvs, func(a, b *net.SRV) int { return cmp.Compare(a.Priority, b.Priority) })
+	for i := 0; i < len(srvs); {
+		j := i + 1
+		for j < len(srvs) && srvs[j].Priority == srvs[i].Priority {
+			j++
+		}
+		group := srvs[i:j]
+		for k := range group {
+			sum := 0
+			for _, s := range group[k:] {
+				sum += int(s.Weight)
+			}
+			if sum == 0 {
+				break
+			}
+			n := rand.IntN(sum)
+			for l, s := range group[k:] {
+				if n -= int(s.Weight); n < 0 {
+					group[k], group[k+l] = group[k+l], group[k]
+					break
+				}
+			}
+		}
+		i = j
+	}
+}
+
+// lookup tries the names for name in turn and returns the first with
+// records of qtype, with those records. Names that do not exist or have no
+// such records lead to the next; a failure of the servers ends the lookup.
+func (r *Resolver) lookup(ctx context.Context, name string, qtype Type) (string, []RR, error) {
+	conf, _ := r.load()
+	for _, fqdn := range conf.names(name) {
+		resp, server, err := r.query(ctx, conf, fqdn, qtype)
+		if err != nil {
+			var netErr net.Error
+			timeout := errors.As(err, &netErr) && netErr.Timeout()
+			return "", nil, &net.DNSError{
+				Err:         err.Error(),
+				Name:        name,
+				Server:      server,
+				IsTimeout:   timeout || errors.Is(err, context.DeadlineExceeded),
+				IsTemporary: true,
+			}
+		}
+		if resp.Rcode() != RcodeSuccess {
+			continue
+		}
+		var rrs []RR
+		for _, rr := range resp.Answer {
+			if rr.Header().Rrtype == qtype {
+				rrs = append(rrs, rr)
+			}
+		}
+		if len(rrs) > 0 {
+			return rrs[0].Header().Name, rrs, nil
+		}
+	}
+	return "", nil, &net.DNSError{Err: errNoSuchHost.Error(), Name: name, IsNotFound: true}
+}
+
+// send sends req to the server at addr over UDP, retrying truncated
+// responses over TCP. The context bounds the exchange.
+func (r *Resolver) send(ctx context.Context, req *Request, addr string) (*Response, error) {
+	var d net.Dialer
+	start := QueryStart()
+	raw, network, err := transport.Exchange(ctx, &d, "udp", addr, req.Raw, max(int(req.OPT.Hdr.Class), 512), 0)
+	var resp *Response
+	if raw != nil {
+		resp = new(Response)
+		if uerr := resp.Unpack(raw); uerr != nil {
+			resp, err = nil, uerr
+		}
+	}
+	Observe(r.Observer, network, req.Question.Type, start, resp, len(raw), err)
+	return resp, err
+}
+
+// query asks the servers for fqdn and qtype until one answers with NOERROR
+// or NXDOMAIN, trying each up to Attempts times.
+func (r *Resolver) query(ctx context.Context, conf *ResolvConf, fqdn string, qtype Type) (*Response, string, error) {
+	servers := conf.Nameservers
+	start := 0
+	if conf.Rotate && len(servers) > 1 {
+		start = int(r.next.Add(1)-1) % len(servers)
+	}
+	exchange := r.exchange
+	if exchange == nil {
+		exchange = r.send
+	}
+
+	var (
+		lastErr    error = errServerMisbehaving
+		lastServer string
+	)
+	for range max(conf.Attempts, 1) {
+		for i := range servers {
+			server := servers[(start+i)%len(servers)]
+			req := new(Request)
+			if conf.EDNS0 {
+				req.SetEDNS0(1232, false)
+			}
+			req.SetQuestion(fqdn, qtype, ClassINET)
+			qctx, cancel := context.WithTimeout(ctx, conf.Timeout)
+			resp, err := exchange(qctx, req, server)
+			cancel()
+			if ctx.Err() != nil {
+				return nil, server, ctx.Err()
+			}
+			lastServer = server
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			switch resp.Rcode() {
+			case RcodeSuccess, RcodeNameError:
+				return resp, server, nil
+			}
+			lastErr = errServerMisbehaving
+		}
+	}
+	return nil, lastServer, lastErr
+}
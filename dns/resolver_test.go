@@ -0,0 +1,272 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dnsoa/go/assert"
+)
+
+func TestParseResolvConf(t *testing.T) {
+	r := assert.New(t)
+	conf, err := ParseResolvConf(strings.NewReader(`# comment
+nameserver 192.0.2.53
+nameserver 2001:db8::53 ; trailing comment
+nameserver fe80::1%eth0
+nameserver 192.0.2.54
+nameserver bogus
+domain example.org
+search example.com corp.example.com
+options ndots:2 timeout:3 attempts:9 rotate edns0 unknown
+`))
+	r.NoError(err)
+	r.DeepEqual([]string{"192.0.2.53:53", "[2001:db8::53]:53", "[fe80::1%eth0]:53"}, conf.Nameservers)
+	r.DeepEqual([]string{"example.com.", "corp.example.com."}, conf.Search)
+	r.Equal(2, conf.Ndots)
+	r.Equal(3*time.Second, conf.Timeout)
+	r.Equal(maxAttempts, conf.Attempts)
+	r.True(conf.Rotate)
+	r.True(conf.EDNS0)
+
+	conf, err = ParseResolvConf(strings.NewReader("search a.example\ndomain b.example\n"))
+	r.NoError(err)
+	r.DeepEqual(DefaultResolvConf().Nameservers, conf.Nameservers)
+	r.DeepEqual([]string{"b.example."}, conf.Search)
+	r.Equal(1, conf.Ndots)
+}
+
+func TestResolvConfNames(t *testing.T) {
+	r := assert.New(t)
+	conf := &ResolvConf{Search: []string{"a.example.", "b.example."}, Ndots: 1}
+	r.DeepEqual([]string{"www.a.example.", "www.b.example.", "www."}, conf.names("www"))
+	r.DeepEqual([]string{"www.test.", "www.test.a.example.", "www.test.b.example."}, conf.names("www.test"))
+	r.DeepEqual([]string{"www.test."}, conf.names("www.test."))
+	conf.Ndots = 2
+	r.DeepEqual([]string{"www.test.a.example.", "www.test.b.example.", "www.test."}, conf.names("www.test"))
+}
+
+func TestParseHosts(t *testing.T) {
+	r := assert.New(t)
+	h, err := ParseHosts(strings.NewReader(`127.0.0.1 localhost
+::1 localhost ip6-localhost # comment
+192.0.2.1 Host.example host
+192.0.2.1 host
+not-an-address bogus
+`))
+	r.NoError(err)
+	r.DeepEqual([]netip.Addr{netip.MustParseAddr("127.0.0.1"), netip.MustParseAddr("::1")}, h.LookupHost("localhost"))
+	r.DeepEqual([]netip.Addr{netip.MustParseAddr("192.0.2.1")}, h.LookupHost("HOST.example."))
+	r.DeepEqual([]netip.Addr{netip.MustParseAddr("192.0.2.1")}, h.LookupHost("host"))
+	r.Len(h.LookupHost("bogus"), 0)
+	r.DeepEqual([]string{"Host.example.", "host."}, h.LookupAddr(netip.MustParseAddr("192.0.2.1")))
+
+	var none *Hosts
+	r.Len(none.LookupHost("localhost"), 0)
+}
+
+// fakeServers answers queries from zone, or with the rcode in failing for
+// a server, and records the queries they get.
+type fakeServers struct {
+	zone    map[string][]RR // by lowercased "name type"
+	failing map[string]Rcode
+	queries []string // "server name type"
+	edns0   bool
+}
+
+func (f *fakeServers) exchange(_ context.Context, req *Request, addr string) (*Response, error) {
+	name, qtype := string(req.Domain), req.Question.Type
+	f.queries = append(f.queries, addr+" "+name+" "+qtype.String())
+	f.edns0 = req.OPT.Hdr.Rrtype == TypeOPT
+	if rcode, ok := f.failing[addr]; ok {
+		if rcode == RcodeSuccess {
+			return nil, context.DeadlineExceeded
+		}
+		resp := new(Response)
+		resp.SetReply(req)
+		resp.SetRcode(rcode)
+		return resp, nil
+	}
+	resp := new(Response)
+	resp.SetReply(req)
+	resp.SetRcode(RcodeNameError)
+	for key, rrs := range f.zone {
+		if owner, _, _ := strings.Cut(key, " "); owner == strings.ToLower(name) {
+			resp.SetRcode(RcodeSuccess)
+			if key == strings.ToLower(name)+" "+qtype.String() {
+				resp.Answer = rrs
+			}
+		}
+	}
+	return resp, nil
+}
+
+func newTestResolver(t *testing.T, resolvConf, hosts string, zone ...string) (*Resolver, *fakeServers) {
+	t.Helper()
+	dir := t.TempDir()
+	r := &Resolver{
+		ResolvConfPath: filepath.Join(dir, "resolv.conf"),
+		HostsPath:      filepath.Join(dir, "hosts"),
+	}
+	assert.NoError(t, os.WriteFile(r.ResolvConfPath, []byte(resolvConf), 0o644))
+	assert.NoError(t, os.WriteFile(r.HostsPath, []byte(hosts), 0o644))
+	f := &fakeServers{zone: make(map[string][]RR), failing: make(map[string]Rcode)}
+	for _, s := range zone {
+		rr, err := NewRR(s)
+		assert.NoError(t, err)
+		key := strings.ToLower(rr.Header().Name) + " " + rr.Header().Rrtype.String()
+		f.zone[key] = append(f.zone[key], rr)
+	}
+	r.exchange = f.exchange
+	return r, f
+}
+
+func TestResolverLookupHost(t *testing.T) {
+	r := assert.New(t)
+	res, f := newTestResolver(t,
+		"nameserver 192.0.2.53\nsearch corp.example\n",
+		"192.0.2.80 intranet\n",
+		"www.corp.example. 300 IN A 192.0.2.1",
+		"www.corp.example. 300 IN AAAA 2001:db8::1",
+		"mail.example. 300 IN A 192.0.2.25",
+		"v6.example. 300 IN AAAA 2001:db8::6",
+	)
+	ctx := context.Background()
+
+	addrs, err := res.LookupHost(ctx, "www")
+	r.NoError(err)
+	r.DeepEqual([]string{"192.0.2.1", "2001:db8::1"}, addrs)
+	r.Equal("192.0.2.53:53 www.corp.example. A", f.queries[0])
+	r.False(f.edns0)
+
+	// With a dot the name is tried as is first.
+	f.queries = nil
+	addrs, err = res.LookupHost(ctx, "mail.example")
+	r.NoError(err)
+	r.DeepEqual([]string{"192.0.2.25"}, addrs)
+	r.Equal("192.0.2.53:53 mail.example. A", f.queries[0])
+
+	ips, err := res.LookupNetIP(ctx, "ip4", "v6.example")
+	r.True(ips == nil)
+	var dnsErr *net.DNSError
+	r.True(errors.As(err, &dnsErr))
+	r.True(dnsErr.IsNotFound)
+	ips, err = res.LookupNetIP(ctx, "ip6", "v6.example")
+	r.NoError(err)
+	r.DeepEqual([]netip.Addr{netip.MustParseAddr("2001:db8::6")}, ips)
+
+	// The hosts file comes first and literals need no lookup.
+	f.queries = nil
+	addrs, err = res.LookupHost(ctx, "intranet")
+	r.NoError(err)
+	r.DeepEqual([]string{"192.0.2.80"}, addrs)
+	addrs, err = res.LookupHost(ctx, "2001:db8::2")
+	r.NoError(err)
+	r.DeepEqual([]string{"2001:db8::2"}, addrs)
+	r.Len(f.queries, 0)
+
+	_, err = res.LookupHost(ctx, "nope.example")
+	r.True(errors.As(err, &dnsErr))
+	r.True(dnsErr.IsNotFound)
+	r.Equal("nope.example", dnsErr.Name)
+
+	_, err = res.LookupNetIP(ctx, "tcp", "www")
+	r.NotNil(err)
+}
+
+func TestResolverServers(t *testing.T) {
+	r := assert.New(t)
+	res, f := newTestResolver(t,
+		"nameserver 192.0.2.1\nnameserver 192.0.2.2\noptions attempts:2 edns0\n", "",
+		"www.example. 300 IN A 192.0.2.80",
+	)
+	ctx := context.Background()
+
+	// SERVFAIL and timeouts move on to the next server.
+	f.failing["192.0.2.1:53"] = RcodeServerFailure
+	addrs, err := res.LookupHost(ctx, "www.example.")
+	r.NoError(err)
+	r.DeepEqual([]string{"192.0.2.80"}, addrs)
+	r.True(f.edns0)
+	r.Equal("192.0.2.1:53 www.example. A", f.queries[0])
+	r.Equal("192.0.2.2:53 www.example. A", f.queries[1])
+
+	f.queries = nil
+	f.failing["192.0.2.2:53"] = RcodeSuccess
+	_, err = res.LookupNetIP(ctx, "ip4", "www.example.")
+	var dnsErr *net.DNSError
+	r.True(errors.As(err, &dnsErr))
+	r.False(dnsErr.IsNotFound)
+	r.True(dnsErr.IsTemporary)
+	r.True(dnsErr.IsTimeout)
+	r.Len(f.queries, 4)
+
+	// With rotate the queries start at the next server every time.
+	r.NoError(os.WriteFile(res.ResolvConfPath, []byte("nameserver 192.0.2.1\nnameserver 192.0.2.2\noptions rotate\n"), 0o644))
+	res.Reload()
+	clear(f.failing)
+	f.queries = nil
+	for range 2 {
+		_, err = res.LookupNetIP(ctx, "ip4", "www.example.")
+		r.NoError(err)
+	}
+	r.Equal("192.0.2.1:53 www.example. A", f.queries[0])
+	r.Equal("192.0.2.2:53 www.example. A", f.queries[1])
+}
+
+func TestResolverLookupSRV(t *testing.T) {
+	r := assert.New(t)
+	res, _ := newTestResolver(t, "nameserver 192.0.2.53\nsearch example.com\n", "",
+		"_sip._tcp.example.com. 300 IN SRV 20 0 5060 backup.example.com.",
+		"_sip._tcp.example.com. 300 IN SRV 10 60 5060 a.example.com.",
+		"_sip._tcp.example.com. 300 IN SRV 10 40 5060 b.example.com.",
+	)
+	cname, srvs, err := res.LookupSRV(context.Background(), "sip", "tcp", "example.com")
+	r.NoError(err)
+	r.Equal("_sip._tcp.example.com.", cname)
+	r.Len(srvs, 3)
+	r.Equal(uint16(10), srvs[0].Priority)
+	r.Equal(uint16(10), srvs[1].Priority)
+	r.Equal("backup.example.com.", srvs[2].Target)
+	r.Equal(uint16(5060), srvs[2].Port)
+
+	_, _, err = res.LookupSRV(context.Background(), "", "", "_xmpp._tcp")
+	var dnsErr *net.DNSError
+	r.True(errors.As(err, &dnsErr))
+	r.True(dnsErr.IsNotFound)
+}
+
+func TestSortSRV(t *testing.T) {
+	r := assert.New(t)
+	first := 0
+	for range 1000 {
+		srvs := []*net.SRV{
+			{Target: "light.", Priority: 1, Weight: 10},
+			{Target: "heavy.", Priority: 1, Weight: 90},
+			{Target: "zero.", Priority: 1, Weight: 0},
+			{Target: "low.", Priority: 0, Weight: 0},
+		}
+		sortSRV(srvs)
+		r.Equal("low.", srvs[0].Target)
+		r.Equal("zero.", srvs[3].Target)
+		if srvs[1].Target == "heavy." {
+			first++
+		}
+	}
+	r.True(first > 800 && first < 980, first)
+}
+
+func TestResolverMissingFiles(t *testing.T) {
+	r := assert.New(t)
+	dir := t.TempDir()
+	res := &Resolver{ResolvConfPath: filepath.Join(dir, "none"), HostsPath: filepath.Join(dir, "none")}
+	conf, hosts := res.load()
+	r.DeepEqual(DefaultResolvConf(), conf)
+	r.Len(hosts.LookupHost("localhost"), 0)
+}
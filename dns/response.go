@@ -2,6 +2,7 @@ package dns
 
 import (
 	"encoding/binary"
+	"errors"
 	"net"
 	"strconv"
 	"unsafe"
@@ -80,6 +81,11 @@ type Response struct {
 	Question Question
 	// Header is the wire format for the DNS packet header.
 	Header Header
+	// PaddingBlock, when positive and the response has an OPT record, makes
+	// PackTo pad the message with an EDNS0 padding option to a multiple of
+	// this many octets, see RFC 8467. Use ResponsePaddingBlock on encrypted
+	// transports.
+	PaddingBlock int
 }
 
 var responsePool = sync.NewPool(func() *Response {
@@ -114,107 +120,170 @@ func (r *Response) SetQuestion(name string, typ Type, class Class) {
 	r.Question.Class = class
 }
 
+// SetReply makes the response a reply to req: it copies the ID, opcode, RD
+// and CD bits and the question, and sets QR.
+func (r *Response) SetReply(req *Request) {
+	r.Header.ID = req.Header.ID
+	r.Header.SetResponse()
+	r.Header.SetOpCode(req.Header.OpCode())
+	if req.Header.RecursionDesired() {
+		r.Header.SetRecursionDesired()
+	}
+	if req.Header.CheckingDisabled() {
+		r.Header.SetCheckingDisabled()
+	}
+	r.SetQuestion(Fqdn(string(req.Domain)), req.Question.Type, req.Question.Class)
+	r.Header.Qdcount = 1
+}
+
+// setOption sets an EDNS0 option on the OPT record of the response, replacing
+// any existing option with the same code. It does nothing when the response
+// carries no OPT record.
+func (r *Response) setOption(code OptionCode, data []byte) {
+	if opt := r.opt(); opt != nil {
+		opt.SetOption(code, data)
+	}
+}
+
+// Rcode returns the RCODE of the response, including the extended bits
+// carried in the OPT record.
+func (r *Response) Rcode() Rcode {
+	return extendedRcode(&r.Header, r.opt())
+}
+
+// SetRcode sets the RCODE of the response. Extended values above 15, such as
+// RcodeBadCookie, need an OPT record for their upper bits; without one only
+// the lower 4 bits are kept.
+func (r *Response) SetRcode(rcode Rcode) {
+	r.Header.SetRcode(rcode & 0xF)
+	if opt := r.opt(); opt != nil {
+		opt.Hdr.Ttl = opt.Hdr.Ttl&0x00FFFFFF | uint32(rcode>>4)<<24
+	}
+}
+
+// Pack returns the wire format of the response. Errors are dropped; use PackTo
+// to learn why a response could not be packed.
 func (r *Response) Pack() []byte {
-	// Calculate approximate size needed
-	size := 512 // Header + Question + some RRs
-	for _, rr := range r.Answer {
-		if rr != nil {
-			size += 128
-		}
+	b, _ := r.PackTo(nil)
+	return b
+}
+
+// PackTo packs the response into buf, growing it when it is too small, and
+// returns the packed message. Messages larger than 65535 octets fail.
+func (r *Response) PackTo(buf []byte) ([]byte, error) {
+	opt := r.opt()
+	if r.PaddingBlock <= 0 || opt == nil {
+		return r.packTo(buf)
 	}
-	for _, rr := range r.Ns {
-		if rr != nil {
-			size += 128
-		}
+	// Pack with an empty padding option to learn the final length, then
+	// fill the option up. Padding never goes past the message size limit.
+	opt.setPadding(0)
+	b, err := r.packTo(buf)
+	if err != nil {
+		return nil, err
 	}
-	for _, rr := range r.Extra {
-		if rr != nil {
-			size += 128
-		}
+	n := min(paddingLen(len(b), r.PaddingBlock), maxMsgSize-len(b))
+	if n == 0 {
+		return b, nil
 	}
+	opt.setPadding(n)
+	return r.packTo(b)
+}
 
-	buf := make([]byte, size)
-	off := 0
+func (r *Response) packTo(buf []byte) ([]byte, error) {
+	size := 512
+	for _, rrs := range [][]RR{r.Answer, r.Ns, r.Extra} {
+		size += 128 * len(rrs)
+	}
+	size = min(max(size, cap(buf)), maxMsgSize)
+	for {
+		if cap(buf) < size {
+			buf = make([]byte, size)
+		}
+		buf = buf[:size]
+		off, err := r.pack(buf)
+		if err == nil {
+			return buf[:off], nil
+		}
+		// Retry with a larger buffer until the message size limit is
+		// reached when out of space.
+		if !errors.Is(err, ErrBuf) || size == maxMsgSize {
+			return nil, err
+		}
+		size = min(size*2, maxMsgSize)
+	}
+}
 
-	// Pack header
+func (r *Response) pack(buf []byte) (int, error) {
 	hdr := r.Header.Pack()
-	copy(buf[off:], hdr[:])
-	off += headerSize
+	off := copy(buf, hdr[:])
+	if off < headerSize {
+		return off, ErrBuf
+	}
 
 	// Create compression map for domain names
 	compression := make(map[string]int)
 
 	// Pack question section
-	off, _ = packDomainNameWithCompression(b2s(r.Question.Name), buf, off, compression)
-	buf[off] = byte(r.Question.Type >> 8)
-	buf[off+1] = byte(r.Question.Type)
-	buf[off+2] = byte(r.Question.Class >> 8)
-	buf[off+3] = byte(r.Question.Class)
-	off += 4
-
-	// Helper function to ensure buffer has enough space
-	ensureSpace := func(needed int) {
-		if off+needed > len(buf) {
-			newBuf := make([]byte, len(buf)*2)
-			copy(newBuf, buf)
-			buf = newBuf
-		}
+	off, err := packDomainNameWithCompression(b2s(r.Question.Name), buf, off, compression)
+	if err != nil {
+		return off, err
+	}
+	if off, err = packUint16(uint16(r.Question.Type), buf, off); err != nil {
+		return off, err
+	}
+	if off, err = packUint16(uint16(r.Question.Class), buf, off); err != nil {
+		return off, err
 	}
 
-	// Helper function to pack an RR
-	packRR := func(rr RR) {
-		if rr == nil {
-			return
+	for _, rrs := range [][]RR{r.Answer, r.Ns, r.Extra} {
+		for _, rr := range rrs {
+			if rr == nil {
+				continue
+			}
+			if off, err = packRR(rr, buf, off, compression); err != nil {
+				return off, err
+			}
 		}
-		h := rr.Header()
-
-		// Pack RR name with compression
-		off, _ = packDomainNameWithCompression(h.Name, buf, off, compression)
-
-		// Ensure space for fixed part: Type(2) + Class(2) + TTL(4) + RDLENGTH(2) = 10 bytes
-		ensureSpace(10)
-
-		// Pack RR type, class, ttl
-		buf[off] = byte(h.Rrtype >> 8)
-		buf[off+1] = byte(h.Rrtype)
-		buf[off+2] = byte(h.Class >> 8)
-		buf[off+3] = byte(h.Class)
-		buf[off+4] = byte(h.Ttl >> 24)
-		buf[off+5] = byte(h.Ttl >> 16)
-		buf[off+6] = byte(h.Ttl >> 8)
-		buf[off+7] = byte(h.Ttl)
-		off += 8
-
-		// Reserve space for RDLENGTH
-		rdlengthOff := off
-		off += 2
-
-		// Pack RDATA
-		rdataStart := off
-		off, _ = rr.pack(buf, off)
-
-		// Fill in RDLENGTH
-		rdlength := uint16(off - rdataStart)
-		buf[rdlengthOff] = byte(rdlength >> 8)
-		buf[rdlengthOff+1] = byte(rdlength)
 	}
+	return off, nil
+}
 
-	// Pack answer section
-	for _, rr := range r.Answer {
-		packRR(rr)
-	}
+// PackRR packs rr into msg at off and returns the offset after it. Owner
+// names are compressed against compression, which maps names to their
+// offsets in msg and is updated as names are packed; nil disables
+// compression.
+func PackRR(rr RR, msg []byte, off int, compression map[string]int) (int, error) {
+	return packRR(rr, msg, off, compression)
+}
 
-	// Pack authority section
-	for _, rr := range r.Ns {
-		packRR(rr)
+// packRR packs rr at off with its owner name compressed.
+func packRR(rr RR, buf []byte, off int, compression map[string]int) (int, error) {
+	h := rr.Header()
+	off, err := packDomainNameWithCompression(h.Name, buf, off, compression)
+	if err != nil {
+		return off, err
 	}
-
-	// Pack additional section
-	for _, rr := range r.Extra {
-		packRR(rr)
+	// Type(2) + Class(2) + TTL(4) + RDLENGTH(2)
+	if off+10 > len(buf) {
+		return off, ErrBuf
 	}
-
-	return buf[:off]
+	binary.BigEndian.PutUint16(buf[off:], uint16(h.Rrtype))
+	binary.BigEndian.PutUint16(buf[off+2:], uint16(h.Class))
+	binary.BigEndian.PutUint32(buf[off+4:], h.Ttl)
+	rdlengthOff := off + 8
+	off += 10
+
+	rdataStart := off
+	if off, err = rr.pack(buf, off); err != nil {
+		return off, err
+	}
+	rdlength := off - rdataStart
+	if rdlength > 0xFFFF {
+		return off, &Error{err: "rdata too long"}
+	}
+	binary.BigEndian.PutUint16(buf[rdlengthOff:], uint16(rdlength))
+	return off, nil
 }
 
 func (r *Response) Unpack(payload []byte) error {
@@ -318,4 +387,5 @@ func (r *Response) Reset() {
 	r.Extra = r.Extra[:0]
 	r.Question = Question{}
 	r.Header = Header{}
+	r.PaddingBlock = 0
 }
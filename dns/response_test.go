@@ -2,6 +2,7 @@ package dns
 
 import (
 	"encoding/hex"
+	"errors"
 	"net"
 	"testing"
 
@@ -155,3 +156,50 @@ func TestResponseUnpack(t *testing.T) {
 	r.Equal(uint32(0), opt.Hdr.Ttl)
 	// r.Equal(OptionCodeCookie, opt.Options[0].Code)
 }
+
+func TestResponseSetReply(t *testing.T) {
+	r := assert.New(t)
+	q := new(Request)
+	q.Header.SetCheckingDisabled()
+	q.SetQuestion("Example.com.", TypeMX, ClassINET)
+	req := new(Request)
+	r.NoError(req.Unpack(q.Raw))
+
+	resp := new(Response)
+	resp.SetReply(req)
+	r.Equal(req.Header.ID, resp.Header.ID)
+	r.True(resp.Header.Response())
+	r.True(resp.Header.RecursionDesired())
+	r.True(resp.Header.CheckingDisabled())
+	r.Equal(OpcodeQuery, resp.Header.OpCode())
+	r.Equal(uint16(1), resp.Header.Qdcount)
+
+	got := new(Response)
+	r.NoError(got.Unpack(resp.Pack()))
+	r.Equal("Example.com.", b2s(got.Question.Name))
+	r.Equal(TypeMX, got.Question.Type)
+}
+
+func TestResponsePackTo(t *testing.T) {
+	r := assert.New(t)
+	resp := new(Response)
+	resp.Question.Name = []byte("example.com.")
+	resp.Question.Type = TypeTXT
+	resp.Question.Class = ClassINET
+	txt := &TXT{Hdr: RR_Header{Name: "example.com.", Rrtype: TypeTXT, Class: ClassINET, Ttl: 60}}
+	for range 40 {
+		txt.TXT = append(txt.TXT, string(make([]byte, 255)))
+	}
+	resp.Answer = []RR{txt}
+
+	// A small buffer grows until the message fits.
+	b, err := resp.PackTo(make([]byte, 0, 16))
+	r.NoError(err)
+	r.True(len(b) > 10000)
+
+	// Other errors are not retried.
+	resp.Answer = append(resp.Answer, &A{Hdr: RR_Header{Name: "a..example.", Rrtype: TypeA, Class: ClassINET}})
+	_, err = resp.PackTo(nil)
+	r.Error(err)
+	r.False(errors.Is(err, ErrBuf))
+}
@@ -0,0 +1,219 @@
+package dns
+
+import (
+	"errors"
+	"iter"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// Reverse mapping zones, see RFC 1035, section 3.5 and RFC 3596, section 2.5.
+const (
+	reverseV4 = ".in-addr.arpa."
+	reverseV6 = ".ip6.arpa."
+)
+
+var (
+	// ErrNotReverse is returned when a name is not a reverse mapping name of
+	// a single address.
+	ErrNotReverse = errors.New("dns: not a reverse mapping name")
+	// ErrNotClassless is returned for a prefix that has no RFC 2317 zone:
+	// only IPv4 prefixes from /25 to /31 are delegated that way.
+	ErrNotClassless = errors.New("dns: prefix needs no classless delegation")
+)
+
+// ReverseAddr returns the reverse mapping name of addr with a trailing dot,
+// e.g. "1.2.0.192.in-addr.arpa." or "1.0.0.0...8.b.d.0.1.0.0.2.ip6.arpa.".
+// IPv4-mapped IPv6 addresses map to in-addr.arpa.
+func ReverseAddr(addr netip.Addr) string {
+	return string(appendReverse(make([]byte, 0, 73), addr.Unmap()))
+}
+
+func appendReverse(b []byte, addr netip.Addr) []byte {
+	if addr.Is4() {
+		ip := addr.As4()
+		for i := 3; i >= 0; i-- {
+			b = strconv.AppendUint(b, uint64(ip[i]), 10)
+			b = append(b, '.')
+		}
+		return append(b, reverseV4[1:]...)
+	}
+	const hexDigits = "0123456789abcdef"
+	ip := addr.As16()
+	for i := 15; i >= 0; i-- {
+		b = append(b, hexDigits[ip[i]&0xf], '.', hexDigits[ip[i]>>4], '.')
+	}
+	return append(b, reverseV6[1:]...)
+}
+
+// ParseReverse returns the address of a reverse mapping name, with or
+// without the trailing dot. Owner names in an RFC 2317 classless zone, such
+// as "5.0/26.2.0.192.in-addr.arpa.", are understood too.
+func ParseReverse(name string) (netip.Addr, error) {
+	name = strings.ToLower(Fqdn(name))
+	switch {
+	case strings.HasSuffix(name, reverseV4):
+		return parseReverse4(name[:len(name)-len(reverseV4)])
+	case strings.HasSuffix(name, reverseV6):
+		return parseReverse6(name[:len(name)-len(reverseV6)])
+	}
+	return netip.Addr{}, ErrNotReverse
+}
+
+func parseReverse4(s string) (netip.Addr, error) {
+	labels := strings.Split(s, ".")
+	if len(labels) == 5 && strings.ContainsAny(labels[1], "/-") {
+		// Drop the label of the classless zone.
+		labels = append(labels[:1], labels[2:]...)
+	}
+	if len(labels) != 4 {
+		return netip.Addr{}, ErrNotReverse
+	}
+	var ip [4]byte
+	for i, l := range labels {
+		if len(l) == 0 || len(l) > 3 || (len(l) > 1 && l[0] == '0') {
+			return netip.Addr{}, ErrNotReverse
+		}
+		v, err := strconv.ParseUint(l, 10, 8)
+		if err != nil {
+			return netip.Addr{}, ErrNotReverse
+		}
+		ip[3-i] = byte(v)
+	}
+	return netip.AddrFrom4(ip), nil
+}
+
+func parseReverse6(s string) (netip.Addr, error) {
+	// 32 nibbles, each followed by a dot except the last.
+	if len(s) != 63 {
+		return netip.Addr{}, ErrNotReverse
+	}
+	var ip [16]byte
+	for i := range 32 {
+		if i < 31 && s[2*i+1] != '.' {
+			return netip.Addr{}, ErrNotReverse
+		}
+		v, ok := fromHex(s[2*i])
+		if !ok {
+			return netip.Addr{}, ErrNotReverse
+		}
+		if i%2 == 0 {
+			ip[15-i/2] |= v
+		} else {
+			ip[15-i/2] |= v << 4
+		}
+	}
+	return netip.AddrFrom16(ip), nil
+}
+
+func fromHex(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	}
+	return 0, false
+}
+
+// PTRs returns PTR records for every address of p, in address order, owned by
+// the reverse mapping name of the address. target returns the host name of
+// an address; addresses for which it returns "" are skipped. Records are
+// built lazily, so a large prefix costs no memory up front.
+func PTRs(p netip.Prefix, ttl uint32, target func(netip.Addr) string) iter.Seq[RR] {
+	return func(yield func(RR) bool) {
+		for addr := range prefixAddrs(p) {
+			host := target(addr)
+			if host == "" {
+				continue
+			}
+			if !yield(newPTR(ReverseAddr(addr), ttl, host)) {
+				return
+			}
+		}
+	}
+}
+
+// ClasslessZone returns the name of the RFC 2317 zone delegated for the IPv4
+// prefix p, from /25 to /31, e.g. "0/26.2.0.192.in-addr.arpa." for
+// 192.0.2.0/26.
+func ClasslessZone(p netip.Prefix) (string, error) {
+	p = p.Masked()
+	if !p.Addr().Is4() || p.Bits() <= 24 || p.Bits() >= 32 {
+		return "", ErrNotClassless
+	}
+	ip := p.Addr().As4()
+	b := make([]byte, 0, 40)
+	b = strconv.AppendUint(b, uint64(ip[3]), 10)
+	b = append(b, '/')
+	b = strconv.AppendInt(b, int64(p.Bits()), 10)
+	b = append(b, '.')
+	for i := 2; i >= 0; i-- {
+		b = strconv.AppendUint(b, uint64(ip[i]), 10)
+		b = append(b, '.')
+	}
+	return string(append(b, reverseV4[1:]...)), nil
+}
+
+// ClasslessCNAMEs returns the CNAME records the /24 parent zone holds to
+// delegate p as described in RFC 2317: one per address, pointing into the
+// zone named by ClasslessZone.
+func ClasslessCNAMEs(p netip.Prefix, ttl uint32) (iter.Seq[RR], error) {
+	zone, err := ClasslessZone(p)
+	if err != nil {
+		return nil, err
+	}
+	return func(yield func(RR) bool) {
+		for addr := range prefixAddrs(p) {
+			last := strconv.Itoa(int(addr.As4()[3]))
+			rr := &CNAME{
+				Hdr:   RR_Header{Name: ReverseAddr(addr), Rrtype: TypeCNAME, Class: ClassINET, Ttl: ttl},
+				CNAME: last + "." + zone,
+			}
+			if !yield(rr) {
+				return
+			}
+		}
+	}, nil
+}
+
+// ClasslessPTRs returns the PTR records of the RFC 2317 zone of p, owned by
+// the names the CNAMEs of ClasslessCNAMEs point to. target works as for PTRs.
+func ClasslessPTRs(p netip.Prefix, ttl uint32, target func(netip.Addr) string) (iter.Seq[RR], error) {
+	zone, err := ClasslessZone(p)
+	if err != nil {
+		return nil, err
+	}
+	return func(yield func(RR) bool) {
+		for addr := range prefixAddrs(p) {
+			host := target(addr)
+			if host == "" {
+				continue
+			}
+			owner := strconv.Itoa(int(addr.As4()[3])) + "." + zone
+			if !yield(newPTR(owner, ttl, host)) {
+				return
+			}
+		}
+	}, nil
+}
+
+func newPTR(owner string, ttl uint32, host string) *PTR {
+	return &PTR{
+		Hdr: RR_Header{Name: owner, Rrtype: TypePTR, Class: ClassINET, Ttl: ttl},
+		Ptr: Fqdn(host),
+	}
+}
+
+// prefixAddrs yields the addresses of p in order.
+func prefixAddrs(p netip.Prefix) iter.Seq[netip.Addr] {
+	return func(yield func(netip.Addr) bool) {
+		p = p.Masked()
+		for addr := p.Addr(); addr.IsValid() && p.Contains(addr); addr = addr.Next() {
+			if !yield(addr) {
+				return
+			}
+		}
+	}
+}
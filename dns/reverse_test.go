@@ -0,0 +1,111 @@
+package dns
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+)
+
+func TestReverseAddr(t *testing.T) {
+	r := assert.New(t)
+	tests := []struct {
+		addr string
+		name string
+	}{
+		{"192.0.2.1", "1.2.0.192.in-addr.arpa."},
+		{"0.0.0.0", "0.0.0.0.in-addr.arpa."},
+		{"::ffff:198.51.100.255", "255.100.51.198.in-addr.arpa."},
+		{"2001:db8::567:89ab", "b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."},
+	}
+	for _, tt := range tests {
+		addr := netip.MustParseAddr(tt.addr)
+		r.Equal(tt.name, ReverseAddr(addr))
+		back, err := ParseReverse(tt.name)
+		r.NoError(err, tt.name)
+		r.Equal(addr.Unmap(), back)
+	}
+
+	addr, err := ParseReverse("1.2.0.192.IN-ADDR.ARPA")
+	r.NoError(err)
+	r.Equal(netip.MustParseAddr("192.0.2.1"), addr)
+	addr, err = ParseReverse("5.0/26.2.0.192.in-addr.arpa.")
+	r.NoError(err)
+	r.Equal(netip.MustParseAddr("192.0.2.5"), addr)
+
+	for _, name := range []string{
+		"2.0.192.in-addr.arpa.",
+		"256.2.0.192.in-addr.arpa.",
+		"01.2.0.192.in-addr.arpa.",
+		"1..0.192.in-addr.arpa.",
+		"a.2.0.192.in-addr.arpa.",
+		"b.a.9.8.ip6.arpa.",
+		"g.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.",
+		"www.example.com.",
+	} {
+		_, err := ParseReverse(name)
+		r.ErrorIs(err, ErrNotReverse, name)
+	}
+}
+
+func TestPTRs(t *testing.T) {
+	r := assert.New(t)
+	target := func(addr netip.Addr) string {
+		if addr.As4()[3] == 2 {
+			return ""
+		}
+		return "host-" + addr.String() + ".example.com"
+	}
+	var got []string
+	for rr := range PTRs(netip.MustParsePrefix("192.0.2.0/30"), 3600, target) {
+		got = append(got, rr.String())
+	}
+	r.DeepEqual([]string{
+		"0.2.0.192.in-addr.arpa.\t3600\tIN\tPTR\thost-192.0.2.0.example.com.",
+		"1.2.0.192.in-addr.arpa.\t3600\tIN\tPTR\thost-192.0.2.1.example.com.",
+		"3.2.0.192.in-addr.arpa.\t3600\tIN\tPTR\thost-192.0.2.3.example.com.",
+	}, got)
+
+	n := 0
+	for range PTRs(netip.MustParsePrefix("2001:db8::/120"), 60, func(netip.Addr) string { return "h.example." }) {
+		n++
+	}
+	r.Equal(256, n)
+}
+
+func TestClassless(t *testing.T) {
+	r := assert.New(t)
+	p := netip.MustParsePrefix("192.0.2.70/26")
+	zone, err := ClasslessZone(p)
+	r.NoError(err)
+	r.Equal("64/26.2.0.192.in-addr.arpa.", zone)
+
+	cnames, err := ClasslessCNAMEs(p, 300)
+	r.NoError(err)
+	var got []RR
+	for rr := range cnames {
+		got = append(got, rr)
+	}
+	r.Equal(64, len(got))
+	r.Equal("64.2.0.192.in-addr.arpa.\t300\tIN\tCNAME\t64.64/26.2.0.192.in-addr.arpa.", got[0].String())
+	r.Equal("127.2.0.192.in-addr.arpa.\t300\tIN\tCNAME\t127.64/26.2.0.192.in-addr.arpa.", got[63].String())
+
+	ptrs, err := ClasslessPTRs(p, 300, func(addr netip.Addr) string { return "h" + addr.String() + ".example" })
+	r.NoError(err)
+	for rr := range ptrs {
+		// Each PTR owner is the target of the matching CNAME and maps back
+		// to its address.
+		r.Equal("64.64/26.2.0.192.in-addr.arpa.", rr.Header().Name)
+		addr, err := ParseReverse(rr.Header().Name)
+		r.NoError(err)
+		r.Equal(netip.MustParseAddr("192.0.2.64"), addr)
+		break
+	}
+
+	for _, s := range []string{"192.0.2.0/24", "192.0.2.1/32", "2001:db8::/126"} {
+		_, err := ClasslessZone(netip.MustParsePrefix(s))
+		r.ErrorIs(err, ErrNotClassless, s)
+	}
+	_, err = ClasslessCNAMEs(netip.MustParsePrefix("10.0.0.0/8"), 60)
+	r.ErrorIs(err, ErrNotClassless)
+}
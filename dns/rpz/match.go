@@ -0,0 +1,259 @@
+package rpz
+
+import (
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/dnsoa/go/dns"
+)
+
+// prefixTable is a longest-prefix-match table keyed by masked prefixes.
+type prefixTable struct {
+	rules map[netip.Prefix]*Rule
+	// bits lists the prefix lengths in use, longest first.
+	bits []int
+}
+
+func (t *prefixTable) add(p netip.Prefix, r *Rule) {
+	if t.rules == nil {
+		t.rules = make(map[netip.Prefix]*Rule)
+	}
+	t.rules[p] = r
+	bits := p.Bits()
+	if p.Addr().Is4() {
+		// Keep IPv4 and IPv6 lengths apart: IPv4 lengths are stored offset by 96.
+		bits += 96
+	}
+	if !slices.Contains(t.bits, bits) {
+		t.bits = append(t.bits, bits)
+		slices.SortFunc(t.bits, func(a, b int) int { return b - a })
+	}
+}
+
+func (t *prefixTable) exact(p netip.Prefix) *Rule {
+	return t.rules[p]
+}
+
+func (t *prefixTable) lookup(addr netip.Addr) (*Rule, bool) {
+	if len(t.rules) == 0 || !addr.IsValid() {
+		return nil, false
+	}
+	addr = addr.Unmap()
+	for _, bits := range t.bits {
+		if addr.Is4() {
+			if bits < 96 {
+				continue
+			}
+			bits -= 96
+		} else if bits > 128 {
+			continue
+		}
+		p, err := addr.Prefix(bits)
+		if err != nil {
+			continue
+		}
+		if r, ok := t.rules[p]; ok {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// MatchQName returns the rule triggered by qname, preferring exact over wildcard matches.
+func (z *Zone) MatchQName(qname string) (*Rule, bool) {
+	return lookupName(z.qname.Lookup, qname)
+}
+
+// MatchNSDName returns the rule triggered by the name server name ns.
+func (z *Zone) MatchNSDName(ns string) (*Rule, bool) {
+	return lookupName(z.nsdname.Lookup, ns)
+}
+
+// MatchClientIP returns the rule with the longest prefix covering the client address.
+func (z *Zone) MatchClientIP(addr netip.Addr) (*Rule, bool) {
+	return z.clientIP.lookup(addr)
+}
+
+// MatchResponseIP returns the rule with the longest prefix covering an answer address.
+func (z *Zone) MatchResponseIP(addr netip.Addr) (*Rule, bool) {
+	return z.responseIP.lookup(addr)
+}
+
+func lookupName(lookup func(string) (*Rule, bool), name string) (*Rule, bool) {
+	name = canonical(name)
+	if name == "" {
+		return nil, false
+	}
+	r, ok := lookup(name)
+	if !ok || r == nil || r.Action == 0 {
+		return nil, false
+	}
+	return r, true
+}
+
+// MatchQuery checks the triggers that are known before resolution: client IP
+// first, then QNAME.
+func (z *Zone) MatchQuery(client netip.Addr, qname string) (*Rule, bool) {
+	if r, ok := z.MatchClientIP(client); ok {
+		return r, true
+	}
+	return z.MatchQName(qname)
+}
+
+// MatchResponse checks the triggers that depend on the resolved response:
+// addresses in the answer section, then name server names in the authority section.
+func (z *Zone) MatchResponse(resp *dns.Response) (*Rule, bool) {
+	for _, rr := range resp.Answer {
+		var addr netip.Addr
+		switch v := rr.(type) {
+		case *dns.A:
+			addr = netip.AddrFrom4(v.A)
+		case *dns.AAAA:
+			addr, _ = netip.AddrFromSlice(v.AAAA)
+		default:
+			continue
+		}
+		if r, ok := z.MatchResponseIP(addr); ok {
+			return r, true
+		}
+	}
+	for _, rr := range resp.Ns {
+		if ns, ok := rr.(*dns.NS); ok {
+			if r, ok := z.MatchNSDName(ns.NS); ok {
+				return r, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Engine evaluates an ordered list of policy zones. The first zone with a
+// matching rule decides.
+type Engine struct {
+	Zones []*Zone
+}
+
+// NewEngine returns an engine over zones, highest precedence first.
+func NewEngine(zones ...*Zone) *Engine {
+	return &Engine{Zones: zones}
+}
+
+// Query returns the rule that applies before resolution, if any.
+func (e *Engine) Query(client netip.Addr, qname string) (*Rule, bool) {
+	for _, z := range e.Zones {
+		if r, ok := z.MatchQuery(client, qname); ok {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// Response returns the rule that applies to a resolved response, if any.
+func (e *Engine) Response(resp *dns.Response) (*Rule, bool) {
+	for _, z := range e.Zones {
+		if r, ok := z.MatchResponse(resp); ok {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// Apply rewrites resp according to the rule. It reports false when the query
+// must be dropped without an answer. The question of resp selects which local
+// data records are returned and becomes their owner name.
+func (r *Rule) Apply(resp *dns.Response) bool {
+	switch r.Action {
+	case ActionDrop:
+		return false
+	case ActionPassthru:
+		return true
+	case ActionNXDOMAIN:
+		resetSections(resp)
+		resp.Header.SetRcode(dns.RcodeNameError)
+	case ActionNODATA:
+		resetSections(resp)
+		resp.Header.SetRcode(dns.RcodeSuccess)
+	case ActionLocalData:
+		resetSections(resp)
+		resp.Header.SetRcode(dns.RcodeSuccess)
+		resp.Answer = append(resp.Answer, r.LocalData(string(resp.Question.Name), resp.Question.Type)...)
+		resp.Header.Ancount = uint16(len(resp.Answer))
+	}
+	resp.Header.SetAuthoritative()
+	return true
+}
+
+// LocalData returns copies of the local data records answering qname and qtype.
+// A CNAME is returned for any qtype. A CNAME target starting with "*." is
+// expanded with qname, so "*.walled-garden.example." rewrites
+// "bad.example." to "bad.example.walled-garden.example.".
+func (r *Rule) LocalData(qname string, qtype dns.Type) []dns.RR {
+	var out []dns.RR
+	owner := dns.Fqdn(qname)
+	for _, rr := range r.Data {
+		hdr := rr.Header()
+		if hdr.Rrtype != dns.TypeCNAME && qtype != dns.TypeANY && hdr.Rrtype != qtype {
+			continue
+		}
+		c := copyRR(rr)
+		c.Header().Name = owner
+		if cname, ok := c.(*dns.CNAME); ok && strings.HasPrefix(cname.CNAME, "*.") {
+			cname.CNAME = strings.TrimSuffix(owner, ".") + cname.CNAME[1:]
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+func resetSections(resp *dns.Response) {
+	resp.Answer = resp.Answer[:0]
+	resp.Ns = resp.Ns[:0]
+	extra := resp.Extra[:0]
+	for _, rr := range resp.Extra {
+		// Keep the OPT pseudo record so EDNS0 still reaches the client.
+		if rr.Header().Rrtype == dns.TypeOPT {
+			extra = append(extra, rr)
+		}
+	}
+	resp.Extra = extra
+	resp.Header.Ancount = 0
+	resp.Header.Nscount = 0
+	resp.Header.Arcount = uint16(len(extra))
+}
+
+func copyRR(rr dns.RR) dns.RR {
+	switch v := rr.(type) {
+	case *dns.A:
+		c := *v
+		return &c
+	case *dns.AAAA:
+		c := *v
+		return &c
+	case *dns.CNAME:
+		c := *v
+		return &c
+	case *dns.TXT:
+		c := *v
+		return &c
+	case *dns.MX:
+		c := *v
+		return &c
+	case *dns.NS:
+		c := *v
+		return &c
+	case *dns.PTR:
+		c := *v
+		return &c
+	case *dns.SRV:
+		c := *v
+		return &c
+	case *dns.SOA:
+		c := *v
+		return &c
+	case *dns.RFC3597:
+		c := *v
+		return &c
+	}
+	return rr
+}
@@ -0,0 +1,327 @@
+// Package rpz implements DNS Response Policy Zones.
+//
+// A policy zone is an ordinary zone whose owner names encode triggers and whose
+// records encode actions, see draft-vixie-dnsop-dns-rpz. The supported triggers
+// are QNAME, client IP (rpz-client-ip), response IP (rpz-ip) and NSDNAME
+// (rpz-nsdname). The supported actions are NXDOMAIN, NODATA, PASSTHRU, DROP and
+// local data.
+package rpz
+
+import (
+	"errors"
+	"io"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/trie"
+)
+
+// Trigger identifies what part of a query or response matched a rule.
+type Trigger uint8
+
+// Triggers in order of precedence.
+const (
+	TriggerClientIP Trigger = iota
+	TriggerQName
+	TriggerResponseIP
+	TriggerNSDName
+)
+
+func (t Trigger) String() string {
+	switch t {
+	case TriggerClientIP:
+		return "CLIENT-IP"
+	case TriggerQName:
+		return "QNAME"
+	case TriggerResponseIP:
+		return "IP"
+	case TriggerNSDName:
+		return "NSDNAME"
+	}
+	return "UNKNOWN"
+}
+
+// Action is the policy applied when a rule matches.
+type Action uint8
+
+const (
+	// ActionNXDOMAIN answers with NXDOMAIN.
+	ActionNXDOMAIN Action = iota + 1
+	// ActionNODATA answers with NOERROR and an empty answer section.
+	ActionNODATA
+	// ActionPassthru stops policy processing and leaves the response untouched.
+	ActionPassthru
+	// ActionDrop discards the query without answering.
+	ActionDrop
+	// ActionLocalData answers with the records of the rule.
+	ActionLocalData
+)
+
+func (a Action) String() string {
+	switch a {
+	case ActionNXDOMAIN:
+		return "NXDOMAIN"
+	case ActionNODATA:
+		return "NODATA"
+	case ActionPassthru:
+		return "PASSTHRU"
+	case ActionDrop:
+		return "DROP"
+	case ActionLocalData:
+		return "Local-Data"
+	}
+	return "UNKNOWN"
+}
+
+// Special CNAME targets that select an action instead of local data.
+const (
+	targetNXDOMAIN = "."
+	targetNODATA   = "*."
+	targetPassthru = "rpz-passthru."
+	targetDrop     = "rpz-drop."
+)
+
+// Trigger labels that follow the trigger data in an owner name.
+const (
+	labelClientIP = "rpz-client-ip"
+	labelIP       = "rpz-ip"
+	labelNSDName  = "rpz-nsdname"
+	labelNSIP     = "rpz-nsip"
+)
+
+var (
+	// ErrOutOfZone is returned when a record is not below the policy zone origin.
+	ErrOutOfZone = errors.New("rpz: record outside of policy zone")
+	// ErrBadTrigger is returned when an owner name does not encode a valid trigger.
+	ErrBadTrigger = errors.New("rpz: bad trigger")
+
+	// errUnsupportedTrigger marks rpz-nsip rules, which are skipped.
+	errUnsupportedTrigger = errors.New("rpz: unsupported trigger")
+)
+
+// Rule is a single policy: a trigger and the action taken when it matches.
+type Rule struct {
+	// Owner is the trigger name relative to the policy zone, e.g. "*.example.com"
+	// or "24.0.2.0.192.rpz-client-ip".
+	Owner   string
+	Trigger Trigger
+	Action  Action
+	// Data holds the records returned for ActionLocalData.
+	Data []dns.RR
+}
+
+// Zone is a loaded policy zone.
+type Zone struct {
+	qname      *trie.DomainTree[*Rule]
+	nsdname    *trie.DomainTree[*Rule]
+	clientIP   prefixTable
+	responseIP prefixTable
+	origin     string
+}
+
+// NewZone returns an empty policy zone with the given origin, e.g. "rpz.example.".
+func NewZone(origin string) *Zone {
+	return &Zone{
+		origin:  canonical(origin),
+		qname:   trie.NewDomainTree[*Rule](),
+		nsdname: trie.NewDomainTree[*Rule](),
+	}
+}
+
+// Origin returns the policy zone origin without a trailing dot.
+func (z *Zone) Origin() string {
+	return z.origin
+}
+
+// Load reads a policy zone in master file format from r and adds its records.
+func (z *Zone) Load(r io.Reader) error {
+	rrs, err := dns.ParseZone(r, z.origin)
+	if err != nil {
+		return err
+	}
+	for _, rr := range rrs {
+		if err := z.Add(rr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Add adds a policy record. SOA and NS records at the zone apex are ignored.
+func (z *Zone) Add(rr dns.RR) error {
+	hdr := rr.Header()
+	owner, ok := z.relative(hdr.Name)
+	if !ok {
+		return ErrOutOfZone
+	}
+	if owner == "" {
+		// Apex records carry zone metadata, not policy.
+		return nil
+	}
+
+	trigger, key, err := parseTrigger(owner)
+	if err == errUnsupportedTrigger {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var rule *Rule
+	switch trigger {
+	case TriggerQName:
+		rule, _ = z.qname.Lookup(key)
+		if rule == nil || rule.Owner != owner {
+			rule = &Rule{Owner: owner, Trigger: TriggerQName}
+			z.qname.Add(key, rule)
+		}
+	case TriggerNSDName:
+		rule, _ = z.nsdname.Lookup(key)
+		if rule == nil || rule.Owner != owner {
+			rule = &Rule{Owner: owner, Trigger: TriggerNSDName}
+			z.nsdname.Add(key, rule)
+		}
+	case TriggerClientIP, TriggerResponseIP:
+		prefix, err := parsePrefix(key)
+		if err != nil {
+			return err
+		}
+		t := &z.clientIP
+		if trigger == TriggerResponseIP {
+			t = &z.responseIP
+		}
+		rule = t.exact(prefix)
+		if rule == nil {
+			rule = &Rule{Owner: owner, Trigger: trigger}
+			t.add(prefix, rule)
+		}
+	}
+	return rule.add(rr)
+}
+
+// add folds a policy record into the rule.
+func (r *Rule) add(rr dns.RR) error {
+	if cname, ok := rr.(*dns.CNAME); ok {
+		switch strings.ToLower(dns.Fqdn(cname.CNAME)) {
+		case targetNXDOMAIN:
+			r.Action, r.Data = ActionNXDOMAIN, nil
+			return nil
+		case targetNODATA:
+			r.Action, r.Data = ActionNODATA, nil
+			return nil
+		case targetPassthru:
+			r.Action, r.Data = ActionPassthru, nil
+			return nil
+		case targetDrop:
+			r.Action, r.Data = ActionDrop, nil
+			return nil
+		}
+	}
+	r.Action = ActionLocalData
+	r.Data = append(r.Data, rr)
+	return nil
+}
+
+// relative strips the zone origin from name.
+func (z *Zone) relative(name string) (string, bool) {
+	name = canonical(name)
+	if name == z.origin {
+		return "", true
+	}
+	if z.origin == "" {
+		return name, true
+	}
+	if strings.HasSuffix(name, "."+z.origin) {
+		return name[:len(name)-len(z.origin)-1], true
+	}
+	return "", false
+}
+
+// parseTrigger splits a relative owner name into its trigger and trigger data.
+// It returns errUnsupportedTrigger for recognised triggers that are not implemented.
+func parseTrigger(owner string) (Trigger, string, error) {
+	label := owner
+	if i := strings.LastIndexByte(owner, '.'); i >= 0 {
+		label = owner[i+1:]
+	}
+	var t Trigger
+	switch label {
+	case labelClientIP:
+		t = TriggerClientIP
+	case labelIP:
+		t = TriggerResponseIP
+	case labelNSDName:
+		t = TriggerNSDName
+	case labelNSIP:
+		return 0, "", errUnsupportedTrigger
+	default:
+		return TriggerQName, owner, nil
+	}
+	if len(owner) == len(label) {
+		return 0, "", ErrBadTrigger
+	}
+	return t, owner[:len(owner)-len(label)-1], nil
+}
+
+// parsePrefix decodes the reversed prefix notation used by IP triggers:
+// "24.0.2.0.192" for 192.0.2.0/24 and "48.zz.1.db8.2001" for 2001:db8:1::/48.
+func parsePrefix(s string) (netip.Prefix, error) {
+	labels := strings.Split(s, ".")
+	if len(labels) < 2 {
+		return netip.Prefix{}, ErrBadTrigger
+	}
+	bits, err := strconv.Atoi(labels[0])
+	if err != nil {
+		return netip.Prefix{}, ErrBadTrigger
+	}
+	labels = labels[1:]
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+
+	var addr netip.Addr
+	if len(labels) == 4 && !strings.Contains(s, "zz") && bits <= 32 && isDecimal(labels) {
+		addr, err = netip.ParseAddr(strings.Join(labels, "."))
+	} else {
+		var v6 strings.Builder
+		for i, l := range labels {
+			if i > 0 {
+				v6.WriteByte(':')
+			}
+			if l != "zz" {
+				v6.WriteString(l)
+			} else if i == 0 || i == len(labels)-1 {
+				v6.WriteByte(':')
+			}
+		}
+		addr, err = netip.ParseAddr(v6.String())
+		if err == nil && !addr.Is6() {
+			err = ErrBadTrigger
+		}
+	}
+	if err != nil {
+		return netip.Prefix{}, ErrBadTrigger
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil || prefix.Addr() != addr {
+		return netip.Prefix{}, ErrBadTrigger
+	}
+	return prefix, nil
+}
+
+func isDecimal(labels []string) bool {
+	for _, l := range labels {
+		if _, err := strconv.ParseUint(l, 10, 8); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// canonical lowercases name and removes the trailing dot.
+func canonical(name string) string {
+	name = strings.TrimSuffix(name, ".")
+	return strings.ToLower(name)
+}
@@ -0,0 +1,204 @@
+package rpz
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+)
+
+const policy = `$TTL 60
+@	IN SOA localhost. root.localhost. 1 3600 600 86400 60
+	IN NS localhost.
+blocked.example.com	CNAME .
+*.blocked.example.com	CNAME .
+nodata.example.com	CNAME *.
+allowed.blocked.example.com	CNAME rpz-passthru.
+drop.example.com	CNAME rpz-drop.
+local.example.com	A 192.0.2.10
+local.example.com	TXT "blocked by policy"
+*.garden.example.com	CNAME *.walled.example.net.
+32.1.0.0.10.rpz-client-ip	CNAME rpz-drop.
+24.0.0.0.10.rpz-client-ip	CNAME rpz-passthru.
+24.0.113.0.203.rpz-ip	CNAME .
+48.zz.1.db8.2001.rpz-ip	CNAME *.
+ns.evil.example.rpz-nsdname	CNAME .
+32.1.0.0.127.rpz-nsip	CNAME .
+`
+
+func loadZone(t *testing.T) *Zone {
+	z := NewZone("rpz.example.")
+	assert.NoError(t, z.Load(strings.NewReader(policy)))
+	return z
+}
+
+func TestQName(t *testing.T) {
+	r := assert.New(t)
+	z := loadZone(t)
+
+	tests := []struct {
+		qname  string
+		action Action
+	}{
+		{"blocked.example.com.", ActionNXDOMAIN},
+		{"BLOCKED.Example.com", ActionNXDOMAIN},
+		{"a.b.blocked.example.com.", ActionNXDOMAIN},
+		{"allowed.blocked.example.com.", ActionPassthru},
+		{"nodata.example.com.", ActionNODATA},
+		{"drop.example.com.", ActionDrop},
+		{"local.example.com.", ActionLocalData},
+		{"x.garden.example.com.", ActionLocalData},
+	}
+	for _, tt := range tests {
+		rule, ok := z.MatchQName(tt.qname)
+		r.True(ok, tt.qname)
+		r.Equal(tt.action, rule.Action, tt.qname)
+		r.Equal(TriggerQName, rule.Trigger)
+	}
+
+	_, ok := z.MatchQName("example.com.")
+	r.False(ok)
+	_, ok = z.MatchQName("garden.example.com.")
+	r.False(ok)
+}
+
+func TestClientIP(t *testing.T) {
+	r := assert.New(t)
+	z := loadZone(t)
+
+	rule, ok := z.MatchClientIP(netip.MustParseAddr("10.0.0.1"))
+	r.True(ok)
+	r.Equal(ActionDrop, rule.Action)
+
+	rule, ok = z.MatchClientIP(netip.MustParseAddr("10.0.0.2"))
+	r.True(ok)
+	r.Equal(ActionPassthru, rule.Action)
+
+	rule, ok = z.MatchClientIP(netip.MustParseAddr("::ffff:10.0.0.1"))
+	r.True(ok)
+	r.Equal(ActionDrop, rule.Action)
+
+	_, ok = z.MatchClientIP(netip.MustParseAddr("10.0.1.1"))
+	r.False(ok)
+
+	// Client IP takes precedence over QNAME.
+	rule, ok = z.MatchQuery(netip.MustParseAddr("10.0.0.1"), "blocked.example.com.")
+	r.True(ok)
+	r.Equal(TriggerClientIP, rule.Trigger)
+	rule, ok = z.MatchQuery(netip.MustParseAddr("192.0.2.1"), "blocked.example.com.")
+	r.True(ok)
+	r.Equal(TriggerQName, rule.Trigger)
+}
+
+func TestResponseTriggers(t *testing.T) {
+	r := assert.New(t)
+	z := loadZone(t)
+
+	resp := new(dns.Response)
+	resp.SetQuestion("www.example.org.", dns.TypeA, dns.ClassINET)
+	resp.Answer = append(resp.Answer, &dns.A{
+		Hdr: dns.RR_Header{Name: "www.example.org.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+		A:   [4]byte{203, 0, 113, 7},
+	})
+	rule, ok := z.MatchResponse(resp)
+	r.True(ok)
+	r.Equal(TriggerResponseIP, rule.Trigger)
+	r.Equal(ActionNXDOMAIN, rule.Action)
+
+	rule, ok = z.MatchResponseIP(netip.MustParseAddr("2001:db8:1::53"))
+	r.True(ok)
+	r.Equal(ActionNODATA, rule.Action)
+	_, ok = z.MatchResponseIP(netip.MustParseAddr("2001:db8:2::53"))
+	r.False(ok)
+
+	resp = new(dns.Response)
+	resp.SetQuestion("www.example.org.", dns.TypeA, dns.ClassINET)
+	resp.Ns = append(resp.Ns, &dns.NS{
+		Hdr: dns.RR_Header{Name: "example.org.", Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: 60},
+		NS:  "ns.evil.example.",
+	})
+	rule, ok = z.MatchResponse(resp)
+	r.True(ok)
+	r.Equal(TriggerNSDName, rule.Trigger)
+}
+
+func TestApply(t *testing.T) {
+	r := assert.New(t)
+	z := loadZone(t)
+
+	resp := new(dns.Response)
+	resp.SetQuestion("local.example.com.", dns.TypeA, dns.ClassINET)
+	rule, ok := z.MatchQName("local.example.com.")
+	r.True(ok)
+	r.True(rule.Apply(resp))
+	r.Equal(dns.RcodeSuccess, resp.Header.Rcode())
+	r.Equal(1, len(resp.Answer))
+	a := resp.Answer[0].(*dns.A)
+	r.Equal("local.example.com.", a.Hdr.Name)
+	r.Equal([4]byte{192, 0, 2, 10}, a.A)
+
+	resp = new(dns.Response)
+	resp.SetQuestion("x.garden.example.com.", dns.TypeA, dns.ClassINET)
+	rule, _ = z.MatchQName("x.garden.example.com.")
+	r.True(rule.Apply(resp))
+	r.Equal(1, len(resp.Answer))
+	cname := resp.Answer[0].(*dns.CNAME)
+	r.Equal("x.garden.example.com.", cname.Hdr.Name)
+	r.Equal("x.garden.example.com.walled.example.net.", cname.CNAME)
+
+	resp = new(dns.Response)
+	resp.SetQuestion("blocked.example.com.", dns.TypeA, dns.ClassINET)
+	resp.Answer = append(resp.Answer, &dns.A{Hdr: dns.RR_Header{Name: "blocked.example.com.", Rrtype: dns.TypeA}})
+	rule, _ = z.MatchQName("blocked.example.com.")
+	r.True(rule.Apply(resp))
+	r.Equal(dns.RcodeNameError, resp.Header.Rcode())
+	r.Equal(0, len(resp.Answer))
+
+	rule, _ = z.MatchQName("drop.example.com.")
+	r.False(rule.Apply(resp))
+}
+
+func TestEngine(t *testing.T) {
+	r := assert.New(t)
+	first := NewZone("first.rpz.")
+	r.NoError(first.Load(strings.NewReader("blocked.example.com CNAME rpz-passthru.\n")))
+	second := loadZone(t)
+	e := NewEngine(first, second)
+
+	rule, ok := e.Query(netip.Addr{}, "blocked.example.com.")
+	r.True(ok)
+	r.Equal(ActionPassthru, rule.Action)
+
+	rule, ok = e.Query(netip.Addr{}, "drop.example.com.")
+	r.True(ok)
+	r.Equal(ActionDrop, rule.Action)
+}
+
+func TestParsePrefix(t *testing.T) {
+	r := assert.New(t)
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"32.1.2.0.192", "192.0.2.1/32"},
+		{"24.0.2.0.192", "192.0.2.0/24"},
+		{"128.1.zz.db8.2001", "2001:db8::1/128"},
+		{"48.zz.1.db8.2001", "2001:db8:1::/48"},
+		{"128.1.zz", "::1/128"},
+	}
+	for _, tt := range tests {
+		p, err := parsePrefix(tt.in)
+		r.NoError(err, tt.in)
+		r.Equal(tt.want, p.String())
+	}
+	for _, bad := range []string{"24", "33.1.2.0.192", "24.1.2.0.192", "x.1.2.0.192"} {
+		_, err := parsePrefix(bad)
+		r.ErrorIs(err, ErrBadTrigger, bad)
+	}
+
+	z := NewZone("rpz.example.")
+	r.ErrorIs(z.Add(&dns.CNAME{Hdr: dns.RR_Header{Name: "x.other.example."}, CNAME: "."}), ErrOutOfZone)
+	r.ErrorIs(z.Add(&dns.CNAME{Hdr: dns.RR_Header{Name: "rpz-ip.rpz.example."}, CNAME: "."}), ErrBadTrigger)
+}
@@ -59,7 +59,7 @@ func (rr *A) String() string {
 }
 func (rr *A) pack(msg []byte, off int) (off1 int, err error) {
 	if off+net.IPv4len > len(msg) {
-		return off, &Error{err: "overflow packing A"}
+		return off, ErrBuf
 	}
 	copy(msg[off:], rr.A[:])
 	off += net.IPv4len
@@ -226,7 +226,7 @@ func (rr *AAAA) Header() *RR_Header { return &rr.Hdr }
 
 func (rr *AAAA) pack(msg []byte, off int) (off1 int, err error) {
 	if off+net.IPv6len > len(msg) {
-		return off, &Error{err: "overflow packing AAAA"}
+		return off, ErrBuf
 	}
 	ip := rr.AAAA.To16()
 	if ip == nil {
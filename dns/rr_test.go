@@ -234,7 +234,7 @@ func TestPackRR(t *testing.T) {
 	}
 
 	msg := make([]byte, 512)
-	off, err := packRR(rr, msg, 0)
+	off, err := packRR(rr, msg, 0, nil)
 	r.NoError(err)
 	r.True(off > 0)
 }
@@ -265,52 +265,6 @@ func TestUnpackRR(t *testing.T) {
 	r.Equal("ns1.example.com.", ns.NS)
 }
 
-// Helper function to pack a complete RR
-func packRR(rr RR, msg []byte, off int) (int, error) {
-	// Pack domain name
-	off, err := packDomainName(rr.Header().Name, msg, off)
-	if err != nil {
-		return off, err
-	}
-
-	// Pack type
-	off, err = packUint16(uint16(rr.Header().Rrtype), msg, off)
-	if err != nil {
-		return off, err
-	}
-
-	// Pack class
-	off, err = packUint16(uint16(rr.Header().Class), msg, off)
-	if err != nil {
-		return off, err
-	}
-
-	// Pack TTL
-	off, err = packUint32(rr.Header().Ttl, msg, off)
-	if err != nil {
-		return off, err
-	}
-
-	// Pack RDATA
-	rdlengthOff := off
-	off += 2 // Skip RDLENGTH for now
-
-	rdStart := off
-	off, err = rr.pack(msg, off)
-	if err != nil {
-		return off, err
-	}
-
-	// Fill in RDLENGTH
-	rdlength := uint16(off - rdStart)
-	_, err = packUint16(rdlength, msg, rdlengthOff)
-	if err != nil {
-		return off, err
-	}
-
-	return off, nil
-}
-
 func BenchmarkPackA(b *testing.B) {
 	rr := &A{
 		Hdr: RR_Header{
@@ -125,6 +125,10 @@ var TypeToRR = map[Type]func() RR{
 	TypeSRV:   func() RR { return new(SRV) },
 	TypeAAAA:  func() RR { return new(AAAA) },
 	TypeOPT:   func() RR { return new(OPT) },
+
+	TypeNSEC:       func() RR { return new(NSEC) },
+	TypeNSEC3:      func() RR { return new(NSEC3) },
+	TypeNSEC3PARAM: func() RR { return new(NSEC3PARAM) },
 }
 
 // ClassToString is a maps Classes to strings for each CLASS wire type.
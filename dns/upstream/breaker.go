@@ -0,0 +1,158 @@
+package upstream
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// State is the state of a circuit breaker.
+type State uint8
+
+const (
+	// Closed lets queries through.
+	Closed State = iota
+	// Open holds queries back until the cooldown has passed.
+	Open
+	// HalfOpen lets a single probe query through after the cooldown; its
+	// outcome closes or reopens the breaker.
+	HalfOpen
+)
+
+var stateStrings = [...]string{"closed", "open", "half-open"}
+
+func (s State) String() string {
+	if int(s) < len(stateStrings) {
+		return stateStrings[s]
+	}
+	return "State(" + strconv.Itoa(int(s)) + ")"
+}
+
+// Breaker configures the circuit breakers of a Group. Zero fields take the
+// defaults.
+type Breaker struct {
+	// Window is the number of recent outcomes the failure ratio is taken
+	// over; 20 by default.
+	Window int
+	// MinQueries is the number of outcomes needed before the breaker can
+	// open; 5 by default.
+	MinQueries int
+	// FailureRatio opens the breaker when that share of the window failed;
+	// 0.5 by default.
+	FailureRatio float64
+	// Cooldown is how long an open breaker holds queries back before it
+	// lets a probe through; 10s by default.
+	Cooldown time.Duration
+}
+
+func (c Breaker) withDefaults() Breaker {
+	if c.Window <= 0 {
+		c.Window = 20
+	}
+	if c.MinQueries <= 0 {
+		c.MinQueries = 5
+	}
+	c.MinQueries = min(c.MinQueries, c.Window)
+	if c.FailureRatio <= 0 {
+		c.FailureRatio = 0.5
+	}
+	if c.Cooldown <= 0 {
+		c.Cooldown = 10 * time.Second
+	}
+	return c
+}
+
+// breaker is the circuit breaker of one upstream. It keeps the outcomes of
+// the last queries in a ring.
+type breaker struct {
+	mu       sync.Mutex
+	cfg      Breaker
+	state    State
+	outcomes []bool // true for a failure
+	next     int
+	n        int
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(cfg Breaker) *breaker {
+	cfg = cfg.withDefaults()
+	return &breaker{cfg: cfg, outcomes: make([]bool, cfg.Window)}
+}
+
+func (b *breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// allow reports whether a query may be sent, moving an open breaker whose
+// cooldown has passed to half-open and taking its single probe.
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case Open:
+		if now.Sub(b.openedAt) < b.cfg.Cooldown {
+			return false
+		}
+		b.state = HalfOpen
+		b.probing = true
+		return true
+	case HalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return true
+}
+
+// record adds the outcome of a query.
+func (b *breaker) record(failed bool, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case HalfOpen, Open:
+		// The probe, or a query sent while every breaker was open.
+		b.probing = false
+		if failed {
+			b.state = Open
+			b.openedAt = now
+			return
+		}
+		b.reset()
+		return
+	}
+	if b.n == len(b.outcomes) {
+		if b.outcomes[b.next] {
+			b.failures--
+		}
+	} else {
+		b.n++
+	}
+	b.outcomes[b.next] = failed
+	if failed {
+		b.failures++
+	}
+	b.next = (b.next + 1) % len(b.outcomes)
+	if b.n >= b.cfg.MinQueries && float64(b.failures) >= b.cfg.FailureRatio*float64(b.n) {
+		b.state = Open
+		b.openedAt = now
+	}
+}
+
+// release gives back a probe taken by allow whose query was abandoned.
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *breaker) reset() {
+	b.state = Closed
+	clear(b.outcomes)
+	b.next, b.n, b.failures = 0, 0, 0
+}
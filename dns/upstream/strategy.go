@@ -0,0 +1,63 @@
+package upstream
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"sync/atomic"
+)
+
+// Strategy chooses the order in which a Group tries its upstreams. Upstreams
+// whose circuit breaker is open are skipped when their turn comes.
+type Strategy interface {
+	// Order reorders ups, a copy of the upstreams of the group, in place.
+	Order(ups []*Upstream)
+}
+
+// Failover tries the upstreams in the order they were added: the next one
+// is only used when those before it fail or are unavailable.
+type Failover struct{}
+
+// Order implements Strategy.
+func (Failover) Order(ups []*Upstream) {}
+
+// RoundRobin starts each query at the next upstream in turn.
+type RoundRobin struct {
+	next atomic.Uint64
+}
+
+// Order implements Strategy.
+func (s *RoundRobin) Order(ups []*Upstream) {
+	if len(ups) < 2 {
+		return
+	}
+	i := int((s.next.Add(1) - 1) % uint64(len(ups)))
+	slices.Reverse(ups[:i])
+	slices.Reverse(ups[i:])
+	slices.Reverse(ups)
+}
+
+// Random tries the upstreams in a random order.
+type Random struct{}
+
+// Order implements Strategy.
+func (Random) Order(ups []*Upstream) {
+	rand.Shuffle(len(ups), func(i, j int) { ups[i], ups[j] = ups[j], ups[i] })
+}
+
+// Fastest tries the upstream with the lowest smoothed round trip time
+// first. Upstreams without a measurement yet come first, so that every one
+// gets measured.
+type Fastest struct{}
+
+// Order implements Strategy.
+func (Fastest) Order(ups []*Upstream) {
+	// Take the times once: they change while the slice is sorted.
+	rtts := make(map[*Upstream]int64, len(ups))
+	for _, u := range ups {
+		rtts[u] = u.rtt.Load()
+	}
+	slices.SortStableFunc(ups, func(a, b *Upstream) int {
+		return cmp.Compare(rtts[a], rtts[b])
+	})
+}
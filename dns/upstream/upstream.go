@@ -0,0 +1,192 @@
+// Package upstream chooses among the upstream servers of a forwarder.
+//
+// A Group sends each query to its upstreams in the order of a Strategy until
+// one answers without SERVFAIL. Every upstream has a circuit breaker fed by
+// timeouts, network errors and SERVFAIL responses; an open breaker takes the
+// upstream out of rotation until a probe query succeeds again.
+//
+//	g := upstream.NewGroup(&upstream.RoundRobin{}, "192.0.2.53:53", "198.51.100.53:53")
+//	resp, u, err := g.Exchange(ctx, req)
+package upstream
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/dns/internal/client"
+)
+
+// DefaultTimeout bounds a query to one upstream.
+const DefaultTimeout = time.Second
+
+// ErrNoUpstream is returned by a Group without upstreams.
+var ErrNoUpstream = errors.New("upstream: no upstream")
+
+// rttWeight is the weight of a new sample in the smoothed round trip time.
+const rttWeight = 0.3
+
+// Upstream is a server a Group forwards queries to.
+type Upstream struct {
+	// Addr is the host:port of the server.
+	Addr string
+
+	rtt     atomic.Int64 // smoothed, in nanoseconds; 0 before the first query
+	breaker *breaker
+}
+
+// RTT returns the smoothed round trip time of the upstream, 0 before it was
+// queried. Timeouts count as the full timeout.
+func (u *Upstream) RTT() time.Duration {
+	return time.Duration(u.rtt.Load())
+}
+
+// State returns the state of the circuit breaker of the upstream.
+func (u *Upstream) State() State {
+	return u.breaker.State()
+}
+
+func (u *Upstream) observe(rtt time.Duration) {
+	for {
+		old := u.rtt.Load()
+		v := int64(rtt)
+		if old != 0 {
+			v = old + int64(rttWeight*float64(int64(rtt)-old))
+		}
+		if u.rtt.CompareAndSwap(old, max(v, 1)) {
+			return
+		}
+	}
+}
+
+// Group is a set of upstreams tried in the order of a strategy.
+type Group struct {
+	ups      []*Upstream
+	strategy Strategy
+	// Net is "udp", the default, or "tcp".
+	Net string
+	// Observer, if set, is told about every query sent.
+	Observer dns.Observer
+	// Timeout bounds the query to each upstream; DefaultTimeout when zero.
+	Timeout time.Duration
+
+	breaker Breaker
+	now     func() time.Time
+}
+
+// NewGroup returns a group of the upstreams at addrs, host:port each,
+// ordered by strategy, with the default circuit breakers.
+func NewGroup(strategy Strategy, addrs ...string) *Group {
+	return NewGroupWithBreaker(strategy, Breaker{}, addrs...)
+}
+
+// NewGroupWithBreaker is NewGroup with circuit breakers configured by cfg.
+func NewGroupWithBreaker(strategy Strategy, cfg Breaker, addrs ...string) *Group {
+	g := &Group{strategy: strategy, breaker: cfg}
+	for _, addr := range addrs {
+		g.Add(addr)
+	}
+	return g
+}
+
+// Add adds the upstream at addr. It must not be called concurrently with
+// Exchange.
+func (g *Group) Add(addr string) *Upstream {
+	u := &Upstream{Addr: addr, breaker: newBreaker(g.breaker)}
+	g.ups = append(g.ups, u)
+	return u
+}
+
+// Upstreams returns the upstreams in the order they were added.
+func (g *Group) Upstreams() []*Upstream {
+	return append([]*Upstream(nil), g.ups...)
+}
+
+func (g *Group) time() time.Time {
+	if g.now != nil {
+		return g.now()
+	}
+	return time.Now()
+}
+
+// Exchange sends req, as packed by SetQuestion, to the upstreams in the
+// order of the strategy until one answers without SERVFAIL, and returns that
+// response and upstream. Upstreams whose breaker is open are skipped, unless
+// all are, in which case all are tried. When every upstream fails, the last
+// SERVFAIL response is returned if there was one, and the last error
+// otherwise.
+func (g *Group) Exchange(ctx context.Context, req *dns.Request) (*dns.Response, *Upstream, error) {
+	if len(g.ups) == 0 {
+		return nil, nil, ErrNoUpstream
+	}
+	order := append(make([]*Upstream, 0, len(g.ups)), g.ups...)
+	g.strategy.Order(order)
+
+	var (
+		last     *dns.Response
+		lastUp   *Upstream
+		lastErr  error
+		attempts int
+	)
+	// The second pass tries every upstream when all breakers are open.
+	for pass := range 2 {
+		for _, u := range order {
+			if pass == 0 && !u.breaker.allow(g.time()) {
+				continue
+			}
+			attempts++
+			resp, err := g.exchange(ctx, req, u)
+			if ctx.Err() != nil {
+				return resp, u, ctx.Err()
+			}
+			if err == nil && resp.Rcode() != dns.RcodeServerFailure {
+				return resp, u, nil
+			}
+			lastErr = err
+			if resp != nil {
+				last, lastUp = resp, u
+			}
+		}
+		if attempts > 0 {
+			break
+		}
+	}
+	if last != nil {
+		return last, lastUp, nil
+	}
+	return nil, nil, lastErr
+}
+
+// exchange sends req to u and feeds the outcome to its breaker and round
+// trip time.
+func (g *Group) exchange(ctx context.Context, req *dns.Request, u *Upstream) (*dns.Response, error) {
+	timeout := g.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	qctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	c := &client.Client{Net: g.Net, Observer: g.Observer}
+
+	start := time.Now()
+	resp, err := c.Exchange(qctx, req, u.Addr)
+	rtt := time.Since(start)
+	if ctx.Err() != nil {
+		// Abandoned by the caller: says nothing about the upstream.
+		u.breaker.release()
+		return resp, err
+	}
+	switch {
+	case err != nil && resp == nil:
+		if errors.Is(err, context.DeadlineExceeded) {
+			u.observe(timeout)
+		}
+		u.breaker.record(true, g.time())
+	default:
+		u.observe(rtt)
+		u.breaker.record(err != nil || resp.Rcode() == dns.RcodeServerFailure, g.time())
+	}
+	return resp, err
+}
@@ -0,0 +1,205 @@
+package upstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/dns"
+	"github.com/dnsoa/go/dns/dnstest"
+)
+
+const qname = "example.com."
+
+func newRequest() *dns.Request {
+	req := new(dns.Request)
+	req.SetQuestion(qname, dns.TypeA, dns.ClassINET)
+	return req
+}
+
+// newServer starts a fake upstream answering qname with addr.
+func newServer(t *testing.T, addr string) *dnstest.Server {
+	srv := dnstest.NewServer(t)
+	srv.Handle(qname, dns.TypeA, dnstest.Reply{Answer: dnstest.RRs(t, qname+" 60 IN A "+addr)})
+	return srv
+}
+
+func exchange(t *testing.T, g *Group) (*dns.Response, *Upstream) {
+	t.Helper()
+	resp, u, err := g.Exchange(context.Background(), newRequest())
+	assert.NoError(t, err)
+	return resp, u
+}
+
+func TestFailover(t *testing.T) {
+	r := assert.New(t)
+	bad := dnstest.NewServer(t)
+	bad.Handle(qname, dns.TypeA, dnstest.ServFail)
+	good := newServer(t, "192.0.2.2")
+
+	g := NewGroup(Failover{}, bad.Addr(), good.Addr())
+	resp, u := exchange(t, g)
+	dnstest.AssertAnswerContains(t, resp, qname+" 60 IN A 192.0.2.2")
+	r.Equal(good.Addr(), u.Addr)
+	r.Len(bad.Queries(), 1)
+	r.True(g.Upstreams()[0].RTT() > 0)
+}
+
+func TestBreaker(t *testing.T) {
+	r := assert.New(t)
+	bad := dnstest.NewServer(t)
+	bad.Handle(qname, dns.TypeA, dnstest.ServFail)
+	good := newServer(t, "192.0.2.2")
+
+	now := time.Unix(1e9, 0)
+	g := NewGroupWithBreaker(Failover{}, Breaker{Window: 4, MinQueries: 2, Cooldown: time.Minute}, bad.Addr(), good.Addr())
+	g.now = func() time.Time { return now }
+	first := g.Upstreams()[0]
+
+	exchange(t, g)
+	r.Equal(Closed, first.State())
+	exchange(t, g)
+	r.Equal(Open, first.State())
+	r.Len(bad.Queries(), 2)
+
+	// Open: the failing upstream is skipped.
+	_, u := exchange(t, g)
+	r.Equal(good.Addr(), u.Addr)
+	r.Len(bad.Queries(), 2)
+
+	// After the cooldown a probe goes through; it fails and reopens.
+	now = now.Add(time.Minute)
+	exchange(t, g)
+	r.Len(bad.Queries(), 3)
+	r.Equal(Open, first.State())
+	exchange(t, g)
+	r.Len(bad.Queries(), 3)
+
+	// The upstream recovers: the next probe closes the breaker.
+	bad.Handle(qname, dns.TypeA, dnstest.Reply{Answer: dnstest.RRs(t, qname+" 60 IN A 192.0.2.1")})
+	now = now.Add(time.Minute)
+	_, u = exchange(t, g)
+	r.Equal(bad.Addr(), u.Addr)
+	r.Equal(Closed, first.State())
+}
+
+func TestBreakerRatio(t *testing.T) {
+	r := assert.New(t)
+	now := time.Unix(1e9, 0)
+	b := newBreaker(Breaker{Window: 4, MinQueries: 4, FailureRatio: 0.5})
+
+	// One failure in four is below the ratio; old outcomes leave the window.
+	for _, failed := range []bool{true, false, false, false, false, true} {
+		b.record(failed, now)
+		r.Equal(Closed, b.State())
+	}
+	b.record(true, now)
+	r.Equal(Open, b.State())
+
+	r.False(b.allow(now))
+	now = now.Add(10 * time.Second)
+	r.True(b.allow(now))
+	r.Equal(HalfOpen, b.State())
+	// A single probe at a time.
+	r.False(b.allow(now))
+	b.release()
+	r.True(b.allow(now))
+	b.record(false, now)
+	r.Equal(Closed, b.State())
+}
+
+func TestTimeout(t *testing.T) {
+	r := assert.New(t)
+	slow := dnstest.NewServer(t)
+	slow.Handle(qname, dns.TypeA, dnstest.Reply{Drop: true})
+	good := newServer(t, "192.0.2.2")
+
+	g := NewGroupWithBreaker(Failover{}, Breaker{MinQueries: 1}, slow.Addr(), good.Addr())
+	g.Timeout = 50 * time.Millisecond
+	_, u := exchange(t, g)
+	r.Equal(good.Addr(), u.Addr)
+	first := g.Upstreams()[0]
+	r.Equal(50*time.Millisecond, first.RTT())
+	r.Equal(Open, first.State())
+}
+
+func TestAllOpen(t *testing.T) {
+	r := assert.New(t)
+	bad := dnstest.NewServer(t)
+	bad.Handle(qname, dns.TypeA, dnstest.ServFail)
+
+	g := NewGroupWithBreaker(Failover{}, Breaker{MinQueries: 1}, bad.Addr())
+	for range 3 {
+		resp, u := exchange(t, g)
+		dnstest.AssertRcode(t, resp, dns.RcodeServerFailure)
+		r.Equal(bad.Addr(), u.Addr)
+	}
+	// Every upstream is open, so all are tried anyway.
+	r.Len(bad.Queries(), 3)
+
+	_, _, err := NewGroup(Failover{}).Exchange(context.Background(), newRequest())
+	r.ErrorIs(err, ErrNoUpstream)
+}
+
+func TestRoundRobin(t *testing.T) {
+	r := assert.New(t)
+	var servers []*dnstest.Server
+	var addrs []string
+	for _, a := range []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"} {
+		srv := newServer(t, a)
+		servers = append(servers, srv)
+		addrs = append(addrs, srv.Addr())
+	}
+	g := NewGroup(&RoundRobin{}, addrs...)
+	var got []string
+	for range 6 {
+		_, u := exchange(t, g)
+		got = append(got, u.Addr)
+	}
+	r.DeepEqual(append(addrs, addrs...), got)
+	for _, srv := range servers {
+		r.Len(srv.Queries(), 2)
+	}
+}
+
+func TestRandom(t *testing.T) {
+	r := assert.New(t)
+	var servers []*dnstest.Server
+	var addrs []string
+	for _, a := range []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"} {
+		srv := newServer(t, a)
+		servers = append(servers, srv)
+		addrs = append(addrs, srv.Addr())
+	}
+	g := NewGroup(Random{}, addrs...)
+	for range 30 {
+		exchange(t, g)
+	}
+	for _, srv := range servers {
+		r.True(len(srv.Queries()) > 0, srv.Addr())
+	}
+}
+
+func TestFastest(t *testing.T) {
+	r := assert.New(t)
+	slow := dnstest.NewServer(t)
+	slow.Handle(qname, dns.TypeA, dnstest.Reply{Delay: 30 * time.Millisecond, Answer: dnstest.RRs(t, qname+" 60 IN A 192.0.2.1")})
+	fast := newServer(t, "192.0.2.2")
+
+	g := NewGroup(Fastest{}, slow.Addr(), fast.Addr())
+	for range 5 {
+		exchange(t, g)
+	}
+	// Each upstream is measured once, then the fast one wins.
+	r.Len(slow.Queries(), 1)
+	r.Len(fast.Queries(), 4)
+	ups := g.Upstreams()
+	r.True(ups[0].RTT() > ups[1].RTT())
+}
+
+func TestStateString(t *testing.T) {
+	r := assert.New(t)
+	r.Equal("half-open", HalfOpen.String())
+	r.Equal("State(7)", State(7).String())
+}
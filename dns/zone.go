@@ -0,0 +1,494 @@
+package dns
+
+import (
+	"bufio"
+	"encoding/hex"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// defaultZoneTTL is used for records that carry no TTL when the zone has no $TTL.
+const defaultZoneTTL = 3600
+
+// ParseZone reads a master file (RFC 1035, section 5) from r and returns its records.
+//
+// Relative owner names and rdata names are completed with origin. The $ORIGIN and
+// $TTL directives, parentheses, comments and quoted strings are understood. Types
+// without a typed RR in this package must use the RFC 3597 \# syntax.
+func ParseZone(r io.Reader, origin string) ([]RR, error) {
+	p := zoneParser{
+		origin: Fqdn(origin),
+		ttl:    defaultZoneTTL,
+	}
+	sc := bufio.NewScanner(r)
+	var (
+		fields []string
+		depth  int
+		lineNo int
+		start  int
+		blank  bool
+		rrs    []RR
+	)
+	for sc.Scan() {
+		lineNo++
+		tokens, d, err := tokenizeZoneLine(sc.Text(), depth)
+		if err != nil {
+			return nil, zoneError(lineNo, err.Error())
+		}
+		if depth == 0 {
+			start = lineNo
+			line := sc.Text()
+			blank = len(line) > 0 && (line[0] == ' ' || line[0] == '\t')
+			fields = fields[:0]
+		}
+		depth = d
+		fields = append(fields, tokens...)
+		if depth > 0 || len(fields) == 0 {
+			continue
+		}
+		rr, err := p.parse(fields, blank)
+		if err != nil {
+			return nil, zoneError(start, err.Error())
+		}
+		if rr != nil {
+			rrs = append(rrs, rr)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if depth > 0 {
+		return nil, zoneError(start, "unbalanced parenthesis")
+	}
+	return rrs, nil
+}
+
+// NewRR parses a single resource record in presentation format,
+// e.g. "example.com. 300 IN A 192.0.2.1".
+func NewRR(s string) (RR, error) {
+	rrs, err := ParseZone(strings.NewReader(s), ".")
+	if err != nil {
+		return nil, err
+	}
+	if len(rrs) != 1 {
+		return nil, &Error{err: "expected exactly one resource record"}
+	}
+	return rrs[0], nil
+}
+
+// Fqdn returns name with a trailing dot.
+func Fqdn(name string) string {
+	if name == "" {
+		return "."
+	}
+	if name[len(name)-1] == '.' && !isEscapedDot(name) {
+		return name
+	}
+	return name + "."
+}
+
+// isEscapedDot reports whether the final dot of name is escaped with a backslash.
+func isEscapedDot(name string) bool {
+	n := 0
+	for i := len(name) - 2; i >= 0 && name[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+func zoneError(line int, msg string) error {
+	return &Error{err: "zone line " + strconv.Itoa(line) + ": " + msg}
+}
+
+// tokenizeZoneLine splits a master file line into fields, honouring quotes,
+// escapes and comments. depth is the parenthesis nesting carried over from
+// previous lines.
+func tokenizeZoneLine(line string, depth int) ([]string, int, error) {
+	var (
+		fields []string
+		cur    strings.Builder
+		inTok  bool
+		quoted bool
+	)
+	flush := func() {
+		if inTok {
+			fields = append(fields, cur.String())
+			cur.Reset()
+			inTok = false
+		}
+	}
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && i+1 < len(line):
+			cur.WriteByte(c)
+			cur.WriteByte(line[i+1])
+			inTok = true
+			i++
+		case c == '"':
+			if quoted {
+				fields = append(fields, cur.String())
+				cur.Reset()
+				inTok = false
+			} else {
+				flush()
+			}
+			quoted = !quoted
+		case quoted:
+			cur.WriteByte(c)
+		case c == ';':
+			flush()
+			return fields, depth, nil
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+		case c == '(':
+			flush()
+			depth++
+		case c == ')':
+			flush()
+			if depth == 0 {
+				return nil, 0, &Error{err: "unbalanced parenthesis"}
+			}
+			depth--
+		default:
+			cur.WriteByte(c)
+			inTok = true
+		}
+	}
+	if quoted {
+		return nil, 0, &Error{err: "unterminated quoted string"}
+	}
+	flush()
+	return fields, depth, nil
+}
+
+type zoneParser struct {
+	origin string
+	owner  string
+	ttl    uint32
+	class  Class
+}
+
+func (p *zoneParser) name(s string) (string, error) {
+	switch {
+	case s == "":
+		return "", &Error{err: "empty domain name"}
+	case s == "@":
+		return p.origin, nil
+	case s == "." || (s[len(s)-1] == '.' && !isEscapedDot(s)):
+		return s, nil
+	case p.origin == ".":
+		return s + ".", nil
+	}
+	return s + "." + p.origin, nil
+}
+
+func (p *zoneParser) parse(fields []string, blank bool) (RR, error) {
+	switch strings.ToUpper(fields[0]) {
+	case "$ORIGIN":
+		if len(fields) < 2 {
+			return nil, &Error{err: "missing $ORIGIN value"}
+		}
+		origin, err := p.name(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		p.origin = origin
+		return nil, nil
+	case "$TTL":
+		if len(fields) < 2 {
+			return nil, &Error{err: "missing $TTL value"}
+		}
+		ttl, err := strconv.ParseUint(fields[1], 10, 32)
+		if err != nil {
+			return nil, &Error{err: "bad $TTL value " + fields[1]}
+		}
+		p.ttl = uint32(ttl)
+		return nil, nil
+	case "$INCLUDE", "$GENERATE":
+		return nil, &Error{err: "unsupported directive " + fields[0]}
+	}
+
+	if !blank {
+		owner, err := p.name(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		p.owner = owner
+		fields = fields[1:]
+	}
+	if p.owner == "" {
+		return nil, &Error{err: "record without owner name"}
+	}
+	hdr := RR_Header{Name: p.owner, Ttl: p.ttl, Class: p.class}
+	if hdr.Class == 0 {
+		hdr.Class = ClassINET
+	}
+	// TTL and class may appear in either order before the type.
+	for len(fields) > 0 {
+		f := fields[0]
+		if ttl, err := strconv.ParseUint(f, 10, 32); err == nil {
+			hdr.Ttl = uint32(ttl)
+		} else if c, ok := parseClass(f); ok {
+			hdr.Class = c
+			p.class = c
+		} else {
+			break
+		}
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return nil, &Error{err: "missing type"}
+	}
+	typ, ok := parseZoneType(fields[0])
+	if !ok {
+		return nil, &Error{err: "unknown type " + fields[0]}
+	}
+	hdr.Rrtype = typ
+	return p.rdata(hdr, fields[1:])
+}
+
+func parseClass(s string) (Class, bool) {
+	for c, name := range ClassToString {
+		if strings.EqualFold(s, name) {
+			return c, true
+		}
+	}
+	if len(s) > 5 && strings.EqualFold(s[:5], "CLASS") {
+		if n, err := strconv.ParseUint(s[5:], 10, 16); err == nil {
+			return Class(n), true
+		}
+	}
+	return 0, false
+}
+
+func parseZoneType(s string) (Type, bool) {
+	if t := ParseType(strings.ToUpper(s)); t != TypeNone {
+		return t, true
+	}
+	if len(s) > 4 && strings.EqualFold(s[:4], "TYPE") {
+		if n, err := strconv.ParseUint(s[4:], 10, 16); err == nil {
+			return Type(n), true
+		}
+	}
+	return TypeNone, false
+}
+
+func (p *zoneParser) rdata(hdr RR_Header, f []string) (RR, error) {
+	if len(f) > 0 && f[0] == `\#` {
+		return parseRFC3597(hdr, f[1:])
+	}
+	want := func(n int) error {
+		if len(f) != n {
+			return &Error{err: "bad rdata for " + hdr.Rrtype.String()}
+		}
+		return nil
+	}
+	u16 := func(s string) (uint16, error) {
+		n, err := strconv.ParseUint(s, 10, 16)
+		if err != nil {
+			return 0, &Error{err: "bad number " + s}
+		}
+		return uint16(n), nil
+	}
+	switch hdr.Rrtype {
+	case TypeA:
+		if err := want(1); err != nil {
+			return nil, err
+		}
+		ip := net.ParseIP(f[0]).To4()
+		if ip == nil {
+			return nil, &Error{err: "bad A address " + f[0]}
+		}
+		return &A{Hdr: hdr, A: ipTo4(ip)}, nil
+	case TypeAAAA:
+		if err := want(1); err != nil {
+			return nil, err
+		}
+		ip := net.ParseIP(f[0])
+		if ip == nil || ip.To4() != nil && !strings.Contains(f[0], ":") {
+			return nil, &Error{err: "bad AAAA address " + f[0]}
+		}
+		return &AAAA{Hdr: hdr, AAAA: ip.To16()}, nil
+	case TypeNS:
+		if err := want(1); err != nil {
+			return nil, err
+		}
+		name, err := p.name(f[0])
+		if err != nil {
+			return nil, err
+		}
+		return &NS{Hdr: hdr, NS: name}, nil
+	case TypeCNAME:
+		if err := want(1); err != nil {
+			return nil, err
+		}
+		name, err := p.name(f[0])
+		if err != nil {
+			return nil, err
+		}
+		return &CNAME{Hdr: hdr, CNAME: name}, nil
+	case TypePTR:
+		if err := want(1); err != nil {
+			return nil, err
+		}
+		name, err := p.name(f[0])
+		if err != nil {
+			return nil, err
+		}
+		return &PTR{Hdr: hdr, Ptr: name}, nil
+	case TypeMX:
+		if err := want(2); err != nil {
+			return nil, err
+		}
+		pref, err := u16(f[0])
+		if err != nil {
+			return nil, err
+		}
+		mx, err := p.name(f[1])
+		if err != nil {
+			return nil, err
+		}
+		return &MX{Hdr: hdr, Preference: pref, MX: mx}, nil
+	case TypeTXT:
+		if len(f) == 0 {
+			return nil, &Error{err: "bad rdata for TXT"}
+		}
+		return &TXT{Hdr: hdr, TXT: append([]string(nil), f...)}, nil
+	case TypeSRV:
+		if err := want(4); err != nil {
+			return nil, err
+		}
+		target, err := p.name(f[3])
+		if err != nil {
+			return nil, err
+		}
+		rr := &SRV{Hdr: hdr, Target: target}
+		if rr.Priority, err = u16(f[0]); err != nil {
+			return nil, err
+		}
+		if rr.Weight, err = u16(f[1]); err != nil {
+			return nil, err
+		}
+		if rr.Port, err = u16(f[2]); err != nil {
+			return nil, err
+		}
+		return rr, nil
+	case TypeSOA:
+		if err := want(7); err != nil {
+			return nil, err
+		}
+		ns, err := p.name(f[0])
+		if err != nil {
+			return nil, err
+		}
+		mbox, err := p.name(f[1])
+		if err != nil {
+			return nil, err
+		}
+		rr := &SOA{Hdr: hdr, Ns: ns, Mbox: mbox}
+		for i, v := range []*uint32{&rr.Serial, &rr.Refresh, &rr.Retry, &rr.Expire, &rr.Minttl} {
+			n, err := strconv.ParseUint(f[2+i], 10, 32)
+			if err != nil {
+				return nil, &Error{err: "bad number " + f[2+i]}
+			}
+			*v = uint32(n)
+		}
+		return rr, nil
+	case TypeNSEC:
+		if len(f) == 0 {
+			return nil, &Error{err: "bad rdata for NSEC"}
+		}
+		types, err := parseTypeBitMap(f[1:])
+		if err != nil {
+			return nil, err
+		}
+		next, err := p.name(f[0])
+		if err != nil {
+			return nil, err
+		}
+		return &NSEC{Hdr: hdr, NextDomain: next, TypeBitMap: types}, nil
+	case TypeNSEC3, TypeNSEC3PARAM:
+		if len(f) < 4 || hdr.Rrtype == TypeNSEC3PARAM && len(f) != 4 || hdr.Rrtype == TypeNSEC3 && len(f) < 5 {
+			return nil, &Error{err: "bad rdata for " + hdr.Rrtype.String()}
+		}
+		var nums [2]uint8
+		for i := range nums {
+			n, err := strconv.ParseUint(f[i], 10, 8)
+			if err != nil {
+				return nil, &Error{err: "bad number " + f[i]}
+			}
+			nums[i] = uint8(n)
+		}
+		iterations, err := u16(f[2])
+		if err != nil {
+			return nil, err
+		}
+		salt := strings.ToLower(f[3])
+		if salt == "-" {
+			salt = ""
+		}
+		if _, err := hex.DecodeString(salt); err != nil || len(salt)/2 > 255 {
+			return nil, &Error{err: "bad salt " + f[3]}
+		}
+		if hdr.Rrtype == TypeNSEC3PARAM {
+			return &NSEC3PARAM{Hdr: hdr, Hash: nums[0], Flags: nums[1], Iterations: iterations,
+				SaltLength: uint8(len(salt) / 2), Salt: salt}, nil
+		}
+		next, err := fromBase32([]byte(f[4]))
+		if err != nil || len(next) == 0 || len(next) > 255 {
+			return nil, &Error{err: "bad next hashed owner " + f[4]}
+		}
+		types, err := parseTypeBitMap(f[5:])
+		if err != nil {
+			return nil, err
+		}
+		return &NSEC3{Hdr: hdr, Hash: nums[0], Flags: nums[1], Iterations: iterations,
+			SaltLength: uint8(len(salt) / 2), Salt: salt, HashLength: uint8(len(next)),
+			NextDomain: strings.ToLower(f[4]), TypeBitMap: types}, nil
+	}
+	return nil, &Error{err: "type " + hdr.Rrtype.String() + " requires RFC 3597 rdata"}
+}
+
+func parseTypeBitMap(f []string) ([]Type, error) {
+	types := make([]Type, 0, len(f))
+	for _, s := range f {
+		t, ok := parseZoneType(s)
+		if !ok {
+			return nil, &Error{err: "bad type " + s}
+		}
+		types = append(types, t)
+	}
+	slices.Sort(types)
+	return slices.Compact(types), nil
+}
+
+func parseRFC3597(hdr RR_Header, f []string) (RR, error) {
+	if len(f) == 0 {
+		return nil, &Error{err: `bad \# rdata`}
+	}
+	n, err := strconv.Atoi(f[0])
+	if err != nil || n < 0 || n > 0xFFFF {
+		return nil, &Error{err: `bad \# rdata length`}
+	}
+	data := strings.Join(f[1:], "")
+	b, err := hex.DecodeString(data)
+	if err != nil || len(b) != n {
+		return nil, &Error{err: `bad \# rdata`}
+	}
+	hdr.Rdlength = uint16(n)
+	if newFn, ok := TypeToRR[hdr.Rrtype]; ok && n > 0 {
+		buf := make([]byte, n)
+		copy(buf, b)
+		rr := newFn()
+		*rr.Header() = hdr
+		off, err := rr.unpack(buf, 0)
+		if err == nil && off == n {
+			return rr, nil
+		}
+	}
+	return &RFC3597{Hdr: hdr, Rdata: hex.EncodeToString(b)}, nil
+}
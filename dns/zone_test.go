@@ -0,0 +1,108 @@
+package dns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+)
+
+func TestParseZone(t *testing.T) {
+	r := assert.New(t)
+	zone := `$TTL 300
+@	IN SOA ns1 hostmaster (
+		2024010101 ; serial
+		7200 3600 1209600 300 )
+	IN NS ns1
+ns1	IN A 192.0.2.1
+www	60 IN A 192.0.2.2
+	IN AAAA 2001:db8::2
+mail	IN MX 10 mx.example.net.
+txt	IN TXT "hello world" "a;b"
+_sip._tcp IN SRV 10 20 5060 sip
+alias	CNAME www
+raw	IN TYPE65280 \# 2 abcd
+`
+	rrs, err := ParseZone(strings.NewReader(zone), "example.com")
+	r.NoError(err)
+	r.Equal(10, len(rrs))
+
+	soa, ok := rrs[0].(*SOA)
+	r.True(ok)
+	r.Equal("example.com.", soa.Hdr.Name)
+	r.Equal("ns1.example.com.", soa.Ns)
+	r.Equal(uint32(2024010101), soa.Serial)
+	r.Equal(uint32(300), soa.Minttl)
+
+	ns := rrs[1].(*NS)
+	r.Equal("example.com.", ns.Hdr.Name)
+	r.Equal(uint32(300), ns.Hdr.Ttl)
+
+	www := rrs[3].(*A)
+	r.Equal("www.example.com.", www.Hdr.Name)
+	r.Equal(uint32(60), www.Hdr.Ttl)
+	r.Equal([4]byte{192, 0, 2, 2}, www.A)
+
+	aaaa := rrs[4].(*AAAA)
+	r.Equal("www.example.com.", aaaa.Hdr.Name)
+	r.Equal("2001:db8::2", aaaa.AAAA.String())
+
+	mx := rrs[5].(*MX)
+	r.Equal(uint16(10), mx.Preference)
+	r.Equal("mx.example.net.", mx.MX)
+
+	txt := rrs[6].(*TXT)
+	r.Equal(2, len(txt.TXT))
+	r.Equal("hello world", txt.TXT[0])
+	r.Equal("a;b", txt.TXT[1])
+
+	srv := rrs[7].(*SRV)
+	r.Equal("_sip._tcp.example.com.", srv.Hdr.Name)
+	r.Equal(uint16(5060), srv.Port)
+	r.Equal("sip.example.com.", srv.Target)
+
+	cname := rrs[8].(*CNAME)
+	r.Equal(ClassINET, cname.Hdr.Class)
+	r.Equal("www.example.com.", cname.CNAME)
+
+	raw := rrs[9].(*RFC3597)
+	r.Equal(Type(65280), raw.Hdr.Rrtype)
+	r.Equal("abcd", raw.Rdata)
+}
+
+func TestParseZoneErrors(t *testing.T) {
+	tests := []string{
+		"www IN A 300.0.0.1",
+		"www IN MX mail",
+		"www IN (A 192.0.2.1",
+		"www IN TXT \"open",
+		"www IN BOGUS x",
+		"www IN HINFO a b",
+		// Empty quoted names.
+		`a. 60 IN CNAME ""`,
+		`"" 60 IN A 1.2.3.4`,
+		`$ORIGIN ""`,
+		`www IN MX 10 ""`,
+		`@ IN SOA "" host. 1 2 3 4 5`,
+	}
+	for _, tt := range tests {
+		_, err := ParseZone(strings.NewReader(tt), "example.com.")
+		assert.Error(t, err, tt)
+	}
+}
+
+func TestNewRR(t *testing.T) {
+	r := assert.New(t)
+	rr, err := NewRR("example.com. 600 IN A 192.0.2.1")
+	r.NoError(err)
+	a, ok := rr.(*A)
+	r.True(ok)
+	r.Equal("example.com.", a.Hdr.Name)
+	r.Equal(uint32(600), a.Hdr.Ttl)
+
+	rr, err = NewRR("example.com. IN TYPE1 \\# 4 c0000201")
+	r.NoError(err)
+	a, ok = rr.(*A)
+	r.True(ok)
+	r.Equal([4]byte{192, 0, 2, 1}, a.A)
+}
@@ -5,61 +5,209 @@ import (
 	"database/sql"
 	"fmt"
 	"reflect"
-	"strconv"
+	"slices"
 	"strings"
 )
 
 //https://github.com/arthurkushman/buildsqlx/blob/master/builder.go#L37
 
-type builder struct {
-	flavor          Flavor
-	db              ExecerAndQueryer
-	table           string
-	columns         []string
-	whereBindings   []map[string]any
-	orderBy         []map[string]string
-	groupBy         string
-	offset          int64
-	limit           int64
+// Builder builds and runs a query on one table. It is created by DB.Table.
+type Builder struct {
+	ctx            context.Context
+	flavor         Flavor
+	db             ExecerAndQueryer
+	table          string
+	columns        []string
+	join           []string
+	whereBindings  []map[string]any
+	havingBindings []map[string]any
+	orderBy        []map[string]string
+	groupBy        string
+	offset         int64
+	limit          int64
 }
 
-func newBuilder(flavor Flavor, db ExecerAndQueryer) *builder {
-	return &builder{
+// Condition values that are not bound as a single placeholder.
+type (
+	// whereGroup is a parenthesized group of conditions.
+	whereGroup []map[string]any
+	// betweenValue binds the two bounds of BETWEEN.
+	betweenValue [2]any
+	// nullValue binds nothing, for IS NULL and IS NOT NULL.
+	nullValue struct{}
+)
+
+func newBuilder(flavor Flavor, db ExecerAndQueryer) *Builder {
+	return &Builder{
 		flavor:  flavor,
 		db:      db,
 		columns: []string{"*"},
 	}
 }
 
-func (b *builder) Table(table string) *builder {
+// WithContext sets the context the query runs with; Count, Scan, Insert,
+// InsertMany, Upsert, Update and Delete are canceled with it.
+func (b *Builder) WithContext(ctx context.Context) *Builder {
+	b.ctx = ctx
+	return b
+}
+
+// Context returns the context of the builder, context.Background if none
+// was set.
+func (b *Builder) Context() context.Context {
+	if b.ctx == nil {
+		return context.Background()
+	}
+	return b.ctx
+}
+
+func (b *Builder) Table(table string) *Builder {
 	b.table = table
 	return b
 }
 
-func (b *builder) Select(columns ...string) *builder {
+func (b *Builder) Select(columns ...string) *Builder {
 	b.columns = columns
 	return b
 }
 
-func (b *builder) Where(column, operator string, value any) *builder {
+// Join adds an INNER JOIN of table ON first operator second, where first
+// and second are columns.
+func (b *Builder) Join(table, first, operator, second string) *Builder {
+	return b.buildJoin("INNER", table, first, operator, second)
+}
+
+// LeftJoin adds a LEFT JOIN of table ON first operator second.
+func (b *Builder) LeftJoin(table, first, operator, second string) *Builder {
+	return b.buildJoin("LEFT", table, first, operator, second)
+}
+
+// RightJoin adds a RIGHT JOIN of table ON first operator second.
+func (b *Builder) RightJoin(table, first, operator, second string) *Builder {
+	return b.buildJoin("RIGHT", table, first, operator, second)
+}
+
+func (b *Builder) buildJoin(kind, table, first, operator, second string) *Builder {
+	b.join = append(b.join, " "+kind+" JOIN "+b.flavor.tableQuote("", table)+
+		" ON "+b.flavor.columnQuote(first)+" "+operator+" "+b.flavor.columnQuote(second))
+	return b
+}
+
+// Where adds a condition joined to the previous ones with AND. value may be
+// a Builder, which is used as a subquery:
+//
+//	db.Table("users").Where("id", "IN", db.Table("orders").Select("user_id"))
+func (b *Builder) Where(column, operator string, value any) *Builder {
+	return b.buildWhere(b.wherePrefix("AND"), column, operator, value)
+}
+
+// OrWhere adds a condition joined to the previous ones with OR.
+func (b *Builder) OrWhere(column, operator string, value any) *Builder {
+	return b.buildWhere(b.wherePrefix("OR"), column, operator, value)
+}
+
+// WhereGroup adds the conditions fn adds to the builder it is passed, in
+// parentheses and joined to the previous ones with AND.
+func (b *Builder) WhereGroup(fn func(*Builder)) *Builder {
+	return b.buildWhereGroup("AND", fn)
+}
+
+// OrWhereGroup is like WhereGroup but joins the group with OR.
+func (b *Builder) OrWhereGroup(fn func(*Builder)) *Builder {
+	return b.buildWhereGroup("OR", fn)
+}
+
+func (b *Builder) buildWhereGroup(prefix string, fn func(*Builder)) *Builder {
+	g := newBuilder(b.flavor, b.db)
+	fn(g)
+	if len(g.whereBindings) == 0 {
+		return b
+	}
+	prefix = b.wherePrefix(prefix)
+	if prefix != "" {
+		prefix = " " + prefix + " "
+	}
+	b.whereBindings = append(b.whereBindings, map[string]any{prefix: whereGroup(g.whereBindings)})
+	return b
+}
+
+// WhereIn adds a column IN condition. values is a slice or a Builder used as
+// a subquery; an empty slice matches no rows.
+func (b *Builder) WhereIn(column string, values any) *Builder {
+	return b.Where(column, "IN", inValues(values))
+}
+
+// WhereNotIn adds a column NOT IN condition, see WhereIn. An empty slice
+// adds no condition.
+func (b *Builder) WhereNotIn(column string, values any) *Builder {
+	vals := inValues(values)
+	if v, ok := vals.([]any); ok && len(v) == 0 {
+		return b
+	}
+	return b.Where(column, "NOT IN", vals)
+}
+
+// WhereNull adds a column IS NULL condition.
+func (b *Builder) WhereNull(column string) *Builder {
+	return b.Where(column, "IS NULL", nullValue{})
+}
+
+// WhereNotNull adds a column IS NOT NULL condition.
+func (b *Builder) WhereNotNull(column string) *Builder {
+	return b.Where(column, "IS NOT NULL", nullValue{})
+}
+
+// WhereBetween adds a column BETWEEN low AND high condition.
+func (b *Builder) WhereBetween(column string, low, high any) *Builder {
+	return b.Where(column, "BETWEEN", betweenValue{low, high})
+}
+
+// Having adds a HAVING condition joined to the previous ones with AND.
+// column may be an aggregate such as COUNT(*).
+func (b *Builder) Having(column, operator string, value any) *Builder {
 	prefix := ""
-	if len(b.whereBindings) > 0 {
-		prefix = "AND"
+	if len(b.havingBindings) > 0 {
+		prefix = " AND "
 	}
-	return b.buildWhere(prefix, column, operator, value)
+	b.havingBindings = append(b.havingBindings, map[string]any{prefix + b.flavor.columnQuote(column) + " " + operator: value})
+	return b
+}
+
+func (b *Builder) wherePrefix(prefix string) string {
+	if len(b.whereBindings) == 0 {
+		return ""
+	}
+	return prefix
 }
 
-func (b *builder) Count() (int, error) {
+// inValues converts a slice of any element type to the []any bound for IN.
+func inValues(values any) any {
+	switch v := values.(type) {
+	case []any, *Builder:
+		return v
+	}
+	rv := reflect.ValueOf(values)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []any{values}
+	}
+	vals := make([]any, rv.Len())
+	for i := range vals {
+		vals[i] = rv.Index(i).Interface()
+	}
+	return vals
+}
+
+func (b *Builder) Count() (int, error) {
 	b1 := b.Clone()
 	b1.columns = []string{"COUNT(*)"}
-	query, args := b1.buildSelect(), prepareValues(b1.whereBindings)
+	query, args := b1.buildSelect(), b1.args()
 	var count int
-	row := b1.db.QueryRow(query, args...)
+	row := b1.db.QueryRowContext(b1.Context(), query, args...)
 	err := row.Scan(&count)
 	return count, err
 }
 
-func (b *builder) buildWhere(prefix, operand, operator string, val any) *builder {
+func (b *Builder) buildWhere(prefix, operand, operator string, val any) *Builder {
 	if prefix != "" {
 		prefix = " " + prefix + " "
 	}
@@ -68,27 +216,27 @@ func (b *builder) buildWhere(prefix, operand, operator string, val any) *builder
 	return b
 }
 
-func (b *builder) OrderBy(column, direction string) *builder {
+func (b *Builder) OrderBy(column, direction string) *Builder {
 	b.orderBy = append(b.orderBy, map[string]string{column: direction})
 	return b
 }
 
-func (b *builder) GroupBy(expr string) *builder {
+func (b *Builder) GroupBy(expr string) *Builder {
 	b.groupBy = expr
 	return b
 }
 
-func (b *builder) Offset(offset int64) *builder {
+func (b *Builder) Offset(offset int64) *Builder {
 	b.offset = offset
 	return b
 }
 
-func (b *builder) Limit(limit int64) *builder {
+func (b *Builder) Limit(limit int64) *Builder {
 	b.limit = limit
 	return b
 }
 
-func (b *builder) buildSelect() string {
+func (b *Builder) buildSelect() string {
 	cols := make([]string, 0, len(b.columns))
 	for _, c := range b.columns {
 		if c == "*" {
@@ -103,11 +251,11 @@ func (b *builder) buildSelect() string {
 }
 
 // builds query string clauses
-func (b *builder) buildClauses() string {
+func (b *Builder) buildClauses() string {
 	clauses := ""
-	// for _, j := range b.join {
-	// 	clauses += j
-	// }
+	for _, j := range b.join {
+		clauses += j
+	}
 
 	// build where clause
 	if len(b.whereBindings) > 0 {
@@ -118,32 +266,56 @@ func (b *builder) buildClauses() string {
 		clauses += " GROUP BY " + b.groupBy
 	}
 
-	// if r.having != "" {
-	// 	clauses += " HAVING " + r.having
-	// }
+	if len(b.havingBindings) > 0 {
+		clauses += composeConditions(" HAVING ", b.havingBindings)
+	}
 
 	clauses += composeOrderBy(b.orderBy)
 
-	if b.limit > 0 {
-		clauses += " LIMIT " + strconv.FormatInt(b.limit, 10)
-	}
-
-	if b.offset > 0 {
-		clauses += " OFFSET " + strconv.FormatInt(b.offset, 10)
-	}
+	clauses += b.flavor.pagination(b.limit, b.offset, len(b.orderBy) > 0)
 
 	return clauses
 }
 
+// args returns the bind values of the query in the order of their
+// placeholders.
+func (b *Builder) args() []any {
+	return append(prepareValues(b.whereBindings), prepareValues(b.havingBindings)...)
+}
+
 // composes WHERE clause string for particular query stmt
 func composeWhere(whereBindings []map[string]any) string {
+	return composeConditions(" WHERE ", whereBindings)
+}
+
+func composeConditions(keyword string, bindings []map[string]any) string {
 	b := acquireStringBuilder()
 	defer releaseStringBuilder(b)
-	b.WriteString(" WHERE ")
-	for _, m := range whereBindings {
+	b.WriteString(keyword)
+	writeConditions(b, bindings)
+	return b.String()
+}
+
+func writeConditions(b *strings.Builder, bindings []map[string]any) {
+	for _, m := range bindings {
 		for k, v := range m {
 			// operand >= $i
 			switch vi := v.(type) {
+			case whereGroup:
+				b.WriteString(k)
+				b.WriteByte('(')
+				writeConditions(b, vi)
+				b.WriteByte(')')
+			case *Builder:
+				b.WriteString(k)
+				b.WriteString(" (")
+				b.WriteString(vi.buildSelect())
+				b.WriteByte(')')
+			case betweenValue:
+				b.WriteString(k)
+				b.WriteString(" ? AND ?")
+			case nullValue:
+				b.WriteString(k)
 			case []any:
 				dataLen := len(vi)
 				b.WriteString(k)
@@ -160,7 +332,6 @@ func composeWhere(whereBindings []map[string]any) string {
 			}
 		}
 	}
-	return b.String()
 }
 
 // composers ORDER BY clause string for particular query stmt
@@ -190,6 +361,15 @@ func prepareValues(values []map[string]any) []any {
 }
 func prepareValue(value any) []any {
 	switch v := value.(type) {
+	case whereGroup:
+		return prepareValues(v)
+	case *Builder:
+		// Subqueries bind their own values in place.
+		return v.args()
+	case betweenValue:
+		return v[:]
+	case nullValue:
+		return nil
 	case []any:
 		// Flatten slices (e.g. for IN clauses) into individual bind values.
 		values := make([]any, 0, len(v))
@@ -228,7 +408,7 @@ func prepareBindings(data map[string]any) (columns []string, values []any, bindi
 	return
 }
 
-func (b *builder) Insert(data any) (sql.Result, error) {
+func (b *Builder) Insert(data any) (sql.Result, error) {
 	defer b.Reset()
 	switch v := data.(type) {
 	case map[string]any:
@@ -238,7 +418,7 @@ func (b *builder) Insert(data any) (sql.Result, error) {
 	}
 }
 
-func (b *builder) insertAny(data any) (sql.Result, error) {
+func (b *Builder) insertAny(data any) (sql.Result, error) {
 	rv := reflect.ValueOf(data)
 	if rv.Kind() == reflect.Slice && rv.Len() == 0 {
 		return nil, fmt.Errorf("empty slice")
@@ -247,19 +427,19 @@ func (b *builder) insertAny(data any) (sql.Result, error) {
 		Table: b.table,
 		Data:  data,
 	}
-	return b.db.Exec(ins.SQL(), ins.Args()...)
+	return b.db.ExecContext(b.Context(), ins.SQL(), ins.Args()...)
 }
 
-func (b *builder) insertMap(data map[string]any) (sql.Result, error) {
+func (b *Builder) insertMap(data map[string]any) (sql.Result, error) {
 	columns, values, bindings := prepareBindings(data)
 	for i := range columns {
 		columns[i] = b.flavor.columnQuote(columns[i])
 	}
 	query := `INSERT INTO ` + b.flavor.tableQuote("", b.table) + ` (` + strings.Join(columns, ", ") + `) VALUES (` + strings.Join(bindings, ", ") + `)`
-	return b.db.Exec(query, values...)
+	return b.db.ExecContext(b.Context(), query, values...)
 }
 
-func (b *builder) Update(data any) (sql.Result, error) {
+func (b *Builder) Update(data any) (sql.Result, error) {
 	defer b.Reset()
 	switch v := data.(type) {
 	case map[string]any:
@@ -269,7 +449,7 @@ func (b *builder) Update(data any) (sql.Result, error) {
 	}
 }
 
-func (b *builder) updateMap(data map[string]any) (sql.Result, error) {
+func (b *Builder) updateMap(data map[string]any) (sql.Result, error) {
 	dataLen := len(data)
 	if dataLen == 0 {
 		return nil, fmt.Errorf("no data to update")
@@ -283,40 +463,276 @@ func (b *builder) updateMap(data map[string]any) (sql.Result, error) {
 		fields = append(fields, fmt.Sprintf("%s=?", b.flavor.columnQuote(k)))
 		values = append(values, v)
 	}
-	whereClause, whereArgs := composeWhere(b.whereBindings), prepareValues(b.whereBindings)
+	whereClause, whereArgs := composeWhere(b.whereBindings), b.args()
 
 	query := "UPDATE " + b.flavor.tableQuote("", b.table) + " SET " + strings.Join(fields, ", ") + whereClause
+	if b.flavor == ClickHouse {
+		// Updates are mutations in ClickHouse.
+		query = "ALTER TABLE " + b.flavor.tableQuote("", b.table) + " UPDATE " + strings.Join(fields, ", ") + whereClause
+	}
 	values = append(values, whereArgs...)
 
-	return b.db.Exec(query, values...)
+	return b.db.ExecContext(b.Context(), query, values...)
+}
+
+// Delete deletes the rows matching the conditions. It refuses to run
+// without any.
+func (b *Builder) Delete() (sql.Result, error) {
+	defer b.Reset()
+	if len(b.whereBindings) == 0 {
+		return nil, fmt.Errorf("missing WHERE clause")
+	}
+	query := "DELETE FROM " + b.flavor.tableQuote("", b.table) + composeWhere(b.whereBindings)
+	return b.db.ExecContext(b.Context(), query, b.args()...)
+}
+
+// InsertMany inserts the rows of data, a slice of maps, structs or struct
+// pointers, with multi-row INSERT statements. Rows are split over several
+// statements to stay within the placeholder limit of the flavor; run it in
+// a transaction to insert them atomically. The result sums the affected
+// rows and has the last insert ID of the last statement.
+func (b *Builder) InsertMany(data any) (sql.Result, error) {
+	defer b.Reset()
+	columns, rows, err := insertRows(data)
+	if err != nil {
+		return nil, err
+	}
+	return b.execInsert(columns, rows, "")
+}
+
+// Upsert inserts data like InsertMany, or a single map or struct, and
+// updates updateColumns of rows that conflict with an existing one; with no
+// updateColumns it updates every column not in conflictColumns.
+//
+// MySQL updates on any duplicate unique key and ignores conflictColumns;
+// PostgreSQL and SQLite update on conflicts over conflictColumns, which must
+// match a unique index, and PostgreSQL refuses to update the same row twice
+// in one statement.
+func (b *Builder) Upsert(data any, conflictColumns, updateColumns []string) (sql.Result, error) {
+	defer b.Reset()
+	columns, rows, err := insertRows(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(updateColumns) == 0 {
+		for _, c := range columns {
+			if !slices.Contains(conflictColumns, c) {
+				updateColumns = append(updateColumns, c)
+			}
+		}
+	}
+	clause, err := b.upsertClause(conflictColumns, updateColumns)
+	if err != nil {
+		return nil, err
+	}
+	return b.execInsert(columns, rows, clause)
+}
+
+func (b *Builder) upsertClause(conflictColumns, updateColumns []string) (string, error) {
+	sets := make([]string, len(updateColumns))
+	switch b.flavor {
+	case MySQL:
+		if len(updateColumns) == 0 {
+			// Nothing to update: keep the row as it is.
+			if len(conflictColumns) == 0 {
+				return "", fmt.Errorf("no columns to update")
+			}
+			c := b.flavor.columnQuote(conflictColumns[0])
+			return " ON DUPLICATE KEY UPDATE " + c + " = " + c, nil
+		}
+		for i, c := range updateColumns {
+			c = b.flavor.columnQuote(c)
+			sets[i] = c + " = VALUES(" + c + ")"
+		}
+		return " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", "), nil
+	case PostgreSQL, SQLite:
+		if len(conflictColumns) == 0 {
+			return "", fmt.Errorf("missing conflict columns")
+		}
+		targets := make([]string, len(conflictColumns))
+		for i, c := range conflictColumns {
+			targets[i] = b.flavor.columnQuote(c)
+		}
+		clause := " ON CONFLICT (" + strings.Join(targets, ", ") + ")"
+		if len(updateColumns) == 0 {
+			return clause + " DO NOTHING", nil
+		}
+		for i, c := range updateColumns {
+			sets[i] = b.flavor.columnQuote(c) + " = " + b.flavor.columnQuote("excluded."+c)
+		}
+		return clause + " DO UPDATE SET " + strings.Join(sets, ", "), nil
+	}
+	return "", fmt.Errorf("upsert is not supported for %s", b.flavor)
+}
+
+// execInsert inserts rows in batches of multi-row INSERT statements ending
+// with suffix.
+func (b *Builder) execInsert(columns []string, rows [][]any, suffix string) (sql.Result, error) {
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = b.flavor.columnQuote(c)
+	}
+	into := b.flavor.tableQuote("", b.table) + " (" + strings.Join(quoted, ", ") + ")"
+	values := "(" + strings.Repeat("?, ", len(columns))[:len(columns)*3-2] + ")"
+	head, row, sep, tail := "INSERT INTO "+into+" VALUES ", values, ", ", ""
+	if b.flavor == Oracle {
+		// Oracle has no multi-row VALUES: INSERT ALL INTO t VALUES (...)
+		// INTO t VALUES (...) SELECT 1 FROM DUAL.
+		head, row, sep, tail = "INSERT ALL ", "INTO "+into+" VALUES "+values, " ", " SELECT 1 FROM DUAL"
+	}
+	size := b.flavor.insertBatchSize(len(columns))
+
+	var results batchResult
+	for batch := range slices.Chunk(rows, size) {
+		q := acquireStringBuilder()
+		q.WriteString(head)
+		args := make([]any, 0, len(batch)*len(columns))
+		for i, r := range batch {
+			if i > 0 {
+				q.WriteString(sep)
+			}
+			q.WriteString(row)
+			args = append(args, r...)
+		}
+		q.WriteString(tail)
+		q.WriteString(suffix)
+		query := q.String()
+		releaseStringBuilder(q)
+		res, err := b.db.ExecContext(b.Context(), query, args...)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// insertRows returns the columns and the values of each row of data, a map
+// or struct, or a slice of maps, structs or struct pointers. Maps have their
+// keys as columns, sorted, and must all have the same keys.
+func insertRows(data any) (columns []string, rows [][]any, err error) {
+	rv := reflect.Indirect(reflect.ValueOf(data))
+	var elems []reflect.Value
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if rv.Len() == 0 {
+			return nil, nil, fmt.Errorf("empty slice")
+		}
+		for i := range rv.Len() {
+			elems = append(elems, rv.Index(i))
+		}
+	case reflect.Map, reflect.Struct:
+		elems = append(elems, rv)
+	default:
+		return nil, nil, fmt.Errorf("unsupported type %T", data)
+	}
+	for i, v := range elems {
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+		var values []any
+		switch v.Kind() {
+		case reflect.Map:
+			if v.Type().Key().Kind() != reflect.String {
+				return nil, nil, fmt.Errorf("unsupported type %s", v.Type())
+			}
+			if columns == nil {
+				for _, k := range v.MapKeys() {
+					columns = append(columns, k.String())
+				}
+				slices.Sort(columns)
+			}
+			if v.Len() != len(columns) {
+				return nil, nil, fmt.Errorf("row %d: columns differ from the first row", i)
+			}
+			values = make([]any, len(columns))
+			for j, c := range columns {
+				mv := v.MapIndex(reflect.ValueOf(c).Convert(v.Type().Key()))
+				if !mv.IsValid() {
+					return nil, nil, fmt.Errorf("row %d: missing column %s", i, c)
+				}
+				values[j] = mv.Interface()
+			}
+		case reflect.Struct:
+			fs := fields(v.Type())
+			if columns == nil {
+				for _, f := range fs {
+					columns = append(columns, f.name)
+				}
+			}
+			if len(fs) != len(columns) {
+				return nil, nil, fmt.Errorf("row %d: columns differ from the first row", i)
+			}
+			values = make([]any, len(fs))
+			for j, f := range fs {
+				values[j] = v.FieldByIndex(f.field.Index).Interface()
+			}
+		default:
+			return nil, nil, fmt.Errorf("unsupported type %s", v.Type())
+		}
+		rows = append(rows, values)
+	}
+	if len(columns) == 0 {
+		return nil, nil, fmt.Errorf("no columns to insert")
+	}
+	return columns, rows, nil
+}
+
+// batchResult is the result of several statements.
+type batchResult []sql.Result
+
+// LastInsertId returns the last insert ID of the last statement.
+func (r batchResult) LastInsertId() (int64, error) {
+	if len(r) == 0 {
+		return 0, fmt.Errorf("no statement executed")
+	}
+	return r[len(r)-1].LastInsertId()
+}
+
+// RowsAffected returns the sum of the rows affected by the statements.
+func (r batchResult) RowsAffected() (int64, error) {
+	var n int64
+	for _, res := range r {
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		n += affected
+	}
+	return n, nil
 }
 
-func (b *builder) Scan(dest any) error {
+func (b *Builder) Scan(dest any) error {
 	defer b.Reset()
-	query, args := b.buildSelect(), prepareValues(b.whereBindings)
-	return ScanContext(context.Background(), b.db, dest, query, args...)
+	query, args := b.buildSelect(), b.args()
+	return ScanContext(b.Context(), b.db, dest, query, args...)
 }
 
-func (b *builder) Reset() {
+func (b *Builder) Reset() {
 	b.table = ""
 	b.columns = []string{"*"}
+	b.join = nil
 	b.whereBindings = make([]map[string]any, 0)
+	b.havingBindings = nil
 	b.orderBy = make([]map[string]string, 0)
 	b.groupBy = ""
 	b.offset = 0
 	b.limit = 0
 }
 
-func (b *builder) Clone() *builder {
-	return &builder{
-		flavor:        b.flavor,
-		db:            b.db,
-		table:         b.table,
-		columns:       b.columns,
-		whereBindings: b.whereBindings,
-		orderBy:       b.orderBy,
-		groupBy:       b.groupBy,
-		offset:        b.offset,
-		limit:         b.limit,
+func (b *Builder) Clone() *Builder {
+	return &Builder{
+		ctx:            b.ctx,
+		flavor:         b.flavor,
+		db:             b.db,
+		table:          b.table,
+		columns:        b.columns,
+		join:           b.join,
+		whereBindings:  b.whereBindings,
+		havingBindings: b.havingBindings,
+		orderBy:        b.orderBy,
+		groupBy:        b.groupBy,
+		offset:         b.offset,
+		limit:          b.limit,
 	}
 }
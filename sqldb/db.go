@@ -60,6 +60,12 @@ func Open(driverName, dataSourceName string, opts ...Option) (*DB, error) {
 		flavor = PostgreSQL
 	case "sqlite3", "sqlite", "nrsqlite3":
 		flavor = SQLite
+	case "sqlserver", "mssql", "azuresql":
+		flavor = SQLServer
+	case "oracle", "godror", "oci8":
+		flavor = Oracle
+	case "clickhouse", "chhttp":
+		flavor = ClickHouse
 	default:
 		_ = db.Close()
 		return nil, fmt.Errorf("unsupported driver: %s", driverName)
@@ -151,8 +157,14 @@ func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
 
 // Transaction runs txFunc in a transaction and commits on success.
 // It rolls back when txFunc returns an error.
-func (db *DB) Transaction(txFunc func(*Tx) error) (err error) {
-	tx, err := db.Begin()
+func (db *DB) Transaction(txFunc func(*Tx) error) error {
+	return db.TransactionContext(context.Background(), txFunc)
+}
+
+// TransactionContext is like Transaction, but the transaction is rolled
+// back when ctx is done before it commits.
+func (db *DB) TransactionContext(ctx context.Context, txFunc func(*Tx) error) (err error) {
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return
 	}
@@ -267,7 +279,7 @@ func (db *DB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, erro
 //
 // NOTE: This intentionally returns a fresh builder to avoid shared mutable state
 // on DB when chaining builder methods.
-func (db *DB) Table(table string) *builder {
+func (db *DB) Table(table string) *Builder {
 	b := newBuilder(db.Flavor, db)
 	b.table = table
 	return b
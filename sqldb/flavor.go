@@ -1,6 +1,7 @@
 package sqldb
 
 import (
+	"strconv"
 	"strings"
 	"time"
 )
@@ -24,6 +25,9 @@ const (
 	MySQL
 	PostgreSQL
 	SQLite
+	SQLServer
+	Oracle
+	ClickHouse
 )
 
 // Flavor is the flag to control the format of compiled sql.
@@ -38,44 +42,118 @@ func (f Flavor) String() string {
 		return "PostgreSQL"
 	case SQLite:
 		return "SQLite"
+	case SQLServer:
+		return "SQLServer"
+	case Oracle:
+		return "Oracle"
+	case ClickHouse:
+		return "ClickHouse"
 	}
 
 	return "<invalid>"
 }
 
-func (f Flavor) tableQuote(prefix string, table string) string {
-	tableQuote := "`"
+// maxPlaceholders returns the number of bind values a statement may have.
+func (f Flavor) maxPlaceholders() int {
+	switch f {
+	case SQLite:
+		// SQLITE_MAX_VARIABLE_NUMBER since SQLite 3.32.0.
+		return 32766
+	case SQLServer:
+		return 2100
+	}
+	return 65535
+}
+
+// insertBatchSize returns the number of rows of columns columns a multi-row
+// INSERT may have.
+func (f Flavor) insertBatchSize(columns int) int {
+	size := max(1, f.maxPlaceholders()/columns)
+	if f == SQLServer {
+		// A table value constructor holds at most 1000 rows.
+		size = min(size, 1000)
+	}
+	return size
+}
+
+// bindPrefix returns the prefix of numbered placeholders, $1 for
+// PostgreSQL, @p1 for SQL Server and :1 for Oracle, or "" when the flavor
+// uses ? placeholders.
+func (f Flavor) bindPrefix() string {
 	switch f {
 	case PostgreSQL:
-		tableQuote = "\""
+		return "$"
+	case SQLServer:
+		return "@p"
+	case Oracle:
+		return ":"
 	}
+	return ""
+}
 
+// quotes returns the opening and closing identifier quotes.
+func (f Flavor) quotes() (string, string) {
+	switch f {
+	case PostgreSQL, Oracle:
+		return "\"", "\""
+	case SQLServer:
+		return "[", "]"
+	}
+	return "`", "`"
+}
+
+func (f Flavor) tableQuote(prefix string, table string) string {
+	left, right := f.quotes()
 	if strings.Contains(table, ".") {
-		return tableQuote + strings.ReplaceAll(table, ".", tableQuote+"."+tableQuote) + tableQuote
+		return left + strings.ReplaceAll(table, ".", right+"."+left) + right
 	}
 
-	return tableQuote + prefix + table + tableQuote
+	return left + prefix + table + right
 }
 
 func (f Flavor) columnQuote(column string) string {
-	columnQuote := ""
-	switch f {
-	case PostgreSQL:
-		columnQuote = "\""
-	default:
-		columnQuote = "`"
-	}
+	left, right := f.quotes()
 	if column == "*" {
 		return "*"
 	}
 	if strings.ContainsRune(column, '.') {
 		if strings.ContainsRune(column, '*') {
-			return columnQuote + strings.ReplaceAll(column, ".", columnQuote+".")
+			return left + strings.ReplaceAll(column, ".", right+".")
 		}
-		return columnQuote + strings.ReplaceAll(column, ".", columnQuote+"."+columnQuote) + columnQuote
+		return left + strings.ReplaceAll(column, ".", right+"."+left) + right
 	} else if strings.Contains(column, "(") || strings.Contains(column, " ") {
 		return column
 	}
 
-	return columnQuote + column + columnQuote
+	return left + column + right
+}
+
+// pagination returns the clause that skips offset rows and returns at most
+// limit rows; zero values do neither. SQL Server and Oracle use OFFSET ...
+// FETCH NEXT, which SQL Server allows only after an ORDER BY.
+func (f Flavor) pagination(limit, offset int64, ordered bool) string {
+	clause := ""
+	switch f {
+	case SQLServer, Oracle:
+		if limit <= 0 && offset <= 0 {
+			return ""
+		}
+		if f == SQLServer && !ordered {
+			clause = " ORDER BY (SELECT NULL)"
+		}
+		if offset > 0 || f == SQLServer {
+			clause += " OFFSET " + strconv.FormatInt(max(offset, 0), 10) + " ROWS"
+		}
+		if limit > 0 {
+			clause += " FETCH NEXT " + strconv.FormatInt(limit, 10) + " ROWS ONLY"
+		}
+	default:
+		if limit > 0 {
+			clause += " LIMIT " + strconv.FormatInt(limit, 10)
+		}
+		if offset > 0 {
+			clause += " OFFSET " + strconv.FormatInt(offset, 10)
+		}
+	}
+	return clause
 }
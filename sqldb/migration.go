@@ -348,25 +348,59 @@ const defaultMigrationService = "default"
 // multiple services sharing the same database keep independent histories.
 func (m *Migrator) createMigrationsTable(ctx context.Context) error {
 	return m.inTransaction(ctx, func(tx *sql.Tx) error {
-		if _, err := tx.ExecContext(ctx, `create table if not exists `+m.table+` (service text not null, version text not null, primary key (service))`); err != nil {
+		if _, err := tx.ExecContext(ctx, m.createTableSQL()); err != nil {
 			return fmt.Errorf("error creating migrations table %v: %w", m.table, err)
 		}
 
 		// Ensure a row exists for this service. Use a flavor-specific upsert so
 		// the initial empty version is inserted exactly once.
-		if _, err := tx.ExecContext(ctx, fixQuery(m.flavor, m.upsertServiceSQL()), m.service, ""); err != nil {
+		upsert := m.upsertServiceSQL()
+		if upsert == "" {
+			return nil
+		}
+		if _, err := tx.ExecContext(ctx, fixQuery(m.flavor, upsert), m.service, ""); err != nil {
 			return fmt.Errorf("error initializing migration row for service %q: %w", m.service, err)
 		}
 		return nil
 	})
 }
 
+// createTableSQL returns a flavor-specific statement that creates the
+// migrations table unless it exists.
+func (m *Migrator) createTableSQL() string {
+	switch m.flavor {
+	case SQLServer:
+		return `if object_id(N'` + m.table + `', N'U') is null create table ` + m.table + ` (service nvarchar(255) not null primary key, version nvarchar(255) not null)`
+	case Oracle:
+		// No IF NOT EXISTS before 23ai: ignore ORA-00955, name already used.
+		// version is nullable as Oracle stores the empty string as NULL.
+		return `begin execute immediate 'create table ` + m.table + ` (service varchar2(255) not null primary key, version varchar2(255))'; ` +
+			`exception when others then if sqlcode != -955 then raise; end if; end;`
+	case ClickHouse:
+		// ClickHouse cannot update rows in place: every version is a new row
+		// and the latest one per service survives merges.
+		return `create table if not exists ` + m.table + ` (service String, version String, applied DateTime64(9) default now64(9)) engine = ReplacingMergeTree(applied) order by service`
+	default:
+		return `create table if not exists ` + m.table + ` (service text not null, version text not null, primary key (service))`
+	}
+}
+
 // upsertServiceSQL returns a flavor-specific statement that inserts a
-// (service, version) row unless the service already exists.
+// (service, version) row unless the service already exists, or "" when no
+// such row is needed.
 func (m *Migrator) upsertServiceSQL() string {
 	switch m.flavor {
 	case MySQL:
 		return `insert into ` + m.table + ` (service, version) values (?, ?) on duplicate key update service = service`
+	case SQLServer:
+		return `merge into ` + m.table + ` as t using (select ? as service, ? as version) as s on t.service = s.service ` +
+			`when not matched then insert (service, version) values (s.service, s.version);`
+	case Oracle:
+		return `merge into ` + m.table + ` t using (select ? as service, ? as version from dual) s on (t.service = s.service) ` +
+			`when not matched then insert (service, version) values (s.service, s.version)`
+	case ClickHouse:
+		// A missing row reads as the initial version.
+		return ""
 	default: // PostgreSQL and SQLite both support ON CONFLICT.
 		return `insert into ` + m.table + ` (service, version) values (?, ?) on conflict (service) do nothing`
 	}
@@ -375,7 +409,11 @@ func (m *Migrator) upsertServiceSQL() string {
 // updateVersion sets the current version for the configured service. Uses
 // parameter binding to avoid SQL injection.
 func (m *Migrator) updateVersion(ctx context.Context, tx *sql.Tx, version string) error {
-	_, err := tx.ExecContext(ctx, fixQuery(m.flavor, `update `+m.table+` set version = ? where service = ?`), version, m.service)
+	query := `update ` + m.table + ` set version = ? where service = ?`
+	if m.flavor == ClickHouse {
+		query = `insert into ` + m.table + ` (version, service) values (?, ?)`
+	}
+	_, err := tx.ExecContext(ctx, fixQuery(m.flavor, query), version, m.service)
 	return err
 }
 
@@ -384,15 +422,19 @@ func (m *Migrator) updateVersion(ctx context.Context, tx *sql.Tx, version string
 // When no row exists yet (e.g. a service that has never migrated), it returns
 // the empty string so callers treat it as the initial version.
 func (m *Migrator) getCurrentVersion(ctx context.Context) (string, error) {
-	var version string
-	err := m.db.QueryRowContext(ctx, fixQuery(m.flavor, `select version from `+m.table+` where service = ?`), m.service).Scan(&version)
+	query := `select version from ` + m.table + ` where service = ?`
+	if m.flavor == ClickHouse {
+		query = `select version from ` + m.table + ` final where service = ?`
+	}
+	var version sql.NullString
+	err := m.db.QueryRowContext(ctx, fixQuery(m.flavor, query), m.service).Scan(&version)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return "", nil
 		}
 		return "", fmt.Errorf("error getting current migration version: %w", err)
 	}
-	return version, nil
+	return version.String, nil
 }
 
 func (m *Migrator) inTransaction(ctx context.Context, callback func(tx *sql.Tx) error) (err error) {
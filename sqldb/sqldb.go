@@ -224,8 +224,10 @@ func tagLookup(tag reflect.StructTag) string {
 }
 
 func fixQuery(flavor Flavor, query string) string {
-	// Only PostgreSQL uses positional $n placeholders; other flavors keep '?'.
-	if flavor != PostgreSQL {
+	// PostgreSQL, SQL Server and Oracle use numbered placeholders ($n, @pn
+	// and :n); other flavors keep '?'.
+	prefix := flavor.bindPrefix()
+	if prefix == "" {
 		return query
 	}
 	// Fast path: nothing to rewrite.
@@ -239,11 +241,19 @@ func fixQuery(flavor Flavor, query string) string {
 	for i := 0; i < len(query); i++ {
 		c := query[i]
 		switch c {
-		case '\'', '"':
+		case '\'', '"', '[':
 			// Copy a quoted span (string literal or quoted identifier) verbatim
 			// so a '?' inside it is never treated as a placeholder. Doubled
 			// quotes ('' or "") are in-span escapes, not terminators.
 			quote := c
+			if c == '[' {
+				// SQL Server bracketed identifiers, closed by ']'.
+				if flavor != SQLServer {
+					builder.WriteByte(c)
+					continue
+				}
+				quote = ']'
+			}
 			builder.WriteByte(c)
 			i++
 			for i < len(query) {
@@ -268,7 +278,7 @@ func fixQuery(flavor Flavor, query string) string {
 				continue
 			}
 			argNum++
-			builder.WriteByte('$')
+			builder.WriteString(prefix)
 			builder.WriteString(strconv.Itoa(argNum))
 		default:
 			builder.WriteByte(c)
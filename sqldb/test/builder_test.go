@@ -0,0 +1,290 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/sqldb"
+)
+
+func newShopDB(t *testing.T) *sqldb.DB {
+	r := assert.New(t)
+	db, err := sqldb.Open("sqlite3", ":memory:")
+	r.NoError(err)
+	t.Cleanup(func() { _ = db.Close() })
+	for _, query := range []string{
+		"CREATE TABLE users (id INTEGER, name TEXT, age INTEGER, email TEXT)",
+		"CREATE TABLE orders (id INTEGER, user_id INTEGER, total INTEGER)",
+		"INSERT INTO users VALUES (1, 'alice', 18, 'alice@example.com'), (2, 'bob', 25, NULL), (3, 'carol', 32, 'carol@example.com'), (4, 'dave', 40, NULL)",
+		"INSERT INTO orders VALUES (1, 1, 10), (2, 1, 20), (3, 3, 5), (4, 9, 7)",
+	} {
+		_, err = db.Exec(query)
+		r.NoError(err)
+	}
+	return db
+}
+
+func TestBuilderJoin(t *testing.T) {
+	r := assert.New(t)
+	db := newShopDB(t)
+
+	var rows []struct {
+		Name  string `db:"name"`
+		Total int    `db:"total"`
+	}
+	err := db.Table("users").Select("users.name", "orders.total").
+		Join("orders", "orders.user_id", "=", "users.id").
+		Where("orders.total", ">", 6).
+		OrderBy("orders.total", "ASC").
+		Scan(&rows)
+	r.NoError(err)
+	r.Len(rows, 2)
+	r.Equal("alice", rows[0].Name)
+	r.Equal(10, rows[0].Total)
+
+	var names []string
+	err = db.Table("users").Select("users.name").
+		LeftJoin("orders", "orders.user_id", "=", "users.id").
+		WhereNull("orders.id").
+		OrderBy("users.id", "ASC").
+		Scan(&names)
+	r.NoError(err)
+	r.DeepEqual([]string{"bob", "dave"}, names)
+
+	// Users with at least two orders.
+	var ids []int
+	err = db.Table("orders").Select("user_id").
+		GroupBy("user_id").
+		Having("COUNT(*)", ">=", 2).
+		Scan(&ids)
+	r.NoError(err)
+	r.DeepEqual([]int{1}, ids)
+}
+
+func TestBuilderConditions(t *testing.T) {
+	r := assert.New(t)
+	db := newShopDB(t)
+
+	names := func(b interface{ Scan(any) error }) []string {
+		var s []string
+		r.NoError(b.Scan(&s))
+		return s
+	}
+
+	r.DeepEqual([]string{"alice", "dave"}, names(db.Table("users").Select("name").
+		Where("age", "<", 20).
+		OrWhere("age", ">", 35).
+		OrderBy("id", "ASC")))
+
+	// age >= 20 AND (email IS NULL OR name = 'carol')
+	r.DeepEqual([]string{"bob", "carol", "dave"}, names(db.Table("users").Select("name").
+		Where("age", ">=", 20).
+		WhereGroup(func(g *sqldb.Builder) {
+			g.WhereNull("email").OrWhere("name", "=", "carol")
+		}).
+		OrderBy("id", "ASC")))
+	// An empty group adds nothing.
+	r.Len(names(db.Table("users").Select("name").WhereGroup(func(*sqldb.Builder) {})), 4)
+	r.DeepEqual([]string{"alice", "dave"}, names(db.Table("users").Select("name").
+		WhereGroup(func(g *sqldb.Builder) { g.Where("age", "<", 20) }).
+		OrWhereGroup(func(g *sqldb.Builder) { g.WhereNull("email").Where("age", ">", 35) }).
+		OrderBy("id", "ASC")))
+
+	r.DeepEqual([]string{"bob", "carol"}, names(db.Table("users").Select("name").
+		WhereIn("id", []int{2, 3, 5}).
+		OrderBy("id", "ASC")))
+	r.Len(names(db.Table("users").Select("name").WhereIn("id", []int{})), 0)
+	r.DeepEqual([]string{"alice", "bob"}, names(db.Table("users").Select("name").
+		WhereBetween("age", 18, 25).
+		WhereNotIn("id", []any{3}).
+		WhereNotIn("id", []string{}).
+		OrderBy("id", "ASC")))
+	r.DeepEqual([]string{"alice", "carol"}, names(db.Table("users").Select("name").
+		WhereNotNull("email").
+		OrderBy("id", "ASC")))
+}
+
+func TestBuilderSubquery(t *testing.T) {
+	r := assert.New(t)
+	db := newShopDB(t)
+
+	// Placeholders of subqueries are bound in order with the outer ones.
+	var names []string
+	err := db.Table("users").Select("name").
+		Where("age", ">", 10).
+		WhereIn("id", db.Table("orders").Select("user_id").Where("total", ">", 6)).
+		Where("name", "<>", "bob").
+		Scan(&names)
+	r.NoError(err)
+	r.DeepEqual([]string{"alice"}, names)
+
+	err = db.Table("users").Select("name").
+		WhereNotIn("id", db.Table("orders").Select("user_id")).
+		OrderBy("id", "ASC").
+		Scan(&names)
+	r.NoError(err)
+	r.DeepEqual([]string{"bob", "dave"}, names)
+
+	var name string
+	err = db.Table("users").Select("name").
+		Where("age", "=", db.Table("users").Select("MAX(age)")).
+		Scan(&name)
+	r.NoError(err)
+	r.Equal("dave", name)
+
+	count, err := db.Table("users").
+		Where("age", "<", 30).
+		WhereIn("id", db.Table("orders").Select("user_id")).
+		Count()
+	r.NoError(err)
+	r.Equal(1, count)
+
+	_, err = db.Table("users").
+		WhereIn("id", db.Table("orders").Select("user_id").Where("total", "<", 6)).
+		Update(map[string]any{"age": 33})
+	r.NoError(err)
+	var age int
+	r.NoError(db.QueryScan(&age, "SELECT age FROM users WHERE id = 3"))
+	r.Equal(33, age)
+}
+
+func TestBuilderDelete(t *testing.T) {
+	r := assert.New(t)
+	db := newShopDB(t)
+
+	_, err := db.Table("orders").Delete()
+	r.Error(err)
+	res, err := db.Table("orders").
+		WhereNotIn("user_id", db.Table("users").Select("id")).
+		OrWhere("total", "<", 6).
+		Delete()
+	r.NoError(err)
+	affected, err := res.RowsAffected()
+	r.NoError(err)
+	r.Equal(int64(2), affected)
+	count, err := db.Table("orders").Count()
+	r.NoError(err)
+	r.Equal(2, count)
+}
+
+type order struct {
+	ID     int `db:"id"`
+	UserID int `db:"user_id"`
+	Total  int `db:"total"`
+}
+
+func TestBuilderInsertMany(t *testing.T) {
+	r := assert.New(t)
+	db := newShopDB(t)
+
+	// More rows than fit in one statement.
+	orders := make([]*order, 20000)
+	for i := range orders {
+		orders[i] = &order{ID: 100 + i, UserID: 2, Total: i}
+	}
+	err := db.Transaction(func(tx *sqldb.Tx) error {
+		res, err := tx.Table("orders").InsertMany(orders)
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		r.NoError(err)
+		r.Equal(int64(len(orders)), affected)
+		return nil
+	})
+	r.NoError(err)
+	count, err := db.Table("orders").Where("user_id", "=", 2).Count()
+	r.NoError(err)
+	r.Equal(len(orders), count)
+
+	res, err := db.Table("users").InsertMany([]map[string]any{
+		{"id": 5, "name": "erin", "age": 20, "email": nil},
+		{"id": 6, "name": "frank", "age": 21, "email": "frank@example.com"},
+	})
+	r.NoError(err)
+	affected, err := res.RowsAffected()
+	r.NoError(err)
+	r.Equal(int64(2), affected)
+
+	_, err = db.Table("users").InsertMany([]map[string]any{{"id": 7}, {"name": "gina"}})
+	r.Error(err)
+	_, err = db.Table("users").InsertMany([]order{})
+	r.Error(err)
+}
+
+func TestBuilderUpsert(t *testing.T) {
+	r := assert.New(t)
+	db := newShopDB(t)
+	_, err := db.Exec("CREATE UNIQUE INDEX users_id ON users (id)")
+	r.NoError(err)
+
+	_, err = db.Table("users").Upsert([]map[string]any{
+		{"id": 1, "name": "alice", "age": 19},
+		{"id": 7, "name": "gina", "age": 50},
+	}, []string{"id"}, []string{"age"})
+	r.NoError(err)
+	var ages []int
+	r.NoError(db.Table("users").Select("age").WhereIn("id", []int{1, 7}).OrderBy("id", "ASC").Scan(&ages))
+	r.DeepEqual([]int{19, 50}, ages)
+
+	// Every other column is updated by default.
+	_, err = db.Table("users").Upsert(map[string]any{"id": 7, "name": "Gina", "age": 51}, []string{"id"}, nil)
+	r.NoError(err)
+	var name string
+	r.NoError(db.Table("users").Select("name").Where("id", "=", 7).Scan(&name))
+	r.Equal("Gina", name)
+	_, err = db.Table("users").Upsert(map[string]any{"id": 7}, []string{"id"}, nil)
+	r.NoError(err)
+	_, err = db.Table("users").Upsert(map[string]any{"id": 7}, nil, []string{"age"})
+	r.Error(err)
+
+	// The statements of the other flavors, as logged.
+	for _, tt := range []struct {
+		flavor sqldb.Flavor
+		query  string
+	}{
+		{sqldb.MySQL, "INSERT INTO `users` (`age`, `id`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `age` = VALUES(`age`)"},
+		{sqldb.PostgreSQL, `INSERT INTO "users" ("age", "id") VALUES ($1, $2) ON CONFLICT ("id") DO UPDATE SET "age" = "excluded"."age"`},
+	} {
+		var query string
+		other := sqldb.NewSqlDB(db.DB, tt.flavor, sqldb.WithDebug(true), sqldb.WithLog(func(_ string, args ...any) {
+			query = args[0].(string)
+		}))
+		_, _ = other.Table("users").Upsert(map[string]any{"id": 1, "age": 20}, []string{"id"}, nil)
+		r.Equal(tt.query, query, tt.flavor)
+	}
+}
+
+func TestBuilderContext(t *testing.T) {
+	r := assert.New(t)
+	db := newShopDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var names []string
+	r.ErrorIs(db.Table("users").WithContext(ctx).Scan(&names), context.Canceled)
+	_, err := db.Table("users").WithContext(ctx).Count()
+	r.ErrorIs(err, context.Canceled)
+	_, err = db.Table("users").WithContext(ctx).Insert(map[string]any{"id": 9})
+	r.ErrorIs(err, context.Canceled)
+	_, err = db.Table("users").WithContext(ctx).InsertMany([]map[string]any{{"id": 9}})
+	r.ErrorIs(err, context.Canceled)
+	_, err = db.Table("users").WithContext(ctx).Where("id", "=", 1).Update(map[string]any{"age": 1})
+	r.ErrorIs(err, context.Canceled)
+	_, err = db.Table("users").WithContext(ctx).Where("id", "=", 1).Delete()
+	r.ErrorIs(err, context.Canceled)
+
+	err = db.TransactionContext(ctx, func(tx *sqldb.Tx) error { return nil })
+	r.ErrorIs(err, context.Canceled)
+	// Inside a transaction the builder honors its own context.
+	err = db.Transaction(func(tx *sqldb.Tx) error {
+		_, err := tx.Table("users").WithContext(ctx).Where("id", "=", 1).Delete()
+		return err
+	})
+	r.ErrorIs(err, context.Canceled)
+
+	count, err := db.Table("users").WithContext(context.Background()).Count()
+	r.NoError(err)
+	r.Equal(4, count)
+}
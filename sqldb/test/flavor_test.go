@@ -0,0 +1,208 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dnsoa/go/assert"
+	"github.com/dnsoa/go/sqldb"
+)
+
+func init() {
+	sql.Register("recorder", recorderDriver{})
+}
+
+// recorders holds the statements run through the recorder driver by data
+// source name.
+var recorders sync.Map // string -> *recorder
+
+// recorder is the connection of the recorder driver: it records the
+// statements it is given, affects no rows and returns none.
+type recorder struct {
+	mu      sync.Mutex
+	queries []string
+}
+
+type recorderDriver struct{}
+
+func (recorderDriver) Open(name string) (driver.Conn, error) {
+	rec, _ := recorders.LoadOrStore(name, new(recorder))
+	return rec.(*recorder), nil
+}
+
+func (rec *recorder) record(query string) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.queries = append(rec.queries, query)
+}
+
+func (rec *recorder) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("recorder: prepare not supported")
+}
+
+func (rec *recorder) Close() error              { return nil }
+func (rec *recorder) Begin() (driver.Tx, error) { return rec, nil }
+func (rec *recorder) Commit() error             { return nil }
+func (rec *recorder) Rollback() error           { return nil }
+
+func (rec *recorder) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	rec.record(query)
+	return driver.RowsAffected(0), nil
+}
+
+func (rec *recorder) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	rec.record(query)
+	return noRows{}, nil
+}
+
+type noRows struct{}
+
+func (noRows) Columns() []string         { return []string{"x"} }
+func (noRows) Close() error              { return nil }
+func (noRows) Next([]driver.Value) error { return io.EOF }
+
+// newRecorderDB returns a DB of flavor on the recorder driver and a function
+// returning the statements run on it so far.
+func newRecorderDB(t *testing.T, flavor sqldb.Flavor) (*sqldb.DB, func() []string) {
+	r := assert.New(t)
+	name := t.Name() + "/" + flavor.String()
+	sqlDB, err := sql.Open("recorder", name)
+	r.NoError(err)
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+		recorders.Delete(name)
+	})
+	return sqldb.NewSqlDB(sqlDB, flavor), func() []string {
+		v, ok := recorders.Load(name)
+		if !ok {
+			return nil
+		}
+		rec := v.(*recorder)
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		return append([]string(nil), rec.queries...)
+	}
+}
+
+func TestFlavorSelect(t *testing.T) {
+	r := assert.New(t)
+	for _, tt := range []struct {
+		flavor          sqldb.Flavor
+		query, unsorted string
+	}{
+		{
+			sqldb.MySQL,
+			"SELECT `name`, `users`.`age` FROM `users` WHERE `age` > ? AND `id` IN (?,?) ORDER BY name ASC LIMIT 10 OFFSET 20",
+			"SELECT * FROM `users` LIMIT 5",
+		},
+		{
+			sqldb.SQLServer,
+			"SELECT [name], [users].[age] FROM [users] WHERE [age] > @p1 AND [id] IN (@p2,@p3) ORDER BY name ASC OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY",
+			"SELECT * FROM [users] ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 5 ROWS ONLY",
+		},
+		{
+			sqldb.Oracle,
+			`SELECT "name", "users"."age" FROM "users" WHERE "age" > :1 AND "id" IN (:2,:3) ORDER BY name ASC OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY`,
+			`SELECT * FROM "users" FETCH NEXT 5 ROWS ONLY`,
+		},
+		{
+			sqldb.ClickHouse,
+			"SELECT `name`, `users`.`age` FROM `users` WHERE `age` > ? AND `id` IN (?,?) ORDER BY name ASC LIMIT 10 OFFSET 20",
+			"SELECT * FROM `users` LIMIT 5",
+		},
+	} {
+		db, queries := newRecorderDB(t, tt.flavor)
+		var names []string
+		r.NoError(db.Table("users").Select("name", "users.age").
+			Where("age", ">", 10).
+			WhereIn("id", []int{1, 2}).
+			OrderBy("name", "ASC").
+			Limit(10).
+			Offset(20).
+			Scan(&names))
+		r.NoError(db.Table("users").Limit(5).Scan(&names))
+		r.DeepEqual([]string{tt.query, tt.unsorted}, queries(), tt.flavor)
+	}
+}
+
+func TestFlavorPlaceholders(t *testing.T) {
+	r := assert.New(t)
+	db, queries := newRecorderDB(t, sqldb.SQLServer)
+	_, err := db.Exec("SELECT [a?]]?], 'b?' FROM t WHERE x = ? AND y = ?", 1, 2)
+	r.NoError(err)
+	r.DeepEqual([]string{"SELECT [a?]]?], 'b?' FROM t WHERE x = @p1 AND y = @p2"}, queries())
+
+	// Brackets are not quotes in other flavors.
+	db, queries = newRecorderDB(t, sqldb.PostgreSQL)
+	_, err = db.Exec("SELECT a[?] FROM t WHERE x = ?", 1, 2)
+	r.NoError(err)
+	r.DeepEqual([]string{"SELECT a[$1] FROM t WHERE x = $2"}, queries())
+}
+
+func TestFlavorWrite(t *testing.T) {
+	r := assert.New(t)
+
+	db, queries := newRecorderDB(t, sqldb.Oracle)
+	_, err := db.Table("users").InsertMany([]map[string]any{{"id": 1, "name": "a"}, {"id": 2, "name": "b"}})
+	r.NoError(err)
+	r.DeepEqual([]string{`INSERT ALL INTO "users" ("id", "name") VALUES (:1, :2) INTO "users" ("id", "name") VALUES (:3, :4) SELECT 1 FROM DUAL`}, queries())
+
+	// SQL Server takes at most 1000 rows per INSERT.
+	db, queries = newRecorderDB(t, sqldb.SQLServer)
+	rows := make([]map[string]any, 1500)
+	for i := range rows {
+		rows[i] = map[string]any{"id": i}
+	}
+	_, err = db.Table("users").InsertMany(rows)
+	r.NoError(err)
+	r.Len(queries(), 2)
+	r.True(strings.HasPrefix(queries()[0], "INSERT INTO [users] ([id]) VALUES (@p1), (@p2)"))
+	r.True(strings.HasSuffix(queries()[1], "(@p499), (@p500)"))
+	_, err = db.Table("users").Upsert(rows, []string{"id"}, nil)
+	r.Error(err)
+
+	db, queries = newRecorderDB(t, sqldb.ClickHouse)
+	_, err = db.Table("users").Where("id", "=", 1).Update(map[string]any{"age": 2})
+	r.NoError(err)
+	r.DeepEqual([]string{"ALTER TABLE `users` UPDATE `age`=? WHERE `id` = ?"}, queries())
+}
+
+func TestFlavorMigration(t *testing.T) {
+	r := assert.New(t)
+	ctx := context.Background()
+	for _, tt := range []struct {
+		flavor sqldb.Flavor
+		// The statements up to the first migration.
+		queries []string
+	}{
+		{sqldb.SQLServer, []string{
+			"if object_id(N'migrations', N'U') is null create table migrations (service nvarchar(255) not null primary key, version nvarchar(255) not null)",
+			"merge into migrations as t using (select @p1 as service, @p2 as version) as s on t.service = s.service when not matched then insert (service, version) values (s.service, s.version);",
+			"select version from migrations where service = @p1",
+			"update migrations set version = @p1 where service = @p2",
+		}},
+		{sqldb.Oracle, []string{
+			"begin execute immediate 'create table migrations (service varchar2(255) not null primary key, version varchar2(255))'; exception when others then if sqlcode != -955 then raise; end if; end;",
+			"merge into migrations t using (select :1 as service, :2 as version from dual) s on (t.service = s.service) when not matched then insert (service, version) values (s.service, s.version)",
+			"select version from migrations where service = :1",
+			"update migrations set version = :1 where service = :2",
+		}},
+		{sqldb.ClickHouse, []string{
+			"create table if not exists migrations (service String, version String, applied DateTime64(9) default now64(9)) engine = ReplacingMergeTree(applied) order by service",
+			"select version from migrations final where service = ?",
+			"insert into migrations (version, service) values (?, ?)",
+		}},
+	} {
+		db, queries := newRecorderDB(t, tt.flavor)
+		r.NoError(db.MigrateUp(ctx, subFS(svcAFS, "testdata/svc-a")))
+		got := queries()
+		r.True(len(got) > len(tt.queries), tt.flavor)
+		r.DeepEqual(tt.queries, got[:len(tt.queries)], tt.flavor)
+	}
+}
@@ -88,3 +88,10 @@ func (tx *Tx) PrepareContext(ctx context.Context, query string) (*sql.Stmt, erro
 func (tx *Tx) QueryScan(dest any, query string, args ...any) error {
 	return Scan(tx, dest, query, args...)
 }
+
+// Table starts a new query builder for the given table in the transaction.
+func (tx *Tx) Table(table string) *Builder {
+	b := newBuilder(tx.Flavor, tx)
+	b.table = table
+	return b
+}